@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// ApplicationDropletNotFoundError is returned when the requested droplet is
+// not a droplet of the application.
+type ApplicationDropletNotFoundError struct {
+	AppName     string
+	DropletGUID string
+}
+
+func (e ApplicationDropletNotFoundError) Error() string {
+	return fmt.Sprintf("Droplet %s is not a droplet of app %s", e.DropletGUID, e.AppName)
+}
@@ -0,0 +1,11 @@
+package actionerror
+
+// DropletProcessingFailedError is returned when an uploaded droplet ends up
+// in the FAILED or EXPIRED state.
+type DropletProcessingFailedError struct {
+	State string
+}
+
+func (e DropletProcessingFailedError) Error() string {
+	return "Droplet processing failed with state " + e.State
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// NoCurrentDropletError is returned when an application does not have a
+// current droplet.
+type NoCurrentDropletError struct {
+	AppName string
+}
+
+func (e NoCurrentDropletError) Error() string {
+	return fmt.Sprintf("App %s does not have a current droplet", e.AppName)
+}
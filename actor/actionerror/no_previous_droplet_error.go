@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// NoPreviousDropletError is returned when an application has no staged
+// droplet far enough before its current droplet to roll back to.
+type NoPreviousDropletError struct {
+	AppName string
+}
+
+func (e NoPreviousDropletError) Error() string {
+	return fmt.Sprintf("App %s has no earlier staged droplet to roll back to", e.AppName)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// NoRollbackToUndoError is returned when no rollback of an application was
+// recorded, or the application's droplet has changed since.
+type NoRollbackToUndoError struct {
+	AppName string
+}
+
+func (e NoRollbackToUndoError) Error() string {
+	return fmt.Sprintf("No rollback of app %s to undo", e.AppName)
+}
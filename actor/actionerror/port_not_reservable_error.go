@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// PortNotReservableError is returned when a TCP route port falls outside of
+// the reservable port ranges of the domain's router group.
+type PortNotReservableError struct {
+	Port            int
+	RouterGroup     string
+	ReservablePorts string
+}
+
+func (e PortNotReservableError) Error() string {
+	return fmt.Sprintf("Port %d is not in the reservable ports (%s) of router group %s", e.Port, e.ReservablePorts, e.RouterGroup)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// RollbackDropletNotFoundError is returned when the droplet requested for a
+// rollback is not a staged droplet of the application.
+type RollbackDropletNotFoundError struct {
+	AppName     string
+	DropletGUID string
+}
+
+func (e RollbackDropletNotFoundError) Error() string {
+	return fmt.Sprintf("Droplet %s is not a staged droplet of app %s", e.DropletGUID, e.AppName)
+}
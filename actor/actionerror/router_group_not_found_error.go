@@ -0,0 +1,20 @@
+package actionerror
+
+import "fmt"
+
+// RouterGroupNotFoundError is returned when a router group cannot be found.
+type RouterGroupNotFoundError struct {
+	Name string
+	GUID string
+}
+
+func (e RouterGroupNotFoundError) Error() string {
+	switch {
+	case e.Name != "":
+		return fmt.Sprintf("Router group %s not found", e.Name)
+	case e.GUID != "":
+		return fmt.Sprintf("Router group with GUID %s not found", e.GUID)
+	default:
+		return "Router group not found"
+	}
+}
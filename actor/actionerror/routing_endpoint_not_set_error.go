@@ -0,0 +1,10 @@
+package actionerror
+
+// RoutingEndpointNotSetError is returned when the targeted Cloud Controller
+// does not advertise a Routing API endpoint.
+type RoutingEndpointNotSetError struct {
+}
+
+func (e RoutingEndpointNotSetError) Error() string {
+	return "Routing endpoint not set"
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// ServiceInstanceProvisioningFailedError is returned when the service broker
+// fails to provision a service instance.
+type ServiceInstanceProvisioningFailedError struct {
+	Name   string
+	Reason string
+}
+
+func (e ServiceInstanceProvisioningFailedError) Error() string {
+	return fmt.Sprintf("Provisioning service instance '%s' failed: %s", e.Name, e.Reason)
+}
@@ -0,0 +1,18 @@
+package actionerror
+
+import (
+	"fmt"
+	"time"
+)
+
+// ServiceInstanceProvisioningTimeoutError is returned when the polling
+// timeout is reached waiting for a service broker to provision a service
+// instance.
+type ServiceInstanceProvisioningTimeoutError struct {
+	Name    string
+	Timeout time.Duration
+}
+
+func (e ServiceInstanceProvisioningTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for service instance '%s' to be provisioned", e.Name)
+}
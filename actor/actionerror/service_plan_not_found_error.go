@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// ServicePlanNotFoundError is returned when a service offering has no plan
+// with the requested name.
+type ServicePlanNotFoundError struct {
+	PlanName    string
+	ServiceName string
+}
+
+func (e ServicePlanNotFoundError) Error() string {
+	return fmt.Sprintf("Service plan '%s' not found for service offering '%s'", e.PlanName, e.ServiceName)
+}
@@ -0,0 +1,175 @@
+package orgaction
+
+import "code.cloudfoundry.org/cli/util/roster"
+
+// RoleAssignment is a role held by a user in an organization, or in a space
+// when Space is set.
+type RoleAssignment struct {
+	Username string
+	UserGUID string
+	Org      string
+	OrgGUID  string
+	Space    string
+
+	// SpaceGUID is empty for org roles.
+	SpaceGUID string
+	Role      roster.Role
+}
+
+// GetUserRoleAssignments returns every org and space role the user holds in
+// the organizations visible to the current user. The spaces of an
+// organization are only searched when the user holds a role in it.
+func (actor Actor) GetUserRoleAssignments(username string) ([]RoleAssignment, Warnings, error) {
+	var allWarnings Warnings
+
+	orgs, warnings, err := actor.V2Actor.GetOrganizations()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var assignments []RoleAssignment
+	for _, org := range orgs {
+		rosterOrg, orgWarnings, err := actor.getOrgRoleHolders(org)
+		allWarnings = append(allWarnings, orgWarnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		orgAssignments := rosterOrg.assignments(username, "")
+		if len(orgAssignments) == 0 {
+			continue
+		}
+		assignments = append(assignments, orgAssignments...)
+
+		spaces, warnings, err := actor.V2Actor.GetOrganizationSpaces(org.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, space := range spaces {
+			rosterSpace, spaceWarnings, err := actor.getSpaceRoleHolders(space)
+			allWarnings = append(allWarnings, spaceWarnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			assignments = append(assignments, rosterSpace.assignments(rosterOrg, username, "")...)
+		}
+	}
+
+	return assignments, allWarnings, nil
+}
+
+// GetRoleHolders returns the users holding the role in the organization, or
+// in each of its spaces for space roles.
+func (actor Actor) GetRoleHolders(orgName string, role roster.Role) ([]RoleAssignment, Warnings, error) {
+	var allWarnings Warnings
+
+	rosterOrg, orgWarnings, err := actor.getRosterOrg(orgName)
+	allWarnings = append(allWarnings, orgWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if !role.IsSpaceRole() {
+		return rosterOrg.assignments("", role), allWarnings, nil
+	}
+
+	spaces, warnings, err := actor.V2Actor.GetOrganizationSpaces(rosterOrg.guid)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var assignments []RoleAssignment
+	for _, space := range spaces {
+		rosterSpace, spaceWarnings, err := actor.getSpaceRoleHolders(space)
+		allWarnings = append(allWarnings, spaceWarnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		assignments = append(assignments, rosterSpace.assignments(rosterOrg, "", role)...)
+	}
+
+	return assignments, allWarnings, nil
+}
+
+// RevokeRoleAssignments removes the roles. Space roles are removed first and
+// the org user role last, as the Cloud Controller does not remove a user from
+// an organization while they hold roles in it.
+func (actor Actor) RevokeRoleAssignments(assignments []RoleAssignment) (Warnings, error) {
+	var spaceChanges, orgChanges, orgUserChanges []RoleChange
+	for _, assignment := range assignments {
+		change := RoleChange{
+			Type:      RevokeRole,
+			Username:  assignment.Username,
+			UserGUID:  assignment.UserGUID,
+			Org:       assignment.Org,
+			OrgGUID:   assignment.OrgGUID,
+			Space:     assignment.Space,
+			SpaceGUID: assignment.SpaceGUID,
+			Role:      assignment.Role,
+		}
+
+		switch {
+		case assignment.Role.IsSpaceRole():
+			spaceChanges = append(spaceChanges, change)
+		case assignment.Role == roster.OrgUser:
+			orgUserChanges = append(orgUserChanges, change)
+		default:
+			orgChanges = append(orgChanges, change)
+		}
+	}
+
+	changes := append(append(spaceChanges, orgChanges...), orgUserChanges...)
+	return actor.ApplyRolePlan(changes)
+}
+
+// assignments returns the org roles held by the user with the username, or
+// held by anyone when username is empty; role limits them to one role.
+func (org *rosterOrg) assignments(username string, role roster.Role) []RoleAssignment {
+	var assignments []RoleAssignment
+	for _, orgRole := range orgRoles {
+		if role != "" && orgRole.role != role {
+			continue
+		}
+		for _, user := range org.holders[orgRole.role] {
+			if username != "" && user.Username != username {
+				continue
+			}
+			assignments = append(assignments, RoleAssignment{
+				Username: user.Username,
+				UserGUID: user.GUID,
+				Org:      org.name,
+				OrgGUID:  org.guid,
+				Role:     orgRole.role,
+			})
+		}
+	}
+	return assignments
+}
+
+func (space *rosterSpace) assignments(org *rosterOrg, username string, role roster.Role) []RoleAssignment {
+	var assignments []RoleAssignment
+	for _, spaceRole := range spaceRoles {
+		if role != "" && spaceRole.role != role {
+			continue
+		}
+		for _, user := range space.holders[spaceRole.role] {
+			if username != "" && user.Username != username {
+				continue
+			}
+			assignments = append(assignments, RoleAssignment{
+				Username:  user.Username,
+				UserGUID:  user.GUID,
+				Org:       org.name,
+				OrgGUID:   org.guid,
+				Space:     space.name,
+				SpaceGUID: space.guid,
+				Role:      spaceRole.role,
+			})
+		}
+	}
+	return assignments
+}
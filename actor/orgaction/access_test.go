@@ -0,0 +1,135 @@
+package orgaction_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/orgaction"
+	"code.cloudfoundry.org/cli/actor/orgaction/orgactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/roster"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Access Actions", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *orgactionfakes.FakeV2Actor
+		fakeV3Actor *orgactionfakes.FakeV3Actor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(orgactionfakes.FakeV2Actor)
+		fakeV3Actor = new(orgactionfakes.FakeV3Actor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor)
+
+		fakeV2Actor.GetOrganizationUsersByRoleStub = func(role constant.OrgRole, orgGUID string) ([]v2action.User, v2action.Warnings, error) {
+			if orgGUID != "org-1-guid" {
+				return []v2action.User{{GUID: "bob-guid", Username: "bob"}}, nil, nil
+			}
+			switch role {
+			case constant.OrgUserRole:
+				return []v2action.User{{GUID: "alice-guid", Username: "alice"}, {GUID: "bob-guid", Username: "bob"}}, nil, nil
+			case constant.OrgAuditorRole:
+				return []v2action.User{{GUID: "alice-guid", Username: "alice"}}, nil, nil
+			}
+			return nil, nil, nil
+		}
+		fakeV2Actor.GetOrganizationSpacesReturns(
+			[]v2action.Space{{GUID: "dev-guid", Name: "dev"}, {GUID: "prod-guid", Name: "prod"}},
+			v2action.Warnings{"spaces-warning"},
+			nil,
+		)
+		fakeV2Actor.GetSpaceUsersByRoleStub = func(role constant.SpaceRole, spaceGUID string) ([]v2action.User, v2action.Warnings, error) {
+			if role == constant.SpaceDeveloperRole {
+				if spaceGUID == "dev-guid" {
+					return []v2action.User{{GUID: "alice-guid", Username: "alice"}, {GUID: "bob-guid", Username: "bob"}}, nil, nil
+				}
+				return []v2action.User{{GUID: "bob-guid", Username: "bob"}}, nil, nil
+			}
+			return nil, nil, nil
+		}
+	})
+
+	Describe("GetUserRoleAssignments", func() {
+		BeforeEach(func() {
+			fakeV2Actor.GetOrganizationsReturns(
+				[]v2action.Organization{{GUID: "org-1-guid", Name: "org-1"}, {GUID: "org-2-guid", Name: "org-2"}},
+				v2action.Warnings{"orgs-warning"},
+				nil,
+			)
+		})
+
+		It("returns every org and space role the user holds", func() {
+			assignments, warnings, err := actor.GetUserRoleAssignments("alice")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("orgs-warning", "spaces-warning"))
+			Expect(assignments).To(Equal([]RoleAssignment{
+				{Username: "alice", UserGUID: "alice-guid", Org: "org-1", OrgGUID: "org-1-guid", Role: roster.OrgUser},
+				{Username: "alice", UserGUID: "alice-guid", Org: "org-1", OrgGUID: "org-1-guid", Role: roster.OrgAuditor},
+				{Username: "alice", UserGUID: "alice-guid", Org: "org-1", OrgGUID: "org-1-guid", Space: "dev", SpaceGUID: "dev-guid", Role: roster.SpaceDeveloper},
+			}))
+
+			By("only searching the spaces of orgs the user belongs to")
+			Expect(fakeV2Actor.GetOrganizationSpacesCallCount()).To(Equal(1))
+			Expect(fakeV2Actor.GetOrganizationSpacesArgsForCall(0)).To(Equal("org-1-guid"))
+		})
+	})
+
+	Describe("GetRoleHolders", func() {
+		BeforeEach(func() {
+			fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{GUID: "org-1-guid", Name: "org-1"}, v2action.Warnings{"org-warning"}, nil)
+		})
+
+		Context("when the role is an org role", func() {
+			It("returns the holders in the org", func() {
+				assignments, warnings, err := actor.GetRoleHolders("org-1", roster.OrgAuditor)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("org-warning"))
+				Expect(assignments).To(Equal([]RoleAssignment{
+					{Username: "alice", UserGUID: "alice-guid", Org: "org-1", OrgGUID: "org-1-guid", Role: roster.OrgAuditor},
+				}))
+				Expect(fakeV2Actor.GetOrganizationSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the role is a space role", func() {
+			It("returns the holders in each space of the org", func() {
+				assignments, warnings, err := actor.GetRoleHolders("org-1", roster.SpaceDeveloper)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("org-warning", "spaces-warning"))
+				Expect(assignments).To(Equal([]RoleAssignment{
+					{Username: "alice", UserGUID: "alice-guid", Org: "org-1", OrgGUID: "org-1-guid", Space: "dev", SpaceGUID: "dev-guid", Role: roster.SpaceDeveloper},
+					{Username: "bob", UserGUID: "bob-guid", Org: "org-1", OrgGUID: "org-1-guid", Space: "dev", SpaceGUID: "dev-guid", Role: roster.SpaceDeveloper},
+					{Username: "bob", UserGUID: "bob-guid", Org: "org-1", OrgGUID: "org-1-guid", Space: "prod", SpaceGUID: "prod-guid", Role: roster.SpaceDeveloper},
+				}))
+			})
+		})
+	})
+
+	Describe("RevokeRoleAssignments", func() {
+		It("removes space roles first and the org user role last", func() {
+			var removed []string
+			fakeV2Actor.RemoveOrganizationRoleStub = func(role constant.OrgRole, _ string, _ string) (v2action.Warnings, error) {
+				removed = append(removed, string(role))
+				return v2action.Warnings{"remove-org-role-warning"}, nil
+			}
+			fakeV2Actor.RemoveSpaceRoleStub = func(role constant.SpaceRole, _ string, _ string) (v2action.Warnings, error) {
+				removed = append(removed, string(role))
+				return v2action.Warnings{"remove-space-role-warning"}, nil
+			}
+
+			warnings, err := actor.RevokeRoleAssignments([]RoleAssignment{
+				{UserGUID: "alice-guid", OrgGUID: "org-1-guid", Role: roster.OrgUser},
+				{UserGUID: "alice-guid", OrgGUID: "org-1-guid", Role: roster.OrgAuditor},
+				{UserGUID: "alice-guid", OrgGUID: "org-1-guid", SpaceGUID: "dev-guid", Role: roster.SpaceDeveloper},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(Equal(Warnings{"remove-space-role-warning", "remove-org-role-warning", "remove-org-role-warning"}))
+			Expect(removed).To(Equal([]string{"developers", "auditors", "users"}))
+
+			_, orgGUID, userGUID := fakeV2Actor.RemoveOrganizationRoleArgsForCall(1)
+			Expect(orgGUID).To(Equal("org-1-guid"))
+			Expect(userGUID).To(Equal("alice-guid"))
+		})
+	})
+})
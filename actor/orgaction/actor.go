@@ -0,0 +1,32 @@
+// Package orgaction contains the business logic for converging an
+// organization and its spaces to a declared configuration.
+package orgaction
+
+import "code.cloudfoundry.org/cli/util/orgconfig"
+
+// Warnings is a list of warnings returned back from the cloud controller
+type Warnings []string
+
+// Actor handles all business logic for org configuration operations.
+type Actor struct {
+	V2Actor V2Actor
+	V3Actor V3Actor
+}
+
+// NewActor returns a new actor.
+func NewActor(v2Actor V2Actor, v3Actor V3Actor) *Actor {
+	return &Actor{
+		V2Actor: v2Actor,
+		V3Actor: v3Actor,
+	}
+}
+
+// ReadOrgConfig reads and validates the org config at the provided path.
+func (Actor) ReadOrgConfig(pathToConfig string) (orgconfig.Org, error) {
+	return orgconfig.ReadOrgConfig(pathToConfig)
+}
+
+// WriteOrgConfig writes the org config to the provided path.
+func (Actor) WriteOrgConfig(config orgconfig.Org, pathToConfig string) error {
+	return orgconfig.WriteOrgConfig(config, pathToConfig)
+}
@@ -0,0 +1,175 @@
+package orgaction
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/orgconfig"
+)
+
+// Apply makes the changes of the plan in order. Names are resolved to GUIDs
+// as the changes are made, so a plan can refer to the organization, spaces
+// and space quotas it creates.
+func (actor Actor) Apply(plan Plan) (Warnings, error) {
+	var allWarnings Warnings
+
+	applier := planApplier{
+		actor:           actor,
+		orgGUID:         plan.OrgGUID,
+		spaceGUIDs:      map[string]string{},
+		spaceQuotaGUIDs: map[string]string{},
+	}
+
+	for _, change := range plan.Changes {
+		warnings, err := applier.apply(plan.OrgName, change)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+type planApplier struct {
+	actor           Actor
+	orgGUID         string
+	spaceGUIDs      map[string]string
+	spaceQuotaGUIDs map[string]string
+}
+
+func (applier *planApplier) apply(orgName string, change Change) (Warnings, error) {
+	v2Actor := applier.actor.V2Actor
+	v3Actor := applier.actor.V3Actor
+
+	switch change.Type {
+	case CreateOrganization:
+		org, warnings, err := v2Actor.CreateOrganization(change.Name, "")
+		applier.orgGUID = org.GUID
+		return Warnings(warnings), err
+
+	case SetOrganizationQuota:
+		quota, warnings, err := v2Actor.GetOrganizationQuotaByName(change.Name)
+		if err != nil {
+			return Warnings(warnings), err
+		}
+		updateWarnings, err := v2Actor.UpdateOrganizationQuota(applier.orgGUID, quota.GUID)
+		return append(Warnings(warnings), updateWarnings...), err
+
+	case EntitleIsolationSegment:
+		warnings, err := v3Actor.EntitleIsolationSegmentToOrganizationByName(change.Name, orgName)
+		return Warnings(warnings), err
+
+	case CreatePrivateDomain:
+		_, warnings, err := v2Actor.CreatePrivateDomain(change.Name, applier.orgGUID)
+		return Warnings(warnings), err
+
+	case AssignOrganizationRole:
+		warnings, err := v2Actor.SetOrganizationRole(constant.OrgRole(change.Role), applier.orgGUID, change.Name)
+		return Warnings(warnings), err
+
+	case CreateSpaceQuota:
+		spaceQuota := toV2SpaceQuota(change.SpaceQuota)
+		spaceQuota.OrganizationGUID = applier.orgGUID
+		created, warnings, err := v2Actor.CreateSpaceQuota(spaceQuota)
+		applier.spaceQuotaGUIDs[created.Name] = created.GUID
+		return Warnings(warnings), err
+
+	case UpdateSpaceQuota:
+		spaceQuotaGUID, warnings, err := applier.spaceQuotaGUID(change.Name)
+		if err != nil {
+			return warnings, err
+		}
+		spaceQuota := toV2SpaceQuota(change.SpaceQuota)
+		spaceQuota.GUID = spaceQuotaGUID
+		_, updateWarnings, err := v2Actor.UpdateSpaceQuota(spaceQuota)
+		return append(warnings, updateWarnings...), err
+
+	case CreateSpace:
+		space, warnings, err := v2Actor.CreateSpace(change.Space, applier.orgGUID)
+		applier.spaceGUIDs[change.Space] = space.GUID
+		return Warnings(warnings), err
+	}
+
+	spaceGUID, allWarnings, err := applier.spaceGUID(change.Space)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	switch change.Type {
+	case SetSpaceQuota:
+		spaceQuotaGUID, warnings, err := applier.spaceQuotaGUID(change.Name)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+		setWarnings, err := v2Actor.SetSpaceQuota(spaceGUID, spaceQuotaGUID)
+		return append(allWarnings, setWarnings...), err
+
+	case AssignSpaceIsolationSegment:
+		warnings, err := v3Actor.AssignIsolationSegmentToSpaceByNameAndSpace(change.Name, spaceGUID)
+		return append(allWarnings, warnings...), err
+
+	case BindSecurityGroup:
+		securityGroup, warnings, err := v2Actor.GetSecurityGroupByName(change.Name)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+		warnings, err = v2Actor.BindSecurityGroupToSpace(securityGroup.GUID, spaceGUID, ccv2.SecurityGroupLifecycleRunning)
+		return append(allWarnings, warnings...), err
+
+	case AssignSpaceRole:
+		warnings, err := v2Actor.SetSpaceRole(constant.SpaceRole(change.Role), spaceGUID, change.Name)
+		return append(allWarnings, warnings...), err
+	}
+
+	return allWarnings, nil
+}
+
+func (applier *planApplier) spaceGUID(spaceName string) (string, Warnings, error) {
+	if guid, ok := applier.spaceGUIDs[spaceName]; ok {
+		return guid, nil, nil
+	}
+
+	space, warnings, err := applier.actor.V2Actor.GetSpaceByOrganizationAndName(applier.orgGUID, spaceName)
+	if err != nil {
+		return "", Warnings(warnings), err
+	}
+
+	applier.spaceGUIDs[spaceName] = space.GUID
+	return space.GUID, Warnings(warnings), nil
+}
+
+func (applier *planApplier) spaceQuotaGUID(spaceQuotaName string) (string, Warnings, error) {
+	if guid, ok := applier.spaceQuotaGUIDs[spaceQuotaName]; ok {
+		return guid, nil, nil
+	}
+
+	spaceQuotas, warnings, err := applier.actor.V2Actor.GetOrganizationSpaceQuotas(applier.orgGUID)
+	if err != nil {
+		return "", Warnings(warnings), err
+	}
+
+	for _, spaceQuota := range spaceQuotas {
+		applier.spaceQuotaGUIDs[spaceQuota.Name] = spaceQuota.GUID
+	}
+
+	guid, ok := applier.spaceQuotaGUIDs[spaceQuotaName]
+	if !ok {
+		return "", Warnings(warnings), v2action.SpaceQuotaNotFoundError{Name: spaceQuotaName}
+	}
+	return guid, Warnings(warnings), nil
+}
+
+func toV2SpaceQuota(spaceQuota orgconfig.SpaceQuota) v2action.SpaceQuota {
+	return v2action.SpaceQuota{
+		Name:                    spaceQuota.Name,
+		MemoryLimitInMB:         spaceQuota.MemoryLimitInMB,
+		InstanceMemoryLimitInMB: spaceQuota.InstanceMemoryLimitInMB,
+		TotalRoutes:             spaceQuota.TotalRoutes,
+		TotalServices:           spaceQuota.TotalServices,
+		AppInstanceLimit:        spaceQuota.AppInstanceLimit,
+		NonBasicServicesAllowed: spaceQuota.PaidServicePlans,
+	}
+}
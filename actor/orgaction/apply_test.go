@@ -0,0 +1,176 @@
+package orgaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/orgaction"
+	"code.cloudfoundry.org/cli/actor/orgaction/orgactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/orgconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Apply", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *orgactionfakes.FakeV2Actor
+		fakeV3Actor *orgactionfakes.FakeV3Actor
+
+		plan       Plan
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(orgactionfakes.FakeV2Actor)
+		fakeV3Actor = new(orgactionfakes.FakeV3Actor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor)
+	})
+
+	JustBeforeEach(func() {
+		warnings, executeErr = actor.Apply(plan)
+	})
+
+	Context("when the plan creates the organization", func() {
+		BeforeEach(func() {
+			plan = Plan{
+				OrgName: "some-org",
+				Changes: []Change{
+					{Type: CreateOrganization, Name: "some-org"},
+					{Type: SetOrganizationQuota, Name: "some-org-quota"},
+					{Type: EntitleIsolationSegment, Name: "some-iso-seg"},
+					{Type: CreatePrivateDomain, Name: "some-domain.com"},
+					{Type: AssignOrganizationRole, Name: "alice", Role: "users"},
+					{Type: CreateSpaceQuota, Name: "small", SpaceQuota: orgconfig.SpaceQuota{Name: "small", MemoryLimitInMB: 1024, PaidServicePlans: true}},
+					{Type: CreateSpace, Space: "dev", Name: "dev"},
+					{Type: SetSpaceQuota, Space: "dev", Name: "small"},
+					{Type: AssignSpaceIsolationSegment, Space: "dev", Name: "some-iso-seg"},
+					{Type: BindSecurityGroup, Space: "dev", Name: "public_networks"},
+					{Type: AssignSpaceRole, Space: "dev", Name: "alice", Role: "developers"},
+				},
+			}
+
+			fakeV2Actor.CreateOrganizationReturns(v2action.Organization{GUID: "some-org-guid"}, v2action.Warnings{"create-org-warning"}, nil)
+			fakeV2Actor.GetOrganizationQuotaByNameReturns(v2action.OrganizationQuota{GUID: "some-org-quota-guid"}, nil, nil)
+			fakeV2Actor.CreateSpaceQuotaReturns(v2action.SpaceQuota{GUID: "small-guid", Name: "small"}, nil, nil)
+			fakeV2Actor.CreateSpaceReturns(v2action.Space{GUID: "dev-guid"}, v2action.Warnings{"create-space-warning"}, nil)
+			fakeV2Actor.GetSecurityGroupByNameReturns(v2action.SecurityGroup{GUID: "some-sg-guid"}, nil, nil)
+			fakeV3Actor.AssignIsolationSegmentToSpaceByNameAndSpaceReturns(v3action.Warnings{"assign-warning"}, nil)
+		})
+
+		It("makes every change with the GUIDs it created", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("create-org-warning", "create-space-warning", "assign-warning"))
+
+			orgName, quotaGUID := fakeV2Actor.CreateOrganizationArgsForCall(0)
+			Expect(orgName).To(Equal("some-org"))
+			Expect(quotaGUID).To(BeEmpty())
+
+			orgGUID, quotaGUID := fakeV2Actor.UpdateOrganizationQuotaArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(quotaGUID).To(Equal("some-org-quota-guid"))
+
+			isoSegName, orgName := fakeV3Actor.EntitleIsolationSegmentToOrganizationByNameArgsForCall(0)
+			Expect(isoSegName).To(Equal("some-iso-seg"))
+			Expect(orgName).To(Equal("some-org"))
+
+			domainName, orgGUID := fakeV2Actor.CreatePrivateDomainArgsForCall(0)
+			Expect(domainName).To(Equal("some-domain.com"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+
+			orgRole, orgGUID, username := fakeV2Actor.SetOrganizationRoleArgsForCall(0)
+			Expect(orgRole).To(Equal(constant.OrgUserRole))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(username).To(Equal("alice"))
+
+			Expect(fakeV2Actor.CreateSpaceQuotaArgsForCall(0)).To(Equal(v2action.SpaceQuota{
+				Name:                    "small",
+				OrganizationGUID:        "some-org-guid",
+				MemoryLimitInMB:         1024,
+				NonBasicServicesAllowed: true,
+			}))
+
+			spaceName, orgGUID := fakeV2Actor.CreateSpaceArgsForCall(0)
+			Expect(spaceName).To(Equal("dev"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+
+			spaceGUID, spaceQuotaGUID := fakeV2Actor.SetSpaceQuotaArgsForCall(0)
+			Expect(spaceGUID).To(Equal("dev-guid"))
+			Expect(spaceQuotaGUID).To(Equal("small-guid"))
+
+			isoSegName, spaceGUID = fakeV3Actor.AssignIsolationSegmentToSpaceByNameAndSpaceArgsForCall(0)
+			Expect(isoSegName).To(Equal("some-iso-seg"))
+			Expect(spaceGUID).To(Equal("dev-guid"))
+
+			sgGUID, spaceGUID, lifecycle := fakeV2Actor.BindSecurityGroupToSpaceArgsForCall(0)
+			Expect(sgGUID).To(Equal("some-sg-guid"))
+			Expect(spaceGUID).To(Equal("dev-guid"))
+			Expect(lifecycle).To(Equal(ccv2.SecurityGroupLifecycleRunning))
+
+			spaceRole, spaceGUID, username := fakeV2Actor.SetSpaceRoleArgsForCall(0)
+			Expect(spaceRole).To(Equal(constant.SpaceDeveloperRole))
+			Expect(spaceGUID).To(Equal("dev-guid"))
+			Expect(username).To(Equal("alice"))
+
+			Expect(fakeV2Actor.GetSpaceByOrganizationAndNameCallCount()).To(Equal(0))
+			Expect(fakeV2Actor.GetOrganizationSpaceQuotasCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the plan changes existing spaces and quotas", func() {
+		BeforeEach(func() {
+			plan = Plan{
+				OrgName: "some-org",
+				OrgGUID: "some-org-guid",
+				Changes: []Change{
+					{Type: UpdateSpaceQuota, Name: "small", SpaceQuota: orgconfig.SpaceQuota{Name: "small", TotalRoutes: 20}},
+					{Type: SetSpaceQuota, Space: "dev", Name: "small"},
+				},
+			}
+
+			fakeV2Actor.GetOrganizationSpaceQuotasReturns([]v2action.SpaceQuota{{GUID: "small-guid", Name: "small"}}, v2action.Warnings{"quotas-warning"}, nil)
+			fakeV2Actor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "dev-guid"}, v2action.Warnings{"space-warning"}, nil)
+		})
+
+		It("looks up the GUIDs by name", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("quotas-warning", "space-warning"))
+
+			Expect(fakeV2Actor.UpdateSpaceQuotaArgsForCall(0)).To(Equal(v2action.SpaceQuota{GUID: "small-guid", Name: "small", TotalRoutes: 20}))
+
+			orgGUID, spaceName := fakeV2Actor.GetSpaceByOrganizationAndNameArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("dev"))
+
+			spaceGUID, spaceQuotaGUID := fakeV2Actor.SetSpaceQuotaArgsForCall(0)
+			Expect(spaceGUID).To(Equal("dev-guid"))
+			Expect(spaceQuotaGUID).To(Equal("small-guid"))
+			Expect(fakeV2Actor.GetOrganizationSpaceQuotasCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when a change fails", func() {
+		BeforeEach(func() {
+			plan = Plan{
+				OrgName: "some-org",
+				OrgGUID: "some-org-guid",
+				Changes: []Change{
+					{Type: CreatePrivateDomain, Name: "some-domain.com"},
+					{Type: CreateSpace, Space: "dev", Name: "dev"},
+				},
+			}
+
+			fakeV2Actor.CreatePrivateDomainReturns(v2action.Domain{}, v2action.Warnings{"domain-warning"}, errors.New("some-error"))
+		})
+
+		It("stops and returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(warnings).To(ConsistOf("domain-warning"))
+			Expect(fakeV2Actor.CreateSpaceCallCount()).To(Equal(0))
+		})
+	})
+})
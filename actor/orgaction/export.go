@@ -0,0 +1,168 @@
+package orgaction
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/orgconfig"
+)
+
+// GetOrgConfig describes the current state of the organization as an org
+// config, so that it can be written out and applied elsewhere.
+func (actor Actor) GetOrgConfig(orgName string) (orgconfig.Org, Warnings, error) {
+	var allWarnings Warnings
+
+	orgSummary, warnings, err := actor.V2Actor.GetOrganizationSummaryByName(orgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return orgconfig.Org{}, allWarnings, err
+	}
+
+	config := orgconfig.Org{
+		Name:  orgSummary.Name,
+		Quota: orgSummary.QuotaName,
+	}
+
+	isolationSegments, isolationSegmentWarnings, err := actor.V3Actor.GetIsolationSegmentsByOrganization(orgSummary.GUID)
+	allWarnings = append(allWarnings, isolationSegmentWarnings...)
+	if err != nil {
+		return orgconfig.Org{}, allWarnings, err
+	}
+	for _, isolationSegment := range isolationSegments {
+		config.IsolationSegments = append(config.IsolationSegments, isolationSegment.Name)
+	}
+	sort.Strings(config.IsolationSegments)
+
+	domains, warnings, err := actor.V2Actor.GetOrganizationDomains(orgSummary.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return orgconfig.Org{}, allWarnings, err
+	}
+	for _, domain := range domains {
+		if domain.IsPrivate() {
+			config.PrivateDomains = append(config.PrivateDomains, domain.Name)
+		}
+	}
+	sort.Strings(config.PrivateDomains)
+
+	orgRoles := []struct {
+		role constant.OrgRole
+		dest *[]string
+	}{
+		{constant.OrgManagerRole, &config.Managers},
+		{constant.OrgBillingManagerRole, &config.BillingManagers},
+		{constant.OrgAuditorRole, &config.Auditors},
+	}
+	for _, orgRole := range orgRoles {
+		users, roleWarnings, roleErr := actor.V2Actor.GetOrganizationUsersByRole(orgRole.role, orgSummary.GUID)
+		allWarnings = append(allWarnings, roleWarnings...)
+		if roleErr != nil {
+			return orgconfig.Org{}, allWarnings, roleErr
+		}
+		*orgRole.dest = usernames(users)
+	}
+
+	spaceQuotas, warnings, err := actor.V2Actor.GetOrganizationSpaceQuotas(orgSummary.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return orgconfig.Org{}, allWarnings, err
+	}
+	for _, spaceQuota := range spaceQuotas {
+		config.SpaceQuotas = append(config.SpaceQuotas, orgconfig.SpaceQuota{
+			Name:                    spaceQuota.Name,
+			MemoryLimitInMB:         spaceQuota.MemoryLimitInMB,
+			InstanceMemoryLimitInMB: spaceQuota.InstanceMemoryLimitInMB,
+			TotalRoutes:             spaceQuota.TotalRoutes,
+			TotalServices:           spaceQuota.TotalServices,
+			AppInstanceLimit:        spaceQuota.AppInstanceLimit,
+			PaidServicePlans:        spaceQuota.NonBasicServicesAllowed,
+		})
+	}
+	sort.Slice(config.SpaceQuotas, func(i int, j int) bool {
+		return config.SpaceQuotas[i].Name < config.SpaceQuotas[j].Name
+	})
+
+	for _, spaceName := range orgSummary.SpaceNames {
+		space, spaceWarnings, spaceErr := actor.getSpaceConfig(orgSummary.GUID, spaceName)
+		allWarnings = append(allWarnings, spaceWarnings...)
+		if spaceErr != nil {
+			return orgconfig.Org{}, allWarnings, spaceErr
+		}
+		config.Spaces = append(config.Spaces, space)
+	}
+
+	// Users who only have the organization user role are listed on their own;
+	// everyone in another role gets the user role anyway.
+	orgUsers, warnings, err := actor.V2Actor.GetOrganizationUsersByRole(constant.OrgUserRole, orgSummary.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return orgconfig.Org{}, allWarnings, err
+	}
+	withOtherRoles := map[string]bool{}
+	for _, username := range allUsernames(config) {
+		withOtherRoles[username] = true
+	}
+	for _, username := range usernames(orgUsers) {
+		if !withOtherRoles[username] {
+			config.Users = append(config.Users, username)
+		}
+	}
+
+	return config, allWarnings, nil
+}
+
+func (actor Actor) getSpaceConfig(orgGUID string, spaceName string) (orgconfig.Space, Warnings, error) {
+	var allWarnings Warnings
+
+	spaceSummary, warnings, err := actor.V2Actor.GetSpaceSummaryByOrganizationAndName(orgGUID, spaceName, false)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return orgconfig.Space{}, allWarnings, err
+	}
+
+	config := orgconfig.Space{
+		Name:           spaceSummary.Name,
+		Quota:          spaceSummary.SpaceQuotaName,
+		SecurityGroups: spaceSummary.RunningSecurityGroupNames,
+	}
+
+	isolationSegment, isolationSegmentWarnings, err := actor.V3Actor.GetSpaceIsolationSegment(spaceSummary.GUID)
+	allWarnings = append(allWarnings, isolationSegmentWarnings...)
+	if err != nil {
+		return orgconfig.Space{}, allWarnings, err
+	}
+	config.IsolationSegment = isolationSegment.Name
+
+	spaceRoles := []struct {
+		role constant.SpaceRole
+		dest *[]string
+	}{
+		{constant.SpaceManagerRole, &config.Managers},
+		{constant.SpaceDeveloperRole, &config.Developers},
+		{constant.SpaceAuditorRole, &config.Auditors},
+	}
+	for _, spaceRole := range spaceRoles {
+		users, roleWarnings, roleErr := actor.V2Actor.GetSpaceUsersByRole(spaceRole.role, spaceSummary.GUID)
+		allWarnings = append(allWarnings, roleWarnings...)
+		if roleErr != nil {
+			return orgconfig.Space{}, allWarnings, roleErr
+		}
+		*spaceRole.dest = usernames(users)
+	}
+
+	return config, allWarnings, nil
+}
+
+// usernames returns the sorted usernames of the users, leaving out clients
+// and other users without a username.
+func usernames(users []v2action.User) []string {
+	var names []string
+	for _, user := range users {
+		if user.Username != "" {
+			names = append(names, user.Username)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
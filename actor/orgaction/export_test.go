@@ -0,0 +1,194 @@
+package orgaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/orgaction"
+	"code.cloudfoundry.org/cli/actor/orgaction/orgactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/orgconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetOrgConfig", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *orgactionfakes.FakeV2Actor
+		fakeV3Actor *orgactionfakes.FakeV3Actor
+
+		config     orgconfig.Org
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(orgactionfakes.FakeV2Actor)
+		fakeV3Actor = new(orgactionfakes.FakeV3Actor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor)
+	})
+
+	JustBeforeEach(func() {
+		config, warnings, executeErr = actor.GetOrgConfig("some-org")
+	})
+
+	Context("when the organization exists", func() {
+		BeforeEach(func() {
+			fakeV2Actor.GetOrganizationSummaryByNameReturns(
+				v2action.OrganizationSummary{
+					Organization: v2action.Organization{GUID: "some-org-guid", Name: "some-org"},
+					QuotaName:    "some-org-quota",
+					SpaceNames:   []string{"dev", "prod"},
+				},
+				v2action.Warnings{"org-summary-warning"},
+				nil,
+			)
+			fakeV3Actor.GetIsolationSegmentsByOrganizationReturns(
+				[]v3action.IsolationSegment{{Name: "iso-b"}, {Name: "iso-a"}},
+				v3action.Warnings{"iso-seg-warning"},
+				nil,
+			)
+			fakeV2Actor.GetOrganizationDomainsReturns(
+				[]v2action.Domain{
+					{Name: "shared.com", Type: constant.SharedDomain},
+					{Name: "private.com", Type: constant.PrivateDomain},
+				},
+				v2action.Warnings{"domains-warning"},
+				nil,
+			)
+			fakeV2Actor.GetOrganizationUsersByRoleStub = func(role constant.OrgRole, _ string) ([]v2action.User, v2action.Warnings, error) {
+				switch role {
+				case constant.OrgManagerRole:
+					return []v2action.User{{Username: "bob"}, {Username: "alice"}, {GUID: "some-client"}}, nil, nil
+				case constant.OrgAuditorRole:
+					return []v2action.User{{Username: "carol"}}, nil, nil
+				case constant.OrgUserRole:
+					return []v2action.User{{Username: "alice"}, {Username: "bob"}, {Username: "carol"}, {Username: "dave"}}, v2action.Warnings{"org-users-warning"}, nil
+				}
+				return nil, nil, nil
+			}
+			fakeV2Actor.GetOrganizationSpaceQuotasReturns(
+				[]v2action.SpaceQuota{
+					{
+						Name:                    "small",
+						MemoryLimitInMB:         1024,
+						InstanceMemoryLimitInMB: -1,
+						TotalRoutes:             10,
+						TotalServices:           -1,
+						AppInstanceLimit:        5,
+						NonBasicServicesAllowed: true,
+					},
+				},
+				v2action.Warnings{"space-quotas-warning"},
+				nil,
+			)
+			fakeV2Actor.GetSpaceSummaryByOrganizationAndNameStub = func(_ string, name string, _ bool) (v2action.SpaceSummary, v2action.Warnings, error) {
+				summary := v2action.SpaceSummary{Space: v2action.Space{GUID: name + "-guid", Name: name}}
+				if name == "dev" {
+					summary.SpaceQuotaName = "small"
+					summary.RunningSecurityGroupNames = []string{"public_networks"}
+				}
+				return summary, v2action.Warnings{name + "-summary-warning"}, nil
+			}
+			fakeV3Actor.GetSpaceIsolationSegmentStub = func(spaceGUID string) (v3action.IsolationSegment, v3action.Warnings, error) {
+				if spaceGUID == "dev-guid" {
+					return v3action.IsolationSegment{Name: "iso-a"}, nil, nil
+				}
+				return v3action.IsolationSegment{}, nil, nil
+			}
+			fakeV2Actor.GetSpaceUsersByRoleStub = func(role constant.SpaceRole, spaceGUID string) ([]v2action.User, v2action.Warnings, error) {
+				if role == constant.SpaceDeveloperRole && spaceGUID == "dev-guid" {
+					return []v2action.User{{Username: "alice"}}, nil, nil
+				}
+				return nil, nil, nil
+			}
+		})
+
+		It("returns the organization's configuration and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("org-summary-warning", "iso-seg-warning", "domains-warning", "space-quotas-warning", "dev-summary-warning", "prod-summary-warning", "org-users-warning"))
+			Expect(config).To(Equal(orgconfig.Org{
+				Name:              "some-org",
+				Quota:             "some-org-quota",
+				IsolationSegments: []string{"iso-a", "iso-b"},
+				PrivateDomains:    []string{"private.com"},
+				Users:             []string{"dave"},
+				Managers:          []string{"alice", "bob"},
+				Auditors:          []string{"carol"},
+				SpaceQuotas: []orgconfig.SpaceQuota{{
+					Name:                    "small",
+					MemoryLimitInMB:         1024,
+					InstanceMemoryLimitInMB: orgconfig.Unlimited,
+					TotalRoutes:             10,
+					TotalServices:           orgconfig.Unlimited,
+					AppInstanceLimit:        5,
+					PaidServicePlans:        true,
+				}},
+				Spaces: []orgconfig.Space{
+					{
+						Name:             "dev",
+						Quota:            "small",
+						IsolationSegment: "iso-a",
+						SecurityGroups:   []string{"public_networks"},
+						Developers:       []string{"alice"},
+					},
+					{Name: "prod"},
+				},
+			}))
+
+			Expect(fakeV2Actor.GetOrganizationSummaryByNameArgsForCall(0)).To(Equal("some-org"))
+			Expect(fakeV3Actor.GetIsolationSegmentsByOrganizationArgsForCall(0)).To(Equal("some-org-guid"))
+
+			orgGUID, spaceName, includeStaging := fakeV2Actor.GetSpaceSummaryByOrganizationAndNameArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("dev"))
+			Expect(includeStaging).To(BeFalse())
+
+			Expect(fakeV3Actor.GetSpaceIsolationSegmentArgsForCall(0)).To(Equal("dev-guid"))
+		})
+	})
+
+	Context("when the organization does not exist", func() {
+		BeforeEach(func() {
+			fakeV2Actor.GetOrganizationSummaryByNameReturns(
+				v2action.OrganizationSummary{},
+				v2action.Warnings{"org-summary-warning"},
+				v2action.OrganizationNotFoundError{Name: "some-org"},
+			)
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(Equal(v2action.OrganizationNotFoundError{Name: "some-org"}))
+			Expect(warnings).To(ConsistOf("org-summary-warning"))
+		})
+	})
+
+	Context("when getting a space's isolation segment fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("isolation segment error")
+			fakeV2Actor.GetOrganizationSummaryByNameReturns(
+				v2action.OrganizationSummary{
+					Organization: v2action.Organization{GUID: "some-org-guid", Name: "some-org"},
+					SpaceNames:   []string{"dev"},
+				},
+				nil,
+				nil,
+			)
+			fakeV2Actor.GetSpaceSummaryByOrganizationAndNameReturns(
+				v2action.SpaceSummary{Space: v2action.Space{GUID: "dev-guid", Name: "dev"}},
+				v2action.Warnings{"dev-summary-warning"},
+				nil,
+			)
+			fakeV3Actor.GetSpaceIsolationSegmentReturns(v3action.IsolationSegment{}, v3action.Warnings{"iso-seg-warning"}, expectedErr)
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(warnings).To(ConsistOf("dev-summary-warning", "iso-seg-warning"))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package orgaction_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestOrgAction(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Org Actions Suite")
+}
@@ -0,0 +1,399 @@
+package orgaction
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/orgconfig"
+)
+
+// ChangeType is the kind of mutation a Change makes.
+type ChangeType string
+
+const (
+	CreateOrganization          ChangeType = "create-org"
+	SetOrganizationQuota        ChangeType = "set-org-quota"
+	EntitleIsolationSegment     ChangeType = "entitle-isolation-segment"
+	CreatePrivateDomain         ChangeType = "create-private-domain"
+	AssignOrganizationRole      ChangeType = "assign-org-role"
+	CreateSpaceQuota            ChangeType = "create-space-quota"
+	UpdateSpaceQuota            ChangeType = "update-space-quota"
+	CreateSpace                 ChangeType = "create-space"
+	SetSpaceQuota               ChangeType = "set-space-quota"
+	AssignSpaceIsolationSegment ChangeType = "assign-space-isolation-segment"
+	BindSecurityGroup           ChangeType = "bind-security-group"
+	AssignSpaceRole             ChangeType = "assign-space-role"
+)
+
+// Change is a single mutation needed to converge an organization.
+type Change struct {
+	Type ChangeType
+
+	// Space is the name of the space the change applies to; it is empty for
+	// changes to the organization.
+	Space string
+
+	// Name is the name of the organization, quota, isolation segment,
+	// domain, security group or user the change is about.
+	Name string
+
+	// Role is the role given to the user Name, for the role changes.
+	Role string
+
+	// SpaceQuota holds the desired limits for the space quota changes.
+	SpaceQuota orgconfig.SpaceQuota
+}
+
+// Plan is the ordered list of changes needed to converge an organization to
+// its configuration. Applying a plan only adds; nothing is ever removed.
+type Plan struct {
+	OrgName string
+
+	// OrgGUID is empty when the organization does not exist yet.
+	OrgGUID string
+	Changes []Change
+}
+
+type orgRole struct {
+	role  constant.OrgRole
+	users []string
+}
+
+type spaceRole struct {
+	role  constant.SpaceRole
+	users []string
+}
+
+// GetOrgPlan compares the configuration with the current state of the
+// organization and returns the changes needed to converge them. It fails if
+// the configuration refers to a quota, isolation segment or security group
+// that does not exist.
+func (actor Actor) GetOrgPlan(config orgconfig.Org) (Plan, Warnings, error) {
+	var allWarnings Warnings
+	plan := Plan{OrgName: config.Name}
+
+	org, warnings, err := actor.V2Actor.GetOrganizationByName(config.Name)
+	allWarnings = append(allWarnings, warnings...)
+	switch err.(type) {
+	case nil:
+		plan.OrgGUID = org.GUID
+	case v2action.OrganizationNotFoundError:
+		plan.add(Change{Type: CreateOrganization, Name: config.Name})
+	default:
+		return Plan{}, allWarnings, err
+	}
+	orgExists := plan.OrgGUID != ""
+
+	if config.Quota != "" {
+		quota, warnings, err := actor.V2Actor.GetOrganizationQuotaByName(config.Quota)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Plan{}, allWarnings, err
+		}
+		if !orgExists || org.QuotaDefinitionGUID != quota.GUID {
+			plan.add(Change{Type: SetOrganizationQuota, Name: config.Quota})
+		}
+	}
+
+	isolationSegmentWarnings, err := actor.planIsolationSegments(&plan, config)
+	allWarnings = append(allWarnings, isolationSegmentWarnings...)
+	if err != nil {
+		return Plan{}, allWarnings, err
+	}
+
+	var existingDomains []v2action.Domain
+	if orgExists {
+		existingDomains, warnings, err = actor.V2Actor.GetOrganizationDomains(plan.OrgGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Plan{}, allWarnings, err
+		}
+	}
+	for _, domainName := range config.PrivateDomains {
+		if !containsDomain(existingDomains, domainName) {
+			plan.add(Change{Type: CreatePrivateDomain, Name: domainName})
+		}
+	}
+
+	orgRoles := []orgRole{
+		{role: constant.OrgUserRole, users: allUsernames(config)},
+		{role: constant.OrgManagerRole, users: config.Managers},
+		{role: constant.OrgBillingManagerRole, users: config.BillingManagers},
+		{role: constant.OrgAuditorRole, users: config.Auditors},
+	}
+	for _, desired := range orgRoles {
+		var existingUsers []v2action.User
+		if orgExists && len(desired.users) > 0 {
+			existingUsers, warnings, err = actor.V2Actor.GetOrganizationUsersByRole(desired.role, plan.OrgGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return Plan{}, allWarnings, err
+			}
+		}
+		for _, username := range desired.users {
+			if !containsUser(existingUsers, username) {
+				plan.add(Change{Type: AssignOrganizationRole, Name: username, Role: string(desired.role)})
+			}
+		}
+	}
+
+	spaceQuotaGUIDs := map[string]string{}
+	if orgExists {
+		existingQuotas, warnings, err := actor.V2Actor.GetOrganizationSpaceQuotas(plan.OrgGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Plan{}, allWarnings, err
+		}
+		for _, existingQuota := range existingQuotas {
+			spaceQuotaGUIDs[existingQuota.Name] = existingQuota.GUID
+		}
+
+		for _, spaceQuota := range config.SpaceQuotas {
+			for _, existingQuota := range existingQuotas {
+				if existingQuota.Name == spaceQuota.Name && !sameLimits(existingQuota, spaceQuota) {
+					plan.add(Change{Type: UpdateSpaceQuota, Name: spaceQuota.Name, SpaceQuota: spaceQuota})
+				}
+			}
+		}
+	}
+	for _, spaceQuota := range config.SpaceQuotas {
+		if _, ok := spaceQuotaGUIDs[spaceQuota.Name]; !ok {
+			plan.add(Change{Type: CreateSpaceQuota, Name: spaceQuota.Name, SpaceQuota: spaceQuota})
+			spaceQuotaGUIDs[spaceQuota.Name] = ""
+		}
+	}
+
+	var existingSpaces []v2action.Space
+	if orgExists {
+		existingSpaces, warnings, err = actor.V2Actor.GetOrganizationSpaces(plan.OrgGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Plan{}, allWarnings, err
+		}
+	}
+	for _, space := range config.Spaces {
+		spaceWarnings, err := actor.planSpace(&plan, space, existingSpaces, spaceQuotaGUIDs)
+		allWarnings = append(allWarnings, spaceWarnings...)
+		if err != nil {
+			return Plan{}, allWarnings, err
+		}
+	}
+
+	return plan, allWarnings, nil
+}
+
+func (actor Actor) planIsolationSegments(plan *Plan, config orgconfig.Org) (Warnings, error) {
+	var allWarnings Warnings
+
+	for _, space := range config.Spaces {
+		if space.IsolationSegment == "" {
+			continue
+		}
+		_, warnings, err := actor.V3Actor.GetIsolationSegmentByName(space.IsolationSegment)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	if len(config.IsolationSegments) == 0 {
+		return allWarnings, nil
+	}
+
+	var entitled []v3action.IsolationSegment
+	if plan.OrgGUID != "" {
+		var (
+			warnings v3action.Warnings
+			err      error
+		)
+		entitled, warnings, err = actor.V3Actor.GetIsolationSegmentsByOrganization(plan.OrgGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	for _, isolationSegmentName := range config.IsolationSegments {
+		_, warnings, err := actor.V3Actor.GetIsolationSegmentByName(isolationSegmentName)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		if !containsIsolationSegment(entitled, isolationSegmentName) {
+			plan.add(Change{Type: EntitleIsolationSegment, Name: isolationSegmentName})
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func (actor Actor) planSpace(plan *Plan, config orgconfig.Space, existingSpaces []v2action.Space, spaceQuotaGUIDs map[string]string) (Warnings, error) {
+	var (
+		allWarnings Warnings
+		space       v2action.Space
+		spaceExists bool
+	)
+
+	for _, existingSpace := range existingSpaces {
+		if existingSpace.Name == config.Name {
+			space = existingSpace
+			spaceExists = true
+		}
+	}
+	if !spaceExists {
+		plan.add(Change{Type: CreateSpace, Space: config.Name, Name: config.Name})
+	}
+
+	if config.Quota != "" {
+		quotaGUID, ok := spaceQuotaGUIDs[config.Quota]
+		if !ok {
+			return allWarnings, v2action.SpaceQuotaNotFoundError{Name: config.Quota}
+		}
+		if quotaGUID == "" || space.SpaceQuotaDefinitionGUID != quotaGUID {
+			plan.add(Change{Type: SetSpaceQuota, Space: config.Name, Name: config.Quota})
+		}
+	}
+
+	if config.IsolationSegment != "" {
+		var current v3action.IsolationSegment
+		if spaceExists {
+			var (
+				warnings v3action.Warnings
+				err      error
+			)
+			current, warnings, err = actor.V3Actor.GetSpaceIsolationSegment(space.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+		}
+		if current.Name != config.IsolationSegment {
+			plan.add(Change{Type: AssignSpaceIsolationSegment, Space: config.Name, Name: config.IsolationSegment})
+		}
+	}
+
+	if len(config.SecurityGroups) > 0 {
+		var bound []v2action.SecurityGroup
+		if spaceExists {
+			var (
+				warnings v2action.Warnings
+				err      error
+			)
+			bound, warnings, err = actor.V2Actor.GetSpaceRunningSecurityGroupsBySpace(space.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+		}
+
+		for _, securityGroupName := range config.SecurityGroups {
+			_, warnings, err := actor.V2Actor.GetSecurityGroupByName(securityGroupName)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+
+			if !containsSecurityGroup(bound, securityGroupName) {
+				plan.add(Change{Type: BindSecurityGroup, Space: config.Name, Name: securityGroupName})
+			}
+		}
+	}
+
+	spaceRoles := []spaceRole{
+		{role: constant.SpaceManagerRole, users: config.Managers},
+		{role: constant.SpaceDeveloperRole, users: config.Developers},
+		{role: constant.SpaceAuditorRole, users: config.Auditors},
+	}
+	for _, desired := range spaceRoles {
+		var existingUsers []v2action.User
+		if spaceExists && len(desired.users) > 0 {
+			var (
+				warnings v2action.Warnings
+				err      error
+			)
+			existingUsers, warnings, err = actor.V2Actor.GetSpaceUsersByRole(desired.role, space.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+		}
+		for _, username := range desired.users {
+			if !containsUser(existingUsers, username) {
+				plan.add(Change{Type: AssignSpaceRole, Space: config.Name, Name: username, Role: string(desired.role)})
+			}
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func (plan *Plan) add(change Change) {
+	plan.Changes = append(plan.Changes, change)
+}
+
+// allUsernames returns every user that has a role anywhere in the
+// organization, as each of them needs to be an organization user.
+func allUsernames(config orgconfig.Org) []string {
+	lists := [][]string{config.Users, config.Managers, config.BillingManagers, config.Auditors}
+	for _, space := range config.Spaces {
+		lists = append(lists, space.Managers, space.Developers, space.Auditors)
+	}
+
+	var usernames []string
+	seen := map[string]bool{}
+	for _, list := range lists {
+		for _, username := range list {
+			if !seen[username] {
+				seen[username] = true
+				usernames = append(usernames, username)
+			}
+		}
+	}
+	return usernames
+}
+
+func sameLimits(existing v2action.SpaceQuota, desired orgconfig.SpaceQuota) bool {
+	return existing.MemoryLimitInMB == desired.MemoryLimitInMB &&
+		existing.InstanceMemoryLimitInMB == desired.InstanceMemoryLimitInMB &&
+		existing.TotalRoutes == desired.TotalRoutes &&
+		existing.TotalServices == desired.TotalServices &&
+		existing.AppInstanceLimit == desired.AppInstanceLimit &&
+		existing.NonBasicServicesAllowed == desired.PaidServicePlans
+}
+
+func containsDomain(domains []v2action.Domain, name string) bool {
+	for _, domain := range domains {
+		if domain.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsIsolationSegment(isolationSegments []v3action.IsolationSegment, name string) bool {
+	for _, isolationSegment := range isolationSegments {
+		if isolationSegment.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsSecurityGroup(securityGroups []v2action.SecurityGroup, name string) bool {
+	for _, securityGroup := range securityGroups {
+		if securityGroup.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsUser(users []v2action.User, username string) bool {
+	for _, user := range users {
+		if user.Username == username {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,209 @@
+package orgaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/orgaction"
+	"code.cloudfoundry.org/cli/actor/orgaction/orgactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/orgconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetOrgPlan", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *orgactionfakes.FakeV2Actor
+		fakeV3Actor *orgactionfakes.FakeV3Actor
+
+		config     orgconfig.Org
+		smallQuota orgconfig.SpaceQuota
+
+		plan       Plan
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(orgactionfakes.FakeV2Actor)
+		fakeV3Actor = new(orgactionfakes.FakeV3Actor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor)
+
+		smallQuota = orgconfig.SpaceQuota{
+			Name:                    "small",
+			MemoryLimitInMB:         1024,
+			InstanceMemoryLimitInMB: orgconfig.Unlimited,
+			TotalRoutes:             10,
+			TotalServices:           orgconfig.Unlimited,
+			AppInstanceLimit:        orgconfig.Unlimited,
+		}
+
+		config = orgconfig.Org{
+			Name:              "some-org",
+			Quota:             "some-org-quota",
+			IsolationSegments: []string{"some-iso-seg"},
+			PrivateDomains:    []string{"some-domain.com"},
+			Users:             []string{"carol"},
+			Managers:          []string{"alice"},
+			SpaceQuotas:       []orgconfig.SpaceQuota{smallQuota},
+			Spaces: []orgconfig.Space{{
+				Name:             "dev",
+				Quota:            "small",
+				IsolationSegment: "some-iso-seg",
+				SecurityGroups:   []string{"public_networks"},
+				Developers:       []string{"bob"},
+			}},
+		}
+
+		fakeV2Actor.GetOrganizationQuotaByNameReturns(v2action.OrganizationQuota{GUID: "some-org-quota-guid", Name: "some-org-quota"}, v2action.Warnings{"quota-warning"}, nil)
+		fakeV3Actor.GetIsolationSegmentByNameReturns(v3action.IsolationSegment{GUID: "some-iso-seg-guid", Name: "some-iso-seg"}, v3action.Warnings{"iso-seg-warning"}, nil)
+		fakeV2Actor.GetSecurityGroupByNameReturns(v2action.SecurityGroup{GUID: "some-sg-guid", Name: "public_networks"}, v2action.Warnings{"sg-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		plan, warnings, executeErr = actor.GetOrgPlan(config)
+	})
+
+	Context("when the organization does not exist", func() {
+		BeforeEach(func() {
+			fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{}, v2action.Warnings{"org-warning"}, v2action.OrganizationNotFoundError{Name: "some-org"})
+		})
+
+		It("plans to create everything", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("org-warning", "quota-warning", "iso-seg-warning", "iso-seg-warning", "sg-warning"))
+			Expect(plan).To(Equal(Plan{
+				OrgName: "some-org",
+				Changes: []Change{
+					{Type: CreateOrganization, Name: "some-org"},
+					{Type: SetOrganizationQuota, Name: "some-org-quota"},
+					{Type: EntitleIsolationSegment, Name: "some-iso-seg"},
+					{Type: CreatePrivateDomain, Name: "some-domain.com"},
+					{Type: AssignOrganizationRole, Name: "carol", Role: "users"},
+					{Type: AssignOrganizationRole, Name: "alice", Role: "users"},
+					{Type: AssignOrganizationRole, Name: "bob", Role: "users"},
+					{Type: AssignOrganizationRole, Name: "alice", Role: "managers"},
+					{Type: CreateSpaceQuota, Name: "small", SpaceQuota: smallQuota},
+					{Type: CreateSpace, Space: "dev", Name: "dev"},
+					{Type: SetSpaceQuota, Space: "dev", Name: "small"},
+					{Type: AssignSpaceIsolationSegment, Space: "dev", Name: "some-iso-seg"},
+					{Type: BindSecurityGroup, Space: "dev", Name: "public_networks"},
+					{Type: AssignSpaceRole, Space: "dev", Name: "bob", Role: "developers"},
+				},
+			}))
+
+			Expect(fakeV2Actor.GetOrganizationDomainsCallCount()).To(Equal(0))
+			Expect(fakeV2Actor.GetOrganizationSpacesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the organization already matches the config", func() {
+		BeforeEach(func() {
+			fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{GUID: "some-org-guid", Name: "some-org", QuotaDefinitionGUID: "some-org-quota-guid"}, nil, nil)
+			fakeV3Actor.GetIsolationSegmentsByOrganizationReturns([]v3action.IsolationSegment{{Name: "some-iso-seg"}}, nil, nil)
+			fakeV2Actor.GetOrganizationDomainsReturns([]v2action.Domain{{Name: "some-domain.com"}}, nil, nil)
+			fakeV2Actor.GetOrganizationUsersByRoleStub = func(role constant.OrgRole, _ string) ([]v2action.User, v2action.Warnings, error) {
+				if role == constant.OrgUserRole {
+					return []v2action.User{{Username: "alice"}, {Username: "bob"}, {Username: "carol"}}, nil, nil
+				}
+				return []v2action.User{{Username: "alice"}}, nil, nil
+			}
+			fakeV2Actor.GetOrganizationSpaceQuotasReturns([]v2action.SpaceQuota{{
+				GUID:                    "small-guid",
+				Name:                    "small",
+				MemoryLimitInMB:         1024,
+				InstanceMemoryLimitInMB: -1,
+				TotalRoutes:             10,
+				TotalServices:           -1,
+				AppInstanceLimit:        -1,
+			}}, nil, nil)
+			fakeV2Actor.GetOrganizationSpacesReturns([]v2action.Space{{GUID: "dev-guid", Name: "dev", SpaceQuotaDefinitionGUID: "small-guid"}}, nil, nil)
+			fakeV3Actor.GetSpaceIsolationSegmentReturns(v3action.IsolationSegment{Name: "some-iso-seg"}, nil, nil)
+			fakeV2Actor.GetSpaceRunningSecurityGroupsBySpaceReturns([]v2action.SecurityGroup{{Name: "public_networks"}}, nil, nil)
+			fakeV2Actor.GetSpaceUsersByRoleReturns([]v2action.User{{Username: "bob"}}, nil, nil)
+		})
+
+		It("plans no changes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(plan).To(Equal(Plan{OrgName: "some-org", OrgGUID: "some-org-guid"}))
+
+			Expect(fakeV3Actor.GetSpaceIsolationSegmentArgsForCall(0)).To(Equal("dev-guid"))
+		})
+
+		Context("when the space quota limits differ and the space has no isolation segment", func() {
+			BeforeEach(func() {
+				config.SpaceQuotas[0].TotalRoutes = 20
+				fakeV3Actor.GetSpaceIsolationSegmentReturns(v3action.IsolationSegment{}, nil, nil)
+			})
+
+			It("plans to update the quota and assign the isolation segment", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plan.Changes).To(Equal([]Change{
+					{Type: UpdateSpaceQuota, Name: "small", SpaceQuota: config.SpaceQuotas[0]},
+					{Type: AssignSpaceIsolationSegment, Space: "dev", Name: "some-iso-seg"},
+				}))
+			})
+		})
+	})
+
+	Context("when looking up the organization fails", func() {
+		BeforeEach(func() {
+			fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{}, v2action.Warnings{"org-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(warnings).To(ConsistOf("org-warning"))
+		})
+	})
+
+	Context("when the config refers to things that do not exist", func() {
+		BeforeEach(func() {
+			fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{}, nil, v2action.OrganizationNotFoundError{Name: "some-org"})
+		})
+
+		Context("when the organization quota does not exist", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetOrganizationQuotaByNameReturns(v2action.OrganizationQuota{}, nil, v2action.OrganizationQuotaNotFoundError{Name: "some-org-quota"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(v2action.OrganizationQuotaNotFoundError{Name: "some-org-quota"}))
+			})
+		})
+
+		Context("when an isolation segment does not exist", func() {
+			BeforeEach(func() {
+				fakeV3Actor.GetIsolationSegmentByNameReturns(v3action.IsolationSegment{}, nil, actionerror.IsolationSegmentNotFoundError{Name: "some-iso-seg"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.IsolationSegmentNotFoundError{Name: "some-iso-seg"}))
+			})
+		})
+
+		Context("when a space quota is neither declared nor defined in the organization", func() {
+			BeforeEach(func() {
+				config.Spaces[0].Quota = "large"
+			})
+
+			It("returns a SpaceQuotaNotFoundError", func() {
+				Expect(executeErr).To(MatchError(v2action.SpaceQuotaNotFoundError{Name: "large"}))
+			})
+		})
+
+		Context("when a security group does not exist", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetSecurityGroupByNameReturns(v2action.SecurityGroup{}, nil, v2action.SecurityGroupNotFoundError{Name: "public_networks"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(v2action.SecurityGroupNotFoundError{Name: "public_networks"}))
+			})
+		})
+	})
+})
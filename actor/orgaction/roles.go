@@ -0,0 +1,354 @@
+package orgaction
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util/roster"
+)
+
+// RoleChangeType is the kind of mutation a RoleChange makes.
+type RoleChangeType string
+
+const (
+	CreateUser RoleChangeType = "create-user"
+	GrantRole  RoleChangeType = "grant-role"
+	RevokeRole RoleChangeType = "revoke-role"
+)
+
+// RoleChange is a single mutation needed to converge role assignments to a
+// roster.
+type RoleChange struct {
+	Type     RoleChangeType
+	Username string
+
+	// Org, Space and Role are empty for CreateUser changes; Space is empty
+	// for org roles.
+	Org   string
+	Space string
+	Role  roster.Role
+
+	OrgGUID   string
+	SpaceGUID string
+
+	// UserGUID is set for RevokeRole changes.
+	UserGUID string
+
+	// Origin and Password are set for CreateUser changes.
+	Origin   string
+	Password string
+}
+
+var (
+	orgRoles = []struct {
+		role     roster.Role
+		constant constant.OrgRole
+	}{
+		{roster.OrgUser, constant.OrgUserRole},
+		{roster.OrgManager, constant.OrgManagerRole},
+		{roster.BillingManager, constant.OrgBillingManagerRole},
+		{roster.OrgAuditor, constant.OrgAuditorRole},
+	}
+
+	spaceRoles = []struct {
+		role     roster.Role
+		constant constant.SpaceRole
+	}{
+		{roster.SpaceManager, constant.SpaceManagerRole},
+		{roster.SpaceDeveloper, constant.SpaceDeveloperRole},
+		{roster.SpaceAuditor, constant.SpaceAuditorRole},
+	}
+)
+
+// roleHolders maps each role to the users holding it.
+type roleHolders map[roster.Role][]v2action.User
+
+func (holders roleHolders) holds(role roster.Role, username string) bool {
+	return containsUser(holders[role], username)
+}
+
+type rosterSpace struct {
+	name    string
+	guid    string
+	holders roleHolders
+}
+
+type rosterOrg struct {
+	name    string
+	guid    string
+	holders roleHolders
+	spaces  []*rosterSpace
+}
+
+func (org *rosterOrg) space(name string) *rosterSpace {
+	for _, space := range org.spaces {
+		if space.name == name {
+			return space
+		}
+	}
+	return nil
+}
+
+// ReadRoster reads and validates the roster at the provided path.
+func (Actor) ReadRoster(pathToRoster string) ([]roster.Entry, error) {
+	return roster.ReadRoster(pathToRoster)
+}
+
+// GetRolePlan compares the roster with the roles currently held in the orgs
+// and spaces it names, and returns the changes needed to converge them. Users
+// that no org knows about are created when the roster gives them a password
+// or an origin. With revoke, roles held by users left out of the roster are
+// removed in the orgs and spaces the roster names; the org user role is never
+// revoked.
+func (actor Actor) GetRolePlan(entries []roster.Entry, revoke bool) ([]RoleChange, Warnings, error) {
+	var (
+		allWarnings Warnings
+		orgs        []*rosterOrg
+	)
+
+	findOrg := func(name string) *rosterOrg {
+		for _, org := range orgs {
+			if org.name == name {
+				return org
+			}
+		}
+		return nil
+	}
+
+	for _, entry := range entries {
+		org := findOrg(entry.Org)
+		if org == nil {
+			var (
+				warnings Warnings
+				err      error
+			)
+			org, warnings, err = actor.getRosterOrg(entry.Org)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			orgs = append(orgs, org)
+		}
+
+		if entry.Space != "" && org.space(entry.Space) == nil {
+			space, warnings, err := actor.getRosterSpace(org.guid, entry.Space)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			org.spaces = append(org.spaces, space)
+		}
+	}
+
+	var changes []RoleChange
+
+	knownUsers := map[string]bool{}
+	for _, org := range orgs {
+		for _, user := range org.holders[roster.OrgUser] {
+			knownUsers[user.Username] = true
+		}
+	}
+	for _, entry := range entries {
+		if knownUsers[entry.Username] || (entry.Password == "" && entry.Origin == "") {
+			continue
+		}
+		knownUsers[entry.Username] = true
+		changes = append(changes, RoleChange{
+			Type:     CreateUser,
+			Username: entry.Username,
+			Origin:   entry.Origin,
+			Password: entry.Password,
+		})
+	}
+
+	type grant struct {
+		org, space, username string
+		role                 roster.Role
+	}
+	granted := map[grant]bool{}
+
+	for _, entry := range entries {
+		org := findOrg(entry.Org)
+
+		orgUser := grant{org: org.name, username: entry.Username, role: roster.OrgUser}
+		if !granted[orgUser] {
+			granted[orgUser] = true
+			if !org.holders.holds(roster.OrgUser, entry.Username) {
+				changes = append(changes, RoleChange{Type: GrantRole, Username: entry.Username, Org: org.name, OrgGUID: org.guid, Role: roster.OrgUser})
+			}
+		}
+
+		desired := grant{org: org.name, space: entry.Space, username: entry.Username, role: entry.Role}
+		if granted[desired] {
+			continue
+		}
+		granted[desired] = true
+
+		if entry.Space == "" {
+			if !org.holders.holds(entry.Role, entry.Username) {
+				changes = append(changes, RoleChange{Type: GrantRole, Username: entry.Username, Org: org.name, OrgGUID: org.guid, Role: entry.Role})
+			}
+			continue
+		}
+
+		space := org.space(entry.Space)
+		if !space.holders.holds(entry.Role, entry.Username) {
+			changes = append(changes, RoleChange{Type: GrantRole, Username: entry.Username, Org: org.name, OrgGUID: org.guid, Space: space.name, SpaceGUID: space.guid, Role: entry.Role})
+		}
+	}
+
+	if !revoke {
+		return changes, allWarnings, nil
+	}
+
+	for _, org := range orgs {
+		for _, orgRole := range orgRoles[1:] {
+			for _, user := range org.holders[orgRole.role] {
+				if user.Username == "" || granted[grant{org: org.name, username: user.Username, role: orgRole.role}] {
+					continue
+				}
+				changes = append(changes, RoleChange{Type: RevokeRole, Username: user.Username, UserGUID: user.GUID, Org: org.name, OrgGUID: org.guid, Role: orgRole.role})
+			}
+		}
+
+		for _, space := range org.spaces {
+			for _, spaceRole := range spaceRoles {
+				for _, user := range space.holders[spaceRole.role] {
+					if user.Username == "" || granted[grant{org: org.name, space: space.name, username: user.Username, role: spaceRole.role}] {
+						continue
+					}
+					changes = append(changes, RoleChange{Type: RevokeRole, Username: user.Username, UserGUID: user.GUID, Org: org.name, OrgGUID: org.guid, Space: space.name, SpaceGUID: space.guid, Role: spaceRole.role})
+				}
+			}
+		}
+	}
+
+	return changes, allWarnings, nil
+}
+
+func (actor Actor) getRosterOrg(orgName string) (*rosterOrg, Warnings, error) {
+	var allWarnings Warnings
+
+	org, warnings, err := actor.V2Actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	rosterOrg, holderWarnings, err := actor.getOrgRoleHolders(org)
+	allWarnings = append(allWarnings, holderWarnings...)
+	return rosterOrg, allWarnings, err
+}
+
+func (actor Actor) getOrgRoleHolders(org v2action.Organization) (*rosterOrg, Warnings, error) {
+	var allWarnings Warnings
+
+	rosterOrg := &rosterOrg{name: org.Name, guid: org.GUID, holders: roleHolders{}}
+	for _, orgRole := range orgRoles {
+		users, warnings, err := actor.V2Actor.GetOrganizationUsersByRole(orgRole.constant, org.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		rosterOrg.holders[orgRole.role] = sortUsers(users)
+	}
+
+	return rosterOrg, allWarnings, nil
+}
+
+func (actor Actor) getRosterSpace(orgGUID string, spaceName string) (*rosterSpace, Warnings, error) {
+	var allWarnings Warnings
+
+	space, warnings, err := actor.V2Actor.GetSpaceByOrganizationAndName(orgGUID, spaceName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	rosterSpace, holderWarnings, err := actor.getSpaceRoleHolders(space)
+	allWarnings = append(allWarnings, holderWarnings...)
+	return rosterSpace, allWarnings, err
+}
+
+func (actor Actor) getSpaceRoleHolders(space v2action.Space) (*rosterSpace, Warnings, error) {
+	var allWarnings Warnings
+
+	rosterSpace := &rosterSpace{name: space.Name, guid: space.GUID, holders: roleHolders{}}
+	for _, spaceRole := range spaceRoles {
+		users, warnings, err := actor.V2Actor.GetSpaceUsersByRole(spaceRole.constant, space.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		rosterSpace.holders[spaceRole.role] = sortUsers(users)
+	}
+
+	return rosterSpace, allWarnings, nil
+}
+
+// ApplyRolePlan makes the changes in order. Creating a user that already
+// exists in UAA is not an error.
+func (actor Actor) ApplyRolePlan(changes []RoleChange) (Warnings, error) {
+	var allWarnings Warnings
+
+	for _, change := range changes {
+		var (
+			warnings v2action.Warnings
+			err      error
+		)
+
+		switch change.Type {
+		case CreateUser:
+			_, warnings, err = actor.V2Actor.CreateUser(change.Username, change.Password, change.Origin)
+			if _, ok := err.(uaa.ConflictError); ok {
+				err = nil
+			}
+		case GrantRole:
+			if change.Role.IsSpaceRole() {
+				warnings, err = actor.V2Actor.SetSpaceRole(spaceRoleConstant(change.Role), change.SpaceGUID, change.Username)
+			} else {
+				warnings, err = actor.V2Actor.SetOrganizationRole(orgRoleConstant(change.Role), change.OrgGUID, change.Username)
+			}
+		case RevokeRole:
+			if change.Role.IsSpaceRole() {
+				warnings, err = actor.V2Actor.RemoveSpaceRole(spaceRoleConstant(change.Role), change.SpaceGUID, change.UserGUID)
+			} else {
+				warnings, err = actor.V2Actor.RemoveOrganizationRole(orgRoleConstant(change.Role), change.OrgGUID, change.UserGUID)
+			}
+		}
+
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func orgRoleConstant(role roster.Role) constant.OrgRole {
+	for _, orgRole := range orgRoles {
+		if orgRole.role == role {
+			return orgRole.constant
+		}
+	}
+	return constant.OrgRole(role)
+}
+
+func spaceRoleConstant(role roster.Role) constant.SpaceRole {
+	for _, spaceRole := range spaceRoles {
+		if spaceRole.role == role {
+			return spaceRole.constant
+		}
+	}
+	return constant.SpaceRole(role)
+}
+
+func sortUsers(users []v2action.User) []v2action.User {
+	sort.Slice(users, func(i int, j int) bool {
+		return users[i].Username < users[j].Username
+	})
+	return users
+}
@@ -0,0 +1,195 @@
+package orgaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/orgaction"
+	"code.cloudfoundry.org/cli/actor/orgaction/orgactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util/roster"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Role Actions", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *orgactionfakes.FakeV2Actor
+		fakeV3Actor *orgactionfakes.FakeV3Actor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(orgactionfakes.FakeV2Actor)
+		fakeV3Actor = new(orgactionfakes.FakeV3Actor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor)
+	})
+
+	Describe("GetRolePlan", func() {
+		var (
+			entries    []roster.Entry
+			revoke     bool
+			changes    []RoleChange
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			revoke = false
+			entries = []roster.Entry{
+				{Username: "alice", Org: "some-org", Role: roster.OrgManager},
+				{Username: "bob", Org: "some-org", Space: "dev", Role: roster.SpaceDeveloper},
+				{Username: "carol", Password: "secret", Org: "some-org", Space: "dev", Role: roster.SpaceAuditor},
+			}
+
+			fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{GUID: "some-org-guid", Name: "some-org"}, v2action.Warnings{"org-warning"}, nil)
+			fakeV2Actor.GetOrganizationUsersByRoleStub = func(role constant.OrgRole, _ string) ([]v2action.User, v2action.Warnings, error) {
+				switch role {
+				case constant.OrgUserRole:
+					return []v2action.User{{GUID: "alice-guid", Username: "alice"}, {GUID: "dave-guid", Username: "dave"}}, nil, nil
+				case constant.OrgManagerRole:
+					return []v2action.User{{GUID: "dave-guid", Username: "dave"}, {GUID: "alice-guid", Username: "alice"}, {GUID: "some-client"}}, nil, nil
+				}
+				return nil, nil, nil
+			}
+			fakeV2Actor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "dev-guid", Name: "dev"}, v2action.Warnings{"space-warning"}, nil)
+			fakeV2Actor.GetSpaceUsersByRoleStub = func(role constant.SpaceRole, _ string) ([]v2action.User, v2action.Warnings, error) {
+				if role == constant.SpaceDeveloperRole {
+					return []v2action.User{{GUID: "dave-guid", Username: "dave"}}, nil, nil
+				}
+				return nil, nil, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.GetRolePlan(entries, revoke)
+		})
+
+		It("creates missing users and grants missing roles", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("org-warning", "space-warning"))
+			Expect(changes).To(Equal([]RoleChange{
+				{Type: CreateUser, Username: "carol", Password: "secret"},
+				{Type: GrantRole, Username: "bob", Org: "some-org", OrgGUID: "some-org-guid", Role: roster.OrgUser},
+				{Type: GrantRole, Username: "bob", Org: "some-org", OrgGUID: "some-org-guid", Space: "dev", SpaceGUID: "dev-guid", Role: roster.SpaceDeveloper},
+				{Type: GrantRole, Username: "carol", Org: "some-org", OrgGUID: "some-org-guid", Role: roster.OrgUser},
+				{Type: GrantRole, Username: "carol", Org: "some-org", OrgGUID: "some-org-guid", Space: "dev", SpaceGUID: "dev-guid", Role: roster.SpaceAuditor},
+			}))
+
+			Expect(fakeV2Actor.GetOrganizationByNameCallCount()).To(Equal(1))
+			Expect(fakeV2Actor.GetSpaceByOrganizationAndNameCallCount()).To(Equal(1))
+			orgGUID, spaceName := fakeV2Actor.GetSpaceByOrganizationAndNameArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("dev"))
+		})
+
+		Context("when revoking unlisted roles", func() {
+			BeforeEach(func() {
+				revoke = true
+			})
+
+			It("also revokes roles held by users left out of the roster", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes[len(changes)-2:]).To(Equal([]RoleChange{
+					{Type: RevokeRole, Username: "dave", UserGUID: "dave-guid", Org: "some-org", OrgGUID: "some-org-guid", Role: roster.OrgManager},
+					{Type: RevokeRole, Username: "dave", UserGUID: "dave-guid", Org: "some-org", OrgGUID: "some-org-guid", Space: "dev", SpaceGUID: "dev-guid", Role: roster.SpaceDeveloper},
+				}))
+			})
+		})
+
+		Context("when an org does not exist", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{}, v2action.Warnings{"org-warning"}, v2action.OrganizationNotFoundError{Name: "some-org"})
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(v2action.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("org-warning"))
+			})
+		})
+	})
+
+	Describe("ApplyRolePlan", func() {
+		var (
+			changes    []RoleChange
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			changes = []RoleChange{
+				{Type: CreateUser, Username: "carol", Password: "secret", Origin: "uaa"},
+				{Type: GrantRole, Username: "carol", OrgGUID: "some-org-guid", Role: roster.OrgUser},
+				{Type: GrantRole, Username: "carol", SpaceGUID: "dev-guid", Role: roster.SpaceAuditor},
+				{Type: RevokeRole, UserGUID: "dave-guid", OrgGUID: "some-org-guid", Role: roster.BillingManager},
+				{Type: RevokeRole, UserGUID: "dave-guid", SpaceGUID: "dev-guid", Role: roster.SpaceManager},
+			}
+			fakeV2Actor.CreateUserReturns(v2action.User{}, v2action.Warnings{"create-warning"}, nil)
+			fakeV2Actor.SetOrganizationRoleReturns(v2action.Warnings{"set-org-role-warning"}, nil)
+			fakeV2Actor.SetSpaceRoleReturns(v2action.Warnings{"set-space-role-warning"}, nil)
+			fakeV2Actor.RemoveOrganizationRoleReturns(v2action.Warnings{"remove-org-role-warning"}, nil)
+			fakeV2Actor.RemoveSpaceRoleReturns(v2action.Warnings{"remove-space-role-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplyRolePlan(changes)
+		})
+
+		It("makes each change", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(Equal(Warnings{"create-warning", "set-org-role-warning", "set-space-role-warning", "remove-org-role-warning", "remove-space-role-warning"}))
+
+			username, password, origin := fakeV2Actor.CreateUserArgsForCall(0)
+			Expect(username).To(Equal("carol"))
+			Expect(password).To(Equal("secret"))
+			Expect(origin).To(Equal("uaa"))
+
+			orgRole, orgGUID, orgUsername := fakeV2Actor.SetOrganizationRoleArgsForCall(0)
+			Expect(orgRole).To(Equal(constant.OrgUserRole))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(orgUsername).To(Equal("carol"))
+
+			spaceRole, spaceGUID, spaceUsername := fakeV2Actor.SetSpaceRoleArgsForCall(0)
+			Expect(spaceRole).To(Equal(constant.SpaceAuditorRole))
+			Expect(spaceGUID).To(Equal("dev-guid"))
+			Expect(spaceUsername).To(Equal("carol"))
+
+			orgRole, orgGUID, userGUID := fakeV2Actor.RemoveOrganizationRoleArgsForCall(0)
+			Expect(orgRole).To(Equal(constant.OrgBillingManagerRole))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(userGUID).To(Equal("dave-guid"))
+
+			spaceRole, spaceGUID, userGUID = fakeV2Actor.RemoveSpaceRoleArgsForCall(0)
+			Expect(spaceRole).To(Equal(constant.SpaceManagerRole))
+			Expect(spaceGUID).To(Equal("dev-guid"))
+			Expect(userGUID).To(Equal("dave-guid"))
+		})
+
+		Context("when the user already exists in UAA", func() {
+			BeforeEach(func() {
+				fakeV2Actor.CreateUserReturns(v2action.User{}, nil, uaa.ConflictError{Message: "Username already in use: carol"})
+			})
+
+			It("carries on with the other changes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeV2Actor.SetOrganizationRoleCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when a change fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("set role failed")
+				fakeV2Actor.SetSpaceRoleReturns(v2action.Warnings{"set-space-role-warning"}, expectedErr)
+			})
+
+			It("stops and returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(Equal(Warnings{"create-warning", "set-org-role-warning", "set-space-role-warning"}))
+				Expect(fakeV2Actor.RemoveOrganizationRoleCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
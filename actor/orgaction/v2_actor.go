@@ -0,0 +1,38 @@
+package orgaction
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+//go:generate counterfeiter . V2Actor
+
+type V2Actor interface {
+	BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID string, lifecycle ccv2.SecurityGroupLifecycle) (v2action.Warnings, error)
+	CreateOrganization(orgName string, quotaGUID string) (v2action.Organization, v2action.Warnings, error)
+	CreatePrivateDomain(domainName string, orgGUID string) (v2action.Domain, v2action.Warnings, error)
+	CreateSpace(spaceName string, orgGUID string) (v2action.Space, v2action.Warnings, error)
+	CreateSpaceQuota(spaceQuota v2action.SpaceQuota) (v2action.SpaceQuota, v2action.Warnings, error)
+	CreateUser(username string, password string, origin string) (v2action.User, v2action.Warnings, error)
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetOrganizationDomains(orgGUID string) ([]v2action.Domain, v2action.Warnings, error)
+	GetOrganizations() ([]v2action.Organization, v2action.Warnings, error)
+	GetOrganizationQuotaByName(quotaName string) (v2action.OrganizationQuota, v2action.Warnings, error)
+	GetOrganizationSpaceQuotas(orgGUID string) ([]v2action.SpaceQuota, v2action.Warnings, error)
+	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+	GetOrganizationSummaryByName(orgName string) (v2action.OrganizationSummary, v2action.Warnings, error)
+	GetOrganizationUsersByRole(role constant.OrgRole, orgGUID string) ([]v2action.User, v2action.Warnings, error)
+	GetSecurityGroupByName(securityGroupName string) (v2action.SecurityGroup, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	GetSpaceRunningSecurityGroupsBySpace(spaceGUID string) ([]v2action.SecurityGroup, v2action.Warnings, error)
+	GetSpaceSummaryByOrganizationAndName(orgGUID string, spaceName string, includeStagingSecurityGroupsRules bool) (v2action.SpaceSummary, v2action.Warnings, error)
+	GetSpaceUsersByRole(role constant.SpaceRole, spaceGUID string) ([]v2action.User, v2action.Warnings, error)
+	RemoveOrganizationRole(role constant.OrgRole, orgGUID string, userGUID string) (v2action.Warnings, error)
+	RemoveSpaceRole(role constant.SpaceRole, spaceGUID string, userGUID string) (v2action.Warnings, error)
+	SetOrganizationRole(role constant.OrgRole, orgGUID string, username string) (v2action.Warnings, error)
+	SetSpaceQuota(spaceGUID string, spaceQuotaGUID string) (v2action.Warnings, error)
+	SetSpaceRole(role constant.SpaceRole, spaceGUID string, username string) (v2action.Warnings, error)
+	UpdateOrganizationQuota(orgGUID string, quotaGUID string) (v2action.Warnings, error)
+	UpdateSpaceQuota(spaceQuota v2action.SpaceQuota) (v2action.SpaceQuota, v2action.Warnings, error)
+}
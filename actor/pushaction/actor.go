@@ -2,6 +2,8 @@
 // push.
 package pushaction
 
+import "time"
+
 // Warnings is a list of warnings returned back from the cloud controller
 type Warnings []string
 
@@ -9,12 +11,17 @@ type Warnings []string
 type Actor struct {
 	V2Actor     V2Actor
 	SharedActor SharedActor
+
+	// UploadRetryDelay is the delay before the first retry of a failed
+	// upload.
+	UploadRetryDelay time.Duration
 }
 
 // NewActor returns a new actor.
 func NewActor(v2Actor V2Actor, sharedActor SharedActor) *Actor {
 	return &Actor{
-		V2Actor:     v2Actor,
-		SharedActor: sharedActor,
+		V2Actor:          v2Actor,
+		SharedActor:      sharedActor,
+		UploadRetryDelay: DefaultUploadRetryDelay,
 	}
 }
@@ -21,6 +21,10 @@ type ApplicationConfig struct {
 
 	CurrentServices map[string]v2action.ServiceInstance
 	DesiredServices map[string]v2action.ServiceInstance
+	// ServiceDeclarations are the manifest's detailed services entries, by
+	// service instance name. Desired services without a GUID are created from
+	// them.
+	ServiceDeclarations map[string]manifest.Service
 
 	AllResources       []v2action.Resource
 	MatchedResources   []v2action.Resource
@@ -39,6 +43,17 @@ func (config ApplicationConfig) UpdatingApplication() bool {
 	return !config.CreatingApplication()
 }
 
+// CreatingServices returns true if any desired service instance has yet to
+// be created.
+func (config ApplicationConfig) CreatingServices() bool {
+	for _, serviceInstance := range config.DesiredServices {
+		if serviceInstance.GUID == "" {
+			return true
+		}
+	}
+	return false
+}
+
 func (actor Actor) ConvertToApplicationConfigs(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]ApplicationConfig, Warnings, error) {
 	var configs []ApplicationConfig
 	var warnings Warnings
@@ -86,8 +101,13 @@ func (actor Actor) ConvertToApplicationConfigs(orgGUID string, spaceGUID string,
 		}
 		log.Debugln("post overriding config:", config.DesiredApplication)
 
+		config.ServiceDeclarations = map[string]manifest.Service{}
+		for _, service := range app.ServiceDeclarations {
+			config.ServiceDeclarations[service.Name] = service
+		}
+
 		var serviceWarnings Warnings
-		config.DesiredServices, serviceWarnings, err = actor.getDesiredServices(config.CurrentServices, app.Services, spaceGUID)
+		config.DesiredServices, serviceWarnings, err = actor.getDesiredServices(config.CurrentServices, app.Services, config.ServiceDeclarations, spaceGUID)
 		warnings = append(warnings, serviceWarnings...)
 		if err != nil {
 			log.Errorln("getting services:", err)
@@ -138,7 +158,7 @@ func (actor Actor) configureRoutes(manifestApp manifest.Application, orgGUID str
 	return config, warnings, nil
 }
 
-func (actor Actor) getDesiredServices(currentServices map[string]v2action.ServiceInstance, requestedServices []string, spaceGUID string) (map[string]v2action.ServiceInstance, Warnings, error) {
+func (actor Actor) getDesiredServices(currentServices map[string]v2action.ServiceInstance, requestedServices []string, declarations map[string]manifest.Service, spaceGUID string) (map[string]v2action.ServiceInstance, Warnings, error) {
 	var warnings Warnings
 
 	desiredServices := map[string]v2action.ServiceInstance{}
@@ -152,6 +172,10 @@ func (actor Actor) getDesiredServices(currentServices map[string]v2action.Servic
 			log.Debugln("adding requested service:", serviceName)
 			serviceInstance, serviceWarnings, err := actor.V2Actor.GetServiceInstanceByNameAndSpace(serviceName, spaceGUID)
 			warnings = append(warnings, serviceWarnings...)
+			if _, ok := err.(v2action.ServiceInstanceNotFoundError); ok && declarations[serviceName].Creatable() {
+				log.Debugln("service will be created:", serviceName)
+				serviceInstance, err = v2action.ServiceInstance{Name: serviceName}, nil
+			}
 			if err != nil {
 				return nil, warnings, err
 			}
@@ -516,6 +516,35 @@ var _ = Describe("Application Config", func() {
 					Expect(warnings).To(ConsistOf("some-service-warning-1", "some-service-warning-2"))
 				})
 			})
+
+			Context("when a service does not exist", func() {
+				BeforeEach(func() {
+					fakeV2Actor.GetServiceInstanceByNameAndSpaceReturns(v2action.ServiceInstance{}, v2action.Warnings{"some-service-warning-2"}, v2action.ServiceInstanceNotFoundError{Name: "service_2"})
+				})
+
+				Context("when the manifest declares how to create it", func() {
+					BeforeEach(func() {
+						manifestApps[0].ServiceDeclarations = []manifest.Service{
+							{Name: "service_2", Offering: "p-mysql", Plan: "small"},
+						}
+					})
+
+					It("adds it to DesiredServices to be created", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(firstConfig.DesiredServices).To(HaveKeyWithValue("service_2", v2action.ServiceInstance{Name: "service_2"}))
+						Expect(firstConfig.ServiceDeclarations).To(Equal(map[string]manifest.Service{
+							"service_2": {Name: "service_2", Offering: "p-mysql", Plan: "small"},
+						}))
+						Expect(firstConfig.CreatingServices()).To(BeTrue())
+					})
+				})
+
+				Context("when the manifest does not declare how to create it", func() {
+					It("returns a ServiceInstanceNotFoundError", func() {
+						Expect(executeErr).To(MatchError(v2action.ServiceInstanceNotFoundError{Name: "service_2"}))
+					})
+				})
+			})
 		})
 
 		Context("when no-route is set", func() {
@@ -2,6 +2,7 @@ package pushaction
 
 import (
 	"os"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -9,7 +10,16 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-const PushRetries = 3
+const (
+	// PushRetries is the number of times an upload is attempted before
+	// giving up.
+	PushRetries = 3
+
+	// DefaultUploadRetryDelay is the delay before the first upload retry. The
+	// delay doubles with every subsequent retry, up to MaxUploadRetryDelay.
+	DefaultUploadRetryDelay = 2 * time.Second
+	MaxUploadRetryDelay     = 30 * time.Second
+)
 
 func (actor Actor) Apply(config ApplicationConfig, progressBar ProgressBar) (<-chan ApplicationConfig, <-chan Event, <-chan Warnings, <-chan error) {
 	configStream := make(chan ApplicationConfig)
@@ -77,6 +87,18 @@ func (actor Actor) Apply(config ApplicationConfig, progressBar ProgressBar) (<-c
 		}
 
 		if len(config.CurrentServices) != len(config.DesiredServices) {
+			if config.CreatingServices() {
+				eventStream <- CreatingServiceInstances
+				config, warnings, err = actor.CreateServices(config)
+				warningsStream <- warnings
+				if err != nil {
+					errorStream <- err
+					return
+				}
+				log.Debugf("created desired services: %#v", config.DesiredServices)
+				eventStream <- CreatedServiceInstances
+			}
+
 			eventStream <- ConfiguringServices
 			var boundServices bool
 			config, boundServices, warnings, err = actor.BindServices(config)
@@ -92,44 +114,58 @@ func (actor Actor) Apply(config ApplicationConfig, progressBar ProgressBar) (<-c
 		}
 
 		if config.DesiredApplication.DockerImage == "" {
-			eventStream <- ResourceMatching
-			config, warnings = actor.SetMatchedResources(config)
-			warningsStream <- warnings
-
-			if len(config.UnmatchedResources) == 0 {
-				eventStream <- UploadingApplication
-				warnings, err = actor.UploadPackage(config)
-				warningsStream <- warnings
-				if err != nil {
-					errorStream <- err
-					return
-				}
+			if actor.PackageUnchanged(config) {
+				log.Info("resources unchanged since the last upload")
+				eventStream <- UploadSkipped
 			} else {
-				archivePath, err := actor.CreateArchive(config)
-				if err != nil {
-					errorStream <- err
-					return
-				}
-				eventStream <- CreatingArchive
-				defer os.Remove(archivePath)
+				eventStream <- ResourceMatching
+				config, warnings = actor.SetMatchedResources(config)
+				warningsStream <- warnings
 
-				for count := 0; count < PushRetries; count++ {
-					warnings, err = actor.UploadPackageWithArchive(config, archivePath, progressBar, eventStream)
+				if len(config.UnmatchedResources) == 0 {
+					eventStream <- UploadingApplication
+					warnings, err = actor.UploadPackage(config)
 					warningsStream <- warnings
-					if _, ok := err.(ccerror.PipeSeekError); !ok {
-						break
+					if err != nil {
+						errorStream <- err
+						return
+					}
+				} else {
+					archivePath, err := actor.CreateArchive(config)
+					if err != nil {
+						errorStream <- err
+						return
+					}
+					eventStream <- CreatingArchive
+					defer os.Remove(archivePath)
+
+					for count := 0; count < PushRetries; count++ {
+						if count > 0 {
+							delay := actor.uploadRetryDelay(count)
+							log.WithField("delay", delay).Info("retrying upload")
+							eventStream <- RetryUpload
+							time.Sleep(delay)
+						}
+
+						warnings, err = actor.UploadPackageWithArchive(config, archivePath, progressBar, eventStream)
+						warningsStream <- warnings
+						if _, ok := err.(ccerror.PipeSeekError); !ok {
+							break
+						}
+						log.Errorln("uploading archive:", err)
 					}
-					eventStream <- RetryUpload
-				}
 
-				if err != nil {
-					if _, ok := err.(ccerror.PipeSeekError); ok {
-						errorStream <- actionerror.UploadFailedError{}
+					if err != nil {
+						if pipeSeekErr, ok := err.(ccerror.PipeSeekError); ok {
+							errorStream <- actionerror.UploadFailedError{Err: pipeSeekErr.Err}
+							return
+						}
+						errorStream <- err
 						return
 					}
-					errorStream <- err
-					return
 				}
+
+				warningsStream <- actor.RecordUploadedPackage(config)
 			}
 		} else {
 			log.WithField("docker_image", config.DesiredApplication.DockerImage).Debug("skipping file upload")
@@ -143,3 +179,17 @@ func (actor Actor) Apply(config ApplicationConfig, progressBar ProgressBar) (<-c
 
 	return configStream, eventStream, warningsStream, errorStream
 }
+
+// uploadRetryDelay returns how long to wait before the given retry of an
+// upload.
+func (actor Actor) uploadRetryDelay(retry int) time.Duration {
+	delay := actor.UploadRetryDelay
+	for i := 1; i < retry && delay < MaxUploadRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > MaxUploadRetryDelay {
+		return MaxUploadRetryDelay
+	}
+	return delay
+}
@@ -3,12 +3,14 @@ package pushaction_test
 import (
 	"errors"
 	"io/ioutil"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/util/manifest"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -172,12 +174,24 @@ var _ = Describe("Apply", func() {
 								Context("when the upload is successful", func() {
 									BeforeEach(func() {
 										fakeV2Actor.UploadApplicationPackageReturns(v2action.Job{}, v2action.Warnings{"upload-warnings-1", "upload-warnings-2"}, nil)
+										fakeV2Actor.GetApplicationReturns(v2action.Application{GUID: "some-app-guid", PackageUpdatedAt: time.Unix(1500000000, 0)}, v2action.Warnings{"get-application-warnings"}, nil)
 									})
 
 									JustBeforeEach(func() {
 										Eventually(eventStream).Should(Receive(Equal(UploadingApplicationWithArchive)))
 										Eventually(eventStream).Should(Receive(Equal(UploadWithArchiveComplete)))
 										Eventually(warningsStream).Should(Receive(ConsistOf("upload-warnings-1", "upload-warnings-2")))
+										Eventually(warningsStream).Should(Receive(ConsistOf("get-application-warnings")))
+									})
+
+									It("records the uploaded package", func() {
+										Eventually(fakeSharedActor.RecordPackageResourcesCallCount).Should(Equal(1))
+										appGUID, packageUpdatedAt, _ := fakeSharedActor.RecordPackageResourcesArgsForCall(0)
+										Expect(appGUID).To(Equal("some-app-guid"))
+										Expect(packageUpdatedAt).To(Equal(time.Unix(1500000000, 0)))
+
+										Expect(fakeV2Actor.GetApplicationCallCount()).To(Equal(1))
+										Expect(fakeV2Actor.GetApplicationArgsForCall(0)).To(Equal("some-app-guid"))
 									})
 
 									It("sends the updated config and a complete event", func() {
@@ -199,11 +213,15 @@ var _ = Describe("Apply", func() {
 
 								Context("when the upload errors", func() {
 									Context("with a retryable error", func() {
+										var uploadErr error
+
 										BeforeEach(func() {
-											fakeV2Actor.UploadApplicationPackageReturns(v2action.Job{}, v2action.Warnings{"upload-warnings-1", "upload-warnings-2"}, ccerror.PipeSeekError{})
+											actor.UploadRetryDelay = time.Millisecond
+											uploadErr = errors.New("connection reset by peer")
+											fakeV2Actor.UploadApplicationPackageReturns(v2action.Job{}, v2action.Warnings{"upload-warnings-1", "upload-warnings-2"}, ccerror.PipeSeekError{Err: uploadErr})
 										})
 
-										It("retries the download up to three times", func() {
+										It("retries the upload up to three times", func() {
 											Eventually(eventStream).Should(Receive(Equal(UploadingApplicationWithArchive)))
 											Eventually(fakeProgressBar.NewProgressBarWrapperCallCount).Should(Equal(1))
 											Eventually(warningsStream).Should(Receive(ConsistOf("upload-warnings-1", "upload-warnings-2")))
@@ -217,9 +235,36 @@ var _ = Describe("Apply", func() {
 											Eventually(eventStream).Should(Receive(Equal(UploadingApplicationWithArchive)))
 											Eventually(fakeProgressBar.NewProgressBarWrapperCallCount).Should(Equal(3))
 											Eventually(warningsStream).Should(Receive(ConsistOf("upload-warnings-1", "upload-warnings-2")))
-											Eventually(eventStream).Should(Receive(Equal(RetryUpload)))
 
-											Eventually(errorStream).Should(Receive(Equal(actionerror.UploadFailedError{})))
+											Eventually(errorStream).Should(Receive(Equal(actionerror.UploadFailedError{Err: uploadErr})))
+										})
+
+										It("reuses the archive for every attempt", func() {
+											Expect(streamsDrainedAndClosed(configStream, eventStream, warningsStream, errorStream)).To(BeTrue())
+											Expect(fakeV2Actor.UploadApplicationPackageCallCount()).To(Equal(3))
+											Expect(fakeSharedActor.ZipDirectoryResourcesCallCount()).To(Equal(1))
+										})
+
+										Context("when a retry succeeds", func() {
+											BeforeEach(func() {
+												fakeV2Actor.UploadApplicationPackageReturnsOnCall(1, v2action.Job{}, v2action.Warnings{"upload-warnings-3"}, nil)
+												fakeV2Actor.GetApplicationReturns(v2action.Application{GUID: "some-app-guid"}, nil, nil)
+											})
+
+											It("completes the push", func() {
+												Eventually(eventStream).Should(Receive(Equal(UploadingApplicationWithArchive)))
+												Eventually(warningsStream).Should(Receive(ConsistOf("upload-warnings-1", "upload-warnings-2")))
+												Eventually(eventStream).Should(Receive(Equal(RetryUpload)))
+
+												Eventually(eventStream).Should(Receive(Equal(UploadingApplicationWithArchive)))
+												Eventually(eventStream).Should(Receive(Equal(UploadWithArchiveComplete)))
+												Eventually(warningsStream).Should(Receive(ConsistOf("upload-warnings-3")))
+												Eventually(warningsStream).Should(Receive())
+												Eventually(configStream).Should(Receive())
+												Eventually(eventStream).Should(Receive(Equal(Complete)))
+
+												Expect(fakeV2Actor.UploadApplicationPackageCallCount()).To(Equal(2))
+											})
 										})
 									})
 
@@ -269,6 +314,11 @@ var _ = Describe("Apply", func() {
 							Context("when the upload is successful", func() {
 								BeforeEach(func() {
 									fakeV2Actor.UploadApplicationPackageReturns(v2action.Job{}, v2action.Warnings{"upload-warnings-1", "upload-warnings-2"}, nil)
+									fakeV2Actor.GetApplicationReturns(v2action.Application{GUID: "some-app-guid"}, v2action.Warnings{"get-application-warnings"}, nil)
+								})
+
+								JustBeforeEach(func() {
+									Eventually(warningsStream).Should(Receive(ConsistOf("get-application-warnings")))
 								})
 
 								It("sends the updated config and a complete event", func() {
@@ -306,6 +356,33 @@ var _ = Describe("Apply", func() {
 						})
 					})
 
+					Context("when the resources are unchanged since the last upload", func() {
+						BeforeEach(func() {
+							fakeV2Actor.CreateApplicationStub = func(application v2action.Application) (v2action.Application, v2action.Warnings, error) {
+								createdApp = application
+								createdApp.GUID = "some-app-guid"
+								createdApp.PackageUpdatedAt = time.Unix(1500000000, 0)
+
+								return createdApp, v2action.Warnings{"create-application-warnings-1", "create-application-warnings-2"}, nil
+							}
+							fakeSharedActor.PackageResourcesUnchangedReturns(true)
+						})
+
+						It("skips resource matching and uploading", func() {
+							Eventually(eventStream).Should(Receive(Equal(UploadSkipped)))
+							Eventually(configStream).Should(Receive())
+							Eventually(eventStream).Should(Receive(Equal(Complete)))
+
+							Expect(fakeSharedActor.PackageResourcesUnchangedCallCount()).To(Equal(1))
+							appGUID, packageUpdatedAt, _ := fakeSharedActor.PackageResourcesUnchangedArgsForCall(0)
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(packageUpdatedAt).To(Equal(time.Unix(1500000000, 0)))
+
+							Expect(fakeV2Actor.ResourceMatchCallCount()).To(Equal(0))
+							Expect(fakeV2Actor.UploadApplicationPackageCallCount()).To(Equal(0))
+						})
+					})
+
 					Context("when a docker image is provided", func() {
 						BeforeEach(func() {
 							config.DesiredApplication.DockerImage = "some-docker-image-path"
@@ -320,6 +397,44 @@ var _ = Describe("Apply", func() {
 					})
 				})
 
+				Context("when a desired service instance has to be created", func() {
+					BeforeEach(func() {
+						config.DesiredServices = map[string]v2action.ServiceInstance{
+							"service_1": {Name: "service_1"},
+						}
+						config.ServiceDeclarations = map[string]manifest.Service{
+							"service_1": {Name: "service_1", Offering: "p-mysql", Plan: "small"},
+						}
+						fakeV2Actor.CreateServiceInstanceReturns(v2action.ServiceInstance{Name: "service_1", GUID: "service_guid"}, v2action.Warnings{"create-service-warning"}, nil)
+					})
+
+					It("creates the service instance before binding it", func() {
+						Eventually(eventStream).Should(Receive(Equal(CreatingServiceInstances)))
+						Eventually(warningsStream).Should(Receive(ConsistOf("create-service-warning")))
+						Eventually(eventStream).Should(Receive(Equal(CreatedServiceInstances)))
+						Eventually(eventStream).Should(Receive(Equal(ConfiguringServices)))
+						Eventually(fakeV2Actor.BindServiceByApplicationAndServiceInstanceCallCount).Should(Equal(1))
+						_, serviceInstanceGUID, _ := fakeV2Actor.BindServiceByApplicationAndServiceInstanceArgsForCall(0)
+						Expect(serviceInstanceGUID).To(Equal("service_guid"))
+					})
+
+					Context("when creating the service instance fails", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = errors.New("provisioning failed")
+							fakeV2Actor.CreateServiceInstanceReturns(v2action.ServiceInstance{}, v2action.Warnings{"create-service-warning"}, expectedErr)
+						})
+
+						It("sends warnings and errors, then stops", func() {
+							Eventually(eventStream).Should(Receive(Equal(CreatingServiceInstances)))
+							Eventually(warningsStream).Should(Receive(ConsistOf("create-service-warning")))
+							Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+							Consistently(eventStream).ShouldNot(Receive())
+						})
+					})
+				})
+
 				Context("when there are no services to bind", func() {
 					BeforeEach(func() {
 						services := map[string]v2action.ServiceInstance{
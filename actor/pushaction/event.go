@@ -10,6 +10,8 @@ const (
 	CreatedRoutes                   Event = "created routes"
 	BoundRoutes                     Event = "bound routes"
 	UnmappingRoutes                 Event = "unmapping routes"
+	CreatingServiceInstances        Event = "creating service instances"
+	CreatedServiceInstances         Event = "created service instances"
 	ConfiguringServices             Event = "configuring services"
 	BoundServices                   Event = "bound services"
 	CreatingArchive                 Event = "creating archive"
@@ -18,5 +20,6 @@ const (
 	UploadingApplicationWithArchive Event = "uploading application with archive"
 	UploadWithArchiveComplete       Event = "upload complete"
 	RetryUpload                     Event = "retry upload"
+	UploadSkipped                   Event = "upload skipped"
 	Complete                        Event = "complete"
 )
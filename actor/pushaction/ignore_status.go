@@ -0,0 +1,26 @@
+package pushaction
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetIgnoreStatuses returns whether each file of the app at path, a directory
+// or an archive, would be uploaded by push and which .cfignore rule decided
+// it.
+func (actor Actor) GetIgnoreStatuses(path string) ([]sharedaction.FileIgnoreStatus, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		log.WithField("path", path).Info("determine ignored files of directory")
+		return actor.SharedActor.GatherDirectoryIgnoreStatuses(path)
+	}
+
+	log.WithField("path", path).Info("determine ignored files of archive")
+	return actor.SharedActor.GatherArchiveIgnoreStatuses(path)
+}
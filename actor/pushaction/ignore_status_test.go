@@ -0,0 +1,93 @@
+package pushaction_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ignore Statuses", func() {
+	var (
+		actor           *Actor
+		fakeSharedActor *pushactionfakes.FakeSharedActor
+	)
+
+	BeforeEach(func() {
+		fakeSharedActor = new(pushactionfakes.FakeSharedActor)
+		actor = NewActor(nil, fakeSharedActor)
+	})
+
+	Describe("GetIgnoreStatuses", func() {
+		var statuses []sharedaction.FileIgnoreStatus
+
+		BeforeEach(func() {
+			statuses = []sharedaction.FileIgnoreStatus{
+				{Filename: "some-file"},
+				{Filename: "some-ignored-file", Ignored: true, Rule: sharedaction.IgnoreRule{Source: ".cfignore", Line: 1, Pattern: "some-ignored-file"}},
+			}
+			fakeSharedActor.GatherDirectoryIgnoreStatusesReturns(statuses, nil)
+			fakeSharedActor.GatherArchiveIgnoreStatusesReturns(statuses, nil)
+		})
+
+		Context("when the path is a directory", func() {
+			var dir string
+
+			BeforeEach(func() {
+				var err error
+				dir, err = ioutil.TempDir("", "ignore-statuses")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(dir)).To(Succeed())
+			})
+
+			It("returns the statuses of the directory", func() {
+				returnedStatuses, err := actor.GetIgnoreStatuses(dir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(returnedStatuses).To(Equal(statuses))
+
+				Expect(fakeSharedActor.GatherDirectoryIgnoreStatusesCallCount()).To(Equal(1))
+				Expect(fakeSharedActor.GatherDirectoryIgnoreStatusesArgsForCall(0)).To(Equal(dir))
+				Expect(fakeSharedActor.GatherArchiveIgnoreStatusesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the path is an archive", func() {
+			var archive string
+
+			BeforeEach(func() {
+				tmpfile, err := ioutil.TempFile("", "ignore-statuses-archive")
+				Expect(err).ToNot(HaveOccurred())
+				archive = tmpfile.Name()
+				Expect(tmpfile.Close()).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(archive)).To(Succeed())
+			})
+
+			It("returns the statuses of the archive", func() {
+				returnedStatuses, err := actor.GetIgnoreStatuses(archive)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(returnedStatuses).To(Equal(statuses))
+
+				Expect(fakeSharedActor.GatherArchiveIgnoreStatusesCallCount()).To(Equal(1))
+				Expect(fakeSharedActor.GatherArchiveIgnoreStatusesArgsForCall(0)).To(Equal(archive))
+			})
+		})
+
+		Context("when the path does not exist", func() {
+			It("returns the error", func() {
+				_, err := actor.GetIgnoreStatuses("/does/not/exist")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+})
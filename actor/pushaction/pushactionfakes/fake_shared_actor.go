@@ -3,12 +3,26 @@ package pushactionfakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 )
 
 type FakeSharedActor struct {
+	GatherArchiveIgnoreStatusesStub        func(archivePath string) ([]sharedaction.FileIgnoreStatus, error)
+	gatherArchiveIgnoreStatusesMutex       sync.RWMutex
+	gatherArchiveIgnoreStatusesArgsForCall []struct {
+		archivePath string
+	}
+	gatherArchiveIgnoreStatusesReturns struct {
+		result1 []sharedaction.FileIgnoreStatus
+		result2 error
+	}
+	gatherArchiveIgnoreStatusesReturnsOnCall map[int]struct {
+		result1 []sharedaction.FileIgnoreStatus
+		result2 error
+	}
 	GatherArchiveResourcesStub        func(archivePath string) ([]sharedaction.Resource, error)
 	gatherArchiveResourcesMutex       sync.RWMutex
 	gatherArchiveResourcesArgsForCall []struct {
@@ -22,6 +36,19 @@ type FakeSharedActor struct {
 		result1 []sharedaction.Resource
 		result2 error
 	}
+	GatherDirectoryIgnoreStatusesStub        func(sourceDir string) ([]sharedaction.FileIgnoreStatus, error)
+	gatherDirectoryIgnoreStatusesMutex       sync.RWMutex
+	gatherDirectoryIgnoreStatusesArgsForCall []struct {
+		sourceDir string
+	}
+	gatherDirectoryIgnoreStatusesReturns struct {
+		result1 []sharedaction.FileIgnoreStatus
+		result2 error
+	}
+	gatherDirectoryIgnoreStatusesReturnsOnCall map[int]struct {
+		result1 []sharedaction.FileIgnoreStatus
+		result2 error
+	}
 	GatherDirectoryResourcesStub        func(sourceDir string) ([]sharedaction.Resource, error)
 	gatherDirectoryResourcesMutex       sync.RWMutex
 	gatherDirectoryResourcesArgsForCall []struct {
@@ -35,6 +62,32 @@ type FakeSharedActor struct {
 		result1 []sharedaction.Resource
 		result2 error
 	}
+	PackageResourcesUnchangedStub        func(appGUID string, packageUpdatedAt time.Time, resources []sharedaction.Resource) bool
+	packageResourcesUnchangedMutex       sync.RWMutex
+	packageResourcesUnchangedArgsForCall []struct {
+		appGUID          string
+		packageUpdatedAt time.Time
+		resources        []sharedaction.Resource
+	}
+	packageResourcesUnchangedReturns struct {
+		result1 bool
+	}
+	packageResourcesUnchangedReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	RecordPackageResourcesStub        func(appGUID string, packageUpdatedAt time.Time, resources []sharedaction.Resource) error
+	recordPackageResourcesMutex       sync.RWMutex
+	recordPackageResourcesArgsForCall []struct {
+		appGUID          string
+		packageUpdatedAt time.Time
+		resources        []sharedaction.Resource
+	}
+	recordPackageResourcesReturns struct {
+		result1 error
+	}
+	recordPackageResourcesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ZipArchiveResourcesStub        func(sourceArchivePath string, filesToInclude []sharedaction.Resource) (string, error)
 	zipArchiveResourcesMutex       sync.RWMutex
 	zipArchiveResourcesArgsForCall []struct {
@@ -67,6 +120,57 @@ type FakeSharedActor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeSharedActor) GatherArchiveIgnoreStatuses(archivePath string) ([]sharedaction.FileIgnoreStatus, error) {
+	fake.gatherArchiveIgnoreStatusesMutex.Lock()
+	ret, specificReturn := fake.gatherArchiveIgnoreStatusesReturnsOnCall[len(fake.gatherArchiveIgnoreStatusesArgsForCall)]
+	fake.gatherArchiveIgnoreStatusesArgsForCall = append(fake.gatherArchiveIgnoreStatusesArgsForCall, struct {
+		archivePath string
+	}{archivePath})
+	fake.recordInvocation("GatherArchiveIgnoreStatuses", []interface{}{archivePath})
+	fake.gatherArchiveIgnoreStatusesMutex.Unlock()
+	if fake.GatherArchiveIgnoreStatusesStub != nil {
+		return fake.GatherArchiveIgnoreStatusesStub(archivePath)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.gatherArchiveIgnoreStatusesReturns.result1, fake.gatherArchiveIgnoreStatusesReturns.result2
+}
+
+func (fake *FakeSharedActor) GatherArchiveIgnoreStatusesCallCount() int {
+	fake.gatherArchiveIgnoreStatusesMutex.RLock()
+	defer fake.gatherArchiveIgnoreStatusesMutex.RUnlock()
+	return len(fake.gatherArchiveIgnoreStatusesArgsForCall)
+}
+
+func (fake *FakeSharedActor) GatherArchiveIgnoreStatusesArgsForCall(i int) string {
+	fake.gatherArchiveIgnoreStatusesMutex.RLock()
+	defer fake.gatherArchiveIgnoreStatusesMutex.RUnlock()
+	return fake.gatherArchiveIgnoreStatusesArgsForCall[i].archivePath
+}
+
+func (fake *FakeSharedActor) GatherArchiveIgnoreStatusesReturns(result1 []sharedaction.FileIgnoreStatus, result2 error) {
+	fake.GatherArchiveIgnoreStatusesStub = nil
+	fake.gatherArchiveIgnoreStatusesReturns = struct {
+		result1 []sharedaction.FileIgnoreStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSharedActor) GatherArchiveIgnoreStatusesReturnsOnCall(i int, result1 []sharedaction.FileIgnoreStatus, result2 error) {
+	fake.GatherArchiveIgnoreStatusesStub = nil
+	if fake.gatherArchiveIgnoreStatusesReturnsOnCall == nil {
+		fake.gatherArchiveIgnoreStatusesReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.FileIgnoreStatus
+			result2 error
+		})
+	}
+	fake.gatherArchiveIgnoreStatusesReturnsOnCall[i] = struct {
+		result1 []sharedaction.FileIgnoreStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSharedActor) GatherArchiveResources(archivePath string) ([]sharedaction.Resource, error) {
 	fake.gatherArchiveResourcesMutex.Lock()
 	ret, specificReturn := fake.gatherArchiveResourcesReturnsOnCall[len(fake.gatherArchiveResourcesArgsForCall)]
@@ -118,6 +222,57 @@ func (fake *FakeSharedActor) GatherArchiveResourcesReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeSharedActor) GatherDirectoryIgnoreStatuses(sourceDir string) ([]sharedaction.FileIgnoreStatus, error) {
+	fake.gatherDirectoryIgnoreStatusesMutex.Lock()
+	ret, specificReturn := fake.gatherDirectoryIgnoreStatusesReturnsOnCall[len(fake.gatherDirectoryIgnoreStatusesArgsForCall)]
+	fake.gatherDirectoryIgnoreStatusesArgsForCall = append(fake.gatherDirectoryIgnoreStatusesArgsForCall, struct {
+		sourceDir string
+	}{sourceDir})
+	fake.recordInvocation("GatherDirectoryIgnoreStatuses", []interface{}{sourceDir})
+	fake.gatherDirectoryIgnoreStatusesMutex.Unlock()
+	if fake.GatherDirectoryIgnoreStatusesStub != nil {
+		return fake.GatherDirectoryIgnoreStatusesStub(sourceDir)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.gatherDirectoryIgnoreStatusesReturns.result1, fake.gatherDirectoryIgnoreStatusesReturns.result2
+}
+
+func (fake *FakeSharedActor) GatherDirectoryIgnoreStatusesCallCount() int {
+	fake.gatherDirectoryIgnoreStatusesMutex.RLock()
+	defer fake.gatherDirectoryIgnoreStatusesMutex.RUnlock()
+	return len(fake.gatherDirectoryIgnoreStatusesArgsForCall)
+}
+
+func (fake *FakeSharedActor) GatherDirectoryIgnoreStatusesArgsForCall(i int) string {
+	fake.gatherDirectoryIgnoreStatusesMutex.RLock()
+	defer fake.gatherDirectoryIgnoreStatusesMutex.RUnlock()
+	return fake.gatherDirectoryIgnoreStatusesArgsForCall[i].sourceDir
+}
+
+func (fake *FakeSharedActor) GatherDirectoryIgnoreStatusesReturns(result1 []sharedaction.FileIgnoreStatus, result2 error) {
+	fake.GatherDirectoryIgnoreStatusesStub = nil
+	fake.gatherDirectoryIgnoreStatusesReturns = struct {
+		result1 []sharedaction.FileIgnoreStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSharedActor) GatherDirectoryIgnoreStatusesReturnsOnCall(i int, result1 []sharedaction.FileIgnoreStatus, result2 error) {
+	fake.GatherDirectoryIgnoreStatusesStub = nil
+	if fake.gatherDirectoryIgnoreStatusesReturnsOnCall == nil {
+		fake.gatherDirectoryIgnoreStatusesReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.FileIgnoreStatus
+			result2 error
+		})
+	}
+	fake.gatherDirectoryIgnoreStatusesReturnsOnCall[i] = struct {
+		result1 []sharedaction.FileIgnoreStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSharedActor) GatherDirectoryResources(sourceDir string) ([]sharedaction.Resource, error) {
 	fake.gatherDirectoryResourcesMutex.Lock()
 	ret, specificReturn := fake.gatherDirectoryResourcesReturnsOnCall[len(fake.gatherDirectoryResourcesArgsForCall)]
@@ -169,6 +324,116 @@ func (fake *FakeSharedActor) GatherDirectoryResourcesReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
+func (fake *FakeSharedActor) PackageResourcesUnchanged(appGUID string, packageUpdatedAt time.Time, resources []sharedaction.Resource) bool {
+	var resourcesCopy []sharedaction.Resource
+	if resources != nil {
+		resourcesCopy = make([]sharedaction.Resource, len(resources))
+		copy(resourcesCopy, resources)
+	}
+	fake.packageResourcesUnchangedMutex.Lock()
+	ret, specificReturn := fake.packageResourcesUnchangedReturnsOnCall[len(fake.packageResourcesUnchangedArgsForCall)]
+	fake.packageResourcesUnchangedArgsForCall = append(fake.packageResourcesUnchangedArgsForCall, struct {
+		appGUID          string
+		packageUpdatedAt time.Time
+		resources        []sharedaction.Resource
+	}{appGUID, packageUpdatedAt, resourcesCopy})
+	fake.recordInvocation("PackageResourcesUnchanged", []interface{}{appGUID, packageUpdatedAt, resourcesCopy})
+	fake.packageResourcesUnchangedMutex.Unlock()
+	if fake.PackageResourcesUnchangedStub != nil {
+		return fake.PackageResourcesUnchangedStub(appGUID, packageUpdatedAt, resources)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.packageResourcesUnchangedReturns.result1
+}
+
+func (fake *FakeSharedActor) PackageResourcesUnchangedCallCount() int {
+	fake.packageResourcesUnchangedMutex.RLock()
+	defer fake.packageResourcesUnchangedMutex.RUnlock()
+	return len(fake.packageResourcesUnchangedArgsForCall)
+}
+
+func (fake *FakeSharedActor) PackageResourcesUnchangedArgsForCall(i int) (string, time.Time, []sharedaction.Resource) {
+	fake.packageResourcesUnchangedMutex.RLock()
+	defer fake.packageResourcesUnchangedMutex.RUnlock()
+	return fake.packageResourcesUnchangedArgsForCall[i].appGUID, fake.packageResourcesUnchangedArgsForCall[i].packageUpdatedAt, fake.packageResourcesUnchangedArgsForCall[i].resources
+}
+
+func (fake *FakeSharedActor) PackageResourcesUnchangedReturns(result1 bool) {
+	fake.PackageResourcesUnchangedStub = nil
+	fake.packageResourcesUnchangedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeSharedActor) PackageResourcesUnchangedReturnsOnCall(i int, result1 bool) {
+	fake.PackageResourcesUnchangedStub = nil
+	if fake.packageResourcesUnchangedReturnsOnCall == nil {
+		fake.packageResourcesUnchangedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.packageResourcesUnchangedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeSharedActor) RecordPackageResources(appGUID string, packageUpdatedAt time.Time, resources []sharedaction.Resource) error {
+	var resourcesCopy []sharedaction.Resource
+	if resources != nil {
+		resourcesCopy = make([]sharedaction.Resource, len(resources))
+		copy(resourcesCopy, resources)
+	}
+	fake.recordPackageResourcesMutex.Lock()
+	ret, specificReturn := fake.recordPackageResourcesReturnsOnCall[len(fake.recordPackageResourcesArgsForCall)]
+	fake.recordPackageResourcesArgsForCall = append(fake.recordPackageResourcesArgsForCall, struct {
+		appGUID          string
+		packageUpdatedAt time.Time
+		resources        []sharedaction.Resource
+	}{appGUID, packageUpdatedAt, resourcesCopy})
+	fake.recordInvocation("RecordPackageResources", []interface{}{appGUID, packageUpdatedAt, resourcesCopy})
+	fake.recordPackageResourcesMutex.Unlock()
+	if fake.RecordPackageResourcesStub != nil {
+		return fake.RecordPackageResourcesStub(appGUID, packageUpdatedAt, resources)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.recordPackageResourcesReturns.result1
+}
+
+func (fake *FakeSharedActor) RecordPackageResourcesCallCount() int {
+	fake.recordPackageResourcesMutex.RLock()
+	defer fake.recordPackageResourcesMutex.RUnlock()
+	return len(fake.recordPackageResourcesArgsForCall)
+}
+
+func (fake *FakeSharedActor) RecordPackageResourcesArgsForCall(i int) (string, time.Time, []sharedaction.Resource) {
+	fake.recordPackageResourcesMutex.RLock()
+	defer fake.recordPackageResourcesMutex.RUnlock()
+	return fake.recordPackageResourcesArgsForCall[i].appGUID, fake.recordPackageResourcesArgsForCall[i].packageUpdatedAt, fake.recordPackageResourcesArgsForCall[i].resources
+}
+
+func (fake *FakeSharedActor) RecordPackageResourcesReturns(result1 error) {
+	fake.RecordPackageResourcesStub = nil
+	fake.recordPackageResourcesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSharedActor) RecordPackageResourcesReturnsOnCall(i int, result1 error) {
+	fake.RecordPackageResourcesStub = nil
+	if fake.recordPackageResourcesReturnsOnCall == nil {
+		fake.recordPackageResourcesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordPackageResourcesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSharedActor) ZipArchiveResources(sourceArchivePath string, filesToInclude []sharedaction.Resource) (string, error) {
 	var filesToIncludeCopy []sharedaction.Resource
 	if filesToInclude != nil {
@@ -286,10 +551,18 @@ func (fake *FakeSharedActor) ZipDirectoryResourcesReturnsOnCall(i int, result1 s
 func (fake *FakeSharedActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.gatherArchiveIgnoreStatusesMutex.RLock()
+	defer fake.gatherArchiveIgnoreStatusesMutex.RUnlock()
 	fake.gatherArchiveResourcesMutex.RLock()
 	defer fake.gatherArchiveResourcesMutex.RUnlock()
+	fake.gatherDirectoryIgnoreStatusesMutex.RLock()
+	defer fake.gatherDirectoryIgnoreStatusesMutex.RUnlock()
 	fake.gatherDirectoryResourcesMutex.RLock()
 	defer fake.gatherDirectoryResourcesMutex.RUnlock()
+	fake.packageResourcesUnchangedMutex.RLock()
+	defer fake.packageResourcesUnchangedMutex.RUnlock()
+	fake.recordPackageResourcesMutex.RLock()
+	defer fake.recordPackageResourcesMutex.RUnlock()
 	fake.zipArchiveResourcesMutex.RLock()
 	defer fake.zipArchiveResourcesMutex.RUnlock()
 	fake.zipDirectoryResourcesMutex.RLock()
@@ -10,25 +10,26 @@ import (
 )
 
 type FakeV2Actor struct {
-	MapRouteToApplicationStub         func(routeGUID string, appGUID string) (v2action.Warnings, error)
-	updateRouteApplicationMutex       sync.RWMutex
-	updateRouteApplicationArgsForCall []struct {
+	MapRouteToApplicationStub        func(routeGUID string, appGUID string) (v2action.Warnings, error)
+	mapRouteToApplicationMutex       sync.RWMutex
+	mapRouteToApplicationArgsForCall []struct {
 		routeGUID string
 		appGUID   string
 	}
-	updateRouteApplicationReturns struct {
+	mapRouteToApplicationReturns struct {
 		result1 v2action.Warnings
 		result2 error
 	}
-	updateRouteApplicationReturnsOnCall map[int]struct {
+	mapRouteToApplicationReturnsOnCall map[int]struct {
 		result1 v2action.Warnings
 		result2 error
 	}
-	BindServiceByApplicationAndServiceInstanceStub        func(appGUID string, serviceInstanceGUID string) (v2action.Warnings, error)
+	BindServiceByApplicationAndServiceInstanceStub        func(appGUID string, serviceInstanceGUID string, parameters map[string]interface{}) (v2action.Warnings, error)
 	bindServiceByApplicationAndServiceInstanceMutex       sync.RWMutex
 	bindServiceByApplicationAndServiceInstanceArgsForCall []struct {
 		appGUID             string
 		serviceInstanceGUID string
+		parameters          map[string]interface{}
 	}
 	bindServiceByApplicationAndServiceInstanceReturns struct {
 		result1 v2action.Warnings
@@ -69,6 +70,26 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	CreateServiceInstanceStub        func(spaceGUID string, serviceName string, servicePlanName string, serviceInstanceName string, parameters map[string]interface{}, tags []string) (v2action.ServiceInstance, v2action.Warnings, error)
+	createServiceInstanceMutex       sync.RWMutex
+	createServiceInstanceArgsForCall []struct {
+		spaceGUID           string
+		serviceName         string
+		servicePlanName     string
+		serviceInstanceName string
+		parameters          map[string]interface{}
+		tags                []string
+	}
+	createServiceInstanceReturns struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	createServiceInstanceReturnsOnCall map[int]struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
 	FindRouteBoundToSpaceWithSettingsStub        func(route v2action.Route) (v2action.Route, v2action.Warnings, error)
 	findRouteBoundToSpaceWithSettingsMutex       sync.RWMutex
 	findRouteBoundToSpaceWithSettingsArgsForCall []struct {
@@ -84,6 +105,21 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetApplicationStub        func(guid string) (v2action.Application, v2action.Warnings, error)
+	getApplicationMutex       sync.RWMutex
+	getApplicationArgsForCall []struct {
+		guid string
+	}
+	getApplicationReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetApplicationByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -237,17 +273,17 @@ type FakeV2Actor struct {
 		result3 v2action.Warnings
 		result4 error
 	}
-	UnmapRouteFromApplicationStub         func(routeGUID string, appGUID string) (v2action.Warnings, error)
-	unbindRouteFromApplicationMutex       sync.RWMutex
-	unbindRouteFromApplicationArgsForCall []struct {
+	UnmapRouteFromApplicationStub        func(routeGUID string, appGUID string) (v2action.Warnings, error)
+	unmapRouteFromApplicationMutex       sync.RWMutex
+	unmapRouteFromApplicationArgsForCall []struct {
 		routeGUID string
 		appGUID   string
 	}
-	unbindRouteFromApplicationReturns struct {
+	unmapRouteFromApplicationReturns struct {
 		result1 v2action.Warnings
 		result2 error
 	}
-	unbindRouteFromApplicationReturnsOnCall map[int]struct {
+	unmapRouteFromApplicationReturnsOnCall map[int]struct {
 		result1 v2action.Warnings
 		result2 error
 	}
@@ -289,38 +325,38 @@ type FakeV2Actor struct {
 }
 
 func (fake *FakeV2Actor) MapRouteToApplication(routeGUID string, appGUID string) (v2action.Warnings, error) {
-	fake.updateRouteApplicationMutex.Lock()
-	ret, specificReturn := fake.updateRouteApplicationReturnsOnCall[len(fake.updateRouteApplicationArgsForCall)]
-	fake.updateRouteApplicationArgsForCall = append(fake.updateRouteApplicationArgsForCall, struct {
+	fake.mapRouteToApplicationMutex.Lock()
+	ret, specificReturn := fake.mapRouteToApplicationReturnsOnCall[len(fake.mapRouteToApplicationArgsForCall)]
+	fake.mapRouteToApplicationArgsForCall = append(fake.mapRouteToApplicationArgsForCall, struct {
 		routeGUID string
 		appGUID   string
 	}{routeGUID, appGUID})
 	fake.recordInvocation("MapRouteToApplication", []interface{}{routeGUID, appGUID})
-	fake.updateRouteApplicationMutex.Unlock()
+	fake.mapRouteToApplicationMutex.Unlock()
 	if fake.MapRouteToApplicationStub != nil {
 		return fake.MapRouteToApplicationStub(routeGUID, appGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.updateRouteApplicationReturns.result1, fake.updateRouteApplicationReturns.result2
+	return fake.mapRouteToApplicationReturns.result1, fake.mapRouteToApplicationReturns.result2
 }
 
 func (fake *FakeV2Actor) MapRouteToApplicationCallCount() int {
-	fake.updateRouteApplicationMutex.RLock()
-	defer fake.updateRouteApplicationMutex.RUnlock()
-	return len(fake.updateRouteApplicationArgsForCall)
+	fake.mapRouteToApplicationMutex.RLock()
+	defer fake.mapRouteToApplicationMutex.RUnlock()
+	return len(fake.mapRouteToApplicationArgsForCall)
 }
 
 func (fake *FakeV2Actor) MapRouteToApplicationArgsForCall(i int) (string, string) {
-	fake.updateRouteApplicationMutex.RLock()
-	defer fake.updateRouteApplicationMutex.RUnlock()
-	return fake.updateRouteApplicationArgsForCall[i].routeGUID, fake.updateRouteApplicationArgsForCall[i].appGUID
+	fake.mapRouteToApplicationMutex.RLock()
+	defer fake.mapRouteToApplicationMutex.RUnlock()
+	return fake.mapRouteToApplicationArgsForCall[i].routeGUID, fake.mapRouteToApplicationArgsForCall[i].appGUID
 }
 
 func (fake *FakeV2Actor) MapRouteToApplicationReturns(result1 v2action.Warnings, result2 error) {
 	fake.MapRouteToApplicationStub = nil
-	fake.updateRouteApplicationReturns = struct {
+	fake.mapRouteToApplicationReturns = struct {
 		result1 v2action.Warnings
 		result2 error
 	}{result1, result2}
@@ -328,29 +364,30 @@ func (fake *FakeV2Actor) MapRouteToApplicationReturns(result1 v2action.Warnings,
 
 func (fake *FakeV2Actor) MapRouteToApplicationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
 	fake.MapRouteToApplicationStub = nil
-	if fake.updateRouteApplicationReturnsOnCall == nil {
-		fake.updateRouteApplicationReturnsOnCall = make(map[int]struct {
+	if fake.mapRouteToApplicationReturnsOnCall == nil {
+		fake.mapRouteToApplicationReturnsOnCall = make(map[int]struct {
 			result1 v2action.Warnings
 			result2 error
 		})
 	}
-	fake.updateRouteApplicationReturnsOnCall[i] = struct {
+	fake.mapRouteToApplicationReturnsOnCall[i] = struct {
 		result1 v2action.Warnings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeV2Actor) BindServiceByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (v2action.Warnings, error) {
+func (fake *FakeV2Actor) BindServiceByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string, parameters map[string]interface{}) (v2action.Warnings, error) {
 	fake.bindServiceByApplicationAndServiceInstanceMutex.Lock()
 	ret, specificReturn := fake.bindServiceByApplicationAndServiceInstanceReturnsOnCall[len(fake.bindServiceByApplicationAndServiceInstanceArgsForCall)]
 	fake.bindServiceByApplicationAndServiceInstanceArgsForCall = append(fake.bindServiceByApplicationAndServiceInstanceArgsForCall, struct {
 		appGUID             string
 		serviceInstanceGUID string
-	}{appGUID, serviceInstanceGUID})
-	fake.recordInvocation("BindServiceByApplicationAndServiceInstance", []interface{}{appGUID, serviceInstanceGUID})
+		parameters          map[string]interface{}
+	}{appGUID, serviceInstanceGUID, parameters})
+	fake.recordInvocation("BindServiceByApplicationAndServiceInstance", []interface{}{appGUID, serviceInstanceGUID, parameters})
 	fake.bindServiceByApplicationAndServiceInstanceMutex.Unlock()
 	if fake.BindServiceByApplicationAndServiceInstanceStub != nil {
-		return fake.BindServiceByApplicationAndServiceInstanceStub(appGUID, serviceInstanceGUID)
+		return fake.BindServiceByApplicationAndServiceInstanceStub(appGUID, serviceInstanceGUID, parameters)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -364,10 +401,10 @@ func (fake *FakeV2Actor) BindServiceByApplicationAndServiceInstanceCallCount() i
 	return len(fake.bindServiceByApplicationAndServiceInstanceArgsForCall)
 }
 
-func (fake *FakeV2Actor) BindServiceByApplicationAndServiceInstanceArgsForCall(i int) (string, string) {
+func (fake *FakeV2Actor) BindServiceByApplicationAndServiceInstanceArgsForCall(i int) (string, string, map[string]interface{}) {
 	fake.bindServiceByApplicationAndServiceInstanceMutex.RLock()
 	defer fake.bindServiceByApplicationAndServiceInstanceMutex.RUnlock()
-	return fake.bindServiceByApplicationAndServiceInstanceArgsForCall[i].appGUID, fake.bindServiceByApplicationAndServiceInstanceArgsForCall[i].serviceInstanceGUID
+	return fake.bindServiceByApplicationAndServiceInstanceArgsForCall[i].appGUID, fake.bindServiceByApplicationAndServiceInstanceArgsForCall[i].serviceInstanceGUID, fake.bindServiceByApplicationAndServiceInstanceArgsForCall[i].parameters
 }
 
 func (fake *FakeV2Actor) BindServiceByApplicationAndServiceInstanceReturns(result1 v2action.Warnings, result2 error) {
@@ -501,6 +538,70 @@ func (fake *FakeV2Actor) CreateRouteReturnsOnCall(i int, result1 v2action.Route,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) CreateServiceInstance(spaceGUID string, serviceName string, servicePlanName string, serviceInstanceName string, parameters map[string]interface{}, tags []string) (v2action.ServiceInstance, v2action.Warnings, error) {
+	var tagsCopy []string
+	if tags != nil {
+		tagsCopy = make([]string, len(tags))
+		copy(tagsCopy, tags)
+	}
+	fake.createServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.createServiceInstanceReturnsOnCall[len(fake.createServiceInstanceArgsForCall)]
+	fake.createServiceInstanceArgsForCall = append(fake.createServiceInstanceArgsForCall, struct {
+		spaceGUID           string
+		serviceName         string
+		servicePlanName     string
+		serviceInstanceName string
+		parameters          map[string]interface{}
+		tags                []string
+	}{spaceGUID, serviceName, servicePlanName, serviceInstanceName, parameters, tagsCopy})
+	fake.recordInvocation("CreateServiceInstance", []interface{}{spaceGUID, serviceName, servicePlanName, serviceInstanceName, parameters, tagsCopy})
+	fake.createServiceInstanceMutex.Unlock()
+	if fake.CreateServiceInstanceStub != nil {
+		return fake.CreateServiceInstanceStub(spaceGUID, serviceName, servicePlanName, serviceInstanceName, parameters, tags)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createServiceInstanceReturns.result1, fake.createServiceInstanceReturns.result2, fake.createServiceInstanceReturns.result3
+}
+
+func (fake *FakeV2Actor) CreateServiceInstanceCallCount() int {
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	return len(fake.createServiceInstanceArgsForCall)
+}
+
+func (fake *FakeV2Actor) CreateServiceInstanceArgsForCall(i int) (string, string, string, string, map[string]interface{}, []string) {
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	return fake.createServiceInstanceArgsForCall[i].spaceGUID, fake.createServiceInstanceArgsForCall[i].serviceName, fake.createServiceInstanceArgsForCall[i].servicePlanName, fake.createServiceInstanceArgsForCall[i].serviceInstanceName, fake.createServiceInstanceArgsForCall[i].parameters, fake.createServiceInstanceArgsForCall[i].tags
+}
+
+func (fake *FakeV2Actor) CreateServiceInstanceReturns(result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.CreateServiceInstanceStub = nil
+	fake.createServiceInstanceReturns = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) CreateServiceInstanceReturnsOnCall(i int, result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.CreateServiceInstanceStub = nil
+	if fake.createServiceInstanceReturnsOnCall == nil {
+		fake.createServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.createServiceInstanceReturnsOnCall[i] = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2Actor) FindRouteBoundToSpaceWithSettings(route v2action.Route) (v2action.Route, v2action.Warnings, error) {
 	fake.findRouteBoundToSpaceWithSettingsMutex.Lock()
 	ret, specificReturn := fake.findRouteBoundToSpaceWithSettingsReturnsOnCall[len(fake.findRouteBoundToSpaceWithSettingsArgsForCall)]
@@ -555,6 +656,60 @@ func (fake *FakeV2Actor) FindRouteBoundToSpaceWithSettingsReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) GetApplication(guid string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationMutex.Lock()
+	ret, specificReturn := fake.getApplicationReturnsOnCall[len(fake.getApplicationArgsForCall)]
+	fake.getApplicationArgsForCall = append(fake.getApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetApplication", []interface{}{guid})
+	fake.getApplicationMutex.Unlock()
+	if fake.GetApplicationStub != nil {
+		return fake.GetApplicationStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationReturns.result1, fake.getApplicationReturns.result2, fake.getApplicationReturns.result3
+}
+
+func (fake *FakeV2Actor) GetApplicationCallCount() int {
+	fake.getApplicationMutex.RLock()
+	defer fake.getApplicationMutex.RUnlock()
+	return len(fake.getApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetApplicationArgsForCall(i int) string {
+	fake.getApplicationMutex.RLock()
+	defer fake.getApplicationMutex.RUnlock()
+	return fake.getApplicationArgsForCall[i].guid
+}
+
+func (fake *FakeV2Actor) GetApplicationReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationStub = nil
+	fake.getApplicationReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationStub = nil
+	if fake.getApplicationReturnsOnCall == nil {
+		fake.getApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2Actor) GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
 	fake.getApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
@@ -1109,38 +1264,38 @@ func (fake *FakeV2Actor) ResourceMatchReturnsOnCall(i int, result1 []v2action.Re
 }
 
 func (fake *FakeV2Actor) UnmapRouteFromApplication(routeGUID string, appGUID string) (v2action.Warnings, error) {
-	fake.unbindRouteFromApplicationMutex.Lock()
-	ret, specificReturn := fake.unbindRouteFromApplicationReturnsOnCall[len(fake.unbindRouteFromApplicationArgsForCall)]
-	fake.unbindRouteFromApplicationArgsForCall = append(fake.unbindRouteFromApplicationArgsForCall, struct {
+	fake.unmapRouteFromApplicationMutex.Lock()
+	ret, specificReturn := fake.unmapRouteFromApplicationReturnsOnCall[len(fake.unmapRouteFromApplicationArgsForCall)]
+	fake.unmapRouteFromApplicationArgsForCall = append(fake.unmapRouteFromApplicationArgsForCall, struct {
 		routeGUID string
 		appGUID   string
 	}{routeGUID, appGUID})
 	fake.recordInvocation("UnmapRouteFromApplication", []interface{}{routeGUID, appGUID})
-	fake.unbindRouteFromApplicationMutex.Unlock()
+	fake.unmapRouteFromApplicationMutex.Unlock()
 	if fake.UnmapRouteFromApplicationStub != nil {
 		return fake.UnmapRouteFromApplicationStub(routeGUID, appGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.unbindRouteFromApplicationReturns.result1, fake.unbindRouteFromApplicationReturns.result2
+	return fake.unmapRouteFromApplicationReturns.result1, fake.unmapRouteFromApplicationReturns.result2
 }
 
 func (fake *FakeV2Actor) UnmapRouteFromApplicationCallCount() int {
-	fake.unbindRouteFromApplicationMutex.RLock()
-	defer fake.unbindRouteFromApplicationMutex.RUnlock()
-	return len(fake.unbindRouteFromApplicationArgsForCall)
+	fake.unmapRouteFromApplicationMutex.RLock()
+	defer fake.unmapRouteFromApplicationMutex.RUnlock()
+	return len(fake.unmapRouteFromApplicationArgsForCall)
 }
 
 func (fake *FakeV2Actor) UnmapRouteFromApplicationArgsForCall(i int) (string, string) {
-	fake.unbindRouteFromApplicationMutex.RLock()
-	defer fake.unbindRouteFromApplicationMutex.RUnlock()
-	return fake.unbindRouteFromApplicationArgsForCall[i].routeGUID, fake.unbindRouteFromApplicationArgsForCall[i].appGUID
+	fake.unmapRouteFromApplicationMutex.RLock()
+	defer fake.unmapRouteFromApplicationMutex.RUnlock()
+	return fake.unmapRouteFromApplicationArgsForCall[i].routeGUID, fake.unmapRouteFromApplicationArgsForCall[i].appGUID
 }
 
 func (fake *FakeV2Actor) UnmapRouteFromApplicationReturns(result1 v2action.Warnings, result2 error) {
 	fake.UnmapRouteFromApplicationStub = nil
-	fake.unbindRouteFromApplicationReturns = struct {
+	fake.unmapRouteFromApplicationReturns = struct {
 		result1 v2action.Warnings
 		result2 error
 	}{result1, result2}
@@ -1148,13 +1303,13 @@ func (fake *FakeV2Actor) UnmapRouteFromApplicationReturns(result1 v2action.Warni
 
 func (fake *FakeV2Actor) UnmapRouteFromApplicationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
 	fake.UnmapRouteFromApplicationStub = nil
-	if fake.unbindRouteFromApplicationReturnsOnCall == nil {
-		fake.unbindRouteFromApplicationReturnsOnCall = make(map[int]struct {
+	if fake.unmapRouteFromApplicationReturnsOnCall == nil {
+		fake.unmapRouteFromApplicationReturnsOnCall = make(map[int]struct {
 			result1 v2action.Warnings
 			result2 error
 		})
 	}
-	fake.unbindRouteFromApplicationReturnsOnCall[i] = struct {
+	fake.unmapRouteFromApplicationReturnsOnCall[i] = struct {
 		result1 v2action.Warnings
 		result2 error
 	}{result1, result2}
@@ -1279,16 +1434,20 @@ func (fake *FakeV2Actor) UploadApplicationPackageReturnsOnCall(i int, result1 v2
 func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
-	fake.updateRouteApplicationMutex.RLock()
-	defer fake.updateRouteApplicationMutex.RUnlock()
+	fake.mapRouteToApplicationMutex.RLock()
+	defer fake.mapRouteToApplicationMutex.RUnlock()
 	fake.bindServiceByApplicationAndServiceInstanceMutex.RLock()
 	defer fake.bindServiceByApplicationAndServiceInstanceMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
 	fake.findRouteBoundToSpaceWithSettingsMutex.RLock()
 	defer fake.findRouteBoundToSpaceWithSettingsMutex.RUnlock()
+	fake.getApplicationMutex.RLock()
+	defer fake.getApplicationMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationRoutesMutex.RLock()
@@ -1309,8 +1468,8 @@ func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
 	defer fake.pollJobMutex.RUnlock()
 	fake.resourceMatchMutex.RLock()
 	defer fake.resourceMatchMutex.RUnlock()
-	fake.unbindRouteFromApplicationMutex.RLock()
-	defer fake.unbindRouteFromApplicationMutex.RUnlock()
+	fake.unmapRouteFromApplicationMutex.RLock()
+	defer fake.unmapRouteFromApplicationMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
 	fake.uploadApplicationPackageMutex.RLock()
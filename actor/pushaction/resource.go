@@ -109,3 +109,38 @@ func (actor Actor) UploadPackageWithArchive(config ApplicationConfig, archivePat
 
 	return allWarnings, err
 }
+
+// PackageUnchanged returns true if the resources being pushed are the same as
+// those last uploaded for the app, and nothing that requires the app to be
+// restaged has changed.
+func (actor Actor) PackageUnchanged(config ApplicationConfig) bool {
+	current := config.CurrentApplication
+	desired := config.DesiredApplication
+
+	if config.CreatingApplication() ||
+		current.PackageUpdatedAt.IsZero() ||
+		current.StagingFailed() ||
+		current.Buildpack != desired.Buildpack ||
+		current.StackGUID != desired.StackGUID {
+		return false
+	}
+
+	return actor.SharedActor.PackageResourcesUnchanged(current.GUID, current.PackageUpdatedAt, actor.ConvertV2ResourcesToSharedResources(config.AllResources))
+}
+
+// RecordUploadedPackage records the resources uploaded for the app so that
+// the upload can be skipped if they are pushed again unchanged. Failing to
+// record them does not fail the push.
+func (actor Actor) RecordUploadedPackage(config ApplicationConfig) Warnings {
+	app, warnings, err := actor.V2Actor.GetApplication(config.DesiredApplication.GUID)
+	if err != nil {
+		log.Errorln("getting uploaded package:", err)
+		return Warnings(warnings)
+	}
+
+	err = actor.SharedActor.RecordPackageResources(app.GUID, app.PackageUpdatedAt, actor.ConvertV2ResourcesToSharedResources(config.AllResources))
+	if err != nil {
+		log.Errorln("recording uploaded package:", err)
+	}
+	return Warnings(warnings)
+}
@@ -6,12 +6,17 @@ import (
 	"io/ioutil"
 	"os"
 	"strings"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -130,6 +135,121 @@ var _ = Describe("Resources", func() {
 		})
 	})
 
+	Describe("PackageUnchanged", func() {
+		var (
+			config          ApplicationConfig
+			packageUpdateAt time.Time
+		)
+
+		BeforeEach(func() {
+			packageUpdateAt = time.Unix(1500000000, 0)
+			app := v2action.Application{
+				GUID:             "some-app-guid",
+				PackageUpdatedAt: packageUpdateAt,
+				StackGUID:        "some-stack-guid",
+			}
+			config = ApplicationConfig{
+				CurrentApplication: Application{Application: app},
+				DesiredApplication: Application{Application: app},
+				AllResources: []v2action.Resource{
+					{Filename: "some-file", SHA1: "some-sha1", Size: 1},
+				},
+			}
+			fakeSharedActor.PackageResourcesUnchangedReturns(true)
+		})
+
+		It("checks the resources against the app's current package", func() {
+			Expect(actor.PackageUnchanged(config)).To(BeTrue())
+
+			Expect(fakeSharedActor.PackageResourcesUnchangedCallCount()).To(Equal(1))
+			appGUID, updatedAt, resources := fakeSharedActor.PackageResourcesUnchangedArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(updatedAt).To(Equal(packageUpdateAt))
+			Expect(resources).To(Equal([]sharedaction.Resource{
+				{Filename: "some-file", SHA1: "some-sha1", Size: 1},
+			}))
+		})
+
+		Context("when the resources have changed", func() {
+			BeforeEach(func() {
+				fakeSharedActor.PackageResourcesUnchangedReturns(false)
+			})
+
+			It("returns false", func() {
+				Expect(actor.PackageUnchanged(config)).To(BeFalse())
+			})
+		})
+
+		DescribeTable("when the package must be uploaded regardless of the resources",
+			func(setup func()) {
+				setup()
+				Expect(actor.PackageUnchanged(config)).To(BeFalse())
+				Expect(fakeSharedActor.PackageResourcesUnchangedCallCount()).To(Equal(0))
+			},
+
+			Entry("the app is being created", func() {
+				config.CurrentApplication = Application{}
+			}),
+			Entry("the app has never had a package", func() {
+				config.CurrentApplication.PackageUpdatedAt = time.Time{}
+			}),
+			Entry("the app failed to stage", func() {
+				config.CurrentApplication.PackageState = ccv2.ApplicationPackageFailed
+			}),
+			Entry("the buildpack is changing", func() {
+				config.DesiredApplication.Buildpack = types.FilteredString{IsSet: true, Value: "some-buildpack"}
+			}),
+			Entry("the stack is changing", func() {
+				config.DesiredApplication.StackGUID = "some-other-stack-guid"
+			}),
+		)
+	})
+
+	Describe("RecordUploadedPackage", func() {
+		var config ApplicationConfig
+
+		BeforeEach(func() {
+			config = ApplicationConfig{
+				DesiredApplication: Application{Application: v2action.Application{GUID: "some-app-guid"}},
+				AllResources: []v2action.Resource{
+					{Filename: "some-file", SHA1: "some-sha1", Size: 1},
+				},
+			}
+		})
+
+		Context("when getting the app succeeds", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationReturns(v2action.Application{GUID: "some-app-guid", PackageUpdatedAt: time.Unix(1500000000, 0)}, v2action.Warnings{"get-application-warning"}, nil)
+			})
+
+			It("records the resources against the app's new package", func() {
+				warnings := actor.RecordUploadedPackage(config)
+				Expect(warnings).To(ConsistOf("get-application-warning"))
+
+				Expect(fakeSharedActor.RecordPackageResourcesCallCount()).To(Equal(1))
+				appGUID, updatedAt, resources := fakeSharedActor.RecordPackageResourcesArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(updatedAt).To(Equal(time.Unix(1500000000, 0)))
+				Expect(resources).To(Equal([]sharedaction.Resource{
+					{Filename: "some-file", SHA1: "some-sha1", Size: 1},
+				}))
+			})
+		})
+
+		Context("when getting the app fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationReturns(v2action.Application{}, v2action.Warnings{"get-application-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the warnings without recording the resources", func() {
+				warnings := actor.RecordUploadedPackage(config)
+				Expect(warnings).To(ConsistOf("get-application-warning"))
+
+				Expect(fakeSharedActor.RecordPackageResourcesCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("SetMatchedResources", func() {
 		var (
 			inputConfig  ApplicationConfig
@@ -6,7 +6,8 @@ func (actor Actor) BindServices(config ApplicationConfig) (ApplicationConfig, bo
 	appGUID := config.DesiredApplication.GUID
 	for serviceInstanceName, serviceInstance := range config.DesiredServices {
 		if _, ok := config.CurrentServices[serviceInstanceName]; !ok {
-			warnings, err := actor.V2Actor.BindServiceByApplicationAndServiceInstance(appGUID, serviceInstance.GUID)
+			bindingParameters := config.ServiceDeclarations[serviceInstanceName].BindingParameters
+			warnings, err := actor.V2Actor.BindServiceByApplicationAndServiceInstance(appGUID, serviceInstance.GUID, bindingParameters)
 			allWarnings = append(allWarnings, warnings...)
 			if err != nil {
 				return config, false, allWarnings, err
@@ -6,6 +6,7 @@ import (
 	. "code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/util/manifest"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -41,6 +42,9 @@ var _ = Describe("Binding Services", func() {
 				"service_instance_2": {GUID: "instance_2_guid"},
 				"service_instance_3": {GUID: "instance_3_guid"},
 			}
+			config.ServiceDeclarations = map[string]manifest.Service{
+				"service_instance_2": {Name: "service_instance_2", BindingParameters: map[string]interface{}{"role": "read-only"}},
+			}
 		})
 
 		JustBeforeEach(func() {
@@ -63,17 +67,20 @@ var _ = Describe("Binding Services", func() {
 					"service_instance_3": {GUID: "instance_3_guid"},
 				}))
 
-				var serviceInstanceGUIDs []string
+				bindingParameters := map[string]map[string]interface{}{}
 				Expect(fakeV2Actor.BindServiceByApplicationAndServiceInstanceCallCount()).To(Equal(2))
-				appGUID, serviceInstanceGUID := fakeV2Actor.BindServiceByApplicationAndServiceInstanceArgsForCall(0)
+				appGUID, serviceInstanceGUID, parameters := fakeV2Actor.BindServiceByApplicationAndServiceInstanceArgsForCall(0)
 				Expect(appGUID).To(Equal("some-app-guid"))
-				serviceInstanceGUIDs = append(serviceInstanceGUIDs, serviceInstanceGUID)
+				bindingParameters[serviceInstanceGUID] = parameters
 
-				appGUID, serviceInstanceGUID = fakeV2Actor.BindServiceByApplicationAndServiceInstanceArgsForCall(1)
+				appGUID, serviceInstanceGUID, parameters = fakeV2Actor.BindServiceByApplicationAndServiceInstanceArgsForCall(1)
 				Expect(appGUID).To(Equal("some-app-guid"))
-				serviceInstanceGUIDs = append(serviceInstanceGUIDs, serviceInstanceGUID)
+				bindingParameters[serviceInstanceGUID] = parameters
 
-				Expect(serviceInstanceGUIDs).To(ConsistOf("instance_2_guid", "instance_3_guid"))
+				Expect(bindingParameters).To(Equal(map[string]map[string]interface{}{
+					"instance_2_guid": {"role": "read-only"},
+					"instance_3_guid": nil,
+				}))
 			})
 		})
 
@@ -0,0 +1,38 @@
+package pushaction
+
+import (
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateServices creates the desired service instances that do not exist yet,
+// as declared in the manifest, and waits for them to be provisioned.
+func (actor Actor) CreateServices(config ApplicationConfig) (ApplicationConfig, Warnings, error) {
+	var names []string
+	for name, serviceInstance := range config.DesiredServices {
+		if serviceInstance.GUID == "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var allWarnings Warnings
+	for _, name := range names {
+		declaration := config.ServiceDeclarations[name]
+		log.WithFields(log.Fields{
+			"name":     name,
+			"offering": declaration.Offering,
+			"plan":     declaration.Plan,
+		}).Info("creating service instance")
+
+		serviceInstance, warnings, err := actor.V2Actor.CreateServiceInstance(config.TargetedSpaceGUID, declaration.Offering, declaration.Plan, name, declaration.Parameters, declaration.Tags)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return config, allWarnings, err
+		}
+		config.DesiredServices[name] = serviceInstance
+	}
+
+	return config, allWarnings, nil
+}
@@ -0,0 +1,93 @@
+package pushaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/util/manifest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Instance Actions", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *pushactionfakes.FakeV2Actor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
+		actor = NewActor(fakeV2Actor, nil)
+	})
+
+	Describe("CreateServices", func() {
+		var (
+			config ApplicationConfig
+
+			returnedConfig ApplicationConfig
+			warnings       Warnings
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			config = ApplicationConfig{TargetedSpaceGUID: "some-space-guid"}
+			config.DesiredServices = map[string]v2action.ServiceInstance{
+				"existing-service": {Name: "existing-service", GUID: "existing-guid"},
+				"new-service":      {Name: "new-service"},
+			}
+			config.ServiceDeclarations = map[string]manifest.Service{
+				"new-service": {
+					Name:       "new-service",
+					Offering:   "p-mysql",
+					Plan:       "small",
+					Parameters: map[string]interface{}{"storage": 10},
+					Tags:       []string{"db"},
+				},
+			}
+
+			fakeV2Actor.CreateServiceInstanceReturns(
+				v2action.ServiceInstance{Name: "new-service", GUID: "new-guid"},
+				v2action.Warnings{"create-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			returnedConfig, warnings, executeErr = actor.CreateServices(config)
+		})
+
+		It("creates only the missing service instances", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("create-warning"))
+
+			Expect(fakeV2Actor.CreateServiceInstanceCallCount()).To(Equal(1))
+			spaceGUID, offering, plan, name, parameters, tags := fakeV2Actor.CreateServiceInstanceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(offering).To(Equal("p-mysql"))
+			Expect(plan).To(Equal("small"))
+			Expect(name).To(Equal("new-service"))
+			Expect(parameters).To(Equal(map[string]interface{}{"storage": 10}))
+			Expect(tags).To(Equal([]string{"db"}))
+
+			Expect(returnedConfig.DesiredServices).To(Equal(map[string]v2action.ServiceInstance{
+				"existing-service": {Name: "existing-service", GUID: "existing-guid"},
+				"new-service":      {Name: "new-service", GUID: "new-guid"},
+			}))
+			Expect(returnedConfig.CreatingServices()).To(BeFalse())
+		})
+
+		Context("when creating a service instance fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.CreateServiceInstanceReturns(v2action.ServiceInstance{}, v2action.Warnings{"create-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+	})
+})
@@ -1,12 +1,20 @@
 package pushaction
 
-import "code.cloudfoundry.org/cli/actor/sharedaction"
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+)
 
 //go:generate counterfeiter . SharedActor
 
 type SharedActor interface {
+	GatherArchiveIgnoreStatuses(archivePath string) ([]sharedaction.FileIgnoreStatus, error)
 	GatherArchiveResources(archivePath string) ([]sharedaction.Resource, error)
+	GatherDirectoryIgnoreStatuses(sourceDir string) ([]sharedaction.FileIgnoreStatus, error)
 	GatherDirectoryResources(sourceDir string) ([]sharedaction.Resource, error)
+	PackageResourcesUnchanged(appGUID string, packageUpdatedAt time.Time, resources []sharedaction.Resource) bool
+	RecordPackageResources(appGUID string, packageUpdatedAt time.Time, resources []sharedaction.Resource) error
 	ZipArchiveResources(sourceArchivePath string, filesToInclude []sharedaction.Resource) (string, error)
 	ZipDirectoryResources(sourceDir string, filesToInclude []sharedaction.Resource) (string, error)
 }
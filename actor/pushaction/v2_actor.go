@@ -10,10 +10,12 @@ import (
 
 type V2Actor interface {
 	MapRouteToApplication(routeGUID string, appGUID string) (v2action.Warnings, error)
-	BindServiceByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (v2action.Warnings, error)
+	BindServiceByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string, parameters map[string]interface{}) (v2action.Warnings, error)
 	CreateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error)
 	CreateRoute(route v2action.Route, generatePort bool) (v2action.Route, v2action.Warnings, error)
+	CreateServiceInstance(spaceGUID string, serviceName string, servicePlanName string, serviceInstanceName string, parameters map[string]interface{}, tags []string) (v2action.ServiceInstance, v2action.Warnings, error)
 	FindRouteBoundToSpaceWithSettings(route v2action.Route) (v2action.Route, v2action.Warnings, error)
+	GetApplication(guid string) (v2action.Application, v2action.Warnings, error)
 	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
 	GetApplicationRoutes(applicationGUID string) (v2action.Routes, v2action.Warnings, error)
 	GetDomainsByNameAndOrganization(domainNames []string, orgGUID string) ([]v2action.Domain, v2action.Warnings, error)
@@ -0,0 +1,23 @@
+package pushaction
+
+import (
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/util/manifest"
+)
+
+// ValidateManifest returns every problem found in the manifest at
+// pathToManifest without contacting the Cloud Controller.
+func (*Actor) ValidateManifest(pathToManifest string) ([]manifest.ValidationError, error) {
+	rawManifest, err := ioutil.ReadFile(pathToManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest.Validate(rawManifest), nil
+}
+
+func (*Actor) ManifestJSONSchema() ([]byte, error) {
+	// Cover method to make testing easier
+	return manifest.JSONSchema()
+}
@@ -0,0 +1,51 @@
+package pushaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/util/manifest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateManifest", func() {
+	var (
+		actor          *Actor
+		tmpDir         string
+		pathToManifest string
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(nil, nil)
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "validate-manifest")
+		Expect(err).ToNot(HaveOccurred())
+		pathToManifest = filepath.Join(tmpDir, "manifest.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	It("returns the problems found in the manifest", func() {
+		Expect(ioutil.WriteFile(pathToManifest, []byte("applications:\n- name: some-app\n  memory: lots\n"), 0666)).To(Succeed())
+
+		validationErrors, err := actor.ValidateManifest(pathToManifest)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(validationErrors).To(Equal([]manifest.ValidationError{
+			{Line: 3, Column: 3, Path: "applications[0].memory", Message: `invalid size "lots"; use a whole number with a unit such as M or G`},
+		}))
+	})
+
+	Context("when the manifest does not exist", func() {
+		It("returns the error", func() {
+			_, err := actor.ValidateManifest(pathToManifest)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+})
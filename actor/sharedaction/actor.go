@@ -4,14 +4,20 @@ package sharedaction
 
 // Actor handles all shared actions
 type Actor struct {
-	Config            Config
-	SecureShellClient SecureShellClient
+	Config                   Config
+	SecureShellClient        SecureShellClient
+	SecureShellClientFactory SecureShellClientFactory
+
+	// SkipResourceCache disables the reuse of previously calculated SHA1s
+	// when gathering resources.
+	SkipResourceCache bool
 }
 
 // NewActor returns an Actor with default settings
 func NewActor(config Config, sshClient SecureShellClient) *Actor {
 	return &Actor{
-		Config:            config,
-		SecureShellClient: sshClient,
+		Config:                   config,
+		SecureShellClient:        sshClient,
+		SecureShellClientFactory: defaultSecureShellClientFactory{},
 	}
 }
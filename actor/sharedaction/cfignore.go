@@ -0,0 +1,282 @@
+package sharedaction
+
+import (
+	"archive/zip"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	ignore "github.com/sabhiram/go-gitignore"
+)
+
+// IgnoreRule is a single pattern that decides whether a file is uploaded.
+type IgnoreRule struct {
+	// Source is the path of the .cfignore file the rule was read from,
+	// relative to the app root. It is empty for the default rules.
+	Source  string
+	Line    int
+	Pattern string
+}
+
+func (rule IgnoreRule) String() string {
+	if rule.Source == "" {
+		return fmt.Sprintf("(default) %s", rule.Pattern)
+	}
+	return fmt.Sprintf("%s:%d: %s", rule.Source, rule.Line, rule.Pattern)
+}
+
+// FileIgnoreStatus describes whether a file of an app is uploaded and which
+// rule, if any, decided it.
+type FileIgnoreStatus struct {
+	Filename string
+	Ignored  bool
+	// Rule is the last rule matching the file; its Pattern is empty when no
+	// rule matched.
+	Rule IgnoreRule
+}
+
+type cfIgnoreRule struct {
+	IgnoreRule
+
+	// dir is the slash separated directory, relative to the app root, that the
+	// rule is scoped to.
+	dir     string
+	negate  bool
+	matcher *ignore.GitIgnore
+}
+
+// cfIgnoreMatcher applies the default ignore rules and every .cfignore file of
+// an app. Like a .gitignore, a .cfignore only applies to the files below the
+// directory it is in, and rules from deeper directories override the rules of
+// their parents.
+type cfIgnoreMatcher struct {
+	rules []cfIgnoreRule
+}
+
+func newCFIgnoreMatcher(defaultLines []string) *cfIgnoreMatcher {
+	matcher := new(cfIgnoreMatcher)
+	for _, line := range defaultLines {
+		matcher.addRule(cfIgnoreRule{IgnoreRule: IgnoreRule{Pattern: line}}, line)
+	}
+	return matcher
+}
+
+// addCFIgnoreFile adds the rules of the .cfignore file in dir.
+func (matcher *cfIgnoreMatcher) addCFIgnoreFile(dir string, raw []byte) {
+	source := path.Join(dir, ".cfignore")
+	for i, line := range strings.Split(string(raw), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		matcher.addRule(cfIgnoreRule{
+			IgnoreRule: IgnoreRule{Source: source, Line: i + 1, Pattern: line},
+			dir:        dir,
+		}, line)
+	}
+}
+
+func (matcher *cfIgnoreMatcher) addRule(rule cfIgnoreRule, line string) {
+	if strings.HasPrefix(line, "!") {
+		rule.negate = true
+		line = line[1:]
+	}
+
+	// CompileIgnoreLines never returns an error.
+	rule.matcher, _ = ignore.CompileIgnoreLines(line)
+	matcher.rules = append(matcher.rules, rule)
+}
+
+// Match returns whether relPath, a slash separated path relative to the app
+// root, is ignored and the last rule that matched it.
+func (matcher cfIgnoreMatcher) Match(relPath string) (bool, IgnoreRule) {
+	var (
+		ignored bool
+		matched IgnoreRule
+	)
+
+	for _, rule := range matcher.rules {
+		scopedPath := relPath
+		if rule.dir != "" {
+			if !strings.HasPrefix(relPath, rule.dir+"/") {
+				continue
+			}
+			scopedPath = strings.TrimPrefix(relPath, rule.dir+"/")
+		}
+
+		if rule.matcher.MatchesPath(scopedPath) {
+			ignored = !rule.negate
+			matched = rule.IgnoreRule
+		}
+	}
+
+	return ignored, matched
+}
+
+func (matcher cfIgnoreMatcher) MatchesPath(relPath string) bool {
+	ignored, _ := matcher.Match(relPath)
+	return ignored
+}
+
+// GatherArchiveIgnoreStatuses returns whether each file of an archive is
+// uploaded by push, and why.
+func (actor Actor) GatherArchiveIgnoreStatuses(archivePath string) ([]FileIgnoreStatus, error) {
+	archive, err := os.Open(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	reader, err := actor.newArchiveReader(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	matcher, err := actor.generateArchiveCFIgnoreMatcher(reader.File)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []FileIgnoreStatus
+	for _, archivedFile := range reader.File {
+		relPath := archivePathToRelPath(archivedFile.Name)
+		if relPath == "" {
+			continue
+		}
+
+		ignored, rule := matcher.Match(relPath)
+		statuses = append(statuses, FileIgnoreStatus{Filename: relPath, Ignored: ignored, Rule: rule})
+	}
+	return statuses, nil
+}
+
+// GatherDirectoryIgnoreStatuses returns whether each file of a directory is
+// uploaded by push, and why.
+func (actor Actor) GatherDirectoryIgnoreStatuses(sourceDir string) ([]FileIgnoreStatus, error) {
+	evalDir, err := filepath.EvalSymlinks(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+
+	matcher, err := actor.generateDirectoryCFIgnoreMatcher(evalDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []FileIgnoreStatus
+	walkErr := filepath.Walk(evalDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(evalDir, path)
+		if err != nil {
+			return err
+		}
+		if relPath == "." {
+			return nil
+		}
+
+		relPath = filepath.ToSlash(relPath)
+		ignored, rule := matcher.Match(relPath)
+		statuses = append(statuses, FileIgnoreStatus{Filename: relPath, Ignored: ignored, Rule: rule})
+		return nil
+	})
+
+	return statuses, walkErr
+}
+
+func (Actor) generateArchiveCFIgnoreMatcher(files []*zip.File) (*cfIgnoreMatcher, error) {
+	var cfIgnoreFiles []*zip.File
+	for _, item := range files {
+		if path.Base(archivePathToRelPath(item.Name)) == ".cfignore" {
+			cfIgnoreFiles = append(cfIgnoreFiles, item)
+		}
+	}
+
+	// Parent directories have to be added before their children so that the
+	// deeper rules win.
+	sort.SliceStable(cfIgnoreFiles, func(i int, j int) bool {
+		return strings.Count(archivePathToRelPath(cfIgnoreFiles[i].Name), "/") < strings.Count(archivePathToRelPath(cfIgnoreFiles[j].Name), "/")
+	})
+
+	matcher := newCFIgnoreMatcher(DefaultIgnoreLines)
+	for _, item := range cfIgnoreFiles {
+		fileReader, err := item.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer fileReader.Close()
+
+		raw, err := ioutil.ReadAll(fileReader)
+		if err != nil {
+			return nil, err
+		}
+
+		dir := path.Dir(archivePathToRelPath(item.Name))
+		if dir == "." {
+			dir = ""
+		}
+		matcher.addCFIgnoreFile(dir, raw)
+	}
+	return matcher, nil
+}
+
+func (actor Actor) generateDirectoryCFIgnoreMatcher(sourceDir string) (*cfIgnoreMatcher, error) {
+	additionalIgnoreLines := DefaultIgnoreLines
+
+	// If verbose logging has files in the current dir, ignore them
+	_, traceFiles := actor.Config.Verbose()
+	for _, traceFilePath := range traceFiles {
+		if relPath, err := filepath.Rel(sourceDir, traceFilePath); err == nil {
+			additionalIgnoreLines = append(additionalIgnoreLines, filepath.ToSlash(relPath))
+		}
+	}
+
+	matcher := newCFIgnoreMatcher(additionalIgnoreLines)
+
+	// Directories are walked before their contents, so the rules of every
+	// parent .cfignore are known by the time a directory is reached. Ignored
+	// directories are read too, as a ! rule in their own .cfignore can
+	// re-include files inside them, the same as in an archive.
+	err := filepath.Walk(sourceDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+
+		relDir, err := filepath.Rel(sourceDir, path)
+		if err != nil {
+			return err
+		}
+		relDir = filepath.ToSlash(relDir)
+		if relDir == "." {
+			relDir = ""
+		}
+
+		raw, err := ioutil.ReadFile(filepath.Join(path, ".cfignore"))
+		if os.IsNotExist(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		matcher.addCFIgnoreFile(relDir, raw)
+		return nil
+	})
+
+	return matcher, err
+}
+
+// archivePathToRelPath converts the name of a file in an archive to a path
+// relative to the app root, without leading or trailing slashes.
+func archivePathToRelPath(name string) string {
+	return strings.Trim(filepath.ToSlash(name), "/")
+}
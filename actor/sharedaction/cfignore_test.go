@@ -0,0 +1,154 @@
+package sharedaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CF Ignore Actions", func() {
+	var (
+		actor            *Actor
+		srcDir           string
+		expectedStatuses []FileIgnoreStatus
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(new(sharedactionfakes.FakeConfig), nil)
+
+		var err error
+		srcDir, err = ioutil.TempDir("", "cfignore-actions")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(os.MkdirAll(filepath.Join(srcDir, "level1", "level2"), 0777)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(srcDir, "level1", "level2", "tmpFile1"), []byte("why hello"), 0644)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(srcDir, "tmpFile2"), []byte("Hello, Binky"), 0644)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(srcDir, "tmpFile3"), []byte("Bananarama"), 0644)).To(Succeed())
+
+		Expect(ioutil.WriteFile(filepath.Join(srcDir, ".cfignore"), []byte("# temporary files\ntmpFile*\n"), 0644)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(srcDir, "level1", ".cfignore"), []byte("!tmpFile1\ntmpFile3\n"), 0644)).To(Succeed())
+
+		expectedStatuses = []FileIgnoreStatus{
+			{Filename: ".cfignore", Ignored: true, Rule: IgnoreRule{Pattern: ".cfignore"}},
+			{Filename: "level1"},
+			{Filename: "level1/.cfignore", Ignored: true, Rule: IgnoreRule{Pattern: ".cfignore"}},
+			{Filename: "level1/level2"},
+			{Filename: "level1/level2/tmpFile1", Rule: IgnoreRule{Source: "level1/.cfignore", Line: 1, Pattern: "!tmpFile1"}},
+			{Filename: "tmpFile2", Ignored: true, Rule: IgnoreRule{Source: ".cfignore", Line: 2, Pattern: "tmpFile*"}},
+			{Filename: "tmpFile3", Ignored: true, Rule: IgnoreRule{Source: ".cfignore", Line: 2, Pattern: "tmpFile*"}},
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(srcDir)).To(Succeed())
+	})
+
+	Describe("GatherDirectoryIgnoreStatuses", func() {
+		It("scopes nested .cfignore files to their directory", func() {
+			statuses, err := actor.GatherDirectoryIgnoreStatuses(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(statuses).To(Equal(expectedStatuses))
+		})
+
+		It("applies the same rules when gathering resources", func() {
+			resources, err := actor.GatherDirectoryResources(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			var filenames []string
+			for _, resource := range resources {
+				filenames = append(filenames, resource.Filename)
+			}
+			Expect(filenames).To(Equal([]string{"level1", "level1/level2", "level1/level2/tmpFile1"}))
+		})
+	})
+
+	Context("when an ignored directory has a .cfignore that re-includes one of its files", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Join(srcDir, "logs"), 0777)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(srcDir, "logs", "keep.log"), []byte("kept"), 0644)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(srcDir, "logs", "other.log"), []byte("ignored"), 0644)).To(Succeed())
+
+			Expect(ioutil.WriteFile(filepath.Join(srcDir, ".cfignore"), []byte("# temporary files\ntmpFile*\nlogs/\n"), 0644)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(srcDir, "logs", ".cfignore"), []byte("!keep.log\n"), 0644)).To(Succeed())
+		})
+
+		It("applies the nested .cfignore", func() {
+			statuses, err := actor.GatherDirectoryIgnoreStatuses(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(statuses).To(ContainElement(FileIgnoreStatus{
+				Filename: "logs/keep.log",
+				Rule:     IgnoreRule{Source: "logs/.cfignore", Line: 1, Pattern: "!keep.log"},
+			}))
+			Expect(statuses).To(ContainElement(FileIgnoreStatus{
+				Filename: "logs/other.log",
+				Ignored:  true,
+				Rule:     IgnoreRule{Source: ".cfignore", Line: 3, Pattern: "logs/"},
+			}))
+
+			resources, err := actor.GatherDirectoryResources(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			var filenames []string
+			for _, resource := range resources {
+				filenames = append(filenames, resource.Filename)
+			}
+			Expect(filenames).To(ContainElement("logs/keep.log"))
+			Expect(filenames).ToNot(ContainElement("logs/other.log"))
+		})
+
+		It("applies the same rules to an archive of the directory", func() {
+			tmpfile, err := ioutil.TempFile("", "cfignore-archive")
+			Expect(err).ToNot(HaveOccurred())
+			archive := tmpfile.Name()
+			Expect(tmpfile.Close()).To(Succeed())
+			defer os.RemoveAll(archive)
+
+			Expect(zipit(srcDir, archive, "")).To(Succeed())
+
+			directoryStatuses, err := actor.GatherDirectoryIgnoreStatuses(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+			archiveStatuses, err := actor.GatherArchiveIgnoreStatuses(archive)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(archiveStatuses).To(Equal(directoryStatuses))
+		})
+	})
+
+	Describe("GatherArchiveIgnoreStatuses", func() {
+		var archive string
+
+		BeforeEach(func() {
+			tmpfile, err := ioutil.TempFile("", "cfignore-archive")
+			Expect(err).ToNot(HaveOccurred())
+			archive = tmpfile.Name()
+			Expect(tmpfile.Close()).To(Succeed())
+
+			Expect(zipit(srcDir, archive, "")).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(archive)).To(Succeed())
+		})
+
+		It("scopes nested .cfignore files to their directory", func() {
+			statuses, err := actor.GatherArchiveIgnoreStatuses(archive)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(statuses).To(Equal(expectedStatuses))
+		})
+
+		It("applies the same rules when gathering resources", func() {
+			resources, err := actor.GatherArchiveResources(archive)
+			Expect(err).ToNot(HaveOccurred())
+
+			var filenames []string
+			for _, resource := range resources {
+				filenames = append(filenames, resource.Filename)
+			}
+			Expect(filenames).To(Equal([]string{"/", "/level1/", "/level1/level2/", "/level1/level2/tmpFile1"}))
+		})
+	})
+})
@@ -9,5 +9,8 @@ type Config interface {
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
 	RefreshToken() string
+	ResourceCacheFilePath() string
+	SSHKnownHostsFilePath() string
+	Target() string
 	Verbose() (bool, []string)
 }
@@ -11,7 +11,6 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/ykk"
-	ignore "github.com/sabhiram/go-gitignore"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -79,7 +78,7 @@ func (actor Actor) GatherArchiveResources(archivePath string) ([]Resource, error
 
 	for _, archivedFile := range reader.File {
 		filename := filepath.ToSlash(archivedFile.Name)
-		if gitIgnore.MatchesPath(filename) {
+		if relPath := archivePathToRelPath(filename); relPath != "" && gitIgnore.MatchesPath(relPath) {
 			continue
 		}
 
@@ -111,33 +110,29 @@ func (actor Actor) GatherArchiveResources(archivePath string) ([]Resource, error
 
 // GatherDirectoryResources returns a list of resources for a directory.
 func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error) {
-	var (
-		resources []Resource
-		gitIgnore *ignore.GitIgnore
-	)
+	var resources []Resource
 
-	gitIgnore, err := actor.generateDirectoryCFIgnoreMatcher(sourceDir)
+	evalDir, err := filepath.EvalSymlinks(sourceDir)
 	if err != nil {
-		log.Errorln("reading .cfignore file:", err)
+		log.Errorln("evaluating symlink:", err)
 		return nil, err
 	}
 
-	evalDir, err := filepath.EvalSymlinks(sourceDir)
+	gitIgnore, err := actor.generateDirectoryCFIgnoreMatcher(evalDir)
 	if err != nil {
-		log.Errorln("evaluating symlink:", err)
+		log.Errorln("reading .cfignore file:", err)
 		return nil, err
 	}
 
+	resourceCacheLock.Lock()
+	defer resourceCacheLock.Unlock()
+	cache := actor.loadResourceCache()
+
 	walkErr := filepath.Walk(evalDir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 
-		// if file ignored contine to the next file
-		if gitIgnore.MatchesPath(path) {
-			return nil
-		}
-
 		relPath, err := filepath.Rel(evalDir, path)
 		if err != nil {
 			return err
@@ -147,6 +142,11 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 			return nil
 		}
 
+		// if file ignored contine to the next file
+		if gitIgnore.MatchesPath(filepath.ToSlash(relPath)) {
+			return nil
+		}
+
 		resource := Resource{
 			Filename: filepath.ToSlash(relPath),
 		}
@@ -154,20 +154,32 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 		if info.IsDir() {
 			resource.Mode = DefaultFolderPermissions
 		} else {
-			file, err := os.Open(path)
-			if err != nil {
-				return err
+			sha1Sum, cached := "", false
+			if cache != nil {
+				sha1Sum, cached = cache.Get(path, info)
 			}
-			defer file.Close()
 
-			sum := sha1.New()
-			_, err = io.Copy(sum, file)
-			if err != nil {
-				return err
+			if !cached {
+				file, err := os.Open(path)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+
+				sum := sha1.New()
+				_, err = io.Copy(sum, file)
+				if err != nil {
+					return err
+				}
+
+				sha1Sum = fmt.Sprintf("%x", sum.Sum(nil))
+				if cache != nil {
+					cache.Set(path, info, sha1Sum)
+				}
 			}
 
 			resource.Mode = fixMode(info.Mode())
-			resource.SHA1 = fmt.Sprintf("%x", sum.Sum(nil))
+			resource.SHA1 = sha1Sum
 			resource.Size = info.Size()
 		}
 		resources = append(resources, resource)
@@ -178,6 +190,13 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 		return nil, EmptyDirectoryError{Path: sourceDir}
 	}
 
+	if cache != nil && walkErr == nil {
+		cache.Prune(evalDir)
+		if err := cache.Save(); err != nil {
+			log.WithField("path", actor.Config.ResourceCacheFilePath()).Errorln("saving resource cache:", err)
+		}
+	}
+
 	return resources, walkErr
 }
 
@@ -338,46 +357,6 @@ func (Actor) addFileToZipFromFileSystem(
 	return nil
 }
 
-func (Actor) generateArchiveCFIgnoreMatcher(files []*zip.File) (*ignore.GitIgnore, error) {
-	for _, item := range files {
-		if strings.HasSuffix(item.Name, ".cfignore") {
-			fileReader, err := item.Open()
-			if err != nil {
-				return nil, err
-			}
-			defer fileReader.Close()
-
-			raw, err := ioutil.ReadAll(fileReader)
-			if err != nil {
-				return nil, err
-			}
-			s := append(DefaultIgnoreLines, strings.Split(string(raw), "\n")...)
-			return ignore.CompileIgnoreLines(s...)
-		}
-	}
-	return ignore.CompileIgnoreLines(DefaultIgnoreLines...)
-}
-
-func (actor Actor) generateDirectoryCFIgnoreMatcher(sourceDir string) (*ignore.GitIgnore, error) {
-	pathToCFIgnore := filepath.Join(sourceDir, ".cfignore")
-
-	additionalIgnoreLines := DefaultIgnoreLines
-
-	// If verbose logging has files in the current dir, ignore them
-	_, traceFiles := actor.Config.Verbose()
-	for _, traceFilePath := range traceFiles {
-		if relPath, err := filepath.Rel(sourceDir, traceFilePath); err == nil {
-			additionalIgnoreLines = append(additionalIgnoreLines, relPath)
-		}
-	}
-
-	if _, err := os.Stat(pathToCFIgnore); !os.IsNotExist(err) {
-		return ignore.CompileIgnoreFileAndLines(pathToCFIgnore, additionalIgnoreLines...)
-	} else {
-		return ignore.CompileIgnoreLines(additionalIgnoreLines...)
-	}
-}
-
 func (Actor) findInResources(path string, filesToInclude []Resource) (Resource, bool) {
 	for _, resource := range filesToInclude {
 		if resource.Filename == filepath.ToSlash(path) {
@@ -0,0 +1,76 @@
+package sharedaction
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/util/resourcecache"
+	log "github.com/sirupsen/logrus"
+)
+
+// resourceCacheLock serializes access to the resource cache file between
+// apps that are pushed concurrently.
+var resourceCacheLock sync.Mutex
+
+// PackageResourcesUnchanged returns true if resources are the resources last
+// uploaded for the app and the app's package has not been updated since.
+func (actor Actor) PackageResourcesUnchanged(appGUID string, packageUpdatedAt time.Time, resources []Resource) bool {
+	resourceCacheLock.Lock()
+	defer resourceCacheLock.Unlock()
+
+	cache := actor.loadResourceCache()
+	if cache == nil {
+		return false
+	}
+
+	return cache.PackageUnchanged(appGUID, packageUpdatedAt, convertToCacheResources(resources))
+}
+
+// RecordPackageResources records resources as the resources uploaded for the
+// app.
+func (actor Actor) RecordPackageResources(appGUID string, packageUpdatedAt time.Time, resources []Resource) error {
+	resourceCacheLock.Lock()
+	defer resourceCacheLock.Unlock()
+
+	cache := actor.loadResourceCache()
+	if cache == nil {
+		return nil
+	}
+
+	cache.SetPackage(appGUID, packageUpdatedAt, convertToCacheResources(resources))
+	return cache.Save()
+}
+
+// loadResourceCache returns the resource cache, or nil if the cache is
+// disabled or cannot be read. The cache is only an optimization, so failing
+// to read it is not an error.
+func (actor Actor) loadResourceCache() *resourcecache.Cache {
+	if actor.SkipResourceCache {
+		log.Debug("resource cache disabled")
+		return nil
+	}
+
+	path := actor.Config.ResourceCacheFilePath()
+	if path == "" {
+		return nil
+	}
+
+	cache, err := resourcecache.Load(path)
+	if err != nil {
+		log.WithField("path", path).Errorln("loading resource cache:", err)
+		return nil
+	}
+	return cache
+}
+
+func convertToCacheResources(resources []Resource) []resourcecache.Resource {
+	cacheResources := make([]resourcecache.Resource, 0, len(resources))
+	for _, resource := range resources {
+		cacheResources = append(cacheResources, resourcecache.Resource{
+			Filename: resource.Filename,
+			Mode:     resource.Mode,
+			SHA1:     resource.SHA1,
+		})
+	}
+	return cacheResources
+}
@@ -0,0 +1,168 @@
+package sharedaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/util/resourcecache"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Resource Cache Actions", func() {
+	var (
+		fakeConfig *sharedactionfakes.FakeConfig
+		actor      *Actor
+		tempDir    string
+		cachePath  string
+		srcDir     string
+		filePath   string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "resource-cache-actions-test")
+		Expect(err).ToNot(HaveOccurred())
+
+		cachePath = filepath.Join(tempDir, ".cf", "resource_cache.json")
+		fakeConfig = new(sharedactionfakes.FakeConfig)
+		fakeConfig.ResourceCacheFilePathReturns(cachePath)
+		actor = NewActor(fakeConfig, nil)
+
+		srcDir, err = filepath.EvalSymlinks(tempDir)
+		Expect(err).ToNot(HaveOccurred())
+		srcDir = filepath.Join(srcDir, "app")
+		Expect(os.MkdirAll(srcDir, 0700)).To(Succeed())
+
+		filePath = filepath.Join(srcDir, "tmpFile1")
+		Expect(ioutil.WriteFile(filePath, []byte("why hello"), 0600)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	Describe("GatherDirectoryResources", func() {
+		It("records the SHA1 of each file in the cache", func() {
+			_, err := actor.GatherDirectoryResources(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			info, err := os.Stat(filePath)
+			Expect(err).ToNot(HaveOccurred())
+
+			cache, err := resourcecache.Load(cachePath)
+			Expect(err).ToNot(HaveOccurred())
+			sha1, found := cache.Get(filePath, info)
+			Expect(found).To(BeTrue())
+			Expect(sha1).To(Equal("9e36efec86d571de3a38389ea799a796fe4782f4"))
+		})
+
+		Context("when the cache has the SHA1 of an unchanged file", func() {
+			BeforeEach(func() {
+				info, err := os.Stat(filePath)
+				Expect(err).ToNot(HaveOccurred())
+
+				cache, err := resourcecache.Load(cachePath)
+				Expect(err).ToNot(HaveOccurred())
+				cache.Set(filePath, info, "some-cached-sha1")
+				Expect(cache.Save()).To(Succeed())
+			})
+
+			It("uses the cached SHA1", func() {
+				resources, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resources).To(HaveLen(1))
+				Expect(resources[0].SHA1).To(Equal("some-cached-sha1"))
+			})
+
+			Context("when the resource cache is skipped", func() {
+				BeforeEach(func() {
+					actor.SkipResourceCache = true
+				})
+
+				It("hashes the file", func() {
+					resources, err := actor.GatherDirectoryResources(srcDir)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(resources).To(HaveLen(1))
+					Expect(resources[0].SHA1).To(Equal("9e36efec86d571de3a38389ea799a796fe4782f4"))
+				})
+			})
+		})
+
+		Context("when the file has been modified since it was cached", func() {
+			BeforeEach(func() {
+				info, err := os.Stat(filePath)
+				Expect(err).ToNot(HaveOccurred())
+
+				cache, err := resourcecache.Load(cachePath)
+				Expect(err).ToNot(HaveOccurred())
+				cache.Set(filePath, info, "some-cached-sha1")
+				Expect(cache.Save()).To(Succeed())
+
+				modTime := info.ModTime().Add(time.Minute)
+				Expect(os.Chtimes(filePath, modTime, modTime)).To(Succeed())
+			})
+
+			It("hashes the file", func() {
+				resources, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resources[0].SHA1).To(Equal("9e36efec86d571de3a38389ea799a796fe4782f4"))
+			})
+		})
+
+		Context("when the cache file cannot be read", func() {
+			BeforeEach(func() {
+				Expect(os.MkdirAll(filepath.Dir(cachePath), 0700)).To(Succeed())
+				Expect(ioutil.WriteFile(cachePath, []byte("not-json"), 0600)).To(Succeed())
+			})
+
+			It("hashes the files without the cache", func() {
+				resources, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resources[0].SHA1).To(Equal("9e36efec86d571de3a38389ea799a796fe4782f4"))
+			})
+		})
+	})
+
+	Describe("PackageResourcesUnchanged and RecordPackageResources", func() {
+		var (
+			updatedAt time.Time
+			resources []Resource
+		)
+
+		BeforeEach(func() {
+			updatedAt = time.Unix(1500000000, 0).UTC()
+			resources = []Resource{
+				{Filename: "tmpFile1", SHA1: "9e36efec86d571de3a38389ea799a796fe4782f4", Size: 9, Mode: 0600},
+			}
+		})
+
+		It("returns false when no resources have been recorded for the app", func() {
+			Expect(actor.PackageResourcesUnchanged("some-app-guid", updatedAt, resources)).To(BeFalse())
+		})
+
+		Context("when resources have been recorded for the app", func() {
+			BeforeEach(func() {
+				Expect(actor.RecordPackageResources("some-app-guid", updatedAt, resources)).To(Succeed())
+			})
+
+			It("returns true for the same resources and package", func() {
+				Expect(actor.PackageResourcesUnchanged("some-app-guid", updatedAt, resources)).To(BeTrue())
+			})
+
+			It("returns false when the resources have changed", func() {
+				resources[0].SHA1 = "some-other-sha1"
+				Expect(actor.PackageResourcesUnchanged("some-app-guid", updatedAt, resources)).To(BeFalse())
+			})
+
+			It("returns false when the resource cache is skipped", func() {
+				actor.SkipResourceCache = true
+				Expect(actor.PackageResourcesUnchanged("some-app-guid", updatedAt, resources)).To(BeFalse())
+			})
+		})
+	})
+})
@@ -1,6 +1,11 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/util/clissh"
+import (
+	"io"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+)
 
 //go:generate counterfeiter . SecureShellClient
 
@@ -9,5 +14,24 @@ type SecureShellClient interface {
 	Close() error
 	InteractiveSession(commands []string, terminalRequest clissh.TTYRequest) error
 	LocalPortForward(localPortForwardSpecs []clissh.LocalPortForward) error
+	RemotePortForward(remotePortForwardSpecs []clissh.RemotePortForward) error
+	DynamicPortForward(dynamicPortForwardSpecs []clissh.DynamicPortForward) error
+	TransferFiles(spec clissh.FileTransferSpec, progressBar clissh.ProgressBar) error
+	UseKnownHosts(knownHosts clissh.KnownHosts)
 	Wait() error
 }
+
+//go:generate counterfeiter . SecureShellClientFactory
+
+// SecureShellClientFactory creates additional SecureShellClients whose remote
+// output is written to the provided writers. It is used when connecting to
+// several instances at once.
+type SecureShellClientFactory interface {
+	NewSecureShellClient(stdOut io.Writer, stdErr io.Writer) SecureShellClient
+}
+
+type defaultSecureShellClientFactory struct{}
+
+func (defaultSecureShellClientFactory) NewSecureShellClient(stdOut io.Writer, stdErr io.Writer) SecureShellClient {
+	return clissh.NewDefaultSecureShell(strings.NewReader(""), stdOut, stdErr)
+}
@@ -53,6 +53,33 @@ type FakeConfig struct {
 	refreshTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ResourceCacheFilePathStub        func() string
+	resourceCacheFilePathMutex       sync.RWMutex
+	resourceCacheFilePathArgsForCall []struct{}
+	resourceCacheFilePathReturns     struct {
+		result1 string
+	}
+	resourceCacheFilePathReturnsOnCall map[int]struct {
+		result1 string
+	}
+	SSHKnownHostsFilePathStub        func() string
+	sSHKnownHostsFilePathMutex       sync.RWMutex
+	sSHKnownHostsFilePathArgsForCall []struct{}
+	sSHKnownHostsFilePathReturns     struct {
+		result1 string
+	}
+	sSHKnownHostsFilePathReturnsOnCall map[int]struct {
+		result1 string
+	}
+	TargetStub        func() string
+	targetMutex       sync.RWMutex
+	targetArgsForCall []struct{}
+	targetReturns     struct {
+		result1 string
+	}
+	targetReturnsOnCall map[int]struct {
+		result1 string
+	}
 	VerboseStub        func() (bool, []string)
 	verboseMutex       sync.RWMutex
 	verboseArgsForCall []struct{}
@@ -268,6 +295,126 @@ func (fake *FakeConfig) RefreshTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ResourceCacheFilePath() string {
+	fake.resourceCacheFilePathMutex.Lock()
+	ret, specificReturn := fake.resourceCacheFilePathReturnsOnCall[len(fake.resourceCacheFilePathArgsForCall)]
+	fake.resourceCacheFilePathArgsForCall = append(fake.resourceCacheFilePathArgsForCall, struct{}{})
+	fake.recordInvocation("ResourceCacheFilePath", []interface{}{})
+	fake.resourceCacheFilePathMutex.Unlock()
+	if fake.ResourceCacheFilePathStub != nil {
+		return fake.ResourceCacheFilePathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.resourceCacheFilePathReturns.result1
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathCallCount() int {
+	fake.resourceCacheFilePathMutex.RLock()
+	defer fake.resourceCacheFilePathMutex.RUnlock()
+	return len(fake.resourceCacheFilePathArgsForCall)
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathReturns(result1 string) {
+	fake.ResourceCacheFilePathStub = nil
+	fake.resourceCacheFilePathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathReturnsOnCall(i int, result1 string) {
+	fake.ResourceCacheFilePathStub = nil
+	if fake.resourceCacheFilePathReturnsOnCall == nil {
+		fake.resourceCacheFilePathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.resourceCacheFilePathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) SSHKnownHostsFilePath() string {
+	fake.sSHKnownHostsFilePathMutex.Lock()
+	ret, specificReturn := fake.sSHKnownHostsFilePathReturnsOnCall[len(fake.sSHKnownHostsFilePathArgsForCall)]
+	fake.sSHKnownHostsFilePathArgsForCall = append(fake.sSHKnownHostsFilePathArgsForCall, struct{}{})
+	fake.recordInvocation("SSHKnownHostsFilePath", []interface{}{})
+	fake.sSHKnownHostsFilePathMutex.Unlock()
+	if fake.SSHKnownHostsFilePathStub != nil {
+		return fake.SSHKnownHostsFilePathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.sSHKnownHostsFilePathReturns.result1
+}
+
+func (fake *FakeConfig) SSHKnownHostsFilePathCallCount() int {
+	fake.sSHKnownHostsFilePathMutex.RLock()
+	defer fake.sSHKnownHostsFilePathMutex.RUnlock()
+	return len(fake.sSHKnownHostsFilePathArgsForCall)
+}
+
+func (fake *FakeConfig) SSHKnownHostsFilePathReturns(result1 string) {
+	fake.SSHKnownHostsFilePathStub = nil
+	fake.sSHKnownHostsFilePathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) SSHKnownHostsFilePathReturnsOnCall(i int, result1 string) {
+	fake.SSHKnownHostsFilePathStub = nil
+	if fake.sSHKnownHostsFilePathReturnsOnCall == nil {
+		fake.sSHKnownHostsFilePathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.sSHKnownHostsFilePathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) Target() string {
+	fake.targetMutex.Lock()
+	ret, specificReturn := fake.targetReturnsOnCall[len(fake.targetArgsForCall)]
+	fake.targetArgsForCall = append(fake.targetArgsForCall, struct{}{})
+	fake.recordInvocation("Target", []interface{}{})
+	fake.targetMutex.Unlock()
+	if fake.TargetStub != nil {
+		return fake.TargetStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.targetReturns.result1
+}
+
+func (fake *FakeConfig) TargetCallCount() int {
+	fake.targetMutex.RLock()
+	defer fake.targetMutex.RUnlock()
+	return len(fake.targetArgsForCall)
+}
+
+func (fake *FakeConfig) TargetReturns(result1 string) {
+	fake.TargetStub = nil
+	fake.targetReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) TargetReturnsOnCall(i int, result1 string) {
+	fake.TargetStub = nil
+	if fake.targetReturnsOnCall == nil {
+		fake.targetReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.targetReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) Verbose() (bool, []string) {
 	fake.verboseMutex.Lock()
 	ret, specificReturn := fake.verboseReturnsOnCall[len(fake.verboseArgsForCall)]
@@ -324,6 +471,12 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.hasTargetedSpaceMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
+	fake.resourceCacheFilePathMutex.RLock()
+	defer fake.resourceCacheFilePathMutex.RUnlock()
+	fake.sSHKnownHostsFilePathMutex.RLock()
+	defer fake.sSHKnownHostsFilePathMutex.RUnlock()
+	fake.targetMutex.RLock()
+	defer fake.targetMutex.RUnlock()
 	fake.verboseMutex.RLock()
 	defer fake.verboseMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -56,6 +56,45 @@ type FakeSecureShellClient struct {
 	localPortForwardReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RemotePortForwardStub        func(remotePortForwardSpecs []clissh.RemotePortForward) error
+	remotePortForwardMutex       sync.RWMutex
+	remotePortForwardArgsForCall []struct {
+		remotePortForwardSpecs []clissh.RemotePortForward
+	}
+	remotePortForwardReturns struct {
+		result1 error
+	}
+	remotePortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DynamicPortForwardStub        func(dynamicPortForwardSpecs []clissh.DynamicPortForward) error
+	dynamicPortForwardMutex       sync.RWMutex
+	dynamicPortForwardArgsForCall []struct {
+		dynamicPortForwardSpecs []clissh.DynamicPortForward
+	}
+	dynamicPortForwardReturns struct {
+		result1 error
+	}
+	dynamicPortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
+	TransferFilesStub        func(spec clissh.FileTransferSpec, progressBar clissh.ProgressBar) error
+	transferFilesMutex       sync.RWMutex
+	transferFilesArgsForCall []struct {
+		spec        clissh.FileTransferSpec
+		progressBar clissh.ProgressBar
+	}
+	transferFilesReturns struct {
+		result1 error
+	}
+	transferFilesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UseKnownHostsStub        func(knownHosts clissh.KnownHosts)
+	useKnownHostsMutex       sync.RWMutex
+	useKnownHostsArgsForCall []struct {
+		knownHosts clissh.KnownHosts
+	}
 	WaitStub        func() error
 	waitMutex       sync.RWMutex
 	waitArgsForCall []struct{}
@@ -268,6 +307,185 @@ func (fake *FakeSecureShellClient) LocalPortForwardReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeSecureShellClient) RemotePortForward(remotePortForwardSpecs []clissh.RemotePortForward) error {
+	var remotePortForwardSpecsCopy []clissh.RemotePortForward
+	if remotePortForwardSpecs != nil {
+		remotePortForwardSpecsCopy = make([]clissh.RemotePortForward, len(remotePortForwardSpecs))
+		copy(remotePortForwardSpecsCopy, remotePortForwardSpecs)
+	}
+	fake.remotePortForwardMutex.Lock()
+	ret, specificReturn := fake.remotePortForwardReturnsOnCall[len(fake.remotePortForwardArgsForCall)]
+	fake.remotePortForwardArgsForCall = append(fake.remotePortForwardArgsForCall, struct {
+		remotePortForwardSpecs []clissh.RemotePortForward
+	}{remotePortForwardSpecsCopy})
+	fake.recordInvocation("RemotePortForward", []interface{}{remotePortForwardSpecsCopy})
+	fake.remotePortForwardMutex.Unlock()
+	if fake.RemotePortForwardStub != nil {
+		return fake.RemotePortForwardStub(remotePortForwardSpecs)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.remotePortForwardReturns.result1
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardCallCount() int {
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	return len(fake.remotePortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardArgsForCall(i int) []clissh.RemotePortForward {
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	return fake.remotePortForwardArgsForCall[i].remotePortForwardSpecs
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardReturns(result1 error) {
+	fake.RemotePortForwardStub = nil
+	fake.remotePortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardReturnsOnCall(i int, result1 error) {
+	fake.RemotePortForwardStub = nil
+	if fake.remotePortForwardReturnsOnCall == nil {
+		fake.remotePortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.remotePortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForward(dynamicPortForwardSpecs []clissh.DynamicPortForward) error {
+	var dynamicPortForwardSpecsCopy []clissh.DynamicPortForward
+	if dynamicPortForwardSpecs != nil {
+		dynamicPortForwardSpecsCopy = make([]clissh.DynamicPortForward, len(dynamicPortForwardSpecs))
+		copy(dynamicPortForwardSpecsCopy, dynamicPortForwardSpecs)
+	}
+	fake.dynamicPortForwardMutex.Lock()
+	ret, specificReturn := fake.dynamicPortForwardReturnsOnCall[len(fake.dynamicPortForwardArgsForCall)]
+	fake.dynamicPortForwardArgsForCall = append(fake.dynamicPortForwardArgsForCall, struct {
+		dynamicPortForwardSpecs []clissh.DynamicPortForward
+	}{dynamicPortForwardSpecsCopy})
+	fake.recordInvocation("DynamicPortForward", []interface{}{dynamicPortForwardSpecsCopy})
+	fake.dynamicPortForwardMutex.Unlock()
+	if fake.DynamicPortForwardStub != nil {
+		return fake.DynamicPortForwardStub(dynamicPortForwardSpecs)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.dynamicPortForwardReturns.result1
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardCallCount() int {
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	return len(fake.dynamicPortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardArgsForCall(i int) []clissh.DynamicPortForward {
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	return fake.dynamicPortForwardArgsForCall[i].dynamicPortForwardSpecs
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardReturns(result1 error) {
+	fake.DynamicPortForwardStub = nil
+	fake.dynamicPortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardReturnsOnCall(i int, result1 error) {
+	fake.DynamicPortForwardStub = nil
+	if fake.dynamicPortForwardReturnsOnCall == nil {
+		fake.dynamicPortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.dynamicPortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) TransferFiles(spec clissh.FileTransferSpec, progressBar clissh.ProgressBar) error {
+	fake.transferFilesMutex.Lock()
+	ret, specificReturn := fake.transferFilesReturnsOnCall[len(fake.transferFilesArgsForCall)]
+	fake.transferFilesArgsForCall = append(fake.transferFilesArgsForCall, struct {
+		spec        clissh.FileTransferSpec
+		progressBar clissh.ProgressBar
+	}{spec, progressBar})
+	fake.recordInvocation("TransferFiles", []interface{}{spec, progressBar})
+	fake.transferFilesMutex.Unlock()
+	if fake.TransferFilesStub != nil {
+		return fake.TransferFilesStub(spec, progressBar)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.transferFilesReturns.result1
+}
+
+func (fake *FakeSecureShellClient) TransferFilesCallCount() int {
+	fake.transferFilesMutex.RLock()
+	defer fake.transferFilesMutex.RUnlock()
+	return len(fake.transferFilesArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) TransferFilesArgsForCall(i int) (clissh.FileTransferSpec, clissh.ProgressBar) {
+	fake.transferFilesMutex.RLock()
+	defer fake.transferFilesMutex.RUnlock()
+	return fake.transferFilesArgsForCall[i].spec, fake.transferFilesArgsForCall[i].progressBar
+}
+
+func (fake *FakeSecureShellClient) TransferFilesReturns(result1 error) {
+	fake.TransferFilesStub = nil
+	fake.transferFilesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) TransferFilesReturnsOnCall(i int, result1 error) {
+	fake.TransferFilesStub = nil
+	if fake.transferFilesReturnsOnCall == nil {
+		fake.transferFilesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.transferFilesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) UseKnownHosts(knownHosts clissh.KnownHosts) {
+	fake.useKnownHostsMutex.Lock()
+	fake.useKnownHostsArgsForCall = append(fake.useKnownHostsArgsForCall, struct {
+		knownHosts clissh.KnownHosts
+	}{knownHosts})
+	fake.recordInvocation("UseKnownHosts", []interface{}{knownHosts})
+	fake.useKnownHostsMutex.Unlock()
+	if fake.UseKnownHostsStub != nil {
+		fake.UseKnownHostsStub(knownHosts)
+	}
+}
+
+func (fake *FakeSecureShellClient) UseKnownHostsCallCount() int {
+	fake.useKnownHostsMutex.RLock()
+	defer fake.useKnownHostsMutex.RUnlock()
+	return len(fake.useKnownHostsArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) UseKnownHostsArgsForCall(i int) clissh.KnownHosts {
+	fake.useKnownHostsMutex.RLock()
+	defer fake.useKnownHostsMutex.RUnlock()
+	return fake.useKnownHostsArgsForCall[i].knownHosts
+}
+
 func (fake *FakeSecureShellClient) Wait() error {
 	fake.waitMutex.Lock()
 	ret, specificReturn := fake.waitReturnsOnCall[len(fake.waitArgsForCall)]
@@ -319,6 +537,14 @@ func (fake *FakeSecureShellClient) Invocations() map[string][][]interface{} {
 	defer fake.interactiveSessionMutex.RUnlock()
 	fake.localPortForwardMutex.RLock()
 	defer fake.localPortForwardMutex.RUnlock()
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	fake.transferFilesMutex.RLock()
+	defer fake.transferFilesMutex.RUnlock()
+	fake.useKnownHostsMutex.RLock()
+	defer fake.useKnownHostsMutex.RUnlock()
 	fake.waitMutex.RLock()
 	defer fake.waitMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
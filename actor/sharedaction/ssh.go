@@ -1,6 +1,11 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/util/clissh"
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/clissh/knownhosts"
+)
 
 type TTYOption clissh.TTYRequest
 
@@ -13,19 +18,39 @@ const (
 
 type LocalPortForward clissh.LocalPortForward
 
+type RemotePortForward clissh.RemotePortForward
+
+type DynamicPortForward clissh.DynamicPortForward
+
+type FileTransferDirection clissh.FileTransferDirection
+
+const (
+	FileTransferDownload FileTransferDirection = iota
+	FileTransferUpload
+)
+
+//go:generate counterfeiter . ProgressBar
+
+type ProgressBar interface {
+	NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader
+}
+
 type SSHOptions struct {
-	Commands              []string
-	Username              string
-	Passcode              string
-	Endpoint              string
-	HostKeyFingerprint    string
-	SkipHostValidation    bool
-	SkipRemoteExecution   bool
-	TTYOption             TTYOption
-	LocalPortForwardSpecs []LocalPortForward
+	Commands                []string
+	Username                string
+	Passcode                string
+	Endpoint                string
+	HostKeyFingerprint      string
+	SkipHostValidation      bool
+	SkipRemoteExecution     bool
+	TTYOption               TTYOption
+	LocalPortForwardSpecs   []LocalPortForward
+	RemotePortForwardSpecs  []RemotePortForward
+	DynamicPortForwardSpecs []DynamicPortForward
 }
 
 func (actor Actor) ExecuteSecureShell(sshOptions SSHOptions) error {
+	actor.SecureShellClient.UseKnownHosts(actor.knownHosts())
 	err := actor.SecureShellClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, false)
 	defer actor.SecureShellClient.Close()
 	if err != nil {
@@ -33,6 +58,23 @@ func (actor Actor) ExecuteSecureShell(sshOptions SSHOptions) error {
 	}
 
 	err = actor.SecureShellClient.LocalPortForward(convertActorToSSHPackageForwardingSpecs(sshOptions.LocalPortForwardSpecs))
+	if err != nil {
+		return err
+	}
+
+	if len(sshOptions.RemotePortForwardSpecs) > 0 {
+		err = actor.SecureShellClient.RemotePortForward(convertActorToSSHPackageRemoteForwardingSpecs(sshOptions.RemotePortForwardSpecs))
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(sshOptions.DynamicPortForwardSpecs) > 0 {
+		err = actor.SecureShellClient.DynamicPortForward(convertActorToSSHPackageDynamicForwardingSpecs(sshOptions.DynamicPortForwardSpecs))
+		if err != nil {
+			return err
+		}
+	}
 
 	if sshOptions.SkipRemoteExecution {
 		err = actor.SecureShellClient.Wait()
@@ -42,6 +84,68 @@ func (actor Actor) ExecuteSecureShell(sshOptions SSHOptions) error {
 	return err
 }
 
+// ExecuteSecureShellCommand runs sshOptions.Commands without a TTY on a new
+// connection whose remote output is written to stdOut and stdErr. The exit
+// status of the remote command is returned; the error is only set when the
+// command could not be run.
+func (actor Actor) ExecuteSecureShellCommand(sshOptions SSHOptions, stdOut io.Writer, stdErr io.Writer) (int, error) {
+	client := actor.SecureShellClientFactory.NewSecureShellClient(stdOut, stdErr)
+	client.UseKnownHosts(actor.knownHosts())
+
+	err := client.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return 0, err
+	}
+	defer client.Close()
+
+	err = client.InteractiveSession(sshOptions.Commands, clissh.RequestTTYNo)
+	if exitErr, ok := err.(exitStatusError); ok {
+		return exitErr.ExitStatus(), nil
+	}
+	return 0, err
+}
+
+// exitStatusError is satisfied by *ssh.ExitError.
+type exitStatusError interface {
+	error
+	ExitStatus() int
+}
+
+type SecureCopyOptions struct {
+	Username           string
+	Passcode           string
+	Endpoint           string
+	HostKeyFingerprint string
+	SkipHostValidation bool
+	Direction          FileTransferDirection
+	LocalPath          string
+	RemotePath         string
+	Recursive          bool
+}
+
+// ExecuteSecureCopy connects to the provided SSH endpoint and copies files to
+// or from the remote container.
+func (actor Actor) ExecuteSecureCopy(copyOptions SecureCopyOptions, progressBar ProgressBar) error {
+	actor.SecureShellClient.UseKnownHosts(actor.knownHosts())
+	err := actor.SecureShellClient.Connect(copyOptions.Username, copyOptions.Passcode, copyOptions.Endpoint, copyOptions.HostKeyFingerprint, copyOptions.SkipHostValidation)
+	defer actor.SecureShellClient.Close()
+	if err != nil {
+		return err
+	}
+
+	return actor.SecureShellClient.TransferFiles(clissh.FileTransferSpec{
+		Direction:  clissh.FileTransferDirection(copyOptions.Direction),
+		LocalPath:  copyOptions.LocalPath,
+		RemotePath: copyOptions.RemotePath,
+		Recursive:  copyOptions.Recursive,
+	}, progressBar)
+}
+
+// knownHosts returns the SSH proxy host keys trusted for the targeted API.
+func (actor Actor) knownHosts() clissh.KnownHosts {
+	return knownhosts.NewChecker(actor.Config.SSHKnownHostsFilePath(), actor.Config.Target())
+}
+
 func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []clissh.LocalPortForward {
 	sshPackageSpecs := []clissh.LocalPortForward{}
 
@@ -51,3 +155,23 @@ func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []cl
 
 	return sshPackageSpecs
 }
+
+func convertActorToSSHPackageRemoteForwardingSpecs(actorSpecs []RemotePortForward) []clissh.RemotePortForward {
+	sshPackageSpecs := []clissh.RemotePortForward{}
+
+	for _, spec := range actorSpecs {
+		sshPackageSpecs = append(sshPackageSpecs, clissh.RemotePortForward(spec))
+	}
+
+	return sshPackageSpecs
+}
+
+func convertActorToSSHPackageDynamicForwardingSpecs(actorSpecs []DynamicPortForward) []clissh.DynamicPortForward {
+	sshPackageSpecs := []clissh.DynamicPortForward{}
+
+	for _, spec := range actorSpecs {
+		sshPackageSpecs = append(sshPackageSpecs, clissh.DynamicPortForward(spec))
+	}
+
+	return sshPackageSpecs
+}
@@ -0,0 +1,45 @@
+package sharedaction
+
+import "code.cloudfoundry.org/cli/util/clissh/knownhosts"
+
+// SSHKnownHost is an SSH proxy host key trusted for an API endpoint.
+type SSHKnownHost knownhosts.Host
+
+// SSHKnownHostNotFoundError is returned when no host key is trusted for an
+// API endpoint.
+type SSHKnownHostNotFoundError struct {
+	APIEndpoint string
+}
+
+func (e SSHKnownHostNotFoundError) Error() string {
+	return "No SSH host key is trusted for " + e.APIEndpoint
+}
+
+// GetSSHKnownHosts returns the SSH proxy host keys trusted for every API
+// endpoint.
+func (actor Actor) GetSSHKnownHosts() ([]SSHKnownHost, error) {
+	hosts, err := knownhosts.NewStore(actor.Config.SSHKnownHostsFilePath()).Hosts()
+	if err != nil {
+		return nil, err
+	}
+
+	var knownHosts []SSHKnownHost
+	for _, host := range hosts {
+		knownHosts = append(knownHosts, SSHKnownHost(host))
+	}
+	return knownHosts, nil
+}
+
+// RemoveSSHKnownHost stops trusting the SSH proxy host key of the provided
+// API endpoint. The next connection trusts whichever key is presented.
+func (actor Actor) RemoveSSHKnownHost(apiEndpoint string) error {
+	removed, err := knownhosts.NewStore(actor.Config.SSHKnownHostsFilePath()).Remove(apiEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if !removed {
+		return SSHKnownHostNotFoundError{APIEndpoint: apiEndpoint}
+	}
+	return nil
+}
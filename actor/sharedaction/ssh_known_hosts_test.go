@@ -0,0 +1,78 @@
+package sharedaction_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/util/clissh/knownhosts"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+)
+
+var _ = Describe("SSH Known Hosts Actions", func() {
+	var (
+		actor      *Actor
+		fakeConfig *sharedactionfakes.FakeConfig
+		tempDir    string
+		path       string
+		key        ssh.PublicKey
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "known-hosts")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(tempDir, "ssh_known_hosts.json")
+
+		fakeConfig = new(sharedactionfakes.FakeConfig)
+		fakeConfig.SSHKnownHostsFilePathReturns(path)
+		actor = NewActor(fakeConfig, nil)
+
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		key, err = ssh.NewPublicKey(&privateKey.PublicKey)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = knownhosts.NewStore(path).Trust("https://api.example.com", "ssh.example.com:2222", key)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	Describe("GetSSHKnownHosts", func() {
+		It("returns the trusted host keys", func() {
+			hosts, err := actor.GetSSHKnownHosts()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hosts).To(HaveLen(1))
+			Expect(hosts[0].APIEndpoint).To(Equal("https://api.example.com"))
+			Expect(hosts[0].SSHEndpoint).To(Equal("ssh.example.com:2222"))
+			Expect(hosts[0].Fingerprint).To(Equal(knownhosts.Fingerprint(key)))
+		})
+	})
+
+	Describe("RemoveSSHKnownHost", func() {
+		It("removes the trusted host key", func() {
+			Expect(actor.RemoveSSHKnownHost("https://api.example.com")).To(Succeed())
+
+			hosts, err := actor.GetSSHKnownHosts()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hosts).To(BeEmpty())
+		})
+
+		Context("when no host key is trusted for the API endpoint", func() {
+			It("returns an SSHKnownHostNotFoundError", func() {
+				err := actor.RemoveSSHKnownHost("https://api.other.example.com")
+				Expect(err).To(MatchError(SSHKnownHostNotFoundError{APIEndpoint: "https://api.other.example.com"}))
+			})
+		})
+	})
+})
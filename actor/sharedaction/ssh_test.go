@@ -1,15 +1,22 @@
 package sharedaction_test
 
 import (
+	"bytes"
 	"errors"
 
 	. "code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
 	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/clissh/knownhosts"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
 
+type exitStatusError int
+
+func (e exitStatusError) Error() string   { return "Process exited with status" }
+func (e exitStatusError) ExitStatus() int { return int(e) }
+
 var _ = Describe("SSH Actions", func() {
 	var (
 		fakeConfig            *sharedactionfakes.FakeConfig
@@ -20,6 +27,8 @@ var _ = Describe("SSH Actions", func() {
 	BeforeEach(func() {
 		fakeSecureShellClient = new(sharedactionfakes.FakeSecureShellClient)
 		fakeConfig = new(sharedactionfakes.FakeConfig)
+		fakeConfig.SSHKnownHostsFilePathReturns("some-known-hosts-path")
+		fakeConfig.TargetReturns("https://api.example.com")
 		actor = NewActor(fakeConfig, fakeSecureShellClient)
 	})
 
@@ -46,6 +55,14 @@ var _ = Describe("SSH Actions", func() {
 			Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
 		})
 
+		It("checks the host key against the known hosts of the targeted API", func() {
+			Expect(fakeSecureShellClient.UseKnownHostsCallCount()).To(Equal(1))
+			knownHostsArg := fakeSecureShellClient.UseKnownHostsArgsForCall(0)
+			Expect(knownHostsArg).To(BeAssignableToTypeOf(knownhosts.Checker{}))
+			Expect(knownHostsArg.(knownhosts.Checker).APIEndpoint).To(Equal("https://api.example.com"))
+			Expect(knownHostsArg.(knownhosts.Checker).Store).To(Equal(knownhosts.NewStore("some-known-hosts-path")))
+		})
+
 		It("calls connect with the provided authorization info", func() {
 			Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(1))
 			usernameArg, passcodeArg, endpointArg, fingerprintArg, _ := fakeSecureShellClient.ConnectArgsForCall(0)
@@ -83,13 +100,68 @@ var _ = Describe("SSH Actions", func() {
 				))
 			})
 
+			It("does not set up remote or dynamic forwarding when none are requested", func() {
+				Expect(fakeSecureShellClient.RemotePortForwardCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(0))
+			})
+
 			Context("when local port forwarding fails", func() {
 				BeforeEach(func() {
-					fakeSecureShellClient.ConnectReturns(errors.New("some-forwarding-error"))
+					fakeSecureShellClient.LocalPortForwardReturns(errors.New("some-forwarding-error"))
 				})
 
 				It("returns the error", func() {
 					Expect(executeErr).To(MatchError("some-forwarding-error"))
+					Expect(fakeSecureShellClient.WaitCallCount()).To(Equal(0))
+					Expect(fakeSecureShellClient.InteractiveSessionCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when remote and dynamic forwards are requested", func() {
+				BeforeEach(func() {
+					sshOptions.RemotePortForwardSpecs = []RemotePortForward{
+						{RemoteAddress: "remote-address-1", LocalAddress: "local-address-1"},
+					}
+					sshOptions.DynamicPortForwardSpecs = []DynamicPortForward{
+						{LocalAddress: "local-address-2"},
+					}
+				})
+
+				It("forwards the remote ports and starts the SOCKS proxy", func() {
+					Expect(fakeSecureShellClient.RemotePortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShellClient.RemotePortForwardArgsForCall(0)).To(Equal(
+						[]clissh.RemotePortForward{
+							{RemoteAddress: "remote-address-1", LocalAddress: "local-address-1"},
+						},
+					))
+
+					Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShellClient.DynamicPortForwardArgsForCall(0)).To(Equal(
+						[]clissh.DynamicPortForward{
+							{LocalAddress: "local-address-2"},
+						},
+					))
+				})
+
+				Context("when remote port forwarding fails", func() {
+					BeforeEach(func() {
+						fakeSecureShellClient.RemotePortForwardReturns(errors.New("some-remote-forwarding-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("some-remote-forwarding-error"))
+						Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when dynamic port forwarding fails", func() {
+					BeforeEach(func() {
+						fakeSecureShellClient.DynamicPortForwardReturns(errors.New("some-dynamic-forwarding-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("some-dynamic-forwarding-error"))
+					})
 				})
 			})
 
@@ -157,4 +229,177 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("ExecuteSecureCopy", func() {
+		var (
+			copyOptions     SecureCopyOptions
+			fakeProgressBar *sharedactionfakes.FakeProgressBar
+			executeErr      error
+		)
+
+		BeforeEach(func() {
+			fakeProgressBar = new(sharedactionfakes.FakeProgressBar)
+			copyOptions = SecureCopyOptions{
+				Username:           "some-user",
+				Passcode:           "some-passcode",
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				SkipHostValidation: true,
+				Direction:          FileTransferUpload,
+				LocalPath:          "some-local-path",
+				RemotePath:         "some-remote-path",
+				Recursive:          true,
+			}
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.ExecuteSecureCopy(copyOptions, fakeProgressBar)
+		})
+
+		AfterEach(func() {
+			Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+		})
+
+		It("connects with the provided authorization info", func() {
+			Expect(fakeSecureShellClient.UseKnownHostsCallCount()).To(Equal(1))
+			Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(1))
+			usernameArg, passcodeArg, endpointArg, fingerprintArg, skipHostValidationArg := fakeSecureShellClient.ConnectArgsForCall(0)
+			Expect(usernameArg).To(Equal("some-user"))
+			Expect(passcodeArg).To(Equal("some-passcode"))
+			Expect(endpointArg).To(Equal("some-endpoint"))
+			Expect(fingerprintArg).To(Equal("some-fingerprint"))
+			Expect(skipHostValidationArg).To(BeTrue())
+		})
+
+		Context("when connecting fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ConnectReturns(errors.New("some-connect-error"))
+			})
+
+			It("returns the error without transferring files", func() {
+				Expect(executeErr).To(MatchError("some-connect-error"))
+				Expect(fakeSecureShellClient.TransferFilesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when connecting succeeds", func() {
+			It("transfers the files with the progress bar", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeSecureShellClient.TransferFilesCallCount()).To(Equal(1))
+				specArg, progressBarArg := fakeSecureShellClient.TransferFilesArgsForCall(0)
+				Expect(specArg).To(Equal(clissh.FileTransferSpec{
+					Direction:  clissh.FileTransferUpload,
+					LocalPath:  "some-local-path",
+					RemotePath: "some-remote-path",
+					Recursive:  true,
+				}))
+				Expect(progressBarArg).To(Equal(fakeProgressBar))
+			})
+
+			Context("when transferring files fails", func() {
+				BeforeEach(func() {
+					fakeSecureShellClient.TransferFilesReturns(errors.New("some-transfer-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-transfer-error"))
+				})
+			})
+		})
+	})
+
+	Describe("ExecuteSecureShellCommand", func() {
+		var (
+			fakeFactory  *sharedactionfakes.FakeSecureShellClientFactory
+			sshOptions   SSHOptions
+			stdOut       *bytes.Buffer
+			stdErr       *bytes.Buffer
+			exitStatus   int
+			executeErr   error
+			newSSHClient *sharedactionfakes.FakeSecureShellClient
+		)
+
+		BeforeEach(func() {
+			newSSHClient = new(sharedactionfakes.FakeSecureShellClient)
+			fakeFactory = new(sharedactionfakes.FakeSecureShellClientFactory)
+			fakeFactory.NewSecureShellClientReturns(newSSHClient)
+			actor.SecureShellClientFactory = fakeFactory
+
+			stdOut = new(bytes.Buffer)
+			stdErr = new(bytes.Buffer)
+			sshOptions = SSHOptions{
+				Commands:           []string{"some-command"},
+				Username:           "some-user",
+				Passcode:           "some-passcode",
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				SkipHostValidation: true,
+			}
+		})
+
+		JustBeforeEach(func() {
+			exitStatus, executeErr = actor.ExecuteSecureShellCommand(sshOptions, stdOut, stdErr)
+		})
+
+		It("connects a new client that writes to the provided writers", func() {
+			Expect(fakeFactory.NewSecureShellClientCallCount()).To(Equal(1))
+			stdOutArg, stdErrArg := fakeFactory.NewSecureShellClientArgsForCall(0)
+			Expect(stdOutArg).To(Equal(stdOut))
+			Expect(stdErrArg).To(Equal(stdErr))
+
+			Expect(newSSHClient.ConnectCallCount()).To(Equal(1))
+			usernameArg, passcodeArg, endpointArg, fingerprintArg, skipHostValidationArg := newSSHClient.ConnectArgsForCall(0)
+			Expect(usernameArg).To(Equal("some-user"))
+			Expect(passcodeArg).To(Equal("some-passcode"))
+			Expect(endpointArg).To(Equal("some-endpoint"))
+			Expect(fingerprintArg).To(Equal("some-fingerprint"))
+			Expect(skipHostValidationArg).To(BeTrue())
+
+			Expect(newSSHClient.UseKnownHostsCallCount()).To(Equal(1))
+			Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(0))
+		})
+
+		It("runs the commands without a tty and closes the client", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(exitStatus).To(Equal(0))
+
+			Expect(newSSHClient.InteractiveSessionCallCount()).To(Equal(1))
+			commandsArg, ttyArg := newSSHClient.InteractiveSessionArgsForCall(0)
+			Expect(commandsArg).To(Equal([]string{"some-command"}))
+			Expect(ttyArg).To(Equal(clissh.RequestTTYNo))
+			Expect(newSSHClient.CloseCallCount()).To(Equal(1))
+		})
+
+		Context("when connecting fails", func() {
+			BeforeEach(func() {
+				newSSHClient.ConnectReturns(errors.New("some-connect-error"))
+			})
+
+			It("returns the error without running the command", func() {
+				Expect(executeErr).To(MatchError("some-connect-error"))
+				Expect(newSSHClient.InteractiveSessionCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the remote command exits with a non-zero status", func() {
+			BeforeEach(func() {
+				newSSHClient.InteractiveSessionReturns(exitStatusError(3))
+			})
+
+			It("returns the exit status without an error", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(exitStatus).To(Equal(3))
+			})
+		})
+
+		Context("when the session fails", func() {
+			BeforeEach(func() {
+				newSSHClient.InteractiveSessionReturns(errors.New("some-session-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-session-error"))
+			})
+		})
+	})
 })
@@ -0,0 +1,197 @@
+package v2action
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"github.com/cloudfoundry/noaa"
+)
+
+const (
+	// ApplicationCrashEventType is the type of the event Cloud Controller
+	// records when an application instance crashes.
+	ApplicationCrashEventType = "audit.app.process.crash"
+
+	// maxDiagnosisLogsPerInstance is the number of recent log lines kept for
+	// each instance.
+	maxDiagnosisLogsPerInstance = 10
+)
+
+// ApplicationCrashCause is the likely reason an application instance
+// crashed, based on the exit description of the crash.
+type ApplicationCrashCause string
+
+const (
+	CrashCauseOutOfMemory ApplicationCrashCause = "out of memory"
+	CrashCauseDiskQuota   ApplicationCrashCause = "disk quota"
+	CrashCauseHealthCheck ApplicationCrashCause = "health check"
+	CrashCauseExited      ApplicationCrashCause = "exited"
+)
+
+// ApplicationCrash is a crash of a single application instance.
+type ApplicationCrash struct {
+	Index           int
+	ExitStatus      int
+	ExitDescription string
+	Reason          string
+	Timestamp       time.Time
+}
+
+// Cause returns the likely reason for the crash.
+func (crash ApplicationCrash) Cause() ApplicationCrashCause {
+	description := strings.ToLower(crash.ExitDescription)
+	switch {
+	case strings.Contains(description, "out of memory"):
+		return CrashCauseOutOfMemory
+	case strings.Contains(description, "disk quota"), strings.Contains(description, "no space left"):
+		return CrashCauseDiskQuota
+	case strings.Contains(description, "never healthy"), strings.Contains(description, "health check"):
+		return CrashCauseHealthCheck
+	default:
+		return CrashCauseExited
+	}
+}
+
+// ApplicationDiagnosis gathers the information needed to explain why an
+// application's instances are failing.
+type ApplicationDiagnosis struct {
+	Application
+
+	// RunningInstances are the current instances of the application. It is
+	// empty when the application is stopped.
+	RunningInstances []ApplicationInstanceWithStats
+
+	// Crashes are the crashes since the requested time, newest first.
+	Crashes []ApplicationCrash
+
+	// InstanceLogs are the most recent app and cell logs of each instance,
+	// oldest first, keyed by instance index.
+	InstanceLogs map[int][]LogMessage
+}
+
+// CrashesForInstance returns the crashes of the instance with the given index,
+// newest first.
+func (diagnosis ApplicationDiagnosis) CrashesForInstance(index int) []ApplicationCrash {
+	var crashes []ApplicationCrash
+	for _, crash := range diagnosis.Crashes {
+		if crash.Index == index {
+			crashes = append(crashes, crash)
+		}
+	}
+	return crashes
+}
+
+// GetApplicationDiagnosisByNameAndSpace returns the instances, crashes since
+// the provided time and recent logs of the application.
+func (actor Actor) GetApplicationDiagnosisByNameAndSpace(appName string, spaceGUID string, since time.Time, client NOAAClient) (ApplicationDiagnosis, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return ApplicationDiagnosis{}, allWarnings, err
+	}
+
+	diagnosis := ApplicationDiagnosis{Application: app}
+
+	// cloud controller calls the instance reporter only when the desired
+	// application state is STARTED
+	if app.State == ccv2.ApplicationStarted {
+		instances, warnings, instancesErr := actor.GetApplicationInstancesWithStatsByApplication(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+
+		switch instancesErr.(type) {
+		case nil:
+			diagnosis.RunningInstances = instances
+		case ApplicationInstancesNotFoundError:
+			// leave the instances empty
+		default:
+			return ApplicationDiagnosis{}, allWarnings, instancesErr
+		}
+	}
+
+	events, warnings, err := actor.CloudControllerClient.GetEvents(
+		ccv2.Query{
+			Filter:   ccv2.ActeeFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{app.GUID},
+		},
+		ccv2.Query{
+			Filter:   ccv2.TypeFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{ApplicationCrashEventType},
+		},
+		ccv2.Query{
+			Filter:   ccv2.TimestampFilter,
+			Operator: ccv2.GreaterThanOperator,
+			Values:   []string{since.UTC().Format(time.RFC3339)},
+		},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ApplicationDiagnosis{}, allWarnings, err
+	}
+
+	for _, event := range events {
+		diagnosis.Crashes = append(diagnosis.Crashes, newApplicationCrash(event))
+	}
+	sort.SliceStable(diagnosis.Crashes, func(i int, j int) bool {
+		return diagnosis.Crashes[i].Timestamp.After(diagnosis.Crashes[j].Timestamp)
+	})
+
+	// The diagnosis is still useful without logs, so a failure to get them is
+	// only reported as a warning.
+	noaaMessages, err := client.RecentLogs(app.GUID, "")
+	if err != nil {
+		allWarnings = append(allWarnings, err.Error())
+		return diagnosis, allWarnings, nil
+	}
+
+	diagnosis.InstanceLogs = map[int][]LogMessage{}
+	for _, message := range noaa.SortRecent(noaaMessages) {
+		sourceType := message.GetSourceType()
+		if sourceType == StagingLog || sourceType == "RTR" {
+			continue
+		}
+
+		index, err := strconv.Atoi(message.GetSourceInstance())
+		if err != nil {
+			continue
+		}
+
+		diagnosis.InstanceLogs[index] = append(diagnosis.InstanceLogs[index], LogMessage{
+			message:        string(message.GetMessage()),
+			messageType:    message.GetMessageType(),
+			timestamp:      time.Unix(0, message.GetTimestamp()),
+			sourceType:     sourceType,
+			sourceInstance: message.GetSourceInstance(),
+		})
+	}
+
+	for index, logs := range diagnosis.InstanceLogs {
+		if len(logs) > maxDiagnosisLogsPerInstance {
+			diagnosis.InstanceLogs[index] = logs[len(logs)-maxDiagnosisLogsPerInstance:]
+		}
+	}
+
+	return diagnosis, allWarnings, nil
+}
+
+func newApplicationCrash(event ccv2.Event) ApplicationCrash {
+	crash := ApplicationCrash{Timestamp: event.Timestamp}
+
+	if index, ok := event.Metadata["index"].(float64); ok {
+		crash.Index = int(index)
+	}
+	if exitStatus, ok := event.Metadata["exit_status"].(float64); ok {
+		crash.ExitStatus = int(exitStatus)
+	}
+	if exitDescription, ok := event.Metadata["exit_description"].(string); ok {
+		crash.ExitDescription = exitDescription
+	}
+	if reason, ok := event.Metadata["reason"].(string); ok {
+		crash.Reason = reason
+	}
+
+	return crash
+}
@@ -0,0 +1,239 @@
+package v2action_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"github.com/cloudfoundry/sonde-go/events"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Application Diagnosis Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeNOAAClient            *v2actionfakes.FakeNOAAClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	DescribeTable("ApplicationCrash.Cause",
+		func(exitDescription string, cause ApplicationCrashCause) {
+			Expect(ApplicationCrash{ExitDescription: exitDescription}.Cause()).To(Equal(cause))
+		},
+		Entry("out of memory", "APP/PROC/WEB: Exited with status 137 (out of memory)", CrashCauseOutOfMemory),
+		Entry("disk quota", "APP/PROC/WEB: Exited with status 1 (disk quota exceeded)", CrashCauseDiskQuota),
+		Entry("health check", "Instance never healthy after 1m0s: Failed to make TCP connection to port 8080: connection refused", CrashCauseHealthCheck),
+		Entry("anything else", "APP/PROC/WEB: Exited with status 1", CrashCauseExited),
+	)
+
+	Describe("CrashesForInstance", func() {
+		It("returns the crashes of the instance", func() {
+			diagnosis := ApplicationDiagnosis{
+				Crashes: []ApplicationCrash{{Index: 1, ExitStatus: 1}, {Index: 0, ExitStatus: 2}, {Index: 1, ExitStatus: 3}},
+			}
+			Expect(diagnosis.CrashesForInstance(1)).To(Equal([]ApplicationCrash{{Index: 1, ExitStatus: 1}, {Index: 1, ExitStatus: 3}}))
+			Expect(diagnosis.CrashesForInstance(2)).To(BeEmpty())
+		})
+	})
+
+	Describe("GetApplicationDiagnosisByNameAndSpace", func() {
+		var (
+			since     time.Time
+			diagnosis ApplicationDiagnosis
+			warnings  Warnings
+			err       error
+		)
+
+		BeforeEach(func() {
+			since = time.Date(2017, 11, 6, 20, 0, 0, 0, time.UTC)
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{{GUID: "some-app-guid", Name: "some-app", State: ccv2.ApplicationStarted}},
+				ccv2.Warnings{"app-warning"},
+				nil)
+			fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationReturns(
+				map[int]ccv2.ApplicationInstanceStatus{
+					0: {ID: 0, Memory: 100, MemoryQuota: 128},
+					1: {ID: 1},
+				},
+				ccv2.Warnings{"stats-warning"},
+				nil)
+			fakeCloudControllerClient.GetApplicationInstancesByApplicationReturns(
+				map[int]ccv2.ApplicationInstance{
+					0: {ID: 0, State: ccv2.ApplicationInstanceRunning},
+					1: {ID: 1, State: ccv2.ApplicationInstanceCrashed},
+				},
+				ccv2.Warnings{"instance-warning"},
+				nil)
+			fakeCloudControllerClient.GetEventsReturns(
+				[]ccv2.Event{
+					{
+						Timestamp: time.Date(2017, 11, 6, 20, 10, 0, 0, time.UTC),
+						Metadata: map[string]interface{}{
+							"index":            float64(1),
+							"exit_status":      float64(137),
+							"exit_description": "APP/PROC/WEB: Exited with status 137 (out of memory)",
+							"reason":           "CRASHED",
+						},
+					},
+					{
+						Timestamp: time.Date(2017, 11, 6, 20, 30, 0, 0, time.UTC),
+						Metadata:  map[string]interface{}{"index": float64(1)},
+					},
+				},
+				ccv2.Warnings{"events-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			diagnosis, warnings, err = actor.GetApplicationDiagnosisByNameAndSpace("some-app", "some-space-guid", since, fakeNOAAClient)
+		})
+
+		Context("when the app has instances, crashes and logs", func() {
+			BeforeEach(func() {
+				outMessage := events.LogMessage_OUT
+				newLogMessage := func(message string, timestamp int64, sourceType string, sourceInstance string) *events.LogMessage {
+					return &events.LogMessage{
+						Message:        []byte(message),
+						MessageType:    &outMessage,
+						Timestamp:      &timestamp,
+						SourceType:     &sourceType,
+						SourceInstance: &sourceInstance,
+					}
+				}
+
+				messages := []*events.LogMessage{
+					newLogMessage("instance-1-cell", 20, "CELL", "1"),
+					newLogMessage("instance-1-app", 10, "APP/PROC/WEB", "1"),
+					newLogMessage("router", 30, "RTR", "1"),
+					newLogMessage("staging", 40, "STG", "0"),
+					newLogMessage("api", 50, "API", "some-api-guid"),
+				}
+				for i := 0; i < 12; i++ {
+					messages = append(messages, newLogMessage(fmt.Sprintf("instance-0-%d", i), int64(100+i), "APP/PROC/WEB", "0"))
+				}
+				fakeNOAAClient.RecentLogsReturns(messages, nil)
+			})
+
+			It("returns the instances, crashes and logs by instance", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("app-warning", "stats-warning", "instance-warning", "events-warning"))
+
+				Expect(diagnosis.Application).To(Equal(Application{GUID: "some-app-guid", Name: "some-app", State: ccv2.ApplicationStarted}))
+				Expect(diagnosis.RunningInstances).To(HaveLen(2))
+				Expect(diagnosis.RunningInstances[0].State).To(Equal(ApplicationInstanceState(ccv2.ApplicationInstanceRunning)))
+				Expect(diagnosis.RunningInstances[1].State).To(Equal(ApplicationInstanceState(ccv2.ApplicationInstanceCrashed)))
+
+				Expect(diagnosis.Crashes).To(Equal([]ApplicationCrash{
+					{Index: 1, Timestamp: time.Date(2017, 11, 6, 20, 30, 0, 0, time.UTC)},
+					{
+						Index:           1,
+						ExitStatus:      137,
+						ExitDescription: "APP/PROC/WEB: Exited with status 137 (out of memory)",
+						Reason:          "CRASHED",
+						Timestamp:       time.Date(2017, 11, 6, 20, 10, 0, 0, time.UTC),
+					},
+				}))
+
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv2.Query{Filter: ccv2.ActeeFilter, Operator: ccv2.EqualOperator, Values: []string{"some-app-guid"}},
+					ccv2.Query{Filter: ccv2.TypeFilter, Operator: ccv2.EqualOperator, Values: []string{"audit.app.process.crash"}},
+					ccv2.Query{Filter: ccv2.TimestampFilter, Operator: ccv2.GreaterThanOperator, Values: []string{"2017-11-06T20:00:00Z"}},
+				))
+
+				Expect(diagnosis.InstanceLogs).To(HaveLen(2))
+				Expect(diagnosis.InstanceLogs[1]).To(HaveLen(2))
+				Expect(diagnosis.InstanceLogs[1][0].Message()).To(Equal("instance-1-app"))
+				Expect(diagnosis.InstanceLogs[1][1].Message()).To(Equal("instance-1-cell"))
+				Expect(diagnosis.InstanceLogs[1][1].SourceType()).To(Equal("CELL"))
+
+				Expect(diagnosis.InstanceLogs[0]).To(HaveLen(10))
+				Expect(diagnosis.InstanceLogs[0][0].Message()).To(Equal("instance-0-2"))
+				Expect(diagnosis.InstanceLogs[0][9].Message()).To(Equal("instance-0-11"))
+
+				appGUID, _ := fakeNOAAClient.RecentLogsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when the app is stopped", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{{GUID: "some-app-guid", Name: "some-app", State: ccv2.ApplicationStopped}},
+					nil,
+					nil)
+			})
+
+			It("does not get the instances", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(diagnosis.RunningInstances).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationCallCount()).To(Equal(0))
+				Expect(diagnosis.Crashes).To(HaveLen(2))
+			})
+		})
+
+		Context("when the instances cannot be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationReturns(nil, ccv2.Warnings{"stats-warning"}, ccerror.ApplicationStoppedStatsError{})
+			})
+
+			It("returns the diagnosis without instances", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(diagnosis.RunningInstances).To(BeEmpty())
+				Expect(warnings).To(ContainElement("stats-warning"))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and warnings", func() {
+				Expect(err).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("app-warning"))
+			})
+		})
+
+		Context("when getting the events fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv2.Warnings{"events-warning"}, errors.New("events-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("events-error"))
+				Expect(warnings).To(ContainElement("events-warning"))
+				Expect(fakeNOAAClient.RecentLogsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the recent logs fails", func() {
+			BeforeEach(func() {
+				fakeNOAAClient.RecentLogsReturns(nil, errors.New("logs-error"))
+			})
+
+			It("returns the diagnosis without logs and the failure as a warning", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("app-warning", "stats-warning", "instance-warning", "events-warning", "logs-error"))
+				Expect(diagnosis.Application).To(Equal(Application{GUID: "some-app-guid", Name: "some-app", State: ccv2.ApplicationStarted}))
+				Expect(diagnosis.RunningInstances).To(HaveLen(2))
+				Expect(diagnosis.Crashes).To(HaveLen(2))
+				Expect(diagnosis.InstanceLogs).To(BeEmpty())
+			})
+		})
+	})
+})
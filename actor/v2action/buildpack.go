@@ -0,0 +1,289 @@
+package v2action
+
+import (
+	"archive/zip"
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util"
+	yaml "gopkg.in/yaml.v2"
+)
+
+type Buildpack ccv2.Buildpack
+
+// BuildpackNotFoundError is returned when a requested buildpack is not found.
+type BuildpackNotFoundError struct {
+	Name  string
+	Stack string
+}
+
+func (e BuildpackNotFoundError) Error() string {
+	if e.Stack == "" {
+		return fmt.Sprintf("Buildpack '%s' not found.", e.Name)
+	}
+	return fmt.Sprintf("Buildpack '%s' with stack '%s' not found.", e.Name, e.Stack)
+}
+
+// MultipleBuildpacksFoundError is returned when several buildpacks share a
+// name and no stack is given to tell them apart.
+type MultipleBuildpacksFoundError struct {
+	Name string
+}
+
+func (e MultipleBuildpacksFoundError) Error() string {
+	return fmt.Sprintf("Multiple buildpacks named '%s' found; specify a stack.", e.Name)
+}
+
+// BuildpackNameTakenError is returned when creating a buildpack whose name is
+// already in use for the stack.
+type BuildpackNameTakenError struct {
+	Name string
+}
+
+func (e BuildpackNameTakenError) Error() string {
+	return fmt.Sprintf("Buildpack '%s' already exists.", e.Name)
+}
+
+//go:generate counterfeiter . Downloader
+
+// Downloader saves the file at a URL in its save path.
+type Downloader interface {
+	DownloadFile(url string) (int64, string, error)
+	SavePath() string
+}
+
+//go:generate counterfeiter . ProgressBar
+
+// ProgressBar wraps a reader to display the progress of reading it.
+type ProgressBar interface {
+	NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader
+}
+
+// CreateBuildpack creates a buildpack without any bits.
+func (actor Actor) CreateBuildpack(buildpack Buildpack) (Buildpack, Warnings, error) {
+	createdBuildpack, warnings, err := actor.CloudControllerClient.CreateBuildpack(ccv2.Buildpack(buildpack))
+	if _, ok := err.(ccerror.BuildpackNameTakenError); ok {
+		return Buildpack{}, Warnings(warnings), BuildpackNameTakenError{Name: buildpack.Name}
+	}
+	return Buildpack(createdBuildpack), Warnings(warnings), err
+}
+
+// GetBuildpackByNameAndStack returns the buildpack with the name. When stack
+// is empty the name must belong to a single buildpack.
+func (actor Actor) GetBuildpackByNameAndStack(buildpackName string, stackName string) (Buildpack, Warnings, error) {
+	queries := []ccv2.Query{{
+		Filter:   ccv2.NameFilter,
+		Operator: ccv2.EqualOperator,
+		Values:   []string{buildpackName},
+	}}
+	if stackName != "" {
+		queries = append(queries, ccv2.Query{
+			Filter:   ccv2.StackFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{stackName},
+		})
+	}
+
+	buildpacks, warnings, err := actor.CloudControllerClient.GetBuildpacks(queries...)
+	if err != nil {
+		return Buildpack{}, Warnings(warnings), err
+	}
+
+	switch len(buildpacks) {
+	case 0:
+		return Buildpack{}, Warnings(warnings), BuildpackNotFoundError{Name: buildpackName, Stack: stackName}
+	case 1:
+		return Buildpack(buildpacks[0]), Warnings(warnings), nil
+	default:
+		return Buildpack{}, Warnings(warnings), MultipleBuildpacksFoundError{Name: buildpackName}
+	}
+}
+
+// UpdateBuildpack updates the fields of the buildpack that are set.
+func (actor Actor) UpdateBuildpack(buildpack Buildpack) (Buildpack, Warnings, error) {
+	updatedBuildpack, warnings, err := actor.CloudControllerClient.UpdateBuildpack(ccv2.Buildpack(buildpack))
+	return Buildpack(updatedBuildpack), Warnings(warnings), err
+}
+
+// PrepareBuildpackBits returns the path of a zip file holding the buildpack
+// at inputPath. URLs are downloaded and directories are zipped into
+// tmpDirPath; zip files are used as they are.
+func (Actor) PrepareBuildpackBits(inputPath string, tmpDirPath string, downloader Downloader) (string, error) {
+	if util.IsHTTPScheme(inputPath) {
+		_, filename, err := downloader.DownloadFile(inputPath)
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(downloader.SavePath(), filename), nil
+	}
+
+	info, err := os.Stat(inputPath)
+	if err != nil {
+		return "", err
+	}
+	if !info.IsDir() {
+		return inputPath, nil
+	}
+
+	absPath, err := filepath.Abs(inputPath)
+	if err != nil {
+		return "", err
+	}
+
+	zipPath := filepath.Join(tmpDirPath, filepath.Base(absPath)+".zip")
+	err = zipBuildpackDirectory(absPath, zipPath)
+	if err != nil {
+		return "", err
+	}
+	return zipPath, nil
+}
+
+// GetBuildpackBitsStack returns the stack named by the manifest.yml at the
+// root of the buildpack zip. It is empty when the zip has no manifest or the
+// manifest does not name a stack.
+func (Actor) GetBuildpackBitsStack(zipPath string) (string, error) {
+	reader, err := zip.OpenReader(zipPath)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	for _, file := range reader.File {
+		if file.Name != "manifest.yml" {
+			continue
+		}
+
+		manifestReader, err := file.Open()
+		if err != nil {
+			return "", err
+		}
+		defer manifestReader.Close()
+
+		raw, err := ioutil.ReadAll(manifestReader)
+		if err != nil {
+			return "", err
+		}
+
+		var manifest struct {
+			Stack string `yaml:"stack"`
+		}
+		err = yaml.Unmarshal(raw, &manifest)
+		return manifest.Stack, err
+	}
+
+	return "", nil
+}
+
+// BuildpackBitsMatch returns true when the bits the buildpack already has
+// are the same as the zip at zipPath. The existing bits are downloaded and
+// compared by checksum.
+func (actor Actor) BuildpackBitsMatch(buildpack Buildpack, zipPath string) (bool, Warnings, error) {
+	if buildpack.Filename == "" {
+		return false, nil, nil
+	}
+
+	checksum, err := buildpackChecksum(zipPath)
+	if err != nil {
+		return false, nil, err
+	}
+
+	existingSum := sha1.New()
+	warnings, err := actor.CloudControllerClient.DownloadBuildpack(buildpack.GUID, existingSum)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return false, Warnings(warnings), nil
+	}
+	if err != nil {
+		return false, Warnings(warnings), err
+	}
+
+	return fmt.Sprintf("%x", existingSum.Sum(nil)) == checksum, Warnings(warnings), nil
+}
+
+// UploadBuildpack uploads the zip at zipPath as the bits of the buildpack.
+func (actor Actor) UploadBuildpack(buildpackGUID string, zipPath string, progressBar ProgressBar) (Warnings, error) {
+	file, err := os.Open(zipPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	reader := progressBar.NewProgressBarWrapper(file, info.Size())
+	warnings, err := actor.CloudControllerClient.UploadBuildpack(buildpackGUID, filepath.Base(zipPath), reader, info.Size())
+	return Warnings(warnings), err
+}
+
+func buildpackChecksum(zipPath string) (string, error) {
+	sum, err := util.NewSha1Checksum(zipPath).ComputeFileSha1()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", sum), nil
+}
+
+// zipBuildpackDirectory zips the contents of dir, keeping file modes so that
+// the buildpack's scripts stay executable.
+func zipBuildpackDirectory(dir string, zipPath string) error {
+	zipFile, err := os.Create(zipPath)
+	if err != nil {
+		return err
+	}
+	defer zipFile.Close()
+
+	writer := zip.NewWriter(zipFile)
+
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if path == dir {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relPath)
+		if info.IsDir() {
+			header.Name += "/"
+			_, err = writer.CreateHeader(header)
+			return err
+		}
+		header.Method = zip.Deflate
+
+		fileWriter, err := writer.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(fileWriter, file)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
@@ -0,0 +1,306 @@
+package v2action_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Buildpack Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		tmpDir                    string
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "buildpack-actions")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	writeZip := func(path string, files map[string]string) {
+		buffer := new(bytes.Buffer)
+		writer := zip.NewWriter(buffer)
+		for name, contents := range files {
+			fileWriter, err := writer.Create(name)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = fileWriter.Write([]byte(contents))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(writer.Close()).To(Succeed())
+		Expect(ioutil.WriteFile(path, buffer.Bytes(), 0600)).To(Succeed())
+	}
+
+	Describe("CreateBuildpack", func() {
+		It("creates the buildpack", func() {
+			fakeCloudControllerClient.CreateBuildpackReturns(ccv2.Buildpack{GUID: "some-buildpack-guid", Name: "some-buildpack"}, ccv2.Warnings{"create-warning"}, nil)
+
+			buildpack, warnings, err := actor.CreateBuildpack(Buildpack{Name: "some-buildpack", Position: types.NullInt{IsSet: true, Value: 1}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("create-warning"))
+			Expect(buildpack).To(Equal(Buildpack{GUID: "some-buildpack-guid", Name: "some-buildpack"}))
+			Expect(fakeCloudControllerClient.CreateBuildpackArgsForCall(0)).To(Equal(ccv2.Buildpack{Name: "some-buildpack", Position: types.NullInt{IsSet: true, Value: 1}}))
+		})
+
+		Context("when the name is taken", func() {
+			It("returns a BuildpackNameTakenError", func() {
+				fakeCloudControllerClient.CreateBuildpackReturns(ccv2.Buildpack{}, ccv2.Warnings{"create-warning"}, ccerror.BuildpackNameTakenError{Message: "taken"})
+
+				_, warnings, err := actor.CreateBuildpack(Buildpack{Name: "some-buildpack"})
+				Expect(err).To(MatchError(BuildpackNameTakenError{Name: "some-buildpack"}))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+	})
+
+	Describe("GetBuildpackByNameAndStack", func() {
+		Context("when a stack is given", func() {
+			It("filters the buildpacks by name and stack", func() {
+				fakeCloudControllerClient.GetBuildpacksReturns([]ccv2.Buildpack{{GUID: "some-buildpack-guid"}}, ccv2.Warnings{"get-warning"}, nil)
+
+				buildpack, warnings, err := actor.GetBuildpackByNameAndStack("some-buildpack", "cflinuxfs2")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(buildpack.GUID).To(Equal("some-buildpack-guid"))
+				Expect(fakeCloudControllerClient.GetBuildpacksArgsForCall(0)).To(Equal([]ccv2.Query{
+					{Filter: ccv2.NameFilter, Operator: ccv2.EqualOperator, Values: []string{"some-buildpack"}},
+					{Filter: ccv2.StackFilter, Operator: ccv2.EqualOperator, Values: []string{"cflinuxfs2"}},
+				}))
+			})
+		})
+
+		Context("when no buildpack is found", func() {
+			It("returns a BuildpackNotFoundError", func() {
+				fakeCloudControllerClient.GetBuildpacksReturns(nil, ccv2.Warnings{"get-warning"}, nil)
+
+				_, warnings, err := actor.GetBuildpackByNameAndStack("some-buildpack", "")
+				Expect(err).To(MatchError(BuildpackNotFoundError{Name: "some-buildpack"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+
+		Context("when several buildpacks share the name", func() {
+			It("returns a MultipleBuildpacksFoundError", func() {
+				fakeCloudControllerClient.GetBuildpacksReturns([]ccv2.Buildpack{{Stack: "cflinuxfs2"}, {Stack: "windows2012R2"}}, nil, nil)
+
+				_, _, err := actor.GetBuildpackByNameAndStack("some-buildpack", "")
+				Expect(err).To(MatchError(MultipleBuildpacksFoundError{Name: "some-buildpack"}))
+			})
+		})
+	})
+
+	Describe("PrepareBuildpackBits", func() {
+		var fakeDownloader *v2actionfakes.FakeDownloader
+
+		BeforeEach(func() {
+			fakeDownloader = new(v2actionfakes.FakeDownloader)
+		})
+
+		Context("when the path is a zip file", func() {
+			It("returns the path", func() {
+				zipPath := filepath.Join(tmpDir, "buildpack.zip")
+				writeZip(zipPath, map[string]string{"bin/detect": "detect"})
+
+				path, err := actor.PrepareBuildpackBits(zipPath, tmpDir, fakeDownloader)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(path).To(Equal(zipPath))
+				Expect(fakeDownloader.DownloadFileCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the path is a URL", func() {
+			It("downloads the buildpack", func() {
+				fakeDownloader.DownloadFileReturns(10, "buildpack.zip", nil)
+				fakeDownloader.SavePathReturns(tmpDir)
+
+				path, err := actor.PrepareBuildpackBits("https://example.com/buildpack.zip", tmpDir, fakeDownloader)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(path).To(Equal(filepath.Join(tmpDir, "buildpack.zip")))
+				Expect(fakeDownloader.DownloadFileArgsForCall(0)).To(Equal("https://example.com/buildpack.zip"))
+			})
+		})
+
+		Context("when the path is a directory", func() {
+			It("zips the directory with the files at the root", func() {
+				buildpackDir := filepath.Join(tmpDir, "my-buildpack")
+				Expect(os.MkdirAll(filepath.Join(buildpackDir, "bin"), 0700)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(buildpackDir, "bin", "detect"), []byte("detect"), 0700)).To(Succeed())
+
+				zipDir := filepath.Join(tmpDir, "zips")
+				Expect(os.Mkdir(zipDir, 0700)).To(Succeed())
+
+				path, err := actor.PrepareBuildpackBits(buildpackDir, zipDir, fakeDownloader)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(path).To(Equal(filepath.Join(zipDir, "my-buildpack.zip")))
+
+				reader, err := zip.OpenReader(path)
+				Expect(err).ToNot(HaveOccurred())
+				defer reader.Close()
+
+				var names []string
+				for _, file := range reader.File {
+					names = append(names, file.Name)
+				}
+				Expect(names).To(Equal([]string{"bin/", "bin/detect"}))
+				Expect(reader.File[1].Mode().Perm() & 0100).ToNot(BeZero())
+			})
+		})
+	})
+
+	Describe("GetBuildpackBitsStack", func() {
+		It("returns the stack named by the manifest", func() {
+			zipPath := filepath.Join(tmpDir, "buildpack.zip")
+			writeZip(zipPath, map[string]string{"manifest.yml": "language: ruby\nstack: cflinuxfs2\n"})
+
+			stack, err := actor.GetBuildpackBitsStack(zipPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stack).To(Equal("cflinuxfs2"))
+		})
+
+		Context("when there is no manifest", func() {
+			It("returns an empty stack", func() {
+				zipPath := filepath.Join(tmpDir, "buildpack.zip")
+				writeZip(zipPath, map[string]string{"bin/detect": "detect"})
+
+				stack, err := actor.GetBuildpackBitsStack(zipPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stack).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("BuildpackBitsMatch", func() {
+		var zipPath string
+
+		BeforeEach(func() {
+			zipPath = filepath.Join(tmpDir, "buildpack.zip")
+			writeZip(zipPath, map[string]string{"bin/detect": "detect"})
+		})
+
+		Context("when the existing bits are the same as the zip", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DownloadBuildpackStub = func(_ string, output io.Writer) (ccv2.Warnings, error) {
+					raw, err := ioutil.ReadFile(zipPath)
+					Expect(err).ToNot(HaveOccurred())
+					_, err = output.Write(raw)
+					Expect(err).ToNot(HaveOccurred())
+					return ccv2.Warnings{"download-warning"}, nil
+				}
+			})
+
+			It("returns true and the warnings", func() {
+				match, warnings, err := actor.BuildpackBitsMatch(Buildpack{GUID: "some-buildpack-guid", Filename: "buildpack.zip"}, zipPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(match).To(BeTrue())
+				Expect(warnings).To(ConsistOf("download-warning"))
+
+				Expect(fakeCloudControllerClient.DownloadBuildpackCallCount()).To(Equal(1))
+				guid, _ := fakeCloudControllerClient.DownloadBuildpackArgsForCall(0)
+				Expect(guid).To(Equal("some-buildpack-guid"))
+			})
+		})
+
+		Context("when the existing bits differ from the zip", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DownloadBuildpackStub = func(_ string, output io.Writer) (ccv2.Warnings, error) {
+					_, err := output.Write([]byte("some-other-bits"))
+					Expect(err).ToNot(HaveOccurred())
+					return nil, nil
+				}
+			})
+
+			It("returns false", func() {
+				match, _, err := actor.BuildpackBitsMatch(Buildpack{Filename: "buildpack.zip"}, zipPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(match).To(BeFalse())
+			})
+		})
+
+		Context("when the buildpack has no bits", func() {
+			It("returns false without downloading", func() {
+				match, _, err := actor.BuildpackBitsMatch(Buildpack{}, zipPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(match).To(BeFalse())
+				Expect(fakeCloudControllerClient.DownloadBuildpackCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the existing bits cannot be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DownloadBuildpackReturns(ccv2.Warnings{"download-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns false and the warnings", func() {
+				match, warnings, err := actor.BuildpackBitsMatch(Buildpack{Filename: "buildpack.zip"}, zipPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(match).To(BeFalse())
+				Expect(warnings).To(ConsistOf("download-warning"))
+			})
+		})
+
+		Context("when downloading the existing bits fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("download failed")
+				fakeCloudControllerClient.DownloadBuildpackReturns(ccv2.Warnings{"download-warning"}, expectedErr)
+			})
+
+			It("returns the error and the warnings", func() {
+				_, warnings, err := actor.BuildpackBitsMatch(Buildpack{Filename: "buildpack.zip"}, zipPath)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("download-warning"))
+			})
+		})
+	})
+
+	Describe("UploadBuildpack", func() {
+		It("uploads the zip under its own name", func() {
+			zipPath := filepath.Join(tmpDir, "buildpack.zip")
+			writeZip(zipPath, map[string]string{"bin/detect": "detect"})
+
+			fakeProgressBar := new(v2actionfakes.FakeProgressBar)
+			fakeProgressBar.NewProgressBarWrapperStub = func(reader io.Reader, _ int64) io.Reader {
+				return reader
+			}
+			fakeCloudControllerClient.UploadBuildpackStub = func(_ string, _ string, bits io.Reader, _ int64) (ccv2.Warnings, error) {
+				_, err := ioutil.ReadAll(bits)
+				Expect(err).ToNot(HaveOccurred())
+				return ccv2.Warnings{"upload-warning"}, nil
+			}
+
+			warnings, err := actor.UploadBuildpack("some-buildpack-guid", zipPath, fakeProgressBar)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("upload-warning"))
+
+			info, err := os.Stat(zipPath)
+			Expect(err).ToNot(HaveOccurred())
+
+			guid, filename, _, size := fakeCloudControllerClient.UploadBuildpackArgsForCall(0)
+			Expect(guid).To(Equal("some-buildpack-guid"))
+			Expect(filename).To(Equal("buildpack.zip"))
+			Expect(size).To(Equal(info.Size()))
+			Expect(fakeProgressBar.NewProgressBarWrapperCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -6,6 +6,7 @@ import "time"
 
 type Config interface {
 	AccessToken() string
+	OverallPollingTimeout() time.Duration
 	PollingInterval() time.Duration
 	RefreshToken() string
 	SSHOAuthClient() string
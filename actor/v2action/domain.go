@@ -174,6 +174,17 @@ func (actor Actor) GetPrivateDomain(domainGUID string) (Domain, Warnings, error)
 	return Domain(domain), Warnings(warnings), err
 }
 
+// CreatePrivateDomain creates a private domain owned by the organization.
+func (actor Actor) CreatePrivateDomain(domainName string, orgGUID string) (Domain, Warnings, error) {
+	domain, warnings, err := actor.CloudControllerClient.CreatePrivateDomain(domainName, orgGUID)
+	if err != nil {
+		return Domain{}, Warnings(warnings), err
+	}
+
+	actor.saveDomain(domain)
+	return Domain(domain), Warnings(warnings), nil
+}
+
 // GetOrganizationDomains returns the shared and private domains associated
 // with an organization.
 func (actor Actor) GetOrganizationDomains(orgGUID string) ([]Domain, Warnings, error) {
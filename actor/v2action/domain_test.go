@@ -594,4 +594,43 @@ var _ = Describe("Domain Actions", func() {
 			})
 		})
 	})
+
+	Describe("CreatePrivateDomain", func() {
+		Context("when the domain is created", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreatePrivateDomainReturns(
+					ccv2.Domain{GUID: "some-domain-guid", Name: "some-domain.com", Type: constant.PrivateDomain},
+					ccv2.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns the domain and caches it", func() {
+				domain, warnings, err := actor.CreatePrivateDomain("some-domain.com", "some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(domain).To(Equal(Domain{GUID: "some-domain-guid", Name: "some-domain.com", Type: constant.PrivateDomain}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				domainName, orgGUID := fakeCloudControllerClient.CreatePrivateDomainArgsForCall(0)
+				Expect(domainName).To(Equal("some-domain.com"))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+
+				_, _, err = actor.GetPrivateDomain("some-domain-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetPrivateDomainCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating the domain fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreatePrivateDomainReturns(ccv2.Domain{}, ccv2.Warnings{"warning-1"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.CreatePrivateDomain("some-domain.com", "some-org-guid")
+				Expect(err).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
@@ -0,0 +1,83 @@
+package v2action
+
+import (
+	"encoding/json"
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// EnvironmentVariableGroup is the set of environment variables in an
+// environment variable group. Values are JSON.
+type EnvironmentVariableGroup ccv2.EnvironmentVariableGroup
+
+// EnvironmentVariable is a single variable in an environment variable group.
+// The value is JSON.
+type EnvironmentVariable struct {
+	Name  string
+	Value json.RawMessage
+}
+
+func (group EnvironmentVariableGroup) sortedNames() []string {
+	var names []string
+	for name := range group {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetEnvironmentVariableGroup returns the environment variables in the named
+// group.
+func (actor Actor) GetEnvironmentVariableGroup(groupName constant.EnvironmentVariableGroupName) (EnvironmentVariableGroup, Warnings, error) {
+	group, warnings, err := actor.CloudControllerClient.GetConfigEnvironmentVariableGroup(groupName)
+	return EnvironmentVariableGroup(group), Warnings(warnings), err
+}
+
+// GetEnvironmentVariables returns the environment variables in the named
+// group sorted by name.
+func (actor Actor) GetEnvironmentVariables(groupName constant.EnvironmentVariableGroupName) ([]EnvironmentVariable, Warnings, error) {
+	group, warnings, err := actor.GetEnvironmentVariableGroup(groupName)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	var variables []EnvironmentVariable
+	for _, name := range group.sortedNames() {
+		variables = append(variables, EnvironmentVariable{Name: name, Value: group[name]})
+	}
+	return variables, warnings, nil
+}
+
+// SetEnvironmentVariableGroup replaces the environment variables in the named
+// group.
+func (actor Actor) SetEnvironmentVariableGroup(groupName constant.EnvironmentVariableGroupName, group EnvironmentVariableGroup) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.UpdateConfigEnvironmentVariableGroup(groupName, ccv2.EnvironmentVariableGroup(group))
+	return Warnings(warnings), err
+}
+
+// MergeEnvironmentVariableGroup sets the provided environment variables in
+// the named group, keeping the variables already in the group that are not
+// provided.
+func (actor Actor) MergeEnvironmentVariableGroup(groupName constant.EnvironmentVariableGroupName, group EnvironmentVariableGroup) (Warnings, error) {
+	var allWarnings Warnings
+
+	currentGroup, warnings, err := actor.GetEnvironmentVariableGroup(groupName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	mergedGroup := EnvironmentVariableGroup{}
+	for name, value := range currentGroup {
+		mergedGroup[name] = value
+	}
+	for name, value := range group {
+		mergedGroup[name] = value
+	}
+
+	warnings, err = actor.SetEnvironmentVariableGroup(groupName, mergedGroup)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
@@ -0,0 +1,138 @@
+package v2action_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Environment Variable Group Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetEnvironmentVariableGroup", func() {
+		It("returns the group and warnings", func() {
+			fakeCloudControllerClient.GetConfigEnvironmentVariableGroupReturns(ccv2.EnvironmentVariableGroup{"LANG": json.RawMessage(`"en_US.UTF-8"`)}, ccv2.Warnings{"get-warning"}, nil)
+
+			group, warnings, err := actor.GetEnvironmentVariableGroup(constant.RunningEnvironmentVariableGroup)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-warning"))
+			Expect(group).To(Equal(EnvironmentVariableGroup{"LANG": json.RawMessage(`"en_US.UTF-8"`)}))
+			Expect(fakeCloudControllerClient.GetConfigEnvironmentVariableGroupArgsForCall(0)).To(Equal(constant.RunningEnvironmentVariableGroup))
+		})
+	})
+
+	Describe("GetEnvironmentVariables", func() {
+		Context("when getting the group succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetConfigEnvironmentVariableGroupReturns(
+					ccv2.EnvironmentVariableGroup{
+						"LANG":  json.RawMessage(`"en_US.UTF-8"`),
+						"DEBUG": json.RawMessage("false"),
+					},
+					ccv2.Warnings{"get-warning"},
+					nil,
+				)
+			})
+
+			It("returns the variables sorted by name and warnings", func() {
+				variables, warnings, err := actor.GetEnvironmentVariables(constant.StagingEnvironmentVariableGroup)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(variables).To(Equal([]EnvironmentVariable{
+					{Name: "DEBUG", Value: json.RawMessage("false")},
+					{Name: "LANG", Value: json.RawMessage(`"en_US.UTF-8"`)},
+				}))
+				Expect(fakeCloudControllerClient.GetConfigEnvironmentVariableGroupArgsForCall(0)).To(Equal(constant.StagingEnvironmentVariableGroup))
+			})
+		})
+
+		Context("when getting the group fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-error")
+				fakeCloudControllerClient.GetConfigEnvironmentVariableGroupReturns(nil, ccv2.Warnings{"get-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetEnvironmentVariables(constant.RunningEnvironmentVariableGroup)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+	})
+
+	Describe("SetEnvironmentVariableGroup", func() {
+		It("replaces the group", func() {
+			fakeCloudControllerClient.UpdateConfigEnvironmentVariableGroupReturns(nil, ccv2.Warnings{"set-warning"}, nil)
+
+			warnings, err := actor.SetEnvironmentVariableGroup(constant.StagingEnvironmentVariableGroup, EnvironmentVariableGroup{"LANG": json.RawMessage(`"en_US.UTF-8"`)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("set-warning"))
+
+			groupName, group := fakeCloudControllerClient.UpdateConfigEnvironmentVariableGroupArgsForCall(0)
+			Expect(groupName).To(Equal(constant.StagingEnvironmentVariableGroup))
+			Expect(group).To(Equal(ccv2.EnvironmentVariableGroup{"LANG": json.RawMessage(`"en_US.UTF-8"`)}))
+		})
+	})
+
+	Describe("MergeEnvironmentVariableGroup", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetConfigEnvironmentVariableGroupReturns(
+				ccv2.EnvironmentVariableGroup{
+					"LANG":  json.RawMessage(`"en_US.UTF-8"`),
+					"DEBUG": json.RawMessage("false"),
+					"PORT":  json.RawMessage("8080"),
+				},
+				ccv2.Warnings{"get-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateConfigEnvironmentVariableGroupReturns(nil, ccv2.Warnings{"set-warning"}, nil)
+		})
+
+		It("sets the provided variables and keeps the others unchanged", func() {
+			warnings, err := actor.MergeEnvironmentVariableGroup(constant.RunningEnvironmentVariableGroup, EnvironmentVariableGroup{
+				"DEBUG":       json.RawMessage("true"),
+				"MAX_THREADS": json.RawMessage("16"),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-warning", "set-warning"))
+
+			groupName, group := fakeCloudControllerClient.UpdateConfigEnvironmentVariableGroupArgsForCall(0)
+			Expect(groupName).To(Equal(constant.RunningEnvironmentVariableGroup))
+			Expect(group).To(Equal(ccv2.EnvironmentVariableGroup{
+				"LANG":        json.RawMessage(`"en_US.UTF-8"`),
+				"DEBUG":       json.RawMessage("true"),
+				"MAX_THREADS": json.RawMessage("16"),
+				"PORT":        json.RawMessage("8080"),
+			}))
+		})
+
+		Context("when getting the group fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetConfigEnvironmentVariableGroupReturns(nil, ccv2.Warnings{"get-warning"}, errors.New("get-error"))
+			})
+
+			It("returns the error without updating the group", func() {
+				warnings, err := actor.MergeEnvironmentVariableGroup(constant.RunningEnvironmentVariableGroup, EnvironmentVariableGroup{"DEBUG": json.RawMessage("true")})
+				Expect(err).To(MatchError("get-error"))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(fakeCloudControllerClient.UpdateConfigEnvironmentVariableGroupCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,69 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+type FeatureFlag ccv2.FeatureFlag
+
+// FeatureFlagNotFoundError is returned when a requested feature flag is not
+// found.
+type FeatureFlagNotFoundError struct {
+	Name string
+}
+
+func (e FeatureFlagNotFoundError) Error() string {
+	return fmt.Sprintf("Feature flag '%s' not found.", e.Name)
+}
+
+// GetFeatureFlags returns all the feature flags of the platform.
+func (actor Actor) GetFeatureFlags() ([]FeatureFlag, Warnings, error) {
+	ccv2FeatureFlags, warnings, err := actor.CloudControllerClient.GetConfigFeatureFlags()
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	featureFlags := make([]FeatureFlag, len(ccv2FeatureFlags))
+	for i, ccv2FeatureFlag := range ccv2FeatureFlags {
+		featureFlags[i] = FeatureFlag(ccv2FeatureFlag)
+	}
+
+	return featureFlags, Warnings(warnings), nil
+}
+
+// GetFeatureFlag returns the feature flag with the provided name.
+func (actor Actor) GetFeatureFlag(name string) (FeatureFlag, Warnings, error) {
+	featureFlags, warnings, err := actor.GetFeatureFlags()
+	if err != nil {
+		return FeatureFlag{}, warnings, err
+	}
+
+	for _, featureFlag := range featureFlags {
+		if featureFlag.Name == name {
+			return featureFlag, warnings, nil
+		}
+	}
+
+	return FeatureFlag{}, warnings, FeatureFlagNotFoundError{Name: name}
+}
+
+// EnableFeatureFlag turns on the feature flag with the provided name.
+func (actor Actor) EnableFeatureFlag(name string) (Warnings, error) {
+	return actor.updateFeatureFlag(name, true)
+}
+
+// DisableFeatureFlag turns off the feature flag with the provided name.
+func (actor Actor) DisableFeatureFlag(name string) (Warnings, error) {
+	return actor.updateFeatureFlag(name, false)
+}
+
+func (actor Actor) updateFeatureFlag(name string, enabled bool) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.UpdateConfigFeatureFlag(name, enabled)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return Warnings(warnings), FeatureFlagNotFoundError{Name: name}
+	}
+	return Warnings(warnings), err
+}
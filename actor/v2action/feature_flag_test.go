@@ -0,0 +1,111 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature Flag Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+
+		fakeCloudControllerClient.GetConfigFeatureFlagsReturns(
+			[]ccv2.FeatureFlag{
+				{Name: "user_org_creation", Enabled: false},
+				{Name: "diego_docker", Enabled: true},
+			},
+			ccv2.Warnings{"feature-flags-warning"},
+			nil,
+		)
+	})
+
+	Describe("GetFeatureFlags", func() {
+		It("returns the feature flags and warnings", func() {
+			featureFlags, warnings, err := actor.GetFeatureFlags()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("feature-flags-warning"))
+			Expect(featureFlags).To(Equal([]FeatureFlag{
+				{Name: "user_org_creation", Enabled: false},
+				{Name: "diego_docker", Enabled: true},
+			}))
+		})
+
+		Context("when getting the feature flags fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetConfigFeatureFlagsReturns(nil, ccv2.Warnings{"feature-flags-warning"}, errors.New("get-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetFeatureFlags()
+				Expect(err).To(MatchError("get-error"))
+				Expect(warnings).To(ConsistOf("feature-flags-warning"))
+			})
+		})
+	})
+
+	Describe("GetFeatureFlag", func() {
+		It("returns the named feature flag", func() {
+			featureFlag, warnings, err := actor.GetFeatureFlag("diego_docker")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("feature-flags-warning"))
+			Expect(featureFlag).To(Equal(FeatureFlag{Name: "diego_docker", Enabled: true}))
+		})
+
+		Context("when the feature flag does not exist", func() {
+			It("returns a FeatureFlagNotFoundError", func() {
+				_, warnings, err := actor.GetFeatureFlag("not_a_flag")
+				Expect(err).To(MatchError(FeatureFlagNotFoundError{Name: "not_a_flag"}))
+				Expect(warnings).To(ConsistOf("feature-flags-warning"))
+			})
+		})
+	})
+
+	Describe("EnableFeatureFlag", func() {
+		It("turns the feature flag on", func() {
+			fakeCloudControllerClient.UpdateConfigFeatureFlagReturns(ccv2.FeatureFlag{}, ccv2.Warnings{"update-warning"}, nil)
+
+			warnings, err := actor.EnableFeatureFlag("user_org_creation")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("update-warning"))
+
+			name, enabled := fakeCloudControllerClient.UpdateConfigFeatureFlagArgsForCall(0)
+			Expect(name).To(Equal("user_org_creation"))
+			Expect(enabled).To(BeTrue())
+		})
+	})
+
+	Describe("DisableFeatureFlag", func() {
+		It("turns the feature flag off", func() {
+			_, err := actor.DisableFeatureFlag("diego_docker")
+			Expect(err).ToNot(HaveOccurred())
+
+			name, enabled := fakeCloudControllerClient.UpdateConfigFeatureFlagArgsForCall(0)
+			Expect(name).To(Equal("diego_docker"))
+			Expect(enabled).To(BeFalse())
+		})
+
+		Context("when the feature flag does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateConfigFeatureFlagReturns(ccv2.FeatureFlag{}, ccv2.Warnings{"update-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns a FeatureFlagNotFoundError", func() {
+				warnings, err := actor.DisableFeatureFlag("not_a_flag")
+				Expect(err).To(MatchError(FeatureFlagNotFoundError{Name: "not_a_flag"}))
+				Expect(warnings).To(ConsistOf("update-warning"))
+			})
+		})
+	})
+})
@@ -72,6 +72,19 @@ func (actor Actor) GetOrganizationByName(orgName string) (Organization, Warnings
 	return Organization(orgs[0]), Warnings(warnings), nil
 }
 
+// CreateOrganization creates an organization with the provided name. When
+// quotaGUID is empty, the organization gets the default quota.
+func (actor Actor) CreateOrganization(orgName string, quotaGUID string) (Organization, Warnings, error) {
+	org, warnings, err := actor.CloudControllerClient.CreateOrganization(orgName, quotaGUID)
+	return Organization(org), Warnings(warnings), err
+}
+
+// UpdateOrganizationQuota assigns an organization quota to the organization.
+func (actor Actor) UpdateOrganizationQuota(orgGUID string, quotaGUID string) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.UpdateOrganizationQuota(orgGUID, quotaGUID)
+	return Warnings(warnings), err
+}
+
 // DeleteOrganization deletes the Organization associated with the provided
 // GUID. Once the deletion request is sent, it polls the deletion job until
 // it's finished.
@@ -75,4 +75,58 @@ var _ = Describe("OrganizationQuota Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationQuotaByName", func() {
+		Context("when the organization quota exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(
+					[]ccv2.OrganizationQuota{{GUID: "some-org-quota-guid", Name: "some-org-quota"}},
+					ccv2.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns the organization quota and warnings", func() {
+				orgQuota, warnings, err := actor.GetOrganizationQuotaByName("some-org-quota")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(orgQuota).To(Equal(OrganizationQuota{GUID: "some-org-quota-guid", Name: "some-org-quota"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(fakeCloudControllerClient.GetOrganizationQuotasCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetOrganizationQuotasArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.NameFilter,
+					Operator: ccv2.EqualOperator,
+					Values:   []string{"some-org-quota"},
+				}))
+			})
+		})
+
+		Context("when the organization quota does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(nil, ccv2.Warnings{"warning-1"}, nil)
+			})
+
+			It("returns an OrganizationQuotaNotFoundError with the name", func() {
+				_, warnings, err := actor.GetOrganizationQuotaByName("some-org-quota")
+				Expect(err).To(MatchError(OrganizationQuotaNotFoundError{Name: "some-org-quota"}))
+				Expect(err.Error()).To(Equal("Organization quota 'some-org-quota' not found."))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some org quota error")
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(nil, ccv2.Warnings{"warning-1", "warning-2"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetOrganizationQuotaByName("some-org-quota")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
 })
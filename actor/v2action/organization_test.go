@@ -377,4 +377,41 @@ var _ = Describe("Org Actions", func() {
 			})
 		})
 	})
+
+	Describe("CreateOrganization", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.CreateOrganizationReturns(
+				ccv2.Organization{GUID: "some-org-guid", Name: "some-org"},
+				ccv2.Warnings{"warning-1"},
+				errors.New("some-error"),
+			)
+		})
+
+		It("creates the organization and returns the result", func() {
+			org, warnings, err := actor.CreateOrganization("some-org", "some-quota-guid")
+			Expect(err).To(MatchError("some-error"))
+			Expect(org).To(Equal(Organization{GUID: "some-org-guid", Name: "some-org"}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			orgName, quotaGUID := fakeCloudControllerClient.CreateOrganizationArgsForCall(0)
+			Expect(orgName).To(Equal("some-org"))
+			Expect(quotaGUID).To(Equal("some-quota-guid"))
+		})
+	})
+
+	Describe("UpdateOrganizationQuota", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.UpdateOrganizationQuotaReturns(ccv2.Organization{}, ccv2.Warnings{"warning-1"}, errors.New("some-error"))
+		})
+
+		It("assigns the quota and returns the warnings and error", func() {
+			warnings, err := actor.UpdateOrganizationQuota("some-org-guid", "some-quota-guid")
+			Expect(err).To(MatchError("some-error"))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			orgGUID, quotaGUID := fakeCloudControllerClient.UpdateOrganizationQuotaArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(quotaGUID).To(Equal("some-quota-guid"))
+		})
+	})
 })
@@ -0,0 +1,222 @@
+package v2action
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/platformconfig"
+)
+
+// EnvironmentVariableChangeType is the kind of change made to a variable in
+// an environment variable group.
+type EnvironmentVariableChangeType string
+
+const (
+	AddEnvironmentVariable    EnvironmentVariableChangeType = "add"
+	UpdateEnvironmentVariable EnvironmentVariableChangeType = "update"
+	RemoveEnvironmentVariable EnvironmentVariableChangeType = "remove"
+)
+
+// EnvironmentVariableChange is a change to a single variable in an
+// environment variable group. The values are JSON.
+type EnvironmentVariableChange struct {
+	Type     EnvironmentVariableChangeType
+	Name     string
+	OldValue json.RawMessage
+	NewValue json.RawMessage
+}
+
+// FeatureFlagChange turns a single feature flag on or off.
+type FeatureFlagChange struct {
+	Name    string
+	Enabled bool
+}
+
+// PlatformConfigPlan is the list of changes needed to converge the feature
+// flags and environment variable groups to a platform config.
+type PlatformConfigPlan struct {
+	FeatureFlags                []FeatureFlagChange
+	RunningEnvironmentVariables []EnvironmentVariableChange
+	StagingEnvironmentVariables []EnvironmentVariableChange
+}
+
+// HasChanges returns true when the plan changes anything.
+func (plan PlatformConfigPlan) HasChanges() bool {
+	return len(plan.FeatureFlags) > 0 ||
+		len(plan.RunningEnvironmentVariables) > 0 ||
+		len(plan.StagingEnvironmentVariables) > 0
+}
+
+// ReadPlatformConfig reads the platform config at the provided path.
+func (Actor) ReadPlatformConfig(pathToConfig string) (platformconfig.Platform, error) {
+	return platformconfig.ReadPlatformConfig(pathToConfig)
+}
+
+// GetPlatformConfigPlan compares the platform config with the current feature
+// flags and environment variable groups and returns the changes needed to
+// converge them. When merge is true, variables in a group that the config does
+// not mention are kept; otherwise they are removed. It fails if the config
+// names a feature flag that does not exist.
+func (actor Actor) GetPlatformConfigPlan(config platformconfig.Platform, merge bool) (PlatformConfigPlan, Warnings, error) {
+	var (
+		plan        PlatformConfigPlan
+		allWarnings Warnings
+	)
+
+	if config.FeatureFlags != nil {
+		featureFlags, warnings, err := actor.GetFeatureFlags()
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return PlatformConfigPlan{}, allWarnings, err
+		}
+
+		current := map[string]bool{}
+		for _, featureFlag := range featureFlags {
+			current[featureFlag.Name] = featureFlag.Enabled
+		}
+
+		var names []string
+		for name := range config.FeatureFlags {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			enabled, exists := current[name]
+			if !exists {
+				return PlatformConfigPlan{}, allWarnings, FeatureFlagNotFoundError{Name: name}
+			}
+			if enabled != config.FeatureFlags[name] {
+				plan.FeatureFlags = append(plan.FeatureFlags, FeatureFlagChange{Name: name, Enabled: config.FeatureFlags[name]})
+			}
+		}
+	}
+
+	changes, warnings, err := actor.planEnvironmentVariableGroup(constant.RunningEnvironmentVariableGroup, config.RunningEnvironmentVariables, merge)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return PlatformConfigPlan{}, allWarnings, err
+	}
+	plan.RunningEnvironmentVariables = changes
+
+	changes, warnings, err = actor.planEnvironmentVariableGroup(constant.StagingEnvironmentVariableGroup, config.StagingEnvironmentVariables, merge)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return PlatformConfigPlan{}, allWarnings, err
+	}
+	plan.StagingEnvironmentVariables = changes
+
+	return plan, allWarnings, nil
+}
+
+// ApplyPlatformConfigPlan makes the changes in the plan. Each environment
+// variable group is read again and updated with a single request.
+func (actor Actor) ApplyPlatformConfigPlan(plan PlatformConfigPlan) (Warnings, error) {
+	var allWarnings Warnings
+
+	for _, change := range plan.FeatureFlags {
+		warnings, err := actor.updateFeatureFlag(change.Name, change.Enabled)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	groups := []struct {
+		name    constant.EnvironmentVariableGroupName
+		changes []EnvironmentVariableChange
+	}{
+		{constant.RunningEnvironmentVariableGroup, plan.RunningEnvironmentVariables},
+		{constant.StagingEnvironmentVariableGroup, plan.StagingEnvironmentVariables},
+	}
+	for _, group := range groups {
+		if len(group.changes) == 0 {
+			continue
+		}
+
+		currentGroup, warnings, err := actor.GetEnvironmentVariableGroup(group.name)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		newGroup := EnvironmentVariableGroup{}
+		for name, value := range currentGroup {
+			newGroup[name] = value
+		}
+		for _, change := range group.changes {
+			if change.Type == RemoveEnvironmentVariable {
+				delete(newGroup, change.Name)
+			} else {
+				newGroup[change.Name] = change.NewValue
+			}
+		}
+
+		warnings, err = actor.SetEnvironmentVariableGroup(group.name, newGroup)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+// planEnvironmentVariableGroup returns the changes that turn the named group
+// into the desired variables. Values are compared by their JSON, so a
+// variable is only updated when its value or type changes. A nil desired
+// group leaves the group alone.
+func (actor Actor) planEnvironmentVariableGroup(groupName constant.EnvironmentVariableGroupName, desired map[string]interface{}, merge bool) ([]EnvironmentVariableChange, Warnings, error) {
+	if desired == nil {
+		return nil, nil, nil
+	}
+
+	current, warnings, err := actor.GetEnvironmentVariableGroup(groupName)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	var names []string
+	for name := range desired {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var changes []EnvironmentVariableChange
+	for _, name := range names {
+		newValue, err := json.Marshal(desired[name])
+		if err != nil {
+			return nil, warnings, err
+		}
+
+		oldValue, exists := current[name]
+		switch {
+		case !exists:
+			changes = append(changes, EnvironmentVariableChange{Type: AddEnvironmentVariable, Name: name, NewValue: newValue})
+		case !equalJSON(oldValue, newValue):
+			changes = append(changes, EnvironmentVariableChange{Type: UpdateEnvironmentVariable, Name: name, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+
+	if !merge {
+		for _, name := range current.sortedNames() {
+			if _, exists := desired[name]; !exists {
+				changes = append(changes, EnvironmentVariableChange{Type: RemoveEnvironmentVariable, Name: name, OldValue: current[name]})
+			}
+		}
+	}
+
+	return changes, warnings, nil
+}
+
+// equalJSON returns true when both JSON documents decode to the same value.
+func equalJSON(a json.RawMessage, b json.RawMessage) bool {
+	var aValue, bValue interface{}
+	if json.Unmarshal(a, &aValue) != nil || json.Unmarshal(b, &bValue) != nil {
+		return bytes.Equal(a, b)
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
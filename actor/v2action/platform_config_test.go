@@ -0,0 +1,189 @@
+package v2action_test
+
+import (
+	"encoding/json"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/platformconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Platform Config Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+
+		fakeCloudControllerClient.GetConfigFeatureFlagsReturns(
+			[]ccv2.FeatureFlag{
+				{Name: "user_org_creation", Enabled: false},
+				{Name: "diego_docker", Enabled: true},
+			},
+			ccv2.Warnings{"feature-flags-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetConfigEnvironmentVariableGroupStub = func(groupName constant.EnvironmentVariableGroupName) (ccv2.EnvironmentVariableGroup, ccv2.Warnings, error) {
+			if groupName == constant.RunningEnvironmentVariableGroup {
+				return ccv2.EnvironmentVariableGroup{
+					"LANG":  json.RawMessage(`"en_US.UTF-8"`),
+					"DEBUG": json.RawMessage("false"),
+					"OLD":   json.RawMessage(`"value"`),
+					"PORT":  json.RawMessage("8080"),
+				}, ccv2.Warnings{"running-warning"}, nil
+			}
+			return ccv2.EnvironmentVariableGroup{}, ccv2.Warnings{"staging-warning"}, nil
+		}
+	})
+
+	Describe("GetPlatformConfigPlan", func() {
+		var (
+			config platformconfig.Platform
+			merge  bool
+			plan   PlatformConfigPlan
+			warns  Warnings
+			err    error
+		)
+
+		BeforeEach(func() {
+			merge = false
+			config = platformconfig.Platform{
+				FeatureFlags: map[string]bool{
+					"user_org_creation": true,
+					"diego_docker":      true,
+				},
+				RunningEnvironmentVariables: map[string]interface{}{
+					"LANG":        "en_US.UTF-8",
+					"DEBUG":       true,
+					"MAX_THREADS": 16,
+					"PORT":        8080,
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			plan, warns, err = actor.GetPlatformConfigPlan(config, merge)
+		})
+
+		It("returns the changes to the feature flags and the groups in the config", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warns).To(ConsistOf("feature-flags-warning", "running-warning"))
+			Expect(plan).To(Equal(PlatformConfigPlan{
+				FeatureFlags: []FeatureFlagChange{{Name: "user_org_creation", Enabled: true}},
+				RunningEnvironmentVariables: []EnvironmentVariableChange{
+					{Type: UpdateEnvironmentVariable, Name: "DEBUG", OldValue: json.RawMessage("false"), NewValue: json.RawMessage("true")},
+					{Type: AddEnvironmentVariable, Name: "MAX_THREADS", NewValue: json.RawMessage("16")},
+					{Type: RemoveEnvironmentVariable, Name: "OLD", OldValue: json.RawMessage(`"value"`)},
+				},
+			}))
+			Expect(plan.HasChanges()).To(BeTrue())
+
+			Expect(fakeCloudControllerClient.GetConfigEnvironmentVariableGroupCallCount()).To(Equal(1))
+		})
+
+		Context("when merging", func() {
+			BeforeEach(func() {
+				merge = true
+			})
+
+			It("keeps the variables the config does not mention", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plan.RunningEnvironmentVariables).To(Equal([]EnvironmentVariableChange{
+					{Type: UpdateEnvironmentVariable, Name: "DEBUG", OldValue: json.RawMessage("false"), NewValue: json.RawMessage("true")},
+					{Type: AddEnvironmentVariable, Name: "MAX_THREADS", NewValue: json.RawMessage("16")},
+				}))
+			})
+		})
+
+		Context("when the config changes the type of a variable", func() {
+			BeforeEach(func() {
+				config = platformconfig.Platform{RunningEnvironmentVariables: map[string]interface{}{"PORT": "8080"}}
+				merge = true
+			})
+
+			It("updates the variable", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plan.RunningEnvironmentVariables).To(Equal([]EnvironmentVariableChange{
+					{Type: UpdateEnvironmentVariable, Name: "PORT", OldValue: json.RawMessage("8080"), NewValue: json.RawMessage(`"8080"`)},
+				}))
+			})
+		})
+
+		Context("when the config empties a group", func() {
+			BeforeEach(func() {
+				config = platformconfig.Platform{StagingEnvironmentVariables: map[string]interface{}{}}
+				fakeCloudControllerClient.GetConfigEnvironmentVariableGroupReturns(ccv2.EnvironmentVariableGroup{"BP_DEBUG": json.RawMessage(`"1"`)}, nil, nil)
+				fakeCloudControllerClient.GetConfigEnvironmentVariableGroupStub = nil
+			})
+
+			It("removes every variable in the group", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plan.StagingEnvironmentVariables).To(Equal([]EnvironmentVariableChange{
+					{Type: RemoveEnvironmentVariable, Name: "BP_DEBUG", OldValue: json.RawMessage(`"1"`)},
+				}))
+				Expect(fakeCloudControllerClient.GetConfigFeatureFlagsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the platform already matches the config", func() {
+			BeforeEach(func() {
+				config = platformconfig.Platform{FeatureFlags: map[string]bool{"diego_docker": true}}
+			})
+
+			It("returns a plan without changes", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plan.HasChanges()).To(BeFalse())
+			})
+		})
+
+		Context("when the config names a feature flag that does not exist", func() {
+			BeforeEach(func() {
+				config = platformconfig.Platform{FeatureFlags: map[string]bool{"not_a_flag": true}}
+			})
+
+			It("returns a FeatureFlagNotFoundError", func() {
+				Expect(err).To(MatchError(FeatureFlagNotFoundError{Name: "not_a_flag"}))
+				Expect(warns).To(ConsistOf("feature-flags-warning"))
+			})
+		})
+	})
+
+	Describe("ApplyPlatformConfigPlan", func() {
+		It("updates the feature flags and each changed group once, leaving the other variables unchanged", func() {
+			fakeCloudControllerClient.UpdateConfigFeatureFlagReturns(ccv2.FeatureFlag{}, ccv2.Warnings{"flag-warning"}, nil)
+			fakeCloudControllerClient.UpdateConfigEnvironmentVariableGroupReturns(nil, ccv2.Warnings{"set-warning"}, nil)
+
+			warnings, err := actor.ApplyPlatformConfigPlan(PlatformConfigPlan{
+				FeatureFlags: []FeatureFlagChange{{Name: "user_org_creation", Enabled: true}},
+				RunningEnvironmentVariables: []EnvironmentVariableChange{
+					{Type: UpdateEnvironmentVariable, Name: "DEBUG", OldValue: json.RawMessage("false"), NewValue: json.RawMessage("true")},
+					{Type: AddEnvironmentVariable, Name: "MAX_THREADS", NewValue: json.RawMessage("16")},
+					{Type: RemoveEnvironmentVariable, Name: "OLD", OldValue: json.RawMessage(`"value"`)},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("flag-warning", "running-warning", "set-warning"))
+
+			name, enabled := fakeCloudControllerClient.UpdateConfigFeatureFlagArgsForCall(0)
+			Expect(name).To(Equal("user_org_creation"))
+			Expect(enabled).To(BeTrue())
+
+			Expect(fakeCloudControllerClient.UpdateConfigEnvironmentVariableGroupCallCount()).To(Equal(1))
+			groupName, group := fakeCloudControllerClient.UpdateConfigEnvironmentVariableGroupArgsForCall(0)
+			Expect(groupName).To(Equal(constant.RunningEnvironmentVariableGroup))
+			Expect(group).To(Equal(ccv2.EnvironmentVariableGroup{
+				"LANG":        json.RawMessage(`"en_US.UTF-8"`),
+				"DEBUG":       json.RawMessage("true"),
+				"MAX_THREADS": json.RawMessage("16"),
+				"PORT":        json.RawMessage("8080"),
+			}))
+		})
+	})
+})
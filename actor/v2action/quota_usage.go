@@ -0,0 +1,168 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// QuotaUsage is the amount of each quota-limited resource in use. Only
+// started applications count towards memory and app instances, and only
+// managed service instances count towards service instances, matching how
+// the Cloud Controller enforces quotas.
+type QuotaUsage struct {
+	MemoryInMB int
+
+	// LargestInstanceMemoryInMB is the memory of the largest app instance,
+	// which is what the instance memory limit applies to.
+	LargestInstanceMemoryInMB int
+	AppInstances              int
+	Routes                    int
+	ServiceInstances          int
+	ReservedRoutePorts        int
+}
+
+// SpaceQuotaUsage is the quota usage of a space together with its space
+// quota, if it has one.
+type SpaceQuotaUsage struct {
+	SpaceName string
+	Usage     QuotaUsage
+
+	// Quota is empty when no space quota is assigned to the space.
+	Quota SpaceQuota
+}
+
+// OrganizationQuotaUsage is the quota usage of an organization, the sum of
+// the usage of its spaces, together with its quota.
+type OrganizationQuotaUsage struct {
+	OrgName string
+	Usage   QuotaUsage
+	Quota   OrganizationQuota
+	Spaces  []SpaceQuotaUsage
+}
+
+func (usage *QuotaUsage) add(other QuotaUsage) {
+	usage.MemoryInMB += other.MemoryInMB
+	if other.LargestInstanceMemoryInMB > usage.LargestInstanceMemoryInMB {
+		usage.LargestInstanceMemoryInMB = other.LargestInstanceMemoryInMB
+	}
+	usage.AppInstances += other.AppInstances
+	usage.Routes += other.Routes
+	usage.ServiceInstances += other.ServiceInstances
+	usage.ReservedRoutePorts += other.ReservedRoutePorts
+}
+
+// GetOrganizationQuotaUsage returns how much of the organization's quota, and
+// of each space's quota, is in use.
+func (actor Actor) GetOrganizationQuotaUsage(orgName string) (OrganizationQuotaUsage, Warnings, error) {
+	var allWarnings Warnings
+
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationQuotaUsage{}, allWarnings, err
+	}
+
+	orgQuota, warnings, err := actor.GetOrganizationQuota(org.QuotaDefinitionGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationQuotaUsage{}, allWarnings, err
+	}
+
+	spaces, warnings, err := actor.GetOrganizationSpaces(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationQuotaUsage{}, allWarnings, err
+	}
+
+	orgUsage := OrganizationQuotaUsage{
+		OrgName: org.Name,
+		Quota:   orgQuota,
+	}
+	spaceQuotas := map[string]SpaceQuota{}
+
+	for _, space := range spaces {
+		spaceUsage := SpaceQuotaUsage{SpaceName: space.Name}
+
+		if space.SpaceQuotaDefinitionGUID != "" {
+			spaceQuota, found := spaceQuotas[space.SpaceQuotaDefinitionGUID]
+			if !found {
+				spaceQuota, warnings, err = actor.GetSpaceQuota(space.SpaceQuotaDefinitionGUID)
+				allWarnings = append(allWarnings, warnings...)
+				if err != nil {
+					return OrganizationQuotaUsage{}, allWarnings, err
+				}
+				spaceQuotas[space.SpaceQuotaDefinitionGUID] = spaceQuota
+			}
+			spaceUsage.Quota = spaceQuota
+		}
+
+		spaceUsage.Usage, warnings, err = actor.getSpaceQuotaUsage(space.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return OrganizationQuotaUsage{}, allWarnings, err
+		}
+
+		orgUsage.Usage.add(spaceUsage.Usage)
+		orgUsage.Spaces = append(orgUsage.Spaces, spaceUsage)
+	}
+
+	sort.Slice(orgUsage.Spaces, func(i int, j int) bool {
+		return orgUsage.Spaces[i].SpaceName < orgUsage.Spaces[j].SpaceName
+	})
+
+	return orgUsage, allWarnings, nil
+}
+
+func (actor Actor) getSpaceQuotaUsage(spaceGUID string) (QuotaUsage, Warnings, error) {
+	var (
+		allWarnings Warnings
+		usage       QuotaUsage
+	)
+
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return QuotaUsage{}, allWarnings, err
+	}
+
+	for _, app := range apps {
+		if app.State != ccv2.ApplicationStarted {
+			continue
+		}
+		usage.MemoryInMB += int(app.Memory) * app.Instances.Value
+		usage.AppInstances += app.Instances.Value
+		if int(app.Memory) > usage.LargestInstanceMemoryInMB {
+			usage.LargestInstanceMemoryInMB = int(app.Memory)
+		}
+	}
+
+	// Routes are counted straight from the Cloud Controller, as their domains
+	// are not needed.
+	routes, routeWarnings, err := actor.CloudControllerClient.GetSpaceRoutes(spaceGUID)
+	allWarnings = append(allWarnings, routeWarnings...)
+	if err != nil {
+		return QuotaUsage{}, allWarnings, err
+	}
+
+	usage.Routes = len(routes)
+	for _, route := range routes {
+		if route.Port.IsSet {
+			usage.ReservedRoutePorts++
+		}
+	}
+
+	serviceInstances, warnings, err := actor.GetServiceInstancesBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return QuotaUsage{}, allWarnings, err
+	}
+
+	for _, serviceInstance := range serviceInstances {
+		if !ccv2.ServiceInstance(serviceInstance).UserProvided() {
+			usage.ServiceInstances++
+		}
+	}
+
+	return usage, allWarnings, nil
+}
@@ -0,0 +1,163 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Quota Usage Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetOrganizationQuotaUsage", func() {
+		var (
+			usage      OrganizationQuotaUsage
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			usage, warnings, executeErr = actor.GetOrganizationQuotaUsage("some-org")
+		})
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv2.Organization{{GUID: "some-org-guid", Name: "some-org", QuotaDefinitionGUID: "some-org-quota-guid"}},
+				ccv2.Warnings{"org-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetOrganizationQuotaReturns(
+				ccv2.OrganizationQuota{GUID: "some-org-quota-guid", Name: "default", MemoryLimitInMB: 10240},
+				ccv2.Warnings{"org-quota-warning"},
+				nil,
+			)
+		})
+
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv2.Space{
+						{GUID: "prod-guid", Name: "prod", SpaceQuotaDefinitionGUID: "small-guid"},
+						{GUID: "dev-guid", Name: "dev", SpaceQuotaDefinitionGUID: "small-guid"},
+						{GUID: "sandbox-guid", Name: "sandbox"},
+					},
+					ccv2.Warnings{"spaces-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpaceQuotaReturns(
+					ccv2.SpaceQuota{GUID: "small-guid", Name: "small", MemoryLimitInMB: 2048},
+					ccv2.Warnings{"space-quota-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationsStub = func(queries ...ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
+					if queries[0].Values[0] != "dev-guid" {
+						return nil, nil, nil
+					}
+					return []ccv2.Application{
+						{Memory: 256, Instances: types.NullInt{Value: 2, IsSet: true}, State: ccv2.ApplicationStarted},
+						{Memory: 1024, Instances: types.NullInt{Value: 1, IsSet: true}, State: ccv2.ApplicationStarted},
+						{Memory: 4096, Instances: types.NullInt{Value: 3, IsSet: true}, State: ccv2.ApplicationStopped},
+					}, ccv2.Warnings{"apps-warning"}, nil
+				}
+				fakeCloudControllerClient.GetSpaceRoutesStub = func(spaceGUID string, _ ...ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
+					if spaceGUID != "dev-guid" {
+						return nil, nil, nil
+					}
+					return []ccv2.Route{
+						{GUID: "http-route-guid"},
+						{GUID: "tcp-route-guid", Port: types.NullInt{Value: 1024, IsSet: true}},
+					}, ccv2.Warnings{"routes-warning"}, nil
+				}
+				fakeCloudControllerClient.GetSpaceServiceInstancesStub = func(spaceGUID string, _ bool, _ ...ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error) {
+					if spaceGUID != "prod-guid" {
+						return nil, nil, nil
+					}
+					return []ccv2.ServiceInstance{
+						{Type: ccv2.ManagedService},
+						{Type: ccv2.UserProvidedService},
+					}, ccv2.Warnings{"service-instances-warning"}, nil
+				}
+			})
+
+			It("returns the usage of the org and its spaces", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("org-warning", "org-quota-warning", "spaces-warning", "space-quota-warning", "apps-warning", "routes-warning", "service-instances-warning"))
+
+				Expect(usage).To(Equal(OrganizationQuotaUsage{
+					OrgName: "some-org",
+					Quota:   OrganizationQuota{GUID: "some-org-quota-guid", Name: "default", MemoryLimitInMB: 10240},
+					Usage: QuotaUsage{
+						MemoryInMB:                1536,
+						LargestInstanceMemoryInMB: 1024,
+						AppInstances:              3,
+						Routes:                    2,
+						ServiceInstances:          1,
+						ReservedRoutePorts:        1,
+					},
+					Spaces: []SpaceQuotaUsage{
+						{
+							SpaceName: "dev",
+							Quota:     SpaceQuota{GUID: "small-guid", Name: "small", MemoryLimitInMB: 2048},
+							Usage: QuotaUsage{
+								MemoryInMB:                1536,
+								LargestInstanceMemoryInMB: 1024,
+								AppInstances:              3,
+								Routes:                    2,
+								ReservedRoutePorts:        1,
+							},
+						},
+						{
+							SpaceName: "prod",
+							Quota:     SpaceQuota{GUID: "small-guid", Name: "small", MemoryLimitInMB: 2048},
+							Usage:     QuotaUsage{ServiceInstances: 1},
+						},
+						{SpaceName: "sandbox"},
+					},
+				}))
+
+				Expect(fakeCloudControllerClient.GetOrganizationQuotaArgsForCall(0)).To(Equal("some-org-quota-guid"))
+				Expect(fakeCloudControllerClient.GetSpaceQuotaCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetSpaceQuotaArgsForCall(0)).To(Equal("small-guid"))
+			})
+		})
+
+		Context("when getting a space's routes fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("routes error")
+				fakeCloudControllerClient.GetSpacesReturns([]ccv2.Space{{GUID: "dev-guid", Name: "dev"}}, nil, nil)
+				fakeCloudControllerClient.GetSpaceRoutesReturns(nil, ccv2.Warnings{"routes-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("org-warning", "org-quota-warning", "routes-warning"))
+			})
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"org-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("org-warning"))
+			})
+		})
+	})
+})
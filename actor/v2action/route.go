@@ -3,6 +3,7 @@ package v2action
 import (
 	"fmt"
 	"path"
+	"strconv"
 	"strings"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -35,9 +36,13 @@ type RouteNotFoundError struct {
 	Host       string
 	DomainGUID string
 	Path       string
+	Port       int
 }
 
 func (e RouteNotFoundError) Error() string {
+	if e.Port != 0 {
+		return fmt.Sprintf("Route with domain guid %s and port %d not found", e.DomainGUID, e.Port)
+	}
 	if e.Path != "" {
 		return fmt.Sprintf("Route with host %s, domain guid %s, and path %s not found", e.Host, e.DomainGUID, e.Path)
 	}
@@ -134,7 +139,13 @@ func (actor Actor) CreateRouteWithExistenceCheck(orgGUID string, spaceName strin
 		} else if len(domains) == 0 {
 			return Route{}, warnings, DomainNotFoundError{Name: route.Domain.Name}
 		}
-		route.Domain.GUID = domains[0].GUID
+		route.Domain = domains[0]
+	}
+
+	validateWarnings, validateErr := actor.ValidateTCPRoutePort(route)
+	warnings = append(warnings, validateWarnings...)
+	if validateErr != nil {
+		return Route{}, Warnings(warnings), validateErr
 	}
 
 	foundRoute, spaceRouteWarnings, findErr := actor.FindRouteBoundToSpaceWithSettings(route)
@@ -238,12 +249,12 @@ func (actor Actor) CheckRoute(route Route) (bool, Warnings, error) {
 // exists anywhere in the system. When the route exists in another space,
 // RouteInDifferentSpaceError is returned.
 func (actor Actor) FindRouteBoundToSpaceWithSettings(route Route) (Route, Warnings, error) {
-	// TODO: Implement TCP Route lookup. Be sure to handle case of RandomTCPPort.
-	if route.Domain.IsTCP() {
+	// A route with a random TCP port cannot exist until it is created.
+	if route.RandomTCPPort() {
 		return Route{}, nil, RouteNotFoundError{DomainGUID: route.Domain.GUID}
 	}
 
-	existingRoute, warnings, err := actor.GetRouteByHostAndDomainAndPath(route.Host, route.Domain.GUID, route.Path)
+	existingRoute, warnings, err := actor.getRouteBySettings(route)
 	if routeNotFoundErr, ok := err.(RouteNotFoundError); ok {
 		// This check only works for API versions 2.55 or higher. It will return
 		// false for anything below that.
@@ -281,6 +292,63 @@ func (actor Actor) FindRouteBoundToSpaceWithSettings(route Route) (Route, Warnin
 	return existingRoute, Warnings(warnings), err
 }
 
+// GetRouteByComponents returns the route in the organization that matches
+// the provided route's domain name, host, path and port. The domain is looked
+// up by name when the route's domain does not have a GUID.
+func (actor Actor) GetRouteByComponents(orgGUID string, route Route) (Route, Warnings, error) {
+	var allWarnings Warnings
+
+	if route.Domain.GUID == "" {
+		domains, warnings, err := actor.GetDomainsByNameAndOrganization([]string{route.Domain.Name}, orgGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Route{}, allWarnings, err
+		} else if len(domains) == 0 {
+			return Route{}, allWarnings, DomainNotFoundError{Name: route.Domain.Name}
+		}
+		route.Domain = domains[0]
+	}
+
+	if route.Domain.IsTCP() && !route.Port.IsSet {
+		return Route{}, allWarnings, RouteNotFoundError{DomainGUID: route.Domain.GUID}
+	}
+
+	foundRoute, warnings, err := actor.getRouteBySettings(route)
+	allWarnings = append(allWarnings, warnings...)
+	return foundRoute, allWarnings, err
+}
+
+// GetRouteByDomainAndPort returns the TCP route with the matching port and
+// the associated domain GUID.
+func (actor Actor) GetRouteByDomainAndPort(domainGUID string, port int) (Route, Warnings, error) {
+	ccv2Routes, warnings, err := actor.CloudControllerClient.GetRoutes(
+		ccv2.Query{
+			Filter:   ccv2.DomainGUIDFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{domainGUID},
+		},
+		ccv2.Query{
+			Filter:   ccv2.PortFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{strconv.Itoa(port)},
+		},
+	)
+	if err != nil {
+		return Route{}, Warnings(warnings), err
+	}
+
+	if len(ccv2Routes) == 0 {
+		return Route{}, Warnings(warnings), RouteNotFoundError{DomainGUID: domainGUID, Port: port}
+	}
+
+	routes, domainWarnings, err := actor.applyDomain(ccv2Routes)
+	if err != nil {
+		return Route{}, append(Warnings(warnings), domainWarnings...), err
+	}
+
+	return routes[0], append(Warnings(warnings), domainWarnings...), err
+}
+
 // GetRouteByHostAndDomain returns the HTTP route with the matching host and
 // the associate domain GUID.
 func (actor Actor) GetRouteByHostAndDomainAndPath(host string, domainGUID string, path string) (Route, Warnings, error) {
@@ -322,6 +390,13 @@ func (actor Actor) GetRouteByHostAndDomainAndPath(host string, domainGUID string
 	return routes[0], append(Warnings(warnings), domainWarnings...), err
 }
 
+func (actor Actor) getRouteBySettings(route Route) (Route, Warnings, error) {
+	if route.Domain.IsTCP() {
+		return actor.GetRouteByDomainAndPort(route.Domain.GUID, route.Port.Value)
+	}
+	return actor.GetRouteByHostAndDomainAndPath(route.Host, route.Domain.GUID, route.Path)
+}
+
 func ActorToCCRoute(route Route) ccv2.Route {
 	return ccv2.Route{
 		DomainGUID: route.Domain.GUID,
@@ -1315,13 +1315,59 @@ var _ = Describe("Route Actions", func() {
 		Context("when the route uses a TCP domain", func() {
 			BeforeEach(func() {
 				route.Domain.RouterGroupType = constant.TCPRouterGroup
+				route.Host = ""
+				route.Path = ""
 			})
 
-			// TODO: Should return only when RandomTCPPort is true, fill in more
-			// tests once TCP routing feature has been added.
-			It("returns RouteNotFoundError", func() {
-				Expect(executeErr).To(MatchError(RouteNotFoundError{DomainGUID: route.Domain.GUID}))
-				Expect(warnings).To(BeEmpty())
+			Context("when the route has a random port", func() {
+				It("returns RouteNotFoundError without querying for the route", func() {
+					Expect(executeErr).To(MatchError(RouteNotFoundError{DomainGUID: route.Domain.GUID}))
+					Expect(warnings).To(BeEmpty())
+					Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the route has a port", func() {
+				BeforeEach(func() {
+					route.Port = types.NullInt{IsSet: true, Value: 1234}
+				})
+
+				Context("when the route exists in the current space", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+							{
+								GUID:       "some-route-guid",
+								SpaceGUID:  "some-space-guid",
+								Port:       types.NullInt{IsSet: true, Value: 1234},
+								DomainGUID: "some-domain-guid",
+							},
+						}, ccv2.Warnings{"get route warning"}, nil)
+					})
+
+					It("queries for the route by domain and port", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(returnedRoute.GUID).To(Equal("some-route-guid"))
+						Expect(warnings).To(ConsistOf("get route warning", "get domain warning"))
+
+						Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(1))
+						Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(Equal([]ccv2.Query{
+							{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Values: []string{"some-domain-guid"}},
+							{Filter: ccv2.PortFilter, Operator: ccv2.EqualOperator, Values: []string{"1234"}},
+						}))
+					})
+				})
+
+				Context("when the route does not exist", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetRoutesReturns(nil, ccv2.Warnings{"get route warning"}, nil)
+						fakeCloudControllerClient.CheckRouteReturns(false, ccv2.Warnings{"check route warning"}, nil)
+					})
+
+					It("returns RouteNotFoundError with the port", func() {
+						Expect(executeErr).To(MatchError(RouteNotFoundError{DomainGUID: "some-domain-guid", Port: 1234}))
+						Expect(warnings).To(ConsistOf("get route warning", "check route warning"))
+					})
+				})
 			})
 		})
 
@@ -1382,4 +1428,175 @@ var _ = Describe("Route Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetRouteByDomainAndPort", func() {
+		var (
+			route      Route
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			route, warnings, executeErr = actor.GetRouteByDomainAndPort("some-domain-guid", 1234)
+		})
+
+		Context("when finding the route is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+					{
+						GUID:       "route-guid-1",
+						SpaceGUID:  "some-space-guid",
+						Port:       types.NullInt{IsSet: true, Value: 1234},
+						DomainGUID: "some-domain-guid",
+					},
+				}, ccv2.Warnings{"get-routes-warning"}, nil)
+				fakeCloudControllerClient.GetSharedDomainReturns(
+					ccv2.Domain{
+						GUID:            "some-domain-guid",
+						Name:            "tcp.domain.com",
+						RouterGroupType: constant.TCPRouterGroup,
+					}, ccv2.Warnings{"get-domain-warning"}, nil)
+			})
+
+			It("returns the route and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-routes-warning", "get-domain-warning"))
+				Expect(route).To(Equal(Route{
+					Domain: Domain{
+						GUID:            "some-domain-guid",
+						Name:            "tcp.domain.com",
+						RouterGroupType: constant.TCPRouterGroup,
+					},
+					GUID:      "route-guid-1",
+					Port:      types.NullInt{IsSet: true, Value: 1234},
+					SpaceGUID: "some-space-guid",
+				}))
+
+				Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(Equal([]ccv2.Query{
+					{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Values: []string{"some-domain-guid"}},
+					{Filter: ccv2.PortFilter, Operator: ccv2.EqualOperator, Values: []string{"1234"}},
+				}))
+			})
+		})
+
+		Context("when there are no routes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv2.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("returns a RouteNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(RouteNotFoundError{DomainGUID: "some-domain-guid", Port: 1234}))
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+			})
+		})
+
+		Context("when getting the routes returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-routes-error")
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv2.Warnings{"get-routes-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+			})
+		})
+	})
+
+	Describe("GetRouteByComponents", func() {
+		var (
+			route         Route
+			returnedRoute Route
+			warnings      Warnings
+			executeErr    error
+		)
+
+		BeforeEach(func() {
+			route = Route{
+				Domain: Domain{Name: "some-domain.com"},
+				Host:   "some-host",
+			}
+		})
+
+		JustBeforeEach(func() {
+			returnedRoute, warnings, executeErr = actor.GetRouteByComponents("some-org-guid", route)
+		})
+
+		Context("when the domain does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSharedDomainsReturns(nil, ccv2.Warnings{"get-shared-domains-warning"}, nil)
+				fakeCloudControllerClient.GetOrganizationPrivateDomainsReturns(nil, ccv2.Warnings{"get-private-domains-warning"}, nil)
+			})
+
+			It("returns a DomainNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(DomainNotFoundError{Name: "some-domain.com"}))
+				Expect(warnings).To(ConsistOf("get-shared-domains-warning", "get-private-domains-warning"))
+			})
+		})
+
+		Context("when the domain is an HTTP domain", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSharedDomainsReturns([]ccv2.Domain{
+					{GUID: "some-domain-guid", Name: "some-domain.com"},
+				}, ccv2.Warnings{"get-shared-domains-warning"}, nil)
+				fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+					{GUID: "some-route-guid", Host: "some-host", DomainGUID: "some-domain-guid"},
+				}, ccv2.Warnings{"get-routes-warning"}, nil)
+				fakeCloudControllerClient.GetSharedDomainReturns(ccv2.Domain{
+					GUID: "some-domain-guid",
+					Name: "some-domain.com",
+				}, ccv2.Warnings{"get-domain-warning"}, nil)
+			})
+
+			It("looks up the route by host and domain", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(returnedRoute.GUID).To(Equal("some-route-guid"))
+				Expect(warnings).To(ContainElement("get-routes-warning"))
+
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(Equal([]ccv2.Query{
+					{Filter: ccv2.HostFilter, Operator: ccv2.EqualOperator, Values: []string{"some-host"}},
+					{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Values: []string{"some-domain-guid"}},
+				}))
+			})
+		})
+
+		Context("when the domain is a TCP domain", func() {
+			BeforeEach(func() {
+				route.Domain.GUID = "some-tcp-domain-guid"
+				route.Domain.RouterGroupType = constant.TCPRouterGroup
+				route.Host = ""
+			})
+
+			Context("when the port is not set", func() {
+				It("returns a RouteNotFoundError without querying for routes", func() {
+					Expect(executeErr).To(MatchError(RouteNotFoundError{DomainGUID: "some-tcp-domain-guid"}))
+					Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the port is set", func() {
+				BeforeEach(func() {
+					route.Port = types.NullInt{IsSet: true, Value: 1234}
+					fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+						{GUID: "some-route-guid", DomainGUID: "some-tcp-domain-guid", Port: types.NullInt{IsSet: true, Value: 1234}},
+					}, ccv2.Warnings{"get-routes-warning"}, nil)
+				})
+
+				It("looks up the route by domain and port", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(returnedRoute.GUID).To(Equal("some-route-guid"))
+					Expect(warnings).To(ContainElement("get-routes-warning"))
+
+					Expect(fakeCloudControllerClient.GetSharedDomainsCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(Equal([]ccv2.Query{
+						{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Values: []string{"some-tcp-domain-guid"}},
+						{Filter: ccv2.PortFilter, Operator: ccv2.EqualOperator, Values: []string{"1234"}},
+					}))
+				})
+			})
+		})
+	})
 })
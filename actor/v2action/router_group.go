@@ -0,0 +1,140 @@
+package v2action
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// RouterGroup represents a Routing API Router Group.
+type RouterGroup ccv2.RouterGroup
+
+// PortRange is an inclusive range of ports.
+type PortRange struct {
+	Start int
+	End   int
+}
+
+// ReservablePortRanges parses the router group's reservable ports, a comma
+// separated list of ports and port ranges (ex. "1024-1033,1050").
+func (routerGroup RouterGroup) ReservablePortRanges() ([]PortRange, error) {
+	var ranges []PortRange
+	for _, rawRange := range strings.Split(routerGroup.ReservablePorts, ",") {
+		rawRange = strings.TrimSpace(rawRange)
+		if rawRange == "" {
+			continue
+		}
+
+		bounds := strings.SplitN(rawRange, "-", 2)
+		start, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid reservable port range %q", rawRange)
+		}
+
+		end := start
+		if len(bounds) == 2 {
+			end, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+			if err != nil || end < start {
+				return nil, fmt.Errorf("invalid reservable port range %q", rawRange)
+			}
+		}
+
+		ranges = append(ranges, PortRange{Start: start, End: end})
+	}
+	return ranges, nil
+}
+
+// IsPortReservable returns true when the port falls within one of the router
+// group's reservable port ranges.
+func (routerGroup RouterGroup) IsPortReservable(port int) (bool, error) {
+	ranges, err := routerGroup.ReservablePortRanges()
+	if err != nil {
+		return false, err
+	}
+
+	for _, portRange := range ranges {
+		if port >= portRange.Start && port <= portRange.End {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetRouterGroups returns all the router groups known to the Routing API.
+func (actor Actor) GetRouterGroups() ([]RouterGroup, Warnings, error) {
+	if actor.CloudControllerClient.RoutingEndpoint() == "" {
+		return nil, nil, actionerror.RoutingEndpointNotSetError{}
+	}
+
+	ccv2RouterGroups, warnings, err := actor.CloudControllerClient.GetRouterGroups()
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var routerGroups []RouterGroup
+	for _, routerGroup := range ccv2RouterGroups {
+		routerGroups = append(routerGroups, RouterGroup(routerGroup))
+	}
+	return routerGroups, Warnings(warnings), nil
+}
+
+// GetRouterGroupByName returns the router group with the provided name.
+func (actor Actor) GetRouterGroupByName(name string) (RouterGroup, Warnings, error) {
+	routerGroups, warnings, err := actor.GetRouterGroups()
+	if err != nil {
+		return RouterGroup{}, warnings, err
+	}
+
+	for _, routerGroup := range routerGroups {
+		if routerGroup.Name == name {
+			return routerGroup, warnings, nil
+		}
+	}
+	return RouterGroup{}, warnings, actionerror.RouterGroupNotFoundError{Name: name}
+}
+
+// GetRouterGroupByGUID returns the router group with the provided GUID.
+func (actor Actor) GetRouterGroupByGUID(guid string) (RouterGroup, Warnings, error) {
+	routerGroups, warnings, err := actor.GetRouterGroups()
+	if err != nil {
+		return RouterGroup{}, warnings, err
+	}
+
+	for _, routerGroup := range routerGroups {
+		if routerGroup.GUID == guid {
+			return routerGroup, warnings, nil
+		}
+	}
+	return RouterGroup{}, warnings, actionerror.RouterGroupNotFoundError{GUID: guid}
+}
+
+// ValidateTCPRoutePort checks that the route's port is within the reservable
+// ports of the route domain's router group. Routes without a port, or on
+// non-TCP domains, are always valid.
+func (actor Actor) ValidateTCPRoutePort(route Route) (Warnings, error) {
+	if !route.Domain.IsTCP() || !route.Port.IsSet {
+		return nil, nil
+	}
+
+	routerGroup, warnings, err := actor.GetRouterGroupByGUID(route.Domain.RouterGroupGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	reservable, err := routerGroup.IsPortReservable(route.Port.Value)
+	if err != nil {
+		return warnings, err
+	}
+
+	if !reservable {
+		return warnings, actionerror.PortNotReservableError{
+			Port:            route.Port.Value,
+			RouterGroup:     routerGroup.Name,
+			ReservablePorts: routerGroup.ReservablePorts,
+		}
+	}
+	return warnings, nil
+}
@@ -0,0 +1,216 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Router Group Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("RouterGroup", func() {
+		Describe("ReservablePortRanges", func() {
+			DescribeTable("parses the reservable ports",
+				func(reservablePorts string, expectedRanges []PortRange) {
+					ranges, err := RouterGroup{ReservablePorts: reservablePorts}.ReservablePortRanges()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(ranges).To(Equal(expectedRanges))
+				},
+
+				Entry("empty", "", nil),
+				Entry("single port", "1050", []PortRange{{Start: 1050, End: 1050}}),
+				Entry("single range", "1024-1033", []PortRange{{Start: 1024, End: 1033}}),
+				Entry("ranges and ports", "1024-1033, 1050", []PortRange{{Start: 1024, End: 1033}, {Start: 1050, End: 1050}}),
+			)
+
+			DescribeTable("returns an error for invalid ranges",
+				func(reservablePorts string) {
+					_, err := RouterGroup{ReservablePorts: reservablePorts}.ReservablePortRanges()
+					Expect(err).To(HaveOccurred())
+				},
+
+				Entry("not a number", "abc"),
+				Entry("invalid end", "1024-abc"),
+				Entry("end before start", "1033-1024"),
+			)
+		})
+
+		Describe("IsPortReservable", func() {
+			DescribeTable("checks the port against the reservable ports",
+				func(port int, expected bool) {
+					reservable, err := RouterGroup{ReservablePorts: "1024-1033,1050"}.IsPortReservable(port)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reservable).To(Equal(expected))
+				},
+
+				Entry("start of range", 1024, true),
+				Entry("end of range", 1033, true),
+				Entry("single port", 1050, true),
+				Entry("below range", 1023, false),
+				Entry("between ranges", 1040, false),
+			)
+		})
+	})
+
+	Describe("GetRouterGroups", func() {
+		Context("when the routing endpoint is not set", func() {
+			It("returns a RoutingEndpointNotSetError", func() {
+				_, _, err := actor.GetRouterGroups()
+				Expect(err).To(MatchError(actionerror.RoutingEndpointNotSetError{}))
+				Expect(fakeCloudControllerClient.GetRouterGroupsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the routing endpoint is set", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.RoutingEndpointReturns("https://api.some-domain.com/routing")
+			})
+
+			Context("when getting the router groups succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetRouterGroupsReturns([]ccv2.RouterGroup{
+						{GUID: "some-guid", Name: "default-tcp", Type: constant.TCPRouterGroup, ReservablePorts: "1024-1033"},
+					}, ccv2.Warnings{"some-warning"}, nil)
+				})
+
+				It("returns the router groups and warnings", func() {
+					routerGroups, warnings, err := actor.GetRouterGroups()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("some-warning"))
+					Expect(routerGroups).To(ConsistOf(
+						RouterGroup{GUID: "some-guid", Name: "default-tcp", Type: constant.TCPRouterGroup, ReservablePorts: "1024-1033"},
+					))
+				})
+			})
+
+			Context("when getting the router groups fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("some-error")
+					fakeCloudControllerClient.GetRouterGroupsReturns(nil, ccv2.Warnings{"some-warning"}, expectedErr)
+				})
+
+				It("returns the error and warnings", func() {
+					_, warnings, err := actor.GetRouterGroups()
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("some-warning"))
+				})
+			})
+		})
+	})
+
+	Describe("GetRouterGroupByName", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.RoutingEndpointReturns("https://api.some-domain.com/routing")
+			fakeCloudControllerClient.GetRouterGroupsReturns([]ccv2.RouterGroup{
+				{GUID: "some-guid", Name: "default-tcp"},
+			}, ccv2.Warnings{"some-warning"}, nil)
+		})
+
+		Context("when the router group exists", func() {
+			It("returns the router group", func() {
+				routerGroup, warnings, err := actor.GetRouterGroupByName("default-tcp")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(routerGroup.GUID).To(Equal("some-guid"))
+			})
+		})
+
+		Context("when the router group does not exist", func() {
+			It("returns a RouterGroupNotFoundError", func() {
+				_, warnings, err := actor.GetRouterGroupByName("other")
+				Expect(err).To(MatchError(actionerror.RouterGroupNotFoundError{Name: "other"}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	Describe("ValidateTCPRoutePort", func() {
+		var (
+			route    Route
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			route = Route{
+				Domain: Domain{
+					GUID:            "some-domain-guid",
+					RouterGroupGUID: "some-router-group-guid",
+					RouterGroupType: constant.TCPRouterGroup,
+				},
+				Port: types.NullInt{IsSet: true, Value: 1024},
+			}
+			fakeCloudControllerClient.RoutingEndpointReturns("https://api.some-domain.com/routing")
+			fakeCloudControllerClient.GetRouterGroupsReturns([]ccv2.RouterGroup{
+				{GUID: "some-router-group-guid", Name: "default-tcp", ReservablePorts: "1024-1033"},
+			}, ccv2.Warnings{"some-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, err = actor.ValidateTCPRoutePort(route)
+		})
+
+		Context("when the route is not a TCP route", func() {
+			BeforeEach(func() {
+				route.Domain.RouterGroupType = constant.HTTPRouterGroup
+			})
+
+			It("does not check the router group", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetRouterGroupsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the port is not set", func() {
+			BeforeEach(func() {
+				route.Port = types.NullInt{}
+			})
+
+			It("does not check the router group", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetRouterGroupsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the port is reservable", func() {
+			It("returns no error and the warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		Context("when the port is not reservable", func() {
+			BeforeEach(func() {
+				route.Port = types.NullInt{IsSet: true, Value: 2000}
+			})
+
+			It("returns a PortNotReservableError", func() {
+				Expect(err).To(MatchError(actionerror.PortNotReservableError{
+					Port:            2000,
+					RouterGroup:     "default-tcp",
+					ReservablePorts: "1024-1033",
+				}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+})
@@ -21,9 +21,10 @@ func (e ServiceBindingNotFoundError) Error() string {
 	return fmt.Sprintf("Service binding for application GUID '%s', and service instance GUID '%s' not found.", e.AppGUID, e.ServiceInstanceGUID)
 }
 
-// BindServiceByApplicationAndServiceInstance binds the service instance to an application.
-func (actor Actor) BindServiceByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (Warnings, error) {
-	_, warnings, err := actor.CloudControllerClient.CreateServiceBinding(appGUID, serviceInstanceGUID, nil)
+// BindServiceByApplicationAndServiceInstance binds the service instance to an
+// application, passing the binding parameters to the service broker.
+func (actor Actor) BindServiceByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string, parameters map[string]interface{}) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.CreateServiceBinding(appGUID, serviceInstanceGUID, parameters)
 
 	return Warnings(warnings), err
 }
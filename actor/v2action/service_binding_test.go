@@ -26,6 +26,7 @@ var _ = Describe("Service Binding Actions", func() {
 		var (
 			applicationGUID     string
 			serviceInstanceGUID string
+			parameters          map[string]interface{}
 
 			executeErr error
 			warnings   Warnings
@@ -33,10 +34,11 @@ var _ = Describe("Service Binding Actions", func() {
 		BeforeEach(func() {
 			applicationGUID = "some-app-guid"
 			serviceInstanceGUID = "some-service-instance-guid"
+			parameters = map[string]interface{}{"some-key": "some-value"}
 		})
 
 		JustBeforeEach(func() {
-			warnings, executeErr = actor.BindServiceByApplicationAndServiceInstance(applicationGUID, serviceInstanceGUID)
+			warnings, executeErr = actor.BindServiceByApplicationAndServiceInstance(applicationGUID, serviceInstanceGUID, parameters)
 		})
 
 		Context("when the binding is successful", func() {
@@ -52,7 +54,7 @@ var _ = Describe("Service Binding Actions", func() {
 				inputAppGUID, inputServiceInstanceGUID, inputParameters := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
 				Expect(inputAppGUID).To(Equal(applicationGUID))
 				Expect(inputServiceInstanceGUID).To(Equal(serviceInstanceGUID))
-				Expect(inputParameters).To(BeNil())
+				Expect(inputParameters).To(Equal(parameters))
 			})
 		})
 
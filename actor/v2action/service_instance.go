@@ -2,7 +2,9 @@ package v2action
 
 import (
 	"fmt"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 )
@@ -22,6 +24,81 @@ func (e ServiceInstanceNotFoundError) Error() string {
 	return fmt.Sprintf("Service instance '%s' not found.", e.Name)
 }
 
+// CreateServiceInstance creates a managed service instance of the named
+// service offering and plan in the space, and waits for the service broker to
+// finish provisioning it. The Cloud Controller fails asynchronous operations
+// that run past the broker's maximum poll duration, so no separate timeout is
+// applied here.
+func (actor Actor) CreateServiceInstance(spaceGUID string, serviceName string, servicePlanName string, serviceInstanceName string, parameters map[string]interface{}, tags []string) (ServiceInstance, Warnings, error) {
+	var allWarnings Warnings
+
+	services, warnings, err := actor.CloudControllerClient.GetServices(ccv2.Query{
+		Filter:   ccv2.LabelFilter,
+		Operator: ccv2.EqualOperator,
+		Values:   []string{serviceName},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceInstance{}, allWarnings, err
+	}
+	if len(services) == 0 {
+		return ServiceInstance{}, allWarnings, actionerror.ServiceNotFoundError{Name: serviceName}
+	}
+
+	plans, warnings, err := actor.CloudControllerClient.GetServicePlans(ccv2.Query{
+		Filter:   ccv2.ServiceGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Values:   []string{services[0].GUID},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceInstance{}, allWarnings, err
+	}
+
+	var servicePlanGUID string
+	for _, plan := range plans {
+		if plan.Name == servicePlanName {
+			servicePlanGUID = plan.GUID
+			break
+		}
+	}
+	if servicePlanGUID == "" {
+		return ServiceInstance{}, allWarnings, actionerror.ServicePlanNotFoundError{PlanName: servicePlanName, ServiceName: serviceName}
+	}
+
+	instance, warnings, err := actor.CloudControllerClient.CreateServiceInstance(spaceGUID, servicePlanGUID, serviceInstanceName, parameters, tags)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceInstance{}, allWarnings, err
+	}
+
+	timeout := time.Now().Add(actor.Config.OverallPollingTimeout())
+	for instance.LastOperation.State == ccv2.LastOperationInProgress {
+		if !time.Now().Before(timeout) {
+			return ServiceInstance{}, allWarnings, actionerror.ServiceInstanceProvisioningTimeoutError{
+				Name:    serviceInstanceName,
+				Timeout: actor.Config.OverallPollingTimeout(),
+			}
+		}
+
+		time.Sleep(actor.Config.PollingInterval())
+		instance, warnings, err = actor.CloudControllerClient.GetServiceInstance(instance.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ServiceInstance{}, allWarnings, err
+		}
+	}
+
+	if instance.LastOperation.State == ccv2.LastOperationFailed {
+		return ServiceInstance{}, allWarnings, actionerror.ServiceInstanceProvisioningFailedError{
+			Name:   serviceInstanceName,
+			Reason: instance.LastOperation.Description,
+		}
+	}
+
+	return ServiceInstance(instance), allWarnings, nil
+}
+
 func (actor Actor) GetServiceInstance(guid string) (ServiceInstance, Warnings, error) {
 	instance, warnings, err := actor.CloudControllerClient.GetServiceInstance(guid)
 	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
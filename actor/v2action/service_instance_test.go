@@ -2,7 +2,9 @@ package v2action_test
 
 import (
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -23,6 +25,193 @@ var _ = Describe("Service Instance Actions", func() {
 		actor = NewActor(fakeCloudControllerClient, nil, nil)
 	})
 
+	Describe("CreateServiceInstance", func() {
+		var (
+			fakeConfig *v2actionfakes.FakeConfig
+
+			serviceInstance ServiceInstance
+			warnings        Warnings
+			executeErr      error
+		)
+
+		BeforeEach(func() {
+			fakeConfig = new(v2actionfakes.FakeConfig)
+			fakeConfig.PollingIntervalReturns(0)
+			fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+			actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
+
+			fakeCloudControllerClient.GetServicesReturns(
+				[]ccv2.Service{{GUID: "some-service-guid", Label: "some-service"}},
+				ccv2.Warnings{"get-services-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServicePlansReturns(
+				[]ccv2.ServicePlan{
+					{GUID: "other-plan-guid", Name: "other-plan"},
+					{GUID: "some-plan-guid", Name: "some-plan"},
+				},
+				ccv2.Warnings{"get-plans-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.CreateServiceInstanceReturns(
+				ccv2.ServiceInstance{
+					GUID:          "some-service-instance-guid",
+					Name:          "some-service-instance",
+					LastOperation: ccv2.LastOperation{State: ccv2.LastOperationSucceeded},
+				},
+				ccv2.Warnings{"create-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			serviceInstance, warnings, executeErr = actor.CreateServiceInstance(
+				"some-space-guid",
+				"some-service",
+				"some-plan",
+				"some-service-instance",
+				map[string]interface{}{"size": "large"},
+				[]string{"tag-1"},
+			)
+		})
+
+		Context("when the service broker provisions synchronously", func() {
+			It("creates the service instance with the plan and returns it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-services-warning", "get-plans-warning", "create-warning"))
+				Expect(serviceInstance.GUID).To(Equal("some-service-instance-guid"))
+
+				Expect(fakeCloudControllerClient.GetServicesArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.LabelFilter,
+					Operator: ccv2.EqualOperator,
+					Values:   []string{"some-service"},
+				}))
+				Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.ServiceGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Values:   []string{"some-service-guid"},
+				}))
+
+				spaceGUID, planGUID, name, parameters, tags := fakeCloudControllerClient.CreateServiceInstanceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(planGUID).To(Equal("some-plan-guid"))
+				Expect(name).To(Equal("some-service-instance"))
+				Expect(parameters).To(Equal(map[string]interface{}{"size": "large"}))
+				Expect(tags).To(Equal([]string{"tag-1"}))
+
+				Expect(fakeCloudControllerClient.GetServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the service broker provisions asynchronously", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateServiceInstanceReturns(
+					ccv2.ServiceInstance{
+						GUID:          "some-service-instance-guid",
+						LastOperation: ccv2.LastOperation{State: ccv2.LastOperationInProgress},
+					},
+					ccv2.Warnings{"create-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetServiceInstanceReturnsOnCall(0,
+					ccv2.ServiceInstance{
+						GUID:          "some-service-instance-guid",
+						LastOperation: ccv2.LastOperation{State: ccv2.LastOperationInProgress},
+					},
+					ccv2.Warnings{"poll-warning-1"},
+					nil,
+				)
+				fakeCloudControllerClient.GetServiceInstanceReturnsOnCall(1,
+					ccv2.ServiceInstance{
+						GUID:          "some-service-instance-guid",
+						LastOperation: ccv2.LastOperation{State: ccv2.LastOperationSucceeded},
+					},
+					ccv2.Warnings{"poll-warning-2"},
+					nil,
+				)
+			})
+
+			It("polls until provisioning finishes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-services-warning", "get-plans-warning", "create-warning", "poll-warning-1", "poll-warning-2"))
+				Expect(fakeCloudControllerClient.GetServiceInstanceCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetServiceInstanceArgsForCall(0)).To(Equal("some-service-instance-guid"))
+				Expect(fakeConfig.PollingIntervalCallCount()).To(Equal(2))
+			})
+
+			Context("when provisioning does not finish before the polling timeout", func() {
+				BeforeEach(func() {
+					fakeConfig.OverallPollingTimeoutReturns(0)
+				})
+
+				It("returns a ServiceInstanceProvisioningTimeoutError without polling", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServiceInstanceProvisioningTimeoutError{
+						Name: "some-service-instance",
+					}))
+					Expect(warnings).To(ConsistOf("get-services-warning", "get-plans-warning", "create-warning"))
+					Expect(fakeCloudControllerClient.GetServiceInstanceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when provisioning fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceReturnsOnCall(1,
+						ccv2.ServiceInstance{
+							GUID:          "some-service-instance-guid",
+							LastOperation: ccv2.LastOperation{State: ccv2.LastOperationFailed, Description: "out of capacity"},
+						},
+						nil,
+						nil,
+					)
+				})
+
+				It("returns a ServiceInstanceProvisioningFailedError", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServiceInstanceProvisioningFailedError{
+						Name:   "some-service-instance",
+						Reason: "out of capacity",
+					}))
+				})
+			})
+		})
+
+		Context("when the service offering does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicesReturns(nil, ccv2.Warnings{"get-services-warning"}, nil)
+			})
+
+			It("returns a ServiceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceNotFoundError{Name: "some-service"}))
+				Expect(warnings).To(ConsistOf("get-services-warning"))
+				Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the plan does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns([]ccv2.ServicePlan{{GUID: "other-plan-guid", Name: "other-plan"}}, nil, nil)
+			})
+
+			It("returns a ServicePlanNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"}))
+				Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating the service instance fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create error")
+				fakeCloudControllerClient.CreateServiceInstanceReturns(ccv2.ServiceInstance{}, ccv2.Warnings{"create-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-services-warning", "get-plans-warning", "create-warning"))
+			})
+		})
+	})
+
 	Describe("GetServiceInstance", func() {
 		var (
 			serviceInstanceGUID string
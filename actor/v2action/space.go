@@ -66,6 +66,12 @@ func (actor Actor) DeleteSpaceByNameAndOrganizationName(spaceName string, orgNam
 	return allWarnings, err
 }
 
+// CreateSpace creates a space with the provided name in the organization.
+func (actor Actor) CreateSpace(spaceName string, orgGUID string) (Space, Warnings, error) {
+	space, warnings, err := actor.CloudControllerClient.CreateSpace(spaceName, orgGUID)
+	return Space(space), Warnings(warnings), err
+}
+
 // GetOrganizationSpaces returns a list of spaces in the specified org
 func (actor Actor) GetOrganizationSpaces(orgGUID string) ([]Space, Warnings, error) {
 	ccv2Spaces, warnings, err := actor.CloudControllerClient.GetSpaces(ccv2.Query{
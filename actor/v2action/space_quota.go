@@ -11,9 +11,13 @@ type SpaceQuota ccv2.SpaceQuota
 
 type SpaceQuotaNotFoundError struct {
 	GUID string
+	Name string
 }
 
 func (e SpaceQuotaNotFoundError) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("Space quota '%s' not found.", e.Name)
+	}
 	return fmt.Sprintf("Space quota with GUID '%s' not found.", e.GUID)
 }
 
@@ -26,3 +30,37 @@ func (actor Actor) GetSpaceQuota(guid string) (SpaceQuota, Warnings, error) {
 
 	return SpaceQuota(spaceQuota), Warnings(warnings), err
 }
+
+// GetOrganizationSpaceQuotas returns the space quotas defined in an
+// organization.
+func (actor Actor) GetOrganizationSpaceQuotas(orgGUID string) ([]SpaceQuota, Warnings, error) {
+	ccv2SpaceQuotas, warnings, err := actor.CloudControllerClient.GetOrganizationSpaceQuotas(orgGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var spaceQuotas []SpaceQuota
+	for _, spaceQuota := range ccv2SpaceQuotas {
+		spaceQuotas = append(spaceQuotas, SpaceQuota(spaceQuota))
+	}
+
+	return spaceQuotas, Warnings(warnings), nil
+}
+
+// CreateSpaceQuota creates a space quota in the space quota's organization.
+func (actor Actor) CreateSpaceQuota(spaceQuota SpaceQuota) (SpaceQuota, Warnings, error) {
+	createdSpaceQuota, warnings, err := actor.CloudControllerClient.CreateSpaceQuota(ccv2.SpaceQuota(spaceQuota))
+	return SpaceQuota(createdSpaceQuota), Warnings(warnings), err
+}
+
+// UpdateSpaceQuota updates the limits of an existing space quota.
+func (actor Actor) UpdateSpaceQuota(spaceQuota SpaceQuota) (SpaceQuota, Warnings, error) {
+	updatedSpaceQuota, warnings, err := actor.CloudControllerClient.UpdateSpaceQuota(ccv2.SpaceQuota(spaceQuota))
+	return SpaceQuota(updatedSpaceQuota), Warnings(warnings), err
+}
+
+// SetSpaceQuota assigns a space quota to a space.
+func (actor Actor) SetSpaceQuota(spaceGUID string, spaceQuotaGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.SetSpaceQuota(spaceGUID, spaceQuotaGUID)
+	return Warnings(warnings), err
+}
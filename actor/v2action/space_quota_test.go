@@ -76,4 +76,91 @@ var _ = Describe("SpaceQuota Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationSpaceQuotas", func() {
+		Context("when the cloud controller returns the space quotas", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationSpaceQuotasReturns(
+					[]ccv2.SpaceQuota{{GUID: "some-space-quota-guid", Name: "some-space-quota", MemoryLimitInMB: 1024}},
+					ccv2.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns the space quotas and warnings", func() {
+				spaceQuotas, warnings, err := actor.GetOrganizationSpaceQuotas("some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(spaceQuotas).To(Equal([]SpaceQuota{{GUID: "some-space-quota-guid", Name: "some-space-quota", MemoryLimitInMB: 1024}}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(fakeCloudControllerClient.GetOrganizationSpaceQuotasArgsForCall(0)).To(Equal("some-org-guid"))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some space quota error")
+				fakeCloudControllerClient.GetOrganizationSpaceQuotasReturns(nil, ccv2.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetOrganizationSpaceQuotas("some-org-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("CreateSpaceQuota", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.CreateSpaceQuotaReturns(
+				ccv2.SpaceQuota{GUID: "some-space-quota-guid", Name: "some-space-quota"},
+				ccv2.Warnings{"warning-1"},
+				errors.New("some-error"),
+			)
+		})
+
+		It("passes the space quota through and returns the result", func() {
+			spaceQuota, warnings, err := actor.CreateSpaceQuota(SpaceQuota{Name: "some-space-quota", OrganizationGUID: "some-org-guid"})
+			Expect(err).To(MatchError("some-error"))
+			Expect(spaceQuota.GUID).To(Equal("some-space-quota-guid"))
+			Expect(warnings).To(ConsistOf("warning-1"))
+			Expect(fakeCloudControllerClient.CreateSpaceQuotaArgsForCall(0)).To(Equal(ccv2.SpaceQuota{Name: "some-space-quota", OrganizationGUID: "some-org-guid"}))
+		})
+	})
+
+	Describe("UpdateSpaceQuota", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.UpdateSpaceQuotaReturns(
+				ccv2.SpaceQuota{GUID: "some-space-quota-guid", TotalRoutes: 5},
+				ccv2.Warnings{"warning-1"},
+				errors.New("some-error"),
+			)
+		})
+
+		It("passes the space quota through and returns the result", func() {
+			spaceQuota, warnings, err := actor.UpdateSpaceQuota(SpaceQuota{GUID: "some-space-quota-guid", TotalRoutes: 5})
+			Expect(err).To(MatchError("some-error"))
+			Expect(spaceQuota.TotalRoutes).To(Equal(5))
+			Expect(warnings).To(ConsistOf("warning-1"))
+			Expect(fakeCloudControllerClient.UpdateSpaceQuotaArgsForCall(0)).To(Equal(ccv2.SpaceQuota{GUID: "some-space-quota-guid", TotalRoutes: 5}))
+		})
+	})
+
+	Describe("SetSpaceQuota", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.SetSpaceQuotaReturns(ccv2.Warnings{"warning-1"}, errors.New("some-error"))
+		})
+
+		It("sets the space quota and returns the warnings and error", func() {
+			warnings, err := actor.SetSpaceQuota("some-space-guid", "some-space-quota-guid")
+			Expect(err).To(MatchError("some-error"))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			spaceGUID, spaceQuotaGUID := fakeCloudControllerClient.SetSpaceQuotaArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(spaceQuotaGUID).To(Equal("some-space-quota-guid"))
+		})
+	})
 })
@@ -379,5 +379,26 @@ var _ = Describe("Space", func() {
 				})
 			})
 		})
+
+		Describe("CreateSpace", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateSpaceReturns(
+					ccv2.Space{GUID: "some-space-guid", Name: "some-space"},
+					ccv2.Warnings{"warning-1"},
+					errors.New("some-error"),
+				)
+			})
+
+			It("creates the space and returns the result", func() {
+				space, warnings, err := actor.CreateSpace("some-space", "some-org-guid")
+				Expect(err).To(MatchError("some-error"))
+				Expect(space).To(Equal(Space{GUID: "some-space-guid", Name: "some-space"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				spaceName, orgGUID := fakeCloudControllerClient.CreateSpaceArgsForCall(0)
+				Expect(spaceName).To(Equal("some-space"))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+			})
+		})
 	})
 })
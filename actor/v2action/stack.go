@@ -2,6 +2,7 @@ package v2action
 
 import (
 	"fmt"
+	"sort"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
@@ -9,6 +10,13 @@ import (
 
 type Stack ccv2.Stack
 
+// StackApplication is an application running on a stack and the name of the
+// space it is in.
+type StackApplication struct {
+	Application
+	SpaceName string
+}
+
 // StackNotFoundError is returned when a requested stack is not found.
 type StackNotFoundError struct {
 	GUID string
@@ -51,3 +59,69 @@ func (actor Actor) GetStackByName(stackName string) (Stack, Warnings, error) {
 
 	return Stack(stacks[0]), Warnings(warnings), nil
 }
+
+// GetStackApplications returns the applications on the named stack in the
+// space, or in every space of the org when spaceGUID is empty. They are
+// sorted by space name and then by application name.
+func (actor Actor) GetStackApplications(stackName string, orgGUID string, spaceGUID string) ([]StackApplication, Warnings, error) {
+	var allWarnings Warnings
+
+	stack, warnings, err := actor.GetStackByName(stackName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	spaces, warnings, err := actor.GetOrganizationSpaces(orgGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	spaceNames := map[string]string{}
+	for _, space := range spaces {
+		spaceNames[space.GUID] = space.Name
+	}
+
+	queries := []ccv2.Query{{
+		Filter:   ccv2.StackGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Values:   []string{stack.GUID},
+	}}
+	if spaceGUID == "" {
+		queries = append(queries, ccv2.Query{
+			Filter:   ccv2.OrganizationGUIDFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{orgGUID},
+		})
+	} else {
+		queries = append(queries, ccv2.Query{
+			Filter:   ccv2.SpaceGUIDFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{spaceGUID},
+		})
+	}
+
+	ccv2Apps, ccWarnings, err := actor.CloudControllerClient.GetApplications(queries...)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	apps := make([]StackApplication, len(ccv2Apps))
+	for i, ccv2App := range ccv2Apps {
+		apps[i] = StackApplication{
+			Application: Application(ccv2App),
+			SpaceName:   spaceNames[ccv2App.SpaceGUID],
+		}
+	}
+
+	sort.Slice(apps, func(i int, j int) bool {
+		if apps[i].SpaceName != apps[j].SpaceName {
+			return apps[i].SpaceName < apps[j].SpaceName
+		}
+		return apps[i].Name < apps[j].Name
+	})
+
+	return apps, allWarnings, nil
+}
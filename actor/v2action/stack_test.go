@@ -166,4 +166,73 @@ var _ = Describe("Stack Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetStackApplications", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetStacksReturns(
+				[]ccv2.Stack{{GUID: "stack-guid", Name: "cflinuxfs2"}},
+				ccv2.Warnings{"stacks-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{
+					{GUID: "space-1-guid", Name: "space-1"},
+					{GUID: "space-2-guid", Name: "space-2"},
+				},
+				ccv2.Warnings{"spaces-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{
+					{Name: "app-b", SpaceGUID: "space-2-guid"},
+					{Name: "app-c", SpaceGUID: "space-1-guid"},
+					{Name: "app-a", SpaceGUID: "space-2-guid"},
+				},
+				ccv2.Warnings{"apps-warning"},
+				nil,
+			)
+		})
+
+		Context("when no space is given", func() {
+			It("returns the stack's apps in the org sorted by space and name", func() {
+				apps, warnings, err := actor.GetStackApplications("cflinuxfs2", "org-guid", "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("stacks-warning", "spaces-warning", "apps-warning"))
+				Expect(apps).To(Equal([]StackApplication{
+					{Application: Application{Name: "app-c", SpaceGUID: "space-1-guid"}, SpaceName: "space-1"},
+					{Application: Application{Name: "app-a", SpaceGUID: "space-2-guid"}, SpaceName: "space-2"},
+					{Application: Application{Name: "app-b", SpaceGUID: "space-2-guid"}, SpaceName: "space-2"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal([]ccv2.Query{
+					{Filter: ccv2.StackGUIDFilter, Operator: ccv2.EqualOperator, Values: []string{"stack-guid"}},
+					{Filter: ccv2.OrganizationGUIDFilter, Operator: ccv2.EqualOperator, Values: []string{"org-guid"}},
+				}))
+			})
+		})
+
+		Context("when a space is given", func() {
+			It("filters the apps by space", func() {
+				_, _, err := actor.GetStackApplications("cflinuxfs2", "org-guid", "space-1-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal([]ccv2.Query{
+					{Filter: ccv2.StackGUIDFilter, Operator: ccv2.EqualOperator, Values: []string{"stack-guid"}},
+					{Filter: ccv2.SpaceGUIDFilter, Operator: ccv2.EqualOperator, Values: []string{"space-1-guid"}},
+				}))
+			})
+		})
+
+		Context("when the stack does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(nil, ccv2.Warnings{"stacks-warning"}, nil)
+			})
+
+			It("returns a StackNotFoundError and warnings", func() {
+				_, warnings, err := actor.GetStackApplications("cflinuxfs2", "org-guid", "")
+				Expect(err).To(MatchError(StackNotFoundError{Name: "cflinuxfs2"}))
+				Expect(warnings).To(ConsistOf("stacks-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -0,0 +1,84 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+)
+
+// TCPRouteSummary represents a TCP route along with the name of its domain's
+// router group and the names of the applications mapped to it.
+type TCPRouteSummary struct {
+	Route
+	RouterGroupName string
+	AppNames        []string
+}
+
+// GetSpaceTCPRouteSummaries returns the TCP routes in the provided space. When
+// routerGroupName is not empty, only the routes whose domain belongs to that
+// router group are returned.
+func (actor Actor) GetSpaceTCPRouteSummaries(spaceGUID string, routerGroupName string) ([]TCPRouteSummary, Warnings, error) {
+	var allWarnings Warnings
+
+	routerGroups, warnings, err := actor.GetRouterGroups()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	routerGroupNames := map[string]string{}
+	var filterGUID string
+	for _, routerGroup := range routerGroups {
+		routerGroupNames[routerGroup.GUID] = routerGroup.Name
+		if routerGroup.Name == routerGroupName {
+			filterGUID = routerGroup.GUID
+		}
+	}
+
+	if routerGroupName != "" && filterGUID == "" {
+		return nil, allWarnings, actionerror.RouterGroupNotFoundError{Name: routerGroupName}
+	}
+
+	routes, warnings, err := actor.GetSpaceRoutes(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var summaries []TCPRouteSummary
+	for _, route := range routes {
+		if !route.Domain.IsTCP() {
+			continue
+		}
+		if filterGUID != "" && route.Domain.RouterGroupGUID != filterGUID {
+			continue
+		}
+
+		apps, warnings, err := actor.GetRouteApplications(route.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		var appNames []string
+		for _, app := range apps {
+			appNames = append(appNames, app.Name)
+		}
+		sort.Strings(appNames)
+
+		summaries = append(summaries, TCPRouteSummary{
+			Route:           route,
+			RouterGroupName: routerGroupNames[route.Domain.RouterGroupGUID],
+			AppNames:        appNames,
+		})
+	}
+
+	sort.Slice(summaries, func(i int, j int) bool {
+		if summaries[i].Domain.Name == summaries[j].Domain.Name {
+			return summaries[i].Port.Value < summaries[j].Port.Value
+		}
+		return summaries[i].Domain.Name < summaries[j].Domain.Name
+	})
+
+	return summaries, allWarnings, nil
+}
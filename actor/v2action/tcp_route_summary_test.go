@@ -0,0 +1,111 @@
+package v2action_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TCP Route Summary Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetSpaceTCPRouteSummaries", func() {
+		var (
+			routerGroupName string
+			summaries       []TCPRouteSummary
+			warnings        Warnings
+			executeErr      error
+		)
+
+		BeforeEach(func() {
+			routerGroupName = ""
+
+			fakeCloudControllerClient.RoutingEndpointReturns("https://api.some-domain.com/routing")
+			fakeCloudControllerClient.GetRouterGroupsReturns([]ccv2.RouterGroup{
+				{GUID: "tcp-group-1-guid", Name: "tcp-group-1"},
+				{GUID: "tcp-group-2-guid", Name: "tcp-group-2"},
+			}, ccv2.Warnings{"get-router-groups-warning"}, nil)
+
+			fakeCloudControllerClient.GetSpaceRoutesReturns([]ccv2.Route{
+				{GUID: "route-1-guid", DomainGUID: "tcp-domain-1-guid", Port: types.NullInt{IsSet: true, Value: 1025}},
+				{GUID: "route-2-guid", DomainGUID: "http-domain-guid", Host: "some-host"},
+				{GUID: "route-3-guid", DomainGUID: "tcp-domain-1-guid", Port: types.NullInt{IsSet: true, Value: 1024}},
+				{GUID: "route-4-guid", DomainGUID: "tcp-domain-2-guid", Port: types.NullInt{IsSet: true, Value: 2000}},
+			}, ccv2.Warnings{"get-space-routes-warning"}, nil)
+
+			fakeCloudControllerClient.GetSharedDomainStub = func(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
+				switch domainGUID {
+				case "tcp-domain-1-guid":
+					return ccv2.Domain{GUID: domainGUID, Name: "a-tcp.com", RouterGroupGUID: "tcp-group-1-guid", RouterGroupType: constant.TCPRouterGroup}, nil, nil
+				case "tcp-domain-2-guid":
+					return ccv2.Domain{GUID: domainGUID, Name: "b-tcp.com", RouterGroupGUID: "tcp-group-2-guid", RouterGroupType: constant.TCPRouterGroup}, nil, nil
+				default:
+					return ccv2.Domain{GUID: domainGUID, Name: "http.com"}, nil, nil
+				}
+			}
+
+			fakeCloudControllerClient.GetRouteApplicationsStub = func(routeGUID string, _ ...ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
+				if routeGUID == "route-1-guid" {
+					return []ccv2.Application{{Name: "app-b"}, {Name: "app-a"}}, ccv2.Warnings{"get-route-apps-warning"}, nil
+				}
+				return nil, nil, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			summaries, warnings, executeErr = actor.GetSpaceTCPRouteSummaries("some-space-guid", routerGroupName)
+		})
+
+		Context("when no router group is provided", func() {
+			It("returns all TCP routes sorted by domain and port", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-router-groups-warning", "get-space-routes-warning", "get-route-apps-warning"))
+
+				Expect(summaries).To(HaveLen(3))
+				Expect(summaries[0].GUID).To(Equal("route-3-guid"))
+				Expect(summaries[0].RouterGroupName).To(Equal("tcp-group-1"))
+				Expect(summaries[1].GUID).To(Equal("route-1-guid"))
+				Expect(summaries[1].AppNames).To(Equal([]string{"app-a", "app-b"}))
+				Expect(summaries[2].GUID).To(Equal("route-4-guid"))
+				Expect(summaries[2].RouterGroupName).To(Equal("tcp-group-2"))
+			})
+		})
+
+		Context("when a router group is provided", func() {
+			BeforeEach(func() {
+				routerGroupName = "tcp-group-2"
+			})
+
+			It("returns only the routes in that router group", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summaries).To(HaveLen(1))
+				Expect(summaries[0].GUID).To(Equal("route-4-guid"))
+			})
+		})
+
+		Context("when the router group does not exist", func() {
+			BeforeEach(func() {
+				routerGroupName = "not-a-group"
+			})
+
+			It("returns a RouterGroupNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouterGroupNotFoundError{Name: "not-a-group"}))
+				Expect(warnings).To(ConsistOf("get-router-groups-warning"))
+				Expect(fakeCloudControllerClient.GetSpaceRoutesCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -1,6 +1,9 @@
 package v2action
 
-import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
 
 // User represents a CLI user.
 type User ccv2.User
@@ -16,3 +19,61 @@ func (actor Actor) CreateUser(username string, password string, origin string) (
 
 	return User(ccUser), Warnings(ccWarnings), err
 }
+
+// GetOrganizationUsersByRole returns the users with the provided role in an
+// organization.
+func (actor Actor) GetOrganizationUsersByRole(role constant.OrgRole, orgGUID string) ([]User, Warnings, error) {
+	ccv2Users, warnings, err := actor.CloudControllerClient.GetOrganizationUsersByRole(role, orgGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var users []User
+	for _, user := range ccv2Users {
+		users = append(users, User(user))
+	}
+
+	return users, Warnings(warnings), nil
+}
+
+// GetSpaceUsersByRole returns the users with the provided role in a space.
+func (actor Actor) GetSpaceUsersByRole(role constant.SpaceRole, spaceGUID string) ([]User, Warnings, error) {
+	ccv2Users, warnings, err := actor.CloudControllerClient.GetSpaceUsersByRole(role, spaceGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var users []User
+	for _, user := range ccv2Users {
+		users = append(users, User(user))
+	}
+
+	return users, Warnings(warnings), nil
+}
+
+// RemoveOrganizationRole removes a role in an organization from the user with
+// the provided GUID.
+func (actor Actor) RemoveOrganizationRole(role constant.OrgRole, orgGUID string, userGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteOrganizationUserByRole(role, orgGUID, userGUID)
+	return Warnings(warnings), err
+}
+
+// RemoveSpaceRole removes a role in a space from the user with the provided
+// GUID.
+func (actor Actor) RemoveSpaceRole(role constant.SpaceRole, spaceGUID string, userGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteSpaceUserByRole(role, spaceGUID, userGUID)
+	return Warnings(warnings), err
+}
+
+// SetOrganizationRole gives the user with the provided username a role in an
+// organization.
+func (actor Actor) SetOrganizationRole(role constant.OrgRole, orgGUID string, username string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UpdateOrganizationUserByRole(role, orgGUID, username)
+	return Warnings(warnings), err
+}
+
+// SetSpaceRole gives the user with the provided username a role in a space.
+func (actor Actor) SetSpaceRole(role constant.SpaceRole, spaceGUID string, username string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UpdateSpaceUserByRole(role, spaceGUID, username)
+	return Warnings(warnings), err
+}
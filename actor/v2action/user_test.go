@@ -6,6 +6,7 @@ import (
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/api/uaa"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -121,4 +122,142 @@ var _ = Describe("User Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationUsersByRole", func() {
+		Context("when the cloud controller returns the users", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationUsersByRoleReturns(
+					[]ccv2.User{{GUID: "some-user-guid", Username: "some-user"}},
+					ccv2.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns the users and warnings", func() {
+				users, warnings, err := actor.GetOrganizationUsersByRole(constant.OrgManagerRole, "some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(users).To(Equal([]User{{GUID: "some-user-guid", Username: "some-user"}}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				role, orgGUID := fakeCloudControllerClient.GetOrganizationUsersByRoleArgsForCall(0)
+				Expect(role).To(Equal(constant.OrgManagerRole))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationUsersByRoleReturns(nil, ccv2.Warnings{"warning-1"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetOrganizationUsersByRole(constant.OrgManagerRole, "some-org-guid")
+				Expect(err).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetSpaceUsersByRole", func() {
+		Context("when the cloud controller returns the users", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceUsersByRoleReturns(
+					[]ccv2.User{{GUID: "some-user-guid", Username: "some-user"}},
+					ccv2.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns the users and warnings", func() {
+				users, warnings, err := actor.GetSpaceUsersByRole(constant.SpaceDeveloperRole, "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(users).To(Equal([]User{{GUID: "some-user-guid", Username: "some-user"}}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				role, spaceGUID := fakeCloudControllerClient.GetSpaceUsersByRoleArgsForCall(0)
+				Expect(role).To(Equal(constant.SpaceDeveloperRole))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceUsersByRoleReturns(nil, ccv2.Warnings{"warning-1"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetSpaceUsersByRole(constant.SpaceDeveloperRole, "some-space-guid")
+				Expect(err).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("SetOrganizationRole", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.UpdateOrganizationUserByRoleReturns(ccv2.Warnings{"warning-1"}, errors.New("some-error"))
+		})
+
+		It("gives the user the role and returns the warnings and error", func() {
+			warnings, err := actor.SetOrganizationRole(constant.OrgAuditorRole, "some-org-guid", "some-user")
+			Expect(err).To(MatchError("some-error"))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			role, orgGUID, username := fakeCloudControllerClient.UpdateOrganizationUserByRoleArgsForCall(0)
+			Expect(role).To(Equal(constant.OrgAuditorRole))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(username).To(Equal("some-user"))
+		})
+	})
+
+	Describe("SetSpaceRole", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.UpdateSpaceUserByRoleReturns(ccv2.Warnings{"warning-1"}, errors.New("some-error"))
+		})
+
+		It("gives the user the role and returns the warnings and error", func() {
+			warnings, err := actor.SetSpaceRole(constant.SpaceManagerRole, "some-space-guid", "some-user")
+			Expect(err).To(MatchError("some-error"))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			role, spaceGUID, username := fakeCloudControllerClient.UpdateSpaceUserByRoleArgsForCall(0)
+			Expect(role).To(Equal(constant.SpaceManagerRole))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(username).To(Equal("some-user"))
+		})
+	})
+
+	Describe("RemoveOrganizationRole", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteOrganizationUserByRoleReturns(ccv2.Warnings{"warning-1"}, errors.New("some-error"))
+		})
+
+		It("removes the role and returns the warnings and error", func() {
+			warnings, err := actor.RemoveOrganizationRole(constant.OrgAuditorRole, "some-org-guid", "some-user-guid")
+			Expect(err).To(MatchError("some-error"))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			role, orgGUID, userGUID := fakeCloudControllerClient.DeleteOrganizationUserByRoleArgsForCall(0)
+			Expect(role).To(Equal(constant.OrgAuditorRole))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(userGUID).To(Equal("some-user-guid"))
+		})
+	})
+
+	Describe("RemoveSpaceRole", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteSpaceUserByRoleReturns(ccv2.Warnings{"warning-1"}, errors.New("some-error"))
+		})
+
+		It("removes the role and returns the warnings and error", func() {
+			warnings, err := actor.RemoveSpaceRole(constant.SpaceDeveloperRole, "some-space-guid", "some-user-guid")
+			Expect(err).To(MatchError("some-error"))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			role, spaceGUID, userGUID := fakeCloudControllerClient.DeleteSpaceUserByRoleArgsForCall(0)
+			Expect(role).To(Equal(constant.SpaceDeveloperRole))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(userGUID).To(Equal("some-user-guid"))
+		})
+	})
 })
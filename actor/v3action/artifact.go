@@ -0,0 +1,169 @@
+package v3action
+
+import (
+	"net/url"
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// ArtifactCleanup is the set of an application's droplets and packages that
+// are eligible for deletion.
+type ArtifactCleanup struct {
+	AppName  string
+	Droplets []Droplet
+	Packages []Package
+}
+
+// Empty returns true when there is nothing to delete.
+func (cleanup ArtifactCleanup) Empty() bool {
+	return len(cleanup.Droplets) == 0 && len(cleanup.Packages) == 0
+}
+
+// GetApplicationArtifactsToCleanup returns the droplets and packages of the
+// application other than its current droplet and the keep most recent
+// droplets and packages. Artifacts that are still being processed are never
+// returned.
+func (actor Actor) GetApplicationArtifactsToCleanup(appName string, spaceGUID string, keep int) (ArtifactCleanup, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return ArtifactCleanup{}, allWarnings, err
+	}
+
+	cleanup, warnings, err := actor.getArtifactsToCleanup(app, keep)
+	allWarnings = append(allWarnings, warnings...)
+	return cleanup, allWarnings, err
+}
+
+// GetSpaceArtifactsToCleanup returns an ArtifactCleanup for every application
+// in the space that has artifacts to delete.
+func (actor Actor) GetSpaceArtifactsToCleanup(spaceGUID string, keep int) ([]ArtifactCleanup, Warnings, error) {
+	apps, allWarnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var cleanups []ArtifactCleanup
+	for _, app := range apps {
+		cleanup, warnings, err := actor.getArtifactsToCleanup(app, keep)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		if !cleanup.Empty() {
+			cleanups = append(cleanups, cleanup)
+		}
+	}
+
+	return cleanups, allWarnings, nil
+}
+
+// CleanupArtifacts deletes the given droplets and packages, waiting for each
+// deletion to complete.
+func (actor Actor) CleanupArtifacts(cleanup ArtifactCleanup) (Warnings, error) {
+	var allWarnings Warnings
+
+	for _, droplet := range cleanup.Droplets {
+		jobURL, warnings, err := actor.CloudControllerClient.DeleteDroplet(droplet.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	for _, pkg := range cleanup.Packages {
+		jobURL, warnings, err := actor.CloudControllerClient.DeletePackage(pkg.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func (actor Actor) getArtifactsToCleanup(app Application, keep int) (ArtifactCleanup, Warnings, error) {
+	var allWarnings Warnings
+	cleanup := ArtifactCleanup{AppName: app.Name}
+
+	var currentDropletGUID string
+	currentDroplet, warnings, err := actor.CloudControllerClient.GetApplicationCurrentDroplet(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	switch err.(type) {
+	case nil:
+		currentDropletGUID = currentDroplet.GUID
+	case ccerror.DropletNotFoundError:
+	default:
+		return ArtifactCleanup{}, allWarnings, err
+	}
+
+	ccv3Droplets, warnings, err := actor.CloudControllerClient.GetApplicationDroplets(app.GUID, url.Values{})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ArtifactCleanup{}, allWarnings, err
+	}
+
+	var droplets []ccv3.Droplet
+	for _, droplet := range ccv3Droplets {
+		if droplet.GUID == currentDropletGUID {
+			continue
+		}
+
+		switch droplet.State {
+		case ccv3.DropletStateStaged, ccv3.DropletStateFailed, ccv3.DropletStateExpired:
+			droplets = append(droplets, droplet)
+		}
+	}
+
+	sort.Slice(droplets, func(i int, j int) bool {
+		return droplets[i].CreatedAt > droplets[j].CreatedAt
+	})
+
+	for i, droplet := range droplets {
+		if i >= keep {
+			cleanup.Droplets = append(cleanup.Droplets, actor.convertCCToActorDroplet(droplet))
+		}
+	}
+
+	ccv3Packages, warnings, err := actor.CloudControllerClient.GetPackages(url.Values{
+		ccv3.AppGUIDFilter: []string{app.GUID},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ArtifactCleanup{}, allWarnings, err
+	}
+
+	var packages []ccv3.Package
+	for _, pkg := range ccv3Packages {
+		switch pkg.State {
+		case ccv3.PackageStateReady, ccv3.PackageStateFailed, ccv3.PackageStateExpired:
+			packages = append(packages, pkg)
+		}
+	}
+
+	sort.Slice(packages, func(i int, j int) bool {
+		return packages[i].CreatedAt > packages[j].CreatedAt
+	})
+
+	for i, pkg := range packages {
+		if i >= keep {
+			cleanup.Packages = append(cleanup.Packages, Package(pkg))
+		}
+	}
+
+	return cleanup, allWarnings, nil
+}
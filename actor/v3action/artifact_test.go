@@ -0,0 +1,252 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Artifact Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetApplicationArtifactsToCleanup", func() {
+		var (
+			keep     int
+			cleanup  ArtifactCleanup
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			keep = 1
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}},
+				ccv3.Warnings{"get-applications-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationCurrentDropletReturns(
+				ccv3.Droplet{GUID: "current-droplet-guid"},
+				ccv3.Warnings{"get-current-droplet-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationDropletsReturns(
+				[]ccv3.Droplet{
+					{GUID: "oldest-droplet-guid", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-01T00:00:00Z"},
+					{GUID: "current-droplet-guid", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-02T00:00:00Z"},
+					{GUID: "newest-droplet-guid", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-04T00:00:00Z"},
+					{GUID: "failed-droplet-guid", State: ccv3.DropletStateFailed, CreatedAt: "2017-01-03T00:00:00Z"},
+					{GUID: "copying-droplet-guid", State: ccv3.DropletStateCopying, CreatedAt: "2016-12-01T00:00:00Z"},
+				},
+				ccv3.Warnings{"get-droplets-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetPackagesReturns(
+				[]ccv3.Package{
+					{GUID: "old-package-guid", State: ccv3.PackageStateReady, CreatedAt: "2017-01-01T00:00:00Z"},
+					{GUID: "new-package-guid", State: ccv3.PackageStateReady, CreatedAt: "2017-01-03T00:00:00Z"},
+					{GUID: "uploading-package-guid", State: ccv3.PackageStateProcessingUpload, CreatedAt: "2016-12-01T00:00:00Z"},
+				},
+				ccv3.Warnings{"get-packages-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			cleanup, warnings, err = actor.GetApplicationArtifactsToCleanup("some-app", "some-space-guid", keep)
+		})
+
+		It("returns everything but the current droplet and the most recent artifacts", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"get-applications-warning",
+				"get-current-droplet-warning",
+				"get-droplets-warning",
+				"get-packages-warning",
+			))
+
+			Expect(cleanup.AppName).To(Equal("some-app"))
+			Expect(cleanup.Droplets).To(Equal([]Droplet{
+				{GUID: "failed-droplet-guid", State: DropletStateFailed, CreatedAt: "2017-01-03T00:00:00Z"},
+				{GUID: "oldest-droplet-guid", State: DropletStateStaged, CreatedAt: "2017-01-01T00:00:00Z"},
+			}))
+			Expect(cleanup.Packages).To(Equal([]Package{
+				{GUID: "old-package-guid", State: ccv3.PackageStateReady, CreatedAt: "2017-01-01T00:00:00Z"},
+			}))
+
+			Expect(fakeCloudControllerClient.GetApplicationCurrentDropletArgsForCall(0)).To(Equal("some-app-guid"))
+			Expect(fakeCloudControllerClient.DeleteDropletCallCount()).To(Equal(0))
+			Expect(fakeCloudControllerClient.DeletePackageCallCount()).To(Equal(0))
+		})
+
+		Context("when keep is 0", func() {
+			BeforeEach(func() {
+				keep = 0
+			})
+
+			It("still keeps the current droplet", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cleanup.Droplets).To(HaveLen(3))
+				for _, droplet := range cleanup.Droplets {
+					Expect(droplet.GUID).ToNot(Equal("current-droplet-guid"))
+				}
+				Expect(cleanup.Packages).To(HaveLen(2))
+			})
+		})
+
+		Context("when the app has no current droplet", func() {
+			BeforeEach(func() {
+				keep = 3
+				fakeCloudControllerClient.GetApplicationCurrentDropletReturns(
+					ccv3.Droplet{},
+					ccv3.Warnings{"get-current-droplet-warning"},
+					ccerror.DropletNotFoundError{},
+				)
+			})
+
+			It("counts every droplet towards the ones kept", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cleanup.Droplets).To(Equal([]Droplet{
+					{GUID: "oldest-droplet-guid", State: DropletStateStaged, CreatedAt: "2017-01-01T00:00:00Z"},
+				}))
+			})
+		})
+
+		Context("when getting the current droplet fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("current droplet error")
+				fakeCloudControllerClient.GetApplicationCurrentDropletReturns(
+					ccv3.Droplet{},
+					ccv3.Warnings{"get-current-droplet-warning"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-current-droplet-warning"))
+			})
+		})
+	})
+
+	Describe("GetSpaceArtifactsToCleanup", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{
+					{Name: "app-1", GUID: "app-1-guid"},
+					{Name: "app-2", GUID: "app-2-guid"},
+				},
+				ccv3.Warnings{"get-applications-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationCurrentDropletReturns(ccv3.Droplet{}, nil, ccerror.DropletNotFoundError{})
+			fakeCloudControllerClient.GetApplicationDropletsStub = func(appGUID string, _ url.Values) ([]ccv3.Droplet, ccv3.Warnings, error) {
+				if appGUID == "app-1-guid" {
+					return []ccv3.Droplet{
+						{GUID: "droplet-1", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-01T00:00:00Z"},
+						{GUID: "droplet-2", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-02T00:00:00Z"},
+					}, ccv3.Warnings{"get-droplets-warning"}, nil
+				}
+				return []ccv3.Droplet{
+					{GUID: "droplet-3", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-01T00:00:00Z"},
+				}, ccv3.Warnings{"get-droplets-warning"}, nil
+			}
+		})
+
+		It("returns the cleanups for the apps that have something to delete", func() {
+			cleanups, warnings, err := actor.GetSpaceArtifactsToCleanup("some-space-guid", 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ContainElement("get-applications-warning"))
+			Expect(cleanups).To(Equal([]ArtifactCleanup{
+				{
+					AppName:  "app-1",
+					Droplets: []Droplet{{GUID: "droplet-1", State: DropletStateStaged, CreatedAt: "2017-01-01T00:00:00Z"}},
+				},
+			}))
+		})
+	})
+
+	Describe("CleanupArtifacts", func() {
+		var cleanup ArtifactCleanup
+
+		BeforeEach(func() {
+			cleanup = ArtifactCleanup{
+				AppName:  "some-app",
+				Droplets: []Droplet{{GUID: "droplet-1"}, {GUID: "droplet-2"}},
+				Packages: []Package{{GUID: "package-1"}},
+			}
+
+			fakeCloudControllerClient.DeleteDropletReturns("/v3/jobs/droplet-job", ccv3.Warnings{"delete-droplet-warning"}, nil)
+			fakeCloudControllerClient.DeletePackageReturns("/v3/jobs/package-job", ccv3.Warnings{"delete-package-warning"}, nil)
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+		})
+
+		It("deletes every droplet and package and waits for the jobs", func() {
+			warnings, err := actor.CleanupArtifacts(cleanup)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"delete-droplet-warning", "poll-warning",
+				"delete-droplet-warning", "poll-warning",
+				"delete-package-warning", "poll-warning",
+			))
+
+			Expect(fakeCloudControllerClient.DeleteDropletCallCount()).To(Equal(2))
+			Expect(fakeCloudControllerClient.DeleteDropletArgsForCall(0)).To(Equal("droplet-1"))
+			Expect(fakeCloudControllerClient.DeleteDropletArgsForCall(1)).To(Equal("droplet-2"))
+			Expect(fakeCloudControllerClient.DeletePackageCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.DeletePackageArgsForCall(0)).To(Equal("package-1"))
+
+			Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(3))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal("/v3/jobs/droplet-job"))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(2)).To(Equal("/v3/jobs/package-job"))
+		})
+
+		Context("when deleting a droplet fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("delete error")
+				fakeCloudControllerClient.DeleteDropletReturns("", ccv3.Warnings{"delete-droplet-warning"}, expectedErr)
+			})
+
+			It("stops and returns the error and all warnings", func() {
+				warnings, err := actor.CleanupArtifacts(cleanup)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("delete-droplet-warning"))
+				Expect(fakeCloudControllerClient.DeletePackageCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when polling a job fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = ccerror.JobFailedError{JobGUID: "some-job", Message: "some-message"}
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.CleanupArtifacts(cleanup)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("delete-droplet-warning", "poll-warning"))
+			})
+		})
+	})
+})
@@ -7,6 +7,7 @@ import "time"
 type Config interface {
 	AccessToken() string
 	PollingInterval() time.Duration
+	RollbackHistoryFilePath() string
 	SSHOAuthClient() string
 	StartupTimeout() time.Duration
 	StagingTimeout() time.Duration
@@ -2,6 +2,8 @@ package v3action
 
 import (
 	"net/url"
+	"os"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -11,12 +13,21 @@ import (
 type DropletState string
 
 const (
-	DropletStateStaged  DropletState = "STAGED"
-	DropletStateFailed  DropletState = "FAILED"
-	DropletStateCopying DropletState = "COPYING"
-	DropletStateExpired DropletState = "EXPIRED"
+	DropletStateStaged           DropletState = "STAGED"
+	DropletStateFailed           DropletState = "FAILED"
+	DropletStateCopying          DropletState = "COPYING"
+	DropletStateExpired          DropletState = "EXPIRED"
+	DropletStateAwaitingUpload   DropletState = "AWAITING_UPLOAD"
+	DropletStateProcessingUpload DropletState = "PROCESSING_UPLOAD"
 )
 
+// DropletFilePermissions are the permissions a downloaded droplet is written
+// with.
+const DropletFilePermissions = 0644
+
+// DropletUploadRetries is the number of times a droplet upload is attempted.
+const DropletUploadRetries = 3
+
 // Droplet represents a Cloud Controller droplet.
 type Droplet struct {
 	GUID       string
@@ -72,6 +83,113 @@ func (actor Actor) GetApplicationDroplets(appName string, spaceGUID string) ([]D
 	return droplets, allWarnings, err
 }
 
+// DownloadApplicationDroplet writes the given droplet of the application to
+// dropletPath. When dropletGUID is empty, the application's current droplet
+// is downloaded. The droplet must belong to the application.
+func (actor Actor) DownloadApplicationDroplet(appName string, spaceGUID string, dropletGUID string, dropletPath string) (Droplet, Warnings, error) {
+	allWarnings := Warnings{}
+	application, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	var ccv3Droplet ccv3.Droplet
+	if dropletGUID == "" {
+		var apiWarnings ccv3.Warnings
+		ccv3Droplet, apiWarnings, err = actor.CloudControllerClient.GetApplicationCurrentDroplet(application.GUID)
+		allWarnings = append(allWarnings, apiWarnings...)
+		if _, ok := err.(ccerror.DropletNotFoundError); ok {
+			return Droplet{}, allWarnings, actionerror.NoCurrentDropletError{AppName: appName}
+		}
+		if err != nil {
+			return Droplet{}, allWarnings, err
+		}
+	} else {
+		ccv3Droplets, apiWarnings, err := actor.CloudControllerClient.GetApplicationDroplets(application.GUID, url.Values{
+			ccv3.GUIDFilter: []string{dropletGUID},
+		})
+		allWarnings = append(allWarnings, apiWarnings...)
+		if err != nil {
+			return Droplet{}, allWarnings, err
+		}
+		if len(ccv3Droplets) == 0 {
+			return Droplet{}, allWarnings, actionerror.ApplicationDropletNotFoundError{AppName: appName, DropletGUID: dropletGUID}
+		}
+		ccv3Droplet = ccv3Droplets[0]
+	}
+
+	dropletFile, err := os.OpenFile(dropletPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, DropletFilePermissions)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	apiWarnings, err := actor.CloudControllerClient.DownloadDroplet(ccv3Droplet.GUID, dropletFile)
+	allWarnings = append(allWarnings, apiWarnings...)
+	closeErr := dropletFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(dropletPath)
+		return Droplet{}, allWarnings, err
+	}
+
+	return actor.convertCCToActorDroplet(ccv3Droplet), allWarnings, nil
+}
+
+// CreateAndUploadDropletByApplicationNameAndSpace creates a new droplet for
+// the application from the droplet tarball at dropletPath and waits for the
+// cloud controller to finish processing it. The droplet is not set as the
+// application's current droplet.
+func (actor Actor) CreateAndUploadDropletByApplicationNameAndSpace(appName string, spaceGUID string, dropletPath string) (Droplet, Warnings, error) {
+	allWarnings := Warnings{}
+	application, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	droplet, apiWarnings, err := actor.CloudControllerClient.CreateDroplet(application.GUID)
+	allWarnings = append(allWarnings, apiWarnings...)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	// The droplet is streamed, so a request that fails part way through is
+	// retried here rather than by the client.
+	for count := 0; count < DropletUploadRetries; count++ {
+		apiWarnings, err = actor.CloudControllerClient.UploadDropletBits(droplet.GUID, dropletPath)
+		allWarnings = append(allWarnings, apiWarnings...)
+		if _, ok := err.(ccerror.PipeSeekError); !ok {
+			break
+		}
+	}
+	if err != nil {
+		if pipeSeekErr, ok := err.(ccerror.PipeSeekError); ok {
+			return Droplet{}, allWarnings, actionerror.UploadFailedError{Err: pipeSeekErr.Err}
+		}
+		return Droplet{}, allWarnings, err
+	}
+
+	for droplet.State != ccv3.DropletStateStaged &&
+		droplet.State != ccv3.DropletStateFailed &&
+		droplet.State != ccv3.DropletStateExpired {
+		time.Sleep(actor.Config.PollingInterval())
+		droplet, apiWarnings, err = actor.CloudControllerClient.GetDroplet(droplet.GUID)
+		allWarnings = append(allWarnings, apiWarnings...)
+		if err != nil {
+			return Droplet{}, allWarnings, err
+		}
+	}
+
+	if droplet.State != ccv3.DropletStateStaged {
+		return Droplet{}, allWarnings, actionerror.DropletProcessingFailedError{State: string(droplet.State)}
+	}
+
+	return actor.convertCCToActorDroplet(droplet), allWarnings, nil
+}
+
 func (actor Actor) convertCCToActorDroplet(ccv3Droplet ccv3.Droplet) Droplet {
 	var buildpacks []Buildpack
 	for _, ccv3Buildpack := range ccv3Droplet.Buildpacks {
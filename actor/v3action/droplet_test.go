@@ -2,8 +2,13 @@ package v3action_test
 
 import (
 	"errors"
+	"io"
+	"io/ioutil"
 	"net/url"
+	"os"
+	"path/filepath"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -17,11 +22,13 @@ var _ = Describe("Droplet Actions", func() {
 	var (
 		actor                     *Actor
 		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
 	)
 
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
-		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil)
 	})
 
 	Describe("SetApplicationDroplet", func() {
@@ -256,4 +263,324 @@ var _ = Describe("Droplet Actions", func() {
 			})
 		})
 	})
+
+	Describe("DownloadApplicationDroplet", func() {
+		var (
+			tmpDir      string
+			dropletPath string
+			dropletGUID string
+
+			droplet  Droplet
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			tmpDir, err = ioutil.TempDir("", "download-droplet")
+			Expect(err).ToNot(HaveOccurred())
+			dropletPath = filepath.Join(tmpDir, "droplet.tgz")
+			dropletGUID = ""
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{{GUID: "some-app-guid"}},
+				ccv3.Warnings{"get-applications-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.DownloadDropletStub = func(_ string, output io.Writer) (ccv3.Warnings, error) {
+				_, err := output.Write([]byte("some-droplet-contents"))
+				return ccv3.Warnings{"download-droplet-warning"}, err
+			}
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			droplet, warnings, err = actor.DownloadApplicationDroplet("some-app-name", "some-space-guid", dropletGUID, dropletPath)
+		})
+
+		Context("when no droplet GUID is provided", func() {
+			Context("when the app has a current droplet", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationCurrentDropletReturns(
+						ccv3.Droplet{GUID: "current-droplet-guid", State: ccv3.DropletStateStaged},
+						ccv3.Warnings{"get-current-droplet-warning"},
+						nil,
+					)
+				})
+
+				It("downloads the current droplet to the given path", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-applications-warning", "get-current-droplet-warning", "download-droplet-warning"))
+					Expect(droplet).To(Equal(Droplet{GUID: "current-droplet-guid", State: DropletStateStaged}))
+
+					Expect(fakeCloudControllerClient.GetApplicationCurrentDropletCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetApplicationCurrentDropletArgsForCall(0)).To(Equal("some-app-guid"))
+					Expect(fakeCloudControllerClient.GetApplicationDropletsCallCount()).To(Equal(0))
+					downloadedGUID, _ := fakeCloudControllerClient.DownloadDropletArgsForCall(0)
+					Expect(downloadedGUID).To(Equal("current-droplet-guid"))
+
+					contents, readErr := ioutil.ReadFile(dropletPath)
+					Expect(readErr).ToNot(HaveOccurred())
+					Expect(string(contents)).To(Equal("some-droplet-contents"))
+				})
+			})
+
+			Context("when the app has no current droplet", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationCurrentDropletReturns(
+						ccv3.Droplet{},
+						ccv3.Warnings{"get-current-droplet-warning"},
+						ccerror.DropletNotFoundError{},
+					)
+				})
+
+				It("returns a NoCurrentDropletError and all warnings", func() {
+					Expect(err).To(MatchError(actionerror.NoCurrentDropletError{AppName: "some-app-name"}))
+					Expect(warnings).To(ConsistOf("get-applications-warning", "get-current-droplet-warning"))
+					Expect(fakeCloudControllerClient.DownloadDropletCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when a droplet GUID is provided", func() {
+			BeforeEach(func() {
+				dropletGUID = "some-droplet-guid"
+				fakeCloudControllerClient.GetApplicationDropletsReturns(
+					[]ccv3.Droplet{{GUID: "some-droplet-guid", State: ccv3.DropletStateStaged}},
+					ccv3.Warnings{"get-droplets-warning"},
+					nil,
+				)
+			})
+
+			It("downloads the given droplet of the app", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-droplets-warning", "download-droplet-warning"))
+
+				appGUID, query := fakeCloudControllerClient.GetApplicationDropletsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(Equal(url.Values{ccv3.GUIDFilter: []string{"some-droplet-guid"}}))
+				Expect(fakeCloudControllerClient.GetApplicationCurrentDropletCallCount()).To(Equal(0))
+
+				downloadedGUID, _ := fakeCloudControllerClient.DownloadDropletArgsForCall(0)
+				Expect(downloadedGUID).To(Equal("some-droplet-guid"))
+			})
+
+			Context("when the droplet is not a droplet of the app", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationDropletsReturns(nil, ccv3.Warnings{"get-droplets-warning"}, nil)
+				})
+
+				It("returns an ApplicationDropletNotFoundError without downloading", func() {
+					Expect(err).To(MatchError(actionerror.ApplicationDropletNotFoundError{AppName: "some-app-name", DropletGUID: "some-droplet-guid"}))
+					Expect(warnings).To(ConsistOf("get-applications-warning", "get-droplets-warning"))
+					Expect(fakeCloudControllerClient.DownloadDropletCallCount()).To(Equal(0))
+					_, statErr := os.Stat(dropletPath)
+					Expect(os.IsNotExist(statErr)).To(BeTrue())
+				})
+			})
+		})
+
+		Context("when downloading the droplet fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("download failed")
+				fakeCloudControllerClient.DownloadDropletStub = func(_ string, output io.Writer) (ccv3.Warnings, error) {
+					_, err := output.Write([]byte("some-partial-contents"))
+					Expect(err).ToNot(HaveOccurred())
+					return ccv3.Warnings{"download-droplet-warning"}, expectedErr
+				}
+			})
+
+			It("returns the error and all warnings and removes the partial file", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "download-droplet-warning"))
+				_, statErr := os.Stat(dropletPath)
+				Expect(os.IsNotExist(statErr)).To(BeTrue())
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					nil,
+					ccv3.Warnings{"get-applications-warning"},
+					nil,
+				)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(err).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app-name"}))
+				Expect(warnings).To(ConsistOf("get-applications-warning"))
+			})
+		})
+	})
+
+	Describe("CreateAndUploadDropletByApplicationNameAndSpace", func() {
+		var (
+			droplet  Droplet
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{{GUID: "some-app-guid"}},
+				ccv3.Warnings{"get-applications-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.CreateDropletReturns(
+				ccv3.Droplet{GUID: "some-droplet-guid", State: ccv3.DropletStateAwaitingUpload},
+				ccv3.Warnings{"create-droplet-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UploadDropletBitsReturns(
+				ccv3.Warnings{"upload-droplet-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			droplet, warnings, err = actor.CreateAndUploadDropletByApplicationNameAndSpace("some-app-name", "some-space-guid", "/some/droplet.tgz")
+		})
+
+		Context("when the droplet is processed successfully", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDropletReturnsOnCall(0,
+					ccv3.Droplet{GUID: "some-droplet-guid", State: ccv3.DropletStateProcessingUpload},
+					ccv3.Warnings{"get-droplet-warning-1"},
+					nil,
+				)
+				fakeCloudControllerClient.GetDropletReturnsOnCall(1,
+					ccv3.Droplet{GUID: "some-droplet-guid", State: ccv3.DropletStateStaged},
+					ccv3.Warnings{"get-droplet-warning-2"},
+					nil,
+				)
+			})
+
+			It("creates, uploads and polls the droplet until it is staged", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(
+					"get-applications-warning",
+					"create-droplet-warning",
+					"upload-droplet-warning",
+					"get-droplet-warning-1",
+					"get-droplet-warning-2",
+				))
+				Expect(droplet).To(Equal(Droplet{GUID: "some-droplet-guid", State: DropletStateStaged}))
+
+				Expect(fakeCloudControllerClient.CreateDropletArgsForCall(0)).To(Equal("some-app-guid"))
+				dropletGUID, dropletPath := fakeCloudControllerClient.UploadDropletBitsArgsForCall(0)
+				Expect(dropletGUID).To(Equal("some-droplet-guid"))
+				Expect(dropletPath).To(Equal("/some/droplet.tgz"))
+				Expect(fakeCloudControllerClient.GetDropletCallCount()).To(Equal(2))
+				Expect(fakeConfig.PollingIntervalCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.SetApplicationDropletCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the droplet processing fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDropletReturns(
+					ccv3.Droplet{GUID: "some-droplet-guid", State: ccv3.DropletStateFailed},
+					ccv3.Warnings{"get-droplet-warning"},
+					nil,
+				)
+			})
+
+			It("returns a DropletProcessingFailedError and all warnings", func() {
+				Expect(err).To(MatchError(actionerror.DropletProcessingFailedError{State: "FAILED"}))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "create-droplet-warning", "upload-droplet-warning", "get-droplet-warning"))
+			})
+		})
+
+		Context("when the droplet expires", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDropletReturns(
+					ccv3.Droplet{GUID: "some-droplet-guid", State: ccv3.DropletStateExpired},
+					ccv3.Warnings{"get-droplet-warning"},
+					nil,
+				)
+			})
+
+			It("returns a DropletProcessingFailedError and all warnings", func() {
+				Expect(err).To(MatchError(actionerror.DropletProcessingFailedError{State: "EXPIRED"}))
+			})
+		})
+
+		Context("when uploading the droplet fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("upload failed")
+				fakeCloudControllerClient.UploadDropletBitsReturns(
+					ccv3.Warnings{"upload-droplet-warning"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "create-droplet-warning", "upload-droplet-warning"))
+				Expect(fakeCloudControllerClient.GetDropletCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the upload cannot be retried by the client", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UploadDropletBitsReturnsOnCall(0,
+					ccv3.Warnings{"upload-droplet-warning-1"},
+					ccerror.PipeSeekError{Err: errors.New("connection reset")},
+				)
+				fakeCloudControllerClient.GetDropletReturns(
+					ccv3.Droplet{GUID: "some-droplet-guid", State: ccv3.DropletStateStaged},
+					nil,
+					nil,
+				)
+			})
+
+			It("uploads the droplet again", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.UploadDropletBitsCallCount()).To(Equal(2))
+				Expect(warnings).To(ContainElement("upload-droplet-warning-1"))
+				Expect(warnings).To(ContainElement("upload-droplet-warning"))
+			})
+
+			Context("when every attempt fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UploadDropletBitsReturns(
+						nil,
+						ccerror.PipeSeekError{Err: errors.New("connection reset")},
+					)
+				})
+
+				It("returns an UploadFailedError", func() {
+					Expect(err).To(MatchError(actionerror.UploadFailedError{Err: errors.New("connection reset")}))
+					Expect(fakeCloudControllerClient.UploadDropletBitsCallCount()).To(Equal(DropletUploadRetries))
+					Expect(fakeCloudControllerClient.GetDropletCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when creating the droplet fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create failed")
+				fakeCloudControllerClient.CreateDropletReturns(
+					ccv3.Droplet{},
+					ccv3.Warnings{"create-droplet-warning"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "create-droplet-warning"))
+				Expect(fakeCloudControllerClient.UploadDropletBitsCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
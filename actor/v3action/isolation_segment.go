@@ -62,6 +62,25 @@ func (actor Actor) GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefa
 	return IsolationSegment(isolationSegment), allWarnings, err
 }
 
+// GetSpaceIsolationSegment returns the isolation segment assigned to the
+// space itself, ignoring the organization's default. It returns an empty
+// IsolationSegment when the space has no isolation segment of its own.
+func (actor Actor) GetSpaceIsolationSegment(spaceGUID string) (IsolationSegment, Warnings, error) {
+	relationship, warnings, err := actor.CloudControllerClient.GetSpaceIsolationSegment(spaceGUID)
+	allWarnings := append(Warnings{}, warnings...)
+	if err != nil || relationship.GUID == "" {
+		return IsolationSegment{}, allWarnings, err
+	}
+
+	isolationSegment, warnings, err := actor.CloudControllerClient.GetIsolationSegment(relationship.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return IsolationSegment{}, allWarnings, err
+	}
+
+	return IsolationSegment(isolationSegment), allWarnings, nil
+}
+
 // CreateIsolationSegmentByName creates a given isolation segment.
 func (actor Actor) CreateIsolationSegmentByName(isolationSegment IsolationSegment) (Warnings, error) {
 	_, warnings, err := actor.CloudControllerClient.CreateIsolationSegment(ccv3.IsolationSegment(isolationSegment))
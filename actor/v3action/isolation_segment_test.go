@@ -302,6 +302,54 @@ var _ = Describe("Isolation Segment Actions", func() {
 		})
 	})
 
+	Describe("GetSpaceIsolationSegment", func() {
+		Context("when the space has its own isolation segment", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceIsolationSegmentReturns(ccv3.Relationship{GUID: "some-iso-guid"}, ccv3.Warnings{"space-warning"}, nil)
+				fakeCloudControllerClient.GetIsolationSegmentReturns(ccv3.IsolationSegment{Name: "some-iso"}, ccv3.Warnings{"iso-warning"}, nil)
+			})
+
+			It("returns the isolation segment and the warnings", func() {
+				isolationSegment, warnings, err := actor.GetSpaceIsolationSegment("some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(isolationSegment).To(Equal(IsolationSegment{Name: "some-iso"}))
+				Expect(warnings).To(ConsistOf("space-warning", "iso-warning"))
+
+				Expect(fakeCloudControllerClient.GetSpaceIsolationSegmentArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(fakeCloudControllerClient.GetIsolationSegmentArgsForCall(0)).To(Equal("some-iso-guid"))
+			})
+		})
+
+		Context("when the space has no isolation segment of its own", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceIsolationSegmentReturns(ccv3.Relationship{}, ccv3.Warnings{"space-warning"}, nil)
+			})
+
+			It("returns an empty isolation segment", func() {
+				isolationSegment, warnings, err := actor.GetSpaceIsolationSegment("some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(isolationSegment).To(Equal(IsolationSegment{}))
+				Expect(warnings).To(ConsistOf("space-warning"))
+				Expect(fakeCloudControllerClient.GetIsolationSegmentCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the space's relationship fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("relationship error")
+				fakeCloudControllerClient.GetSpaceIsolationSegmentReturns(ccv3.Relationship{}, ccv3.Warnings{"space-warning"}, expectedErr)
+			})
+
+			It("returns the error and the warnings", func() {
+				_, warnings, err := actor.GetSpaceIsolationSegment("some-space-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("space-warning"))
+			})
+		})
+	})
+
 	Describe("GetEffectiveIsolationSegmentBySpace", func() {
 		Context("when the retrieving the space isolation segment succeeds", func() {
 			BeforeEach(func() {
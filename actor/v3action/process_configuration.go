@@ -0,0 +1,104 @@
+package v3action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifest"
+)
+
+// ProcessConfiguration is the desired configuration of one process type of an
+// application. Unset fields leave the process's current value in place.
+type ProcessConfiguration struct {
+	Type                string
+	Command             string
+	DiskInMB            types.NullUint64
+	HealthCheckEndpoint string
+	HealthCheckType     string
+	Instances           types.NullInt
+	MemoryInMB          types.NullUint64
+}
+
+// GetManifestProcessConfigurations returns the process configurations listed
+// in the processes section of the named application in the manifest.
+func (Actor) GetManifestProcessConfigurations(pathToManifest string, appName string) ([]ProcessConfiguration, error) {
+	apps, err := manifest.ReadAndMergeManifests(pathToManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, app := range apps {
+		if app.Name != appName {
+			continue
+		}
+
+		var configs []ProcessConfiguration
+		for _, process := range app.Processes {
+			configs = append(configs, ProcessConfiguration{
+				Type:                process.Type,
+				Command:             process.Command,
+				DiskInMB:            types.NullUint64{IsSet: process.DiskQuota.IsSet, Value: process.DiskQuota.Value},
+				HealthCheckEndpoint: process.HealthCheckHTTPEndpoint,
+				HealthCheckType:     process.HealthCheckType,
+				Instances:           process.Instances,
+				MemoryInMB:          types.NullUint64{IsSet: process.Memory.IsSet, Value: process.Memory.Value},
+			})
+		}
+		return configs, nil
+	}
+
+	return nil, actionerror.AppNotFoundInManifestError{Name: appName}
+}
+
+// ConfigureApplicationProcess applies the command, health check and scale of
+// config to the application's process of the same type.
+func (actor Actor) ConfigureApplicationProcess(appGUID string, config ProcessConfiguration) (Warnings, error) {
+	healthCheckEndpoint := config.HealthCheckEndpoint
+	if config.HealthCheckType != "" && config.HealthCheckType != "http" {
+		if healthCheckEndpoint != "" && healthCheckEndpoint != "/" {
+			return nil, actionerror.HTTPHealthCheckInvalidError{}
+		}
+		healthCheckEndpoint = ""
+	}
+
+	process, warnings, err := actor.CloudControllerClient.GetApplicationProcessByType(appGUID, config.Type)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		if _, ok := err.(ccerror.ProcessNotFoundError); ok {
+			return allWarnings, actionerror.ProcessNotFoundError{ProcessType: config.Type}
+		}
+		return allWarnings, err
+	}
+
+	if config.Command != "" {
+		warnings, err = actor.CloudControllerClient.PatchApplicationProcessCommand(process.GUID, config.Command)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	if config.HealthCheckType != "" {
+		warnings, err = actor.CloudControllerClient.PatchApplicationProcessHealthCheck(process.GUID, config.HealthCheckType, healthCheckEndpoint)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	if config.Instances.IsSet || config.MemoryInMB.IsSet || config.DiskInMB.IsSet {
+		warnings, err = actor.CloudControllerClient.CreateApplicationProcessScale(appGUID, ccv3.Process{
+			Type:       config.Type,
+			Instances:  config.Instances,
+			MemoryInMB: config.MemoryInMB,
+			DiskInMB:   config.DiskInMB,
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
@@ -0,0 +1,229 @@
+package v3action_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Process Configuration Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetManifestProcessConfigurations", func() {
+		var (
+			tmpDir         string
+			pathToManifest string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "process-configuration")
+			Expect(err).ToNot(HaveOccurred())
+			pathToManifest = filepath.Join(tmpDir, "manifest.yml")
+
+			manifest := []byte(`---
+applications:
+- name: some-app
+  processes:
+  - type: web
+    instances: 3
+    memory: 1G
+    health-check-type: http
+    health-check-http-endpoint: /health
+  - type: worker
+    command: bundle exec sidekiq
+    disk_quota: 512M
+- name: other-app
+`)
+			Expect(ioutil.WriteFile(pathToManifest, manifest, 0666)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		It("returns the processes of the app", func() {
+			configs, err := actor.GetManifestProcessConfigurations(pathToManifest, "some-app")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configs).To(Equal([]ProcessConfiguration{
+				{
+					Type:                "web",
+					HealthCheckEndpoint: "/health",
+					HealthCheckType:     "http",
+					Instances:           types.NullInt{IsSet: true, Value: 3},
+					MemoryInMB:          types.NullUint64{IsSet: true, Value: 1024},
+				},
+				{
+					Type:     "worker",
+					Command:  "bundle exec sidekiq",
+					DiskInMB: types.NullUint64{IsSet: true, Value: 512},
+				},
+			}))
+		})
+
+		Context("when the app has no processes section", func() {
+			It("returns no configurations", func() {
+				configs, err := actor.GetManifestProcessConfigurations(pathToManifest, "other-app")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(configs).To(BeEmpty())
+			})
+		})
+
+		Context("when the app is not in the manifest", func() {
+			It("returns an AppNotFoundInManifestError", func() {
+				_, err := actor.GetManifestProcessConfigurations(pathToManifest, "missing-app")
+				Expect(err).To(MatchError(actionerror.AppNotFoundInManifestError{Name: "missing-app"}))
+			})
+		})
+	})
+
+	Describe("ConfigureApplicationProcess", func() {
+		var (
+			config ProcessConfiguration
+
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			config = ProcessConfiguration{Type: "worker"}
+			fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+				ccv3.Process{GUID: "some-process-guid", Type: "worker"},
+				ccv3.Warnings{"get-process-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.PatchApplicationProcessCommandReturns(ccv3.Warnings{"patch-command-warning"}, nil)
+			fakeCloudControllerClient.PatchApplicationProcessHealthCheckReturns(ccv3.Warnings{"patch-health-check-warning"}, nil)
+			fakeCloudControllerClient.CreateApplicationProcessScaleReturns(ccv3.Warnings{"scale-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ConfigureApplicationProcess("some-app-guid", config)
+		})
+
+		Context("when only the type is set", func() {
+			It("does not change the process", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-process-warning"))
+
+				appGUID, processType := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(processType).To(Equal("worker"))
+
+				Expect(fakeCloudControllerClient.PatchApplicationProcessCommandCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.PatchApplicationProcessHealthCheckCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.CreateApplicationProcessScaleCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when every field is set", func() {
+			BeforeEach(func() {
+				config = ProcessConfiguration{
+					Type:                "worker",
+					Command:             "bundle exec sidekiq",
+					DiskInMB:            types.NullUint64{IsSet: true, Value: 512},
+					HealthCheckEndpoint: "/health",
+					HealthCheckType:     "http",
+					Instances:           types.NullInt{IsSet: true, Value: 2},
+					MemoryInMB:          types.NullUint64{IsSet: true, Value: 256},
+				}
+			})
+
+			It("updates the command, health check and scale of the process", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-process-warning", "patch-command-warning", "patch-health-check-warning", "scale-warning"))
+
+				processGUID, command := fakeCloudControllerClient.PatchApplicationProcessCommandArgsForCall(0)
+				Expect(processGUID).To(Equal("some-process-guid"))
+				Expect(command).To(Equal("bundle exec sidekiq"))
+
+				processGUID, healthCheckType, endpoint := fakeCloudControllerClient.PatchApplicationProcessHealthCheckArgsForCall(0)
+				Expect(processGUID).To(Equal("some-process-guid"))
+				Expect(healthCheckType).To(Equal("http"))
+				Expect(endpoint).To(Equal("/health"))
+
+				appGUID, process := fakeCloudControllerClient.CreateApplicationProcessScaleArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(process).To(Equal(ccv3.Process{
+					Type:       "worker",
+					Instances:  types.NullInt{IsSet: true, Value: 2},
+					MemoryInMB: types.NullUint64{IsSet: true, Value: 256},
+					DiskInMB:   types.NullUint64{IsSet: true, Value: 512},
+				}))
+			})
+		})
+
+		Context("when a non-http health check is given the default endpoint", func() {
+			BeforeEach(func() {
+				config.HealthCheckType = "port"
+				config.HealthCheckEndpoint = "/"
+			})
+
+			It("clears the endpoint", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, healthCheckType, endpoint := fakeCloudControllerClient.PatchApplicationProcessHealthCheckArgsForCall(0)
+				Expect(healthCheckType).To(Equal("port"))
+				Expect(endpoint).To(BeEmpty())
+			})
+		})
+
+		Context("when a non-http health check is given an endpoint", func() {
+			BeforeEach(func() {
+				config.HealthCheckType = "process"
+				config.HealthCheckEndpoint = "/health"
+			})
+
+			It("returns an HTTPHealthCheckInvalidError", func() {
+				Expect(executeErr).To(MatchError(actionerror.HTTPHealthCheckInvalidError{}))
+				Expect(fakeCloudControllerClient.GetApplicationProcessByTypeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the process does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(ccv3.Process{}, ccv3.Warnings{"get-process-warning"}, ccerror.ProcessNotFoundError{})
+			})
+
+			It("returns a ProcessNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessNotFoundError{ProcessType: "worker"}))
+				Expect(warnings).To(ConsistOf("get-process-warning"))
+			})
+		})
+
+		Context("when updating the command fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("patch error")
+				config.Command = "some-command"
+				config.Instances = types.NullInt{IsSet: true, Value: 2}
+				fakeCloudControllerClient.PatchApplicationProcessCommandReturns(ccv3.Warnings{"patch-command-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings without scaling", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-process-warning", "patch-command-warning"))
+				Expect(fakeCloudControllerClient.CreateApplicationProcessScaleCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,137 @@
+package v3action
+
+import (
+	"net/url"
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/util/rollbackhistory"
+)
+
+// Rollback describes moving an application from its current droplet to an
+// earlier one.
+type Rollback struct {
+	Application Application
+	// FromDroplet is the application's current droplet. It is empty when the
+	// application has no current droplet.
+	FromDroplet Droplet
+	ToDroplet   Droplet
+}
+
+// GetApplicationRollback returns the rollback of the application to
+// dropletGUID or, when dropletGUID is empty, to the staged droplet created
+// steps droplets before the current one.
+func (actor Actor) GetApplicationRollback(appName string, spaceGUID string, dropletGUID string, steps int) (Rollback, Warnings, error) {
+	rollback, stagedDroplets, allWarnings, err := actor.getRollbackCandidates(appName, spaceGUID)
+	if err != nil {
+		return Rollback{}, allWarnings, err
+	}
+
+	if dropletGUID != "" {
+		for _, droplet := range stagedDroplets {
+			if droplet.GUID == dropletGUID {
+				rollback.ToDroplet = actor.convertCCToActorDroplet(droplet)
+				return rollback, allWarnings, nil
+			}
+		}
+		return Rollback{}, allWarnings, actionerror.RollbackDropletNotFoundError{AppName: appName, DropletGUID: dropletGUID}
+	}
+
+	// Without a current droplet, the most recent staged droplet is one step
+	// back.
+	target := steps - 1
+	for i, droplet := range stagedDroplets {
+		if droplet.GUID == rollback.FromDroplet.GUID {
+			target = i + steps
+			break
+		}
+	}
+
+	if target < 0 || target >= len(stagedDroplets) {
+		return Rollback{}, allWarnings, actionerror.NoPreviousDropletError{AppName: appName}
+	}
+
+	rollback.ToDroplet = actor.convertCCToActorDroplet(stagedDroplets[target])
+	return rollback, allWarnings, nil
+}
+
+// GetApplicationRollbackUndo returns the rollback that restores the droplet
+// the application ran before it was last rolled back. The application's
+// droplet must not have changed since that rollback.
+func (actor Actor) GetApplicationRollbackUndo(appName string, spaceGUID string) (Rollback, Warnings, error) {
+	rollback, stagedDroplets, allWarnings, err := actor.getRollbackCandidates(appName, spaceGUID)
+	if err != nil {
+		return Rollback{}, allWarnings, err
+	}
+
+	entry, exists, err := rollbackhistory.NewStore(actor.Config.RollbackHistoryFilePath()).Get(rollback.Application.GUID)
+	if err != nil {
+		return Rollback{}, allWarnings, err
+	}
+
+	if !exists || entry.ToDropletGUID != rollback.FromDroplet.GUID {
+		return Rollback{}, allWarnings, actionerror.NoRollbackToUndoError{AppName: appName}
+	}
+
+	for _, droplet := range stagedDroplets {
+		if droplet.GUID == entry.FromDropletGUID {
+			rollback.ToDroplet = actor.convertCCToActorDroplet(droplet)
+			return rollback, allWarnings, nil
+		}
+	}
+
+	return Rollback{}, allWarnings, actionerror.RollbackDropletNotFoundError{AppName: appName, DropletGUID: entry.FromDropletGUID}
+}
+
+// RecordApplicationRollback remembers the droplet the application ran before
+// the rollback so that GetApplicationRollbackUndo can restore it.
+func (actor Actor) RecordApplicationRollback(rollback Rollback) error {
+	return rollbackhistory.NewStore(actor.Config.RollbackHistoryFilePath()).Record(rollbackhistory.Entry{
+		AppGUID:         rollback.Application.GUID,
+		AppName:         rollback.Application.Name,
+		FromDropletGUID: rollback.FromDroplet.GUID,
+		ToDropletGUID:   rollback.ToDroplet.GUID,
+	})
+}
+
+// getRollbackCandidates returns a Rollback with the application and its
+// current droplet filled in, along with the application's staged droplets
+// ordered from newest to oldest.
+func (actor Actor) getRollbackCandidates(appName string, spaceGUID string) (Rollback, []ccv3.Droplet, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return Rollback{}, nil, allWarnings, err
+	}
+	rollback := Rollback{Application: app}
+
+	currentDroplet, warnings, err := actor.CloudControllerClient.GetApplicationCurrentDroplet(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	switch err.(type) {
+	case nil:
+		rollback.FromDroplet = actor.convertCCToActorDroplet(currentDroplet)
+	case ccerror.DropletNotFoundError:
+	default:
+		return Rollback{}, nil, allWarnings, err
+	}
+
+	droplets, warnings, err := actor.CloudControllerClient.GetApplicationDroplets(app.GUID, url.Values{})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Rollback{}, nil, allWarnings, err
+	}
+
+	var stagedDroplets []ccv3.Droplet
+	for _, droplet := range droplets {
+		if droplet.State == ccv3.DropletStateStaged {
+			stagedDroplets = append(stagedDroplets, droplet)
+		}
+	}
+
+	sort.Slice(stagedDroplets, func(i int, j int) bool {
+		return stagedDroplets[i].CreatedAt > stagedDroplets[j].CreatedAt
+	})
+
+	return rollback, stagedDroplets, allWarnings, nil
+}
@@ -0,0 +1,185 @@
+package v3action_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/util/rollbackhistory"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rollback Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
+		tmpDir                    string
+		historyPath               string
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil)
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "rollback-actions")
+		Expect(err).ToNot(HaveOccurred())
+		historyPath = filepath.Join(tmpDir, "rollback_history.json")
+		fakeConfig.RollbackHistoryFilePathReturns(historyPath)
+
+		fakeCloudControllerClient.GetApplicationsReturns(
+			[]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}},
+			ccv3.Warnings{"get-applications-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetApplicationCurrentDropletReturns(
+			ccv3.Droplet{GUID: "droplet-3", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-03T00:00:00Z"},
+			ccv3.Warnings{"get-current-droplet-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetApplicationDropletsReturns(
+			[]ccv3.Droplet{
+				{GUID: "droplet-1", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-01T00:00:00Z"},
+				{GUID: "droplet-3", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-03T00:00:00Z"},
+				{GUID: "droplet-2", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-02T00:00:00Z"},
+				{GUID: "failed-droplet", State: ccv3.DropletStateFailed, CreatedAt: "2017-01-02T12:00:00Z"},
+				{GUID: "droplet-4", State: ccv3.DropletStateStaged, CreatedAt: "2017-01-04T00:00:00Z"},
+			},
+			ccv3.Warnings{"get-droplets-warning"},
+			nil,
+		)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	Describe("GetApplicationRollback", func() {
+		It("rolls back to the staged droplet before the current one", func() {
+			rollback, warnings, err := actor.GetApplicationRollback("some-app", "some-space-guid", "", 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-applications-warning", "get-current-droplet-warning", "get-droplets-warning"))
+
+			Expect(rollback.Application.GUID).To(Equal("some-app-guid"))
+			Expect(rollback.FromDroplet.GUID).To(Equal("droplet-3"))
+			Expect(rollback.ToDroplet.GUID).To(Equal("droplet-2"))
+		})
+
+		It("skips as many staged droplets as requested", func() {
+			rollback, _, err := actor.GetApplicationRollback("some-app", "some-space-guid", "", 2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rollback.ToDroplet.GUID).To(Equal("droplet-1"))
+		})
+
+		Context("when there are not enough earlier droplets", func() {
+			It("returns a NoPreviousDropletError", func() {
+				_, warnings, err := actor.GetApplicationRollback("some-app", "some-space-guid", "", 3)
+				Expect(err).To(MatchError(actionerror.NoPreviousDropletError{AppName: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-current-droplet-warning", "get-droplets-warning"))
+			})
+		})
+
+		Context("when the app has no current droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationCurrentDropletReturns(ccv3.Droplet{}, nil, ccerror.DropletNotFoundError{})
+			})
+
+			It("rolls back to the most recent staged droplet", func() {
+				rollback, _, err := actor.GetApplicationRollback("some-app", "some-space-guid", "", 1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rollback.FromDroplet).To(Equal(Droplet{}))
+				Expect(rollback.ToDroplet.GUID).To(Equal("droplet-4"))
+			})
+		})
+
+		Context("when a droplet GUID is provided", func() {
+			It("rolls back to that droplet", func() {
+				rollback, _, err := actor.GetApplicationRollback("some-app", "some-space-guid", "droplet-1", 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rollback.ToDroplet.GUID).To(Equal("droplet-1"))
+			})
+
+			Context("when the droplet is not a staged droplet of the app", func() {
+				It("returns a RollbackDropletNotFoundError", func() {
+					_, _, err := actor.GetApplicationRollback("some-app", "some-space-guid", "failed-droplet", 0)
+					Expect(err).To(MatchError(actionerror.RollbackDropletNotFoundError{AppName: "some-app", DropletGUID: "failed-droplet"}))
+				})
+			})
+		})
+
+		Context("when listing the droplets fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("droplets error")
+				fakeCloudControllerClient.GetApplicationDropletsReturns(nil, ccv3.Warnings{"get-droplets-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetApplicationRollback("some-app", "some-space-guid", "", 1)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-current-droplet-warning", "get-droplets-warning"))
+			})
+		})
+	})
+
+	Describe("RecordApplicationRollback and GetApplicationRollbackUndo", func() {
+		Context("when no rollback was recorded", func() {
+			It("returns a NoRollbackToUndoError", func() {
+				_, _, err := actor.GetApplicationRollbackUndo("some-app", "some-space-guid")
+				Expect(err).To(MatchError(actionerror.NoRollbackToUndoError{AppName: "some-app"}))
+			})
+		})
+
+		Context("when the current droplet is the one rolled back to", func() {
+			BeforeEach(func() {
+				Expect(actor.RecordApplicationRollback(Rollback{
+					Application: Application{Name: "some-app", GUID: "some-app-guid"},
+					FromDroplet: Droplet{GUID: "droplet-4"},
+					ToDroplet:   Droplet{GUID: "droplet-3"},
+				})).To(Succeed())
+			})
+
+			It("records the rollback in the history file", func() {
+				entry, exists, err := rollbackhistory.NewStore(historyPath).Get("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(exists).To(BeTrue())
+				Expect(entry.FromDropletGUID).To(Equal("droplet-4"))
+				Expect(entry.ToDropletGUID).To(Equal("droplet-3"))
+			})
+
+			It("returns the rollback to the droplet that was current before", func() {
+				rollback, warnings, err := actor.GetApplicationRollbackUndo("some-app", "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-current-droplet-warning", "get-droplets-warning"))
+				Expect(rollback.FromDroplet.GUID).To(Equal("droplet-3"))
+				Expect(rollback.ToDroplet.GUID).To(Equal("droplet-4"))
+			})
+		})
+
+		Context("when the app's droplet changed after the rollback", func() {
+			BeforeEach(func() {
+				Expect(actor.RecordApplicationRollback(Rollback{
+					Application: Application{Name: "some-app", GUID: "some-app-guid"},
+					FromDroplet: Droplet{GUID: "droplet-4"},
+					ToDroplet:   Droplet{GUID: "droplet-1"},
+				})).To(Succeed())
+			})
+
+			It("returns a NoRollbackToUndoError", func() {
+				_, _, err := actor.GetApplicationRollbackUndo("some-app", "some-space-guid")
+				Expect(err).To(MatchError(actionerror.NoRollbackToUndoError{AppName: "some-app"}))
+			})
+		})
+	})
+})
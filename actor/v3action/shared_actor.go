@@ -1,6 +1,10 @@
 package v3action
 
-import "code.cloudfoundry.org/cli/actor/sharedaction"
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+)
 
 //go:generate counterfeiter . SharedActor
 
@@ -9,5 +13,7 @@ type SharedActor interface {
 	GatherDirectoryResources(sourceDir string) ([]sharedaction.Resource, error)
 	ZipArchiveResources(sourceArchivePath string, filesToInclude []sharedaction.Resource) (string, error)
 	ZipDirectoryResources(sourceDir string, filesToInclude []sharedaction.Resource) (string, error)
+	ExecuteSecureCopy(copyOptions sharedaction.SecureCopyOptions, progressBar sharedaction.ProgressBar) error
 	ExecuteSecureShell(sshOptions sharedaction.SSHOptions) error
+	ExecuteSecureShellCommand(sshOptions sharedaction.SSHOptions, stdOut io.Writer, stdErr io.Writer) (int, error)
 }
@@ -2,39 +2,221 @@ package v3action
 
 import (
 	"fmt"
+	"io"
+	"sort"
+	"sync"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 )
 
 type SSHOptions struct {
-	Commands            []string
-	TTYOption           sharedaction.TTYOption
-	Forward             []string
-	SkipHostValidation  bool
-	SkipRemoteExecution bool
+	Commands                []string
+	TTYOption               sharedaction.TTYOption
+	LocalPortForwardSpecs   []sharedaction.LocalPortForward
+	RemotePortForwardSpecs  []sharedaction.RemotePortForward
+	DynamicPortForwardSpecs []sharedaction.DynamicPortForward
+	SkipHostValidation      bool
+	SkipRemoteExecution     bool
+}
+
+// SSHInstanceResult is the outcome of running a command on a single process
+// instance.
+type SSHInstanceResult struct {
+	Index      uint
+	ExitStatus int
+	Err        error
+}
+
+// SSHOutputFunc returns the writers a process instance's output should be
+// sent to.
+type SSHOutputFunc func(index uint) (stdOut io.Writer, stdErr io.Writer)
+
+type SecureCopyOptions struct {
+	Direction          sharedaction.FileTransferDirection
+	LocalPath          string
+	RemotePath         string
+	Recursive          bool
+	SkipHostValidation bool
+}
+
+// SSHAuthentication contains the information needed to connect to a process
+// instance over SSH.
+type SSHAuthentication struct {
+	Endpoint           string
+	HostKeyFingerprint string
+	Passcode           string
+	Username           string
 }
 
-// TODO: implement port forward
 func (actor Actor) ExecuteSecureShellByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint, sshOptions SSHOptions) (Warnings, error) {
+	sshAuth, warnings, err := actor.getSSHAuthenticationByApplicationNameSpaceProcessTypeAndIndex(appName, spaceGUID, processType, processIndex)
+	if err != nil {
+		return warnings, err
+	}
+
+	err = actor.SharedActor.ExecuteSecureShell(sharedaction.SSHOptions{
+		Username:                sshAuth.Username,
+		Commands:                sshOptions.Commands,
+		Passcode:                sshAuth.Passcode,
+		Endpoint:                sshAuth.Endpoint,
+		HostKeyFingerprint:      sshAuth.HostKeyFingerprint,
+		SkipHostValidation:      sshOptions.SkipHostValidation,
+		SkipRemoteExecution:     sshOptions.SkipRemoteExecution,
+		TTYOption:               sshOptions.TTYOption,
+		LocalPortForwardSpecs:   sshOptions.LocalPortForwardSpecs,
+		RemotePortForwardSpecs:  sshOptions.RemotePortForwardSpecs,
+		DynamicPortForwardSpecs: sshOptions.DynamicPortForwardSpecs,
+	})
+
+	return warnings, err
+}
+
+// ExecuteSecureCopyByApplicationNameSpaceProcessTypeAndIndex copies files to
+// or from the provided process instance.
+func (actor Actor) ExecuteSecureCopyByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint, copyOptions SecureCopyOptions, progressBar sharedaction.ProgressBar) (Warnings, error) {
+	sshAuth, warnings, err := actor.getSSHAuthenticationByApplicationNameSpaceProcessTypeAndIndex(appName, spaceGUID, processType, processIndex)
+	if err != nil {
+		return warnings, err
+	}
+
+	err = actor.SharedActor.ExecuteSecureCopy(sharedaction.SecureCopyOptions{
+		Username:           sshAuth.Username,
+		Passcode:           sshAuth.Passcode,
+		Endpoint:           sshAuth.Endpoint,
+		HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+		SkipHostValidation: copyOptions.SkipHostValidation,
+		Direction:          copyOptions.Direction,
+		LocalPath:          copyOptions.LocalPath,
+		RemotePath:         copyOptions.RemotePath,
+		Recursive:          copyOptions.Recursive,
+	}, progressBar)
+
+	return warnings, err
+}
+
+// ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessType runs
+// sshOptions.Commands on every running instance of the provided process, with
+// at most maxInFlight sessions open at a time. The results are sorted by
+// instance index.
+func (actor Actor) ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessType(appName string, spaceGUID string, processType string, sshOptions SSHOptions, maxInFlight int, outputs SSHOutputFunc) ([]SSHInstanceResult, Warnings, error) {
+	endpoint, fingerprint, err := actor.getSSHEndpointAndFingerprint()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	summary, warnings, err := actor.GetApplicationSummaryByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	var (
+		process      ProcessSummary
+		processFound bool
+	)
+	for _, processSummary := range summary.ProcessSummaries {
+		if processSummary.Type == processType {
+			process = processSummary
+			processFound = true
+			break
+		}
+	}
+
+	if !processFound {
+		return nil, warnings, actionerror.ProcessNotFoundError{ProcessType: processType}
+	}
+
+	if !summary.Application.Started() {
+		return nil, warnings, actionerror.ApplicationNotStartedError{Name: appName}
+	}
+
+	var runningIndexes []uint
+	for _, instance := range process.InstanceDetails {
+		if instance.State == "RUNNING" {
+			runningIndexes = append(runningIndexes, uint(instance.Index))
+		}
+	}
+
+	if len(runningIndexes) == 0 {
+		return nil, warnings, actionerror.NoRunningProcessInstancesError{ProcessType: processType}
+	}
+
+	if maxInFlight < 1 {
+		maxInFlight = 1
+	}
+
+	var (
+		wg        sync.WaitGroup
+		lock      sync.Mutex
+		results   []SSHInstanceResult
+		semaphore = make(chan struct{}, maxInFlight)
+	)
+	for _, index := range runningIndexes {
+		wg.Add(1)
+		go func(index uint) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			result := SSHInstanceResult{Index: index}
+
+			passcode, passcodeErr := actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
+			if passcodeErr != nil {
+				result.Err = passcodeErr
+			} else {
+				stdOut, stdErr := outputs(index)
+				result.ExitStatus, result.Err = actor.SharedActor.ExecuteSecureShellCommand(sharedaction.SSHOptions{
+					Username:           fmt.Sprintf("cf:%s/%d", process.GUID, index),
+					Commands:           sshOptions.Commands,
+					Passcode:           passcode,
+					Endpoint:           endpoint,
+					HostKeyFingerprint: fingerprint,
+					SkipHostValidation: sshOptions.SkipHostValidation,
+				}, stdOut, stdErr)
+			}
+
+			lock.Lock()
+			results = append(results, result)
+			lock.Unlock()
+		}(index)
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i int, j int) bool {
+		return results[i].Index < results[j].Index
+	})
+
+	return results, warnings, nil
+}
+
+func (actor Actor) getSSHEndpointAndFingerprint() (string, string, error) {
 	endpoint := actor.CloudControllerClient.AppSSHEndpoint()
 	if endpoint == "" {
-		return nil, actionerror.SSHEndpointNotSetError{}
+		return "", "", actionerror.SSHEndpointNotSetError{}
 	}
 
 	fingerprint := actor.CloudControllerClient.AppSSHHostKeyFingerprint()
 	if fingerprint == "" {
-		return nil, actionerror.SSHHostKeyFingerprintNotSetError{}
+		return "", "", actionerror.SSHHostKeyFingerprintNotSetError{}
+	}
+
+	return endpoint, fingerprint, nil
+}
+
+func (actor Actor) getSSHAuthenticationByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint) (SSHAuthentication, Warnings, error) {
+	endpoint, fingerprint, err := actor.getSSHEndpointAndFingerprint()
+	if err != nil {
+		return SSHAuthentication{}, nil, err
 	}
 
 	passcode, err := actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
 	if err != nil {
-		return Warnings{}, err
+		return SSHAuthentication{}, Warnings{}, err
 	}
 
 	summary, warnings, err := actor.GetApplicationSummaryByNameAndSpace(appName, spaceGUID)
 	if err != nil {
-		return warnings, err
+		return SSHAuthentication{}, warnings, err
 	}
 
 	var processGUID string
@@ -42,30 +224,24 @@ func (actor Actor) ExecuteSecureShellByApplicationNameSpaceProcessTypeAndIndex(a
 		if process.Type == processType {
 			processGUID = process.GUID
 			if uint(process.Instances.Value) < processIndex+1 {
-				return warnings, actionerror.ProcessInstanceNotFoundError{ProcessType: processType, InstanceIndex: processIndex}
+				return SSHAuthentication{}, warnings, actionerror.ProcessInstanceNotFoundError{ProcessType: processType, InstanceIndex: processIndex}
 			}
 			break
 		}
 	}
 
 	if processGUID == "" {
-		return warnings, actionerror.ProcessNotFoundError{ProcessType: processType}
+		return SSHAuthentication{}, warnings, actionerror.ProcessNotFoundError{ProcessType: processType}
 	}
 
 	if !summary.Application.Started() {
-		return warnings, actionerror.ApplicationNotStartedError{Name: appName}
+		return SSHAuthentication{}, warnings, actionerror.ApplicationNotStartedError{Name: appName}
 	}
 
-	err = actor.SharedActor.ExecuteSecureShell(sharedaction.SSHOptions{
-		Username:            fmt.Sprintf("cf:%s/%d", processGUID, processIndex),
-		Commands:            sshOptions.Commands,
-		Passcode:            passcode,
-		Endpoint:            endpoint,
-		HostKeyFingerprint:  fingerprint,
-		SkipHostValidation:  sshOptions.SkipHostValidation,
-		SkipRemoteExecution: sshOptions.SkipRemoteExecution,
-		TTYOption:           sshOptions.TTYOption,
-	})
-
-	return warnings, err
+	return SSHAuthentication{
+		Endpoint:           endpoint,
+		HostKeyFingerprint: fingerprint,
+		Passcode:           passcode,
+		Username:           fmt.Sprintf("cf:%s/%d", processGUID, processIndex),
+	}, warnings, nil
 }
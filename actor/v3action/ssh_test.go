@@ -1,11 +1,14 @@
 package v3action_test
 
 import (
+	"bytes"
 	"errors"
+	"io"
 	"net/url"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -41,10 +44,13 @@ var _ = Describe("SSH Actions", func() {
 
 		JustBeforeEach(func() {
 			warnings, executeErr = actor.ExecuteSecureShellByApplicationNameSpaceProcessTypeAndIndex("some-app", "some-space-guid", "some-process-type", 0, SSHOptions{
-				Commands:            []string{"some-command"},
-				TTYOption:           sharedaction.RequestTTYForce,
-				SkipHostValidation:  true,
-				SkipRemoteExecution: true,
+				Commands:                []string{"some-command"},
+				TTYOption:               sharedaction.RequestTTYForce,
+				LocalPortForwardSpecs:   []sharedaction.LocalPortForward{{LocalAddress: "localhost:8080", RemoteAddress: "localhost:80"}},
+				RemotePortForwardSpecs:  []sharedaction.RemotePortForward{{RemoteAddress: "localhost:9090", LocalAddress: "localhost:90"}},
+				DynamicPortForwardSpecs: []sharedaction.DynamicPortForward{{LocalAddress: "localhost:1080"}},
+				SkipHostValidation:      true,
+				SkipRemoteExecution:     true,
 			})
 		})
 
@@ -162,14 +168,17 @@ var _ = Describe("SSH Actions", func() {
 
 								Expect(fakeSharedActor.ExecuteSecureShellCallCount()).To(Equal(1))
 								Expect(fakeSharedActor.ExecuteSecureShellArgsForCall(0)).To(Equal(sharedaction.SSHOptions{
-									Commands:            []string{"some-command"},
-									Username:            "cf:some-process-guid/0",
-									Passcode:            "some-ssh-passcode",
-									Endpoint:            "some-app-ssh-endpoint",
-									HostKeyFingerprint:  "some-app-ssh-fingerprint",
-									TTYOption:           sharedaction.RequestTTYForce,
-									SkipHostValidation:  true,
-									SkipRemoteExecution: true,
+									Commands:                []string{"some-command"},
+									Username:                "cf:some-process-guid/0",
+									Passcode:                "some-ssh-passcode",
+									Endpoint:                "some-app-ssh-endpoint",
+									HostKeyFingerprint:      "some-app-ssh-fingerprint",
+									TTYOption:               sharedaction.RequestTTYForce,
+									LocalPortForwardSpecs:   []sharedaction.LocalPortForward{{LocalAddress: "localhost:8080", RemoteAddress: "localhost:80"}},
+									RemotePortForwardSpecs:  []sharedaction.RemotePortForward{{RemoteAddress: "localhost:9090", LocalAddress: "localhost:90"}},
+									DynamicPortForwardSpecs: []sharedaction.DynamicPortForward{{LocalAddress: "localhost:1080"}},
+									SkipHostValidation:      true,
+									SkipRemoteExecution:     true,
 								}))
 
 								Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
@@ -184,4 +193,212 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("ExecuteSecureCopyByApplicationNameSpaceProcessTypeAndIndex", func() {
+		var fakeProgressBar *sharedactionfakes.FakeProgressBar
+
+		BeforeEach(func() {
+			fakeProgressBar = new(sharedactionfakes.FakeProgressBar)
+
+			fakeConfig.AccessTokenReturns("some-access-token")
+			fakeConfig.SSHOAuthClientReturns("some-access-oauth-client")
+			fakeCloudControllerClient.AppSSHEndpointReturns("some-app-ssh-endpoint")
+			fakeCloudControllerClient.AppSSHHostKeyFingerprintReturns("some-app-ssh-fingerprint")
+			fakeUAAClient.GetSSHPasscodeReturns("some-ssh-passcode", nil)
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", State: "STARTED"}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{{Type: "some-process-type", GUID: "some-process-guid", Instances: types.NullInt{IsSet: true, Value: 2}}}, ccv3.Warnings{"some-process-warnings"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ExecuteSecureCopyByApplicationNameSpaceProcessTypeAndIndex("some-app", "some-space-guid", "some-process-type", 1, SecureCopyOptions{
+				Direction:          sharedaction.FileTransferDownload,
+				LocalPath:          "some-local-path",
+				RemotePath:         "some-remote-path",
+				Recursive:          true,
+				SkipHostValidation: true,
+			}, fakeProgressBar)
+		})
+
+		Context("when the app ssh endpoint is empty", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.AppSSHEndpointReturns("")
+			})
+
+			It("returns an SSHEndpointNotSetError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SSHEndpointNotSetError{}))
+				Expect(fakeSharedActor.ExecuteSecureCopyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the process instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{{Type: "some-process-type", GUID: "some-process-guid", Instances: types.NullInt{IsSet: true, Value: 1}}}, ccv3.Warnings{"some-process-warnings"}, nil)
+			})
+
+			It("returns a ProcessInstanceNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 1}))
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings"))
+			})
+		})
+
+		Context("when copying the files fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.ExecuteSecureCopyReturns(errors.New("some-copy-error"))
+			})
+
+			It("returns all warnings and the error", func() {
+				Expect(executeErr).To(MatchError("some-copy-error"))
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings"))
+			})
+		})
+
+		Context("when copying the files succeeds", func() {
+			It("copies the files with the process instance's credentials", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings"))
+
+				Expect(fakeSharedActor.ExecuteSecureCopyCallCount()).To(Equal(1))
+				copyOptionsArg, progressBarArg := fakeSharedActor.ExecuteSecureCopyArgsForCall(0)
+				Expect(copyOptionsArg).To(Equal(sharedaction.SecureCopyOptions{
+					Username:           "cf:some-process-guid/1",
+					Passcode:           "some-ssh-passcode",
+					Endpoint:           "some-app-ssh-endpoint",
+					HostKeyFingerprint: "some-app-ssh-fingerprint",
+					SkipHostValidation: true,
+					Direction:          sharedaction.FileTransferDownload,
+					LocalPath:          "some-local-path",
+					RemotePath:         "some-remote-path",
+					Recursive:          true,
+				}))
+				Expect(progressBarArg).To(Equal(fakeProgressBar))
+			})
+		})
+	})
+
+	Describe("ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessType", func() {
+		var (
+			results []SSHInstanceResult
+			outputs map[uint]*bytes.Buffer
+		)
+
+		BeforeEach(func() {
+			fakeConfig.AccessTokenReturns("some-access-token")
+			fakeConfig.SSHOAuthClientReturns("some-access-oauth-client")
+			fakeCloudControllerClient.AppSSHEndpointReturns("some-app-ssh-endpoint")
+			fakeCloudControllerClient.AppSSHHostKeyFingerprintReturns("some-app-ssh-fingerprint")
+			fakeUAAClient.GetSSHPasscodeReturns("some-ssh-passcode", nil)
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", State: "STARTED"}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{{Type: "some-process-type", GUID: "some-process-guid", Instances: types.NullInt{IsSet: true, Value: 3}}}, ccv3.Warnings{"some-process-warnings"}, nil)
+			fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.Instance{
+				{Index: 2, State: "RUNNING"},
+				{Index: 0, State: "RUNNING"},
+				{Index: 1, State: "CRASHED"},
+			}, ccv3.Warnings{"some-instance-warnings"}, nil)
+
+			outputs = map[uint]*bytes.Buffer{0: new(bytes.Buffer), 2: new(bytes.Buffer)}
+		})
+
+		JustBeforeEach(func() {
+			results, warnings, executeErr = actor.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessType("some-app", "some-space-guid", "some-process-type", SSHOptions{
+				Commands:           []string{"some-command"},
+				SkipHostValidation: true,
+			}, 2, func(index uint) (io.Writer, io.Writer) {
+				return outputs[index], outputs[index]
+			})
+		})
+
+		Context("when the app ssh hostkey fingerprint is empty", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.AppSSHHostKeyFingerprintReturns("")
+			})
+
+			It("returns an SSHHostKeyFingerprintNotSetError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SSHHostKeyFingerprintNotSetError{}))
+				Expect(fakeSharedActor.ExecuteSecureShellCommandCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the process does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{{Type: "some-other-type", GUID: "some-process-guid"}}, ccv3.Warnings{"some-process-warnings"}, nil)
+			})
+
+			It("returns a ProcessNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessNotFoundError{ProcessType: "some-process-type"}))
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+			})
+		})
+
+		Context("when the application is not started", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", State: "STOPPED"}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			})
+
+			It("returns an ApplicationNotStartedError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotStartedError{Name: "some-app"}))
+			})
+		})
+
+		Context("when no instances are running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.Instance{{Index: 0, State: "CRASHED"}}, nil, nil)
+			})
+
+			It("returns a NoRunningProcessInstancesError", func() {
+				Expect(executeErr).To(MatchError(actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"}))
+				Expect(fakeSharedActor.ExecuteSecureShellCommandCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the instances are running", func() {
+			BeforeEach(func() {
+				fakeSharedActor.ExecuteSecureShellCommandStub = func(sshOptions sharedaction.SSHOptions, stdOut io.Writer, stdErr io.Writer) (int, error) {
+					switch sshOptions.Username {
+					case "cf:some-process-guid/0":
+						_, _ = stdOut.Write([]byte("output-0"))
+						return 0, nil
+					default:
+						_, _ = stdErr.Write([]byte("output-2"))
+						return 0, errors.New("some-session-error")
+					}
+				}
+			})
+
+			It("runs the command on every running instance and returns the results sorted by index", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+
+				Expect(results).To(Equal([]SSHInstanceResult{
+					{Index: 0, ExitStatus: 0},
+					{Index: 2, ExitStatus: 0, Err: errors.New("some-session-error")},
+				}))
+				Expect(outputs[0].String()).To(Equal("output-0"))
+				Expect(outputs[2].String()).To(Equal("output-2"))
+
+				Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(2))
+				Expect(fakeSharedActor.ExecuteSecureShellCommandCallCount()).To(Equal(2))
+				sshOptionsArg, _, _ := fakeSharedActor.ExecuteSecureShellCommandArgsForCall(0)
+				Expect(sshOptionsArg.Commands).To(Equal([]string{"some-command"}))
+				Expect(sshOptionsArg.Passcode).To(Equal("some-ssh-passcode"))
+				Expect(sshOptionsArg.Endpoint).To(Equal("some-app-ssh-endpoint"))
+				Expect(sshOptionsArg.HostKeyFingerprint).To(Equal("some-app-ssh-fingerprint"))
+				Expect(sshOptionsArg.SkipHostValidation).To(BeTrue())
+			})
+
+			Context("when getting a passcode fails", func() {
+				BeforeEach(func() {
+					fakeUAAClient.GetSSHPasscodeReturns("", errors.New("some-passcode-error"))
+				})
+
+				It("records the error for each instance", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(results).To(Equal([]SSHInstanceResult{
+						{Index: 0, Err: errors.New("some-passcode-error")},
+						{Index: 2, Err: errors.New("some-passcode-error")},
+					}))
+					Expect(fakeSharedActor.ExecuteSecureShellCommandCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
 })
@@ -27,6 +27,15 @@ type FakeConfig struct {
 	pollingIntervalReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	RollbackHistoryFilePathStub        func() string
+	rollbackHistoryFilePathMutex       sync.RWMutex
+	rollbackHistoryFilePathArgsForCall []struct{}
+	rollbackHistoryFilePathReturns     struct {
+		result1 string
+	}
+	rollbackHistoryFilePathReturnsOnCall map[int]struct {
+		result1 string
+	}
 	SSHOAuthClientStub        func() string
 	sSHOAuthClientMutex       sync.RWMutex
 	sSHOAuthClientArgsForCall []struct{}
@@ -138,6 +147,46 @@ func (fake *FakeConfig) PollingIntervalReturnsOnCall(i int, result1 time.Duratio
 	}{result1}
 }
 
+func (fake *FakeConfig) RollbackHistoryFilePath() string {
+	fake.rollbackHistoryFilePathMutex.Lock()
+	ret, specificReturn := fake.rollbackHistoryFilePathReturnsOnCall[len(fake.rollbackHistoryFilePathArgsForCall)]
+	fake.rollbackHistoryFilePathArgsForCall = append(fake.rollbackHistoryFilePathArgsForCall, struct{}{})
+	fake.recordInvocation("RollbackHistoryFilePath", []interface{}{})
+	fake.rollbackHistoryFilePathMutex.Unlock()
+	if fake.RollbackHistoryFilePathStub != nil {
+		return fake.RollbackHistoryFilePathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.rollbackHistoryFilePathReturns.result1
+}
+
+func (fake *FakeConfig) RollbackHistoryFilePathCallCount() int {
+	fake.rollbackHistoryFilePathMutex.RLock()
+	defer fake.rollbackHistoryFilePathMutex.RUnlock()
+	return len(fake.rollbackHistoryFilePathArgsForCall)
+}
+
+func (fake *FakeConfig) RollbackHistoryFilePathReturns(result1 string) {
+	fake.RollbackHistoryFilePathStub = nil
+	fake.rollbackHistoryFilePathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) RollbackHistoryFilePathReturnsOnCall(i int, result1 string) {
+	fake.RollbackHistoryFilePathStub = nil
+	if fake.rollbackHistoryFilePathReturnsOnCall == nil {
+		fake.rollbackHistoryFilePathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.rollbackHistoryFilePathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) SSHOAuthClient() string {
 	fake.sSHOAuthClientMutex.Lock()
 	ret, specificReturn := fake.sSHOAuthClientReturnsOnCall[len(fake.sSHOAuthClientArgsForCall)]
@@ -265,6 +314,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.accessTokenMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
+	fake.rollbackHistoryFilePathMutex.RLock()
+	defer fake.rollbackHistoryFilePathMutex.RUnlock()
 	fake.sSHOAuthClientMutex.RLock()
 	defer fake.sSHOAuthClientMutex.RUnlock()
 	fake.startupTimeoutMutex.RLock()
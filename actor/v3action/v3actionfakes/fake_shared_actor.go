@@ -2,6 +2,7 @@
 package v3actionfakes
 
 import (
+	"io"
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -63,6 +64,18 @@ type FakeSharedActor struct {
 		result1 string
 		result2 error
 	}
+	ExecuteSecureCopyStub        func(copyOptions sharedaction.SecureCopyOptions, progressBar sharedaction.ProgressBar) error
+	executeSecureCopyMutex       sync.RWMutex
+	executeSecureCopyArgsForCall []struct {
+		copyOptions sharedaction.SecureCopyOptions
+		progressBar sharedaction.ProgressBar
+	}
+	executeSecureCopyReturns struct {
+		result1 error
+	}
+	executeSecureCopyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ExecuteSecureShellStub        func(sshOptions sharedaction.SSHOptions) error
 	executeSecureShellMutex       sync.RWMutex
 	executeSecureShellArgsForCall []struct {
@@ -74,6 +87,21 @@ type FakeSharedActor struct {
 	executeSecureShellReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ExecuteSecureShellCommandStub        func(sshOptions sharedaction.SSHOptions, stdOut io.Writer, stdErr io.Writer) (int, error)
+	executeSecureShellCommandMutex       sync.RWMutex
+	executeSecureShellCommandArgsForCall []struct {
+		sshOptions sharedaction.SSHOptions
+		stdOut     io.Writer
+		stdErr     io.Writer
+	}
+	executeSecureShellCommandReturns struct {
+		result1 int
+		result2 error
+	}
+	executeSecureShellCommandReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -294,6 +322,55 @@ func (fake *FakeSharedActor) ZipDirectoryResourcesReturnsOnCall(i int, result1 s
 	}{result1, result2}
 }
 
+func (fake *FakeSharedActor) ExecuteSecureCopy(copyOptions sharedaction.SecureCopyOptions, progressBar sharedaction.ProgressBar) error {
+	fake.executeSecureCopyMutex.Lock()
+	ret, specificReturn := fake.executeSecureCopyReturnsOnCall[len(fake.executeSecureCopyArgsForCall)]
+	fake.executeSecureCopyArgsForCall = append(fake.executeSecureCopyArgsForCall, struct {
+		copyOptions sharedaction.SecureCopyOptions
+		progressBar sharedaction.ProgressBar
+	}{copyOptions, progressBar})
+	fake.recordInvocation("ExecuteSecureCopy", []interface{}{copyOptions, progressBar})
+	fake.executeSecureCopyMutex.Unlock()
+	if fake.ExecuteSecureCopyStub != nil {
+		return fake.ExecuteSecureCopyStub(copyOptions, progressBar)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.executeSecureCopyReturns.result1
+}
+
+func (fake *FakeSharedActor) ExecuteSecureCopyCallCount() int {
+	fake.executeSecureCopyMutex.RLock()
+	defer fake.executeSecureCopyMutex.RUnlock()
+	return len(fake.executeSecureCopyArgsForCall)
+}
+
+func (fake *FakeSharedActor) ExecuteSecureCopyArgsForCall(i int) (sharedaction.SecureCopyOptions, sharedaction.ProgressBar) {
+	fake.executeSecureCopyMutex.RLock()
+	defer fake.executeSecureCopyMutex.RUnlock()
+	return fake.executeSecureCopyArgsForCall[i].copyOptions, fake.executeSecureCopyArgsForCall[i].progressBar
+}
+
+func (fake *FakeSharedActor) ExecuteSecureCopyReturns(result1 error) {
+	fake.ExecuteSecureCopyStub = nil
+	fake.executeSecureCopyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSharedActor) ExecuteSecureCopyReturnsOnCall(i int, result1 error) {
+	fake.ExecuteSecureCopyStub = nil
+	if fake.executeSecureCopyReturnsOnCall == nil {
+		fake.executeSecureCopyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.executeSecureCopyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSharedActor) ExecuteSecureShell(sshOptions sharedaction.SSHOptions) error {
 	fake.executeSecureShellMutex.Lock()
 	ret, specificReturn := fake.executeSecureShellReturnsOnCall[len(fake.executeSecureShellArgsForCall)]
@@ -342,6 +419,59 @@ func (fake *FakeSharedActor) ExecuteSecureShellReturnsOnCall(i int, result1 erro
 	}{result1}
 }
 
+func (fake *FakeSharedActor) ExecuteSecureShellCommand(sshOptions sharedaction.SSHOptions, stdOut io.Writer, stdErr io.Writer) (int, error) {
+	fake.executeSecureShellCommandMutex.Lock()
+	ret, specificReturn := fake.executeSecureShellCommandReturnsOnCall[len(fake.executeSecureShellCommandArgsForCall)]
+	fake.executeSecureShellCommandArgsForCall = append(fake.executeSecureShellCommandArgsForCall, struct {
+		sshOptions sharedaction.SSHOptions
+		stdOut     io.Writer
+		stdErr     io.Writer
+	}{sshOptions, stdOut, stdErr})
+	fake.recordInvocation("ExecuteSecureShellCommand", []interface{}{sshOptions, stdOut, stdErr})
+	fake.executeSecureShellCommandMutex.Unlock()
+	if fake.ExecuteSecureShellCommandStub != nil {
+		return fake.ExecuteSecureShellCommandStub(sshOptions, stdOut, stdErr)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.executeSecureShellCommandReturns.result1, fake.executeSecureShellCommandReturns.result2
+}
+
+func (fake *FakeSharedActor) ExecuteSecureShellCommandCallCount() int {
+	fake.executeSecureShellCommandMutex.RLock()
+	defer fake.executeSecureShellCommandMutex.RUnlock()
+	return len(fake.executeSecureShellCommandArgsForCall)
+}
+
+func (fake *FakeSharedActor) ExecuteSecureShellCommandArgsForCall(i int) (sharedaction.SSHOptions, io.Writer, io.Writer) {
+	fake.executeSecureShellCommandMutex.RLock()
+	defer fake.executeSecureShellCommandMutex.RUnlock()
+	return fake.executeSecureShellCommandArgsForCall[i].sshOptions, fake.executeSecureShellCommandArgsForCall[i].stdOut, fake.executeSecureShellCommandArgsForCall[i].stdErr
+}
+
+func (fake *FakeSharedActor) ExecuteSecureShellCommandReturns(result1 int, result2 error) {
+	fake.ExecuteSecureShellCommandStub = nil
+	fake.executeSecureShellCommandReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSharedActor) ExecuteSecureShellCommandReturnsOnCall(i int, result1 int, result2 error) {
+	fake.ExecuteSecureShellCommandStub = nil
+	if fake.executeSecureShellCommandReturnsOnCall == nil {
+		fake.executeSecureShellCommandReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.executeSecureShellCommandReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSharedActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -353,8 +483,12 @@ func (fake *FakeSharedActor) Invocations() map[string][][]interface{} {
 	defer fake.zipArchiveResourcesMutex.RUnlock()
 	fake.zipDirectoryResourcesMutex.RLock()
 	defer fake.zipDirectoryResourcesMutex.RUnlock()
+	fake.executeSecureCopyMutex.RLock()
+	defer fake.executeSecureCopyMutex.RUnlock()
 	fake.executeSecureShellMutex.RLock()
 	defer fake.executeSecureShellMutex.RUnlock()
+	fake.executeSecureShellCommandMutex.RLock()
+	defer fake.executeSecureShellCommandMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,11 @@
+package ccerror
+
+// BuildpackNameTakenError is returned when creating a buildpack with a name
+// (and stack) that is already in use.
+type BuildpackNameTakenError struct {
+	Message string
+}
+
+func (e BuildpackNameTakenError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,263 @@
+package ccv2
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// Buildpack represents a Cloud Controller Buildpack.
+type Buildpack struct {
+	GUID string
+	Name string
+
+	// Position is the order in which the buildpack is tried during buildpack
+	// detection.
+	Position types.NullInt
+
+	// Enabled is true when the buildpack can be used for staging.
+	Enabled types.NullBool
+
+	// Locked is true when the buildpack's bits cannot be updated.
+	Locked types.NullBool
+
+	// Stack is the name of the stack the buildpack is for. It is empty when
+	// the buildpack can be used with any stack.
+	Stack string
+
+	// Filename is the name of the file the buildpack's bits were uploaded as.
+	Filename string
+}
+
+// MarshalJSON converts a buildpack into a Cloud Controller Buildpack. Only
+// fields that are set are included.
+func (buildpack Buildpack) MarshalJSON() ([]byte, error) {
+	ccBuildpack := struct {
+		Name     string `json:"name,omitempty"`
+		Position *int   `json:"position,omitempty"`
+		Enabled  *bool  `json:"enabled,omitempty"`
+		Locked   *bool  `json:"locked,omitempty"`
+		Stack    string `json:"stack,omitempty"`
+	}{
+		Name:  buildpack.Name,
+		Stack: buildpack.Stack,
+	}
+
+	if buildpack.Position.IsSet {
+		ccBuildpack.Position = &buildpack.Position.Value
+	}
+	if buildpack.Enabled.IsSet {
+		ccBuildpack.Enabled = &buildpack.Enabled.Value
+	}
+	if buildpack.Locked.IsSet {
+		ccBuildpack.Locked = &buildpack.Locked.Value
+	}
+
+	return json.Marshal(ccBuildpack)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Buildpack response.
+func (buildpack *Buildpack) UnmarshalJSON(data []byte) error {
+	var ccBuildpack struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Name     string         `json:"name"`
+			Position types.NullInt  `json:"position"`
+			Enabled  types.NullBool `json:"enabled"`
+			Locked   types.NullBool `json:"locked"`
+			Stack    string         `json:"stack"`
+			Filename string         `json:"filename"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccBuildpack); err != nil {
+		return err
+	}
+
+	buildpack.GUID = ccBuildpack.Metadata.GUID
+	buildpack.Name = ccBuildpack.Entity.Name
+	buildpack.Position = ccBuildpack.Entity.Position
+	buildpack.Enabled = ccBuildpack.Entity.Enabled
+	buildpack.Locked = ccBuildpack.Entity.Locked
+	buildpack.Stack = ccBuildpack.Entity.Stack
+	buildpack.Filename = ccBuildpack.Entity.Filename
+	return nil
+}
+
+// CreateBuildpack creates a buildpack without any bits.
+func (client *Client) CreateBuildpack(buildpack Buildpack) (Buildpack, Warnings, error) {
+	bodyBytes, err := json.Marshal(buildpack)
+	if err != nil {
+		return Buildpack{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostBuildpackRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Buildpack{}, nil, err
+	}
+
+	var createdBuildpack Buildpack
+	response := cloudcontroller.Response{
+		Result: &createdBuildpack,
+	}
+
+	err = client.connection.Make(request, &response)
+	return createdBuildpack, response.Warnings, err
+}
+
+// DownloadBuildpack writes the bits of the buildpack with the provided GUID
+// to output as they are received.
+func (client *Client) DownloadBuildpack(buildpackGUID string, output io.Writer) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetBuildpackBitsRequest,
+		URIParams:   Params{"buildpack_guid": buildpackGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{
+		Writer: output,
+	}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// GetBuildpacks returns a list of Buildpacks based off of the provided
+// queries.
+func (client *Client) GetBuildpacks(queries ...Query) ([]Buildpack, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetBuildpacksRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullBuildpacksList []Buildpack
+	warnings, err := client.paginate(request, Buildpack{}, func(item interface{}) error {
+		if buildpack, ok := item.(Buildpack); ok {
+			fullBuildpacksList = append(fullBuildpacksList, buildpack)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Buildpack{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullBuildpacksList, warnings, err
+}
+
+// UpdateBuildpack updates the buildpack with the GUID of the provided
+// buildpack. Only the fields that are set are changed.
+func (client *Client) UpdateBuildpack(buildpack Buildpack) (Buildpack, Warnings, error) {
+	bodyBytes, err := json.Marshal(buildpack)
+	if err != nil {
+		return Buildpack{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutBuildpackRequest,
+		URIParams:   Params{"buildpack_guid": buildpack.GUID},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Buildpack{}, nil, err
+	}
+
+	var updatedBuildpack Buildpack
+	response := cloudcontroller.Response{
+		Result: &updatedBuildpack,
+	}
+
+	err = client.connection.Make(request, &response)
+	return updatedBuildpack, response.Warnings, err
+}
+
+// UploadBuildpack uploads the contents of a buildpack zip to the buildpack
+// with the provided GUID, naming the upload filename. The bits are streamed,
+// so a failed request cannot be retried.
+func (client *Client) UploadBuildpack(buildpackGUID string, filename string, bits io.Reader, bitsLength int64) (Warnings, error) {
+	contentLength, err := buildpackRequestSize(filename, bitsLength)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType, body, writeErrors := createMultipartBodyAndHeaderForBuildpack(filename, bits)
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutBuildpackBitsRequest,
+		URIParams:   Params{"buildpack_guid": buildpackGUID},
+		Body:        body,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-Type", contentType)
+	request.ContentLength = contentLength
+
+	response := cloudcontroller.Response{}
+
+	httpErrors := client.uploadBits(request, &response)
+	err = waitForUpload(writeErrors, httpErrors)
+	return response.Warnings, err
+}
+
+func createMultipartBodyAndHeaderForBuildpack(filename string, bits io.Reader) (string, io.ReadSeeker, <-chan error) {
+	writerOutput, writerInput := cloudcontroller.NewPipeBomb()
+	form := multipart.NewWriter(writerInput)
+
+	writeErrors := make(chan error)
+
+	go func() {
+		defer close(writeErrors)
+		defer writerInput.Close()
+
+		writer, err := form.CreateFormFile("buildpack", filename)
+		if err != nil {
+			writeErrors <- err
+			return
+		}
+
+		_, err = io.Copy(writer, bits)
+		if err != nil {
+			writeErrors <- err
+			return
+		}
+
+		err = form.Close()
+		if err != nil {
+			writeErrors <- err
+		}
+	}()
+
+	return form.FormDataContentType(), writerOutput, writeErrors
+}
+
+func buildpackRequestSize(filename string, bitsLength int64) (int64, error) {
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+
+	_, err := form.CreateFormFile("buildpack", filename)
+	if err != nil {
+		return 0, err
+	}
+	err = form.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(body.Len()) + bitsLength, nil
+}
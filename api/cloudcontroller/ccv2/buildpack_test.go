@@ -0,0 +1,254 @@
+package ccv2_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Buildpack", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateBuildpack", func() {
+		Context("when the buildpack is created", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-buildpack-guid"
+					},
+					"entity": {
+						"name": "some-buildpack",
+						"position": 3,
+						"enabled": false,
+						"locked": false,
+						"stack": "cflinuxfs2",
+						"filename": null
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/buildpacks"),
+						VerifyJSON(`{"name":"some-buildpack","position":3,"enabled":false,"stack":"cflinuxfs2"}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the buildpack and warnings", func() {
+				buildpack, warnings, err := client.CreateBuildpack(Buildpack{
+					Name:     "some-buildpack",
+					Position: types.NullInt{IsSet: true, Value: 3},
+					Enabled:  types.NullBool{IsSet: true, Value: false},
+					Stack:    "cflinuxfs2",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(buildpack).To(Equal(Buildpack{
+					GUID:     "some-buildpack-guid",
+					Name:     "some-buildpack",
+					Position: types.NullInt{IsSet: true, Value: 3},
+					Enabled:  types.NullBool{IsSet: true, Value: false},
+					Locked:   types.NullBool{IsSet: true, Value: false},
+					Stack:    "cflinuxfs2",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the name is already taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 290001,
+					"description": "The buildpack name is already in use: some-buildpack",
+					"error_code": "CF-BuildpackNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/buildpacks"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a BuildpackNameTakenError and warnings", func() {
+				_, warnings, err := client.CreateBuildpack(Buildpack{Name: "some-buildpack"})
+				Expect(err).To(MatchError(ccerror.BuildpackNameTakenError{Message: "The buildpack name is already in use: some-buildpack"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("DownloadBuildpack", func() {
+		Context("when the buildpack has bits", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/buildpacks/some-buildpack-guid/download"),
+						RespondWith(http.StatusOK, "some-bits", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("writes the bits to the output", func() {
+				output := new(bytes.Buffer)
+				warnings, err := client.DownloadBuildpack("some-buildpack-guid", output)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(output.String()).To(Equal("some-bits"))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the buildpack has no bits", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10000,
+					"description": "Unknown request",
+					"error_code": "CF-NotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/buildpacks/some-buildpack-guid/download"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and writes nothing", func() {
+				output := new(bytes.Buffer)
+				warnings, err := client.DownloadBuildpack("some-buildpack-guid", output)
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Unknown request"}))
+				Expect(output.Len()).To(BeZero())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetBuildpacks", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-buildpack-guid"
+						},
+						"entity": {
+							"name": "some-buildpack",
+							"position": 1,
+							"enabled": true,
+							"locked": true,
+							"stack": null,
+							"filename": "some-buildpack.zip"
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/buildpacks", "q=name:some-buildpack"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the buildpacks and warnings", func() {
+			buildpacks, warnings, err := client.GetBuildpacks(Query{
+				Filter:   NameFilter,
+				Operator: EqualOperator,
+				Values:   []string{"some-buildpack"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buildpacks).To(ConsistOf(Buildpack{
+				GUID:     "some-buildpack-guid",
+				Name:     "some-buildpack",
+				Position: types.NullInt{IsSet: true, Value: 1},
+				Enabled:  types.NullBool{IsSet: true, Value: true},
+				Locked:   types.NullBool{IsSet: true, Value: true},
+				Filename: "some-buildpack.zip",
+			}))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("UpdateBuildpack", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {
+					"guid": "some-buildpack-guid"
+				},
+				"entity": {
+					"name": "some-buildpack",
+					"position": 1,
+					"enabled": true,
+					"locked": true
+				}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/buildpacks/some-buildpack-guid"),
+					VerifyJSON(`{"locked":true}`),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("sends only the fields that are set", func() {
+			buildpack, warnings, err := client.UpdateBuildpack(Buildpack{
+				GUID:   "some-buildpack-guid",
+				Locked: types.NullBool{IsSet: true, Value: true},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buildpack.Locked).To(Equal(types.NullBool{IsSet: true, Value: true}))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("UploadBuildpack", func() {
+		var bits []byte
+
+		BeforeEach(func() {
+			bits = []byte("some-zip-contents")
+
+			verifyBody := func(_ http.ResponseWriter, req *http.Request) {
+				contentType := req.Header.Get("Content-Type")
+				Expect(contentType).To(MatchRegexp("multipart/form-data; boundary=[\\w\\d]+"))
+
+				defer req.Body.Close()
+				requestReader := multipart.NewReader(req.Body, contentType[30:])
+
+				part, err := requestReader.NextPart()
+				Expect(err).NotTo(HaveOccurred())
+				defer part.Close()
+
+				Expect(part.FormName()).To(Equal("buildpack"))
+				Expect(part.FileName()).To(Equal("some-buildpack.zip"))
+				Expect(ioutil.ReadAll(part)).To(Equal(bits))
+			}
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/buildpacks/some-buildpack-guid/bits"),
+					verifyBody,
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("uploads the bits as a multipart form", func() {
+			warnings, err := client.UploadBuildpack("some-buildpack-guid", "some-buildpack.zip", bytes.NewReader(bits), int64(len(bits)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+})
@@ -0,0 +1,14 @@
+package constant
+
+// EnvironmentVariableGroupName is the name of a platform-wide group of
+// environment variables.
+type EnvironmentVariableGroupName string
+
+const (
+	// RunningEnvironmentVariableGroup is the group of environment variables
+	// given to every running application.
+	RunningEnvironmentVariableGroup EnvironmentVariableGroupName = "running"
+	// StagingEnvironmentVariableGroup is the group of environment variables
+	// given to every application while it stages.
+	StagingEnvironmentVariableGroup EnvironmentVariableGroupName = "staging"
+)
@@ -0,0 +1,21 @@
+package constant
+
+// OrgRole is the URL path segment of a role a user can have in an
+// organization.
+type OrgRole string
+
+const (
+	OrgManagerRole        OrgRole = "managers"
+	OrgBillingManagerRole OrgRole = "billing_managers"
+	OrgAuditorRole        OrgRole = "auditors"
+	OrgUserRole           OrgRole = "users"
+)
+
+// SpaceRole is the URL path segment of a role a user can have in a space.
+type SpaceRole string
+
+const (
+	SpaceManagerRole   SpaceRole = "managers"
+	SpaceDeveloperRole SpaceRole = "developers"
+	SpaceAuditorRole   SpaceRole = "auditors"
+)
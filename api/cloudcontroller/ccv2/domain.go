@@ -1,6 +1,7 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -64,6 +65,46 @@ func (client *Client) GetSharedDomain(domainGUID string) (Domain, Warnings, erro
 	return domain, response.Warnings, nil
 }
 
+// privateDomainRequestBody represents the body of a create private domain
+// request.
+type privateDomainRequestBody struct {
+	Name                   string `json:"name"`
+	OwningOrganizationGUID string `json:"owning_organization_guid"`
+}
+
+// CreatePrivateDomain creates a private domain owned by the provided
+// organization.
+func (client *Client) CreatePrivateDomain(domainName string, orgGUID string) (Domain, Warnings, error) {
+	bodyBytes, err := json.Marshal(privateDomainRequestBody{
+		Name:                   domainName,
+		OwningOrganizationGUID: orgGUID,
+	})
+	if err != nil {
+		return Domain{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostPrivateDomainRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Domain{}, nil, err
+	}
+
+	var domain Domain
+	response := cloudcontroller.Response{
+		Result: &domain,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return Domain{}, response.Warnings, err
+	}
+
+	domain.Type = constant.PrivateDomain
+	return domain, response.Warnings, nil
+}
+
 // GetPrivateDomain returns the Private Domain associated with the provided
 // Domain GUID.
 func (client *Client) GetPrivateDomain(domainGUID string) (Domain, Warnings, error) {
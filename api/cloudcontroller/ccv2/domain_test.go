@@ -405,4 +405,60 @@ var _ = Describe("Domain", func() {
 			})
 		})
 	})
+
+	Describe("CreatePrivateDomain", func() {
+		Context("when the create is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-domain-guid"
+					},
+					"entity": {
+						"name": "some-domain.com",
+						"owning_organization_guid": "some-org-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/private_domains"),
+						VerifyJSON(`{"name": "some-domain.com", "owning_organization_guid": "some-org-guid"}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created private domain and warnings", func() {
+				domain, warnings, err := client.CreatePrivateDomain("some-domain.com", "some-org-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(domain).To(Equal(Domain{
+					GUID: "some-domain-guid",
+					Name: "some-domain.com",
+					Type: constant.PrivateDomain,
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the create returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"description": "The domain name is taken: some-domain.com",
+					"error_code": "CF-DomainNameTaken",
+					"code": 130003
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/private_domains"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CreatePrivateDomain("some-domain.com", "some-org-guid")
+				Expect(err).To(MatchError(ccerror.BadRequestError{Message: "The domain name is taken: some-domain.com"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
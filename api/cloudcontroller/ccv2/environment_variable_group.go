@@ -0,0 +1,65 @@
+package ccv2
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// EnvironmentVariableGroup is the set of environment variables in a Cloud
+// Controller environment variable group. Values are kept as the JSON Cloud
+// Controller returned, so variables that are not strings are sent back
+// unchanged.
+type EnvironmentVariableGroup map[string]json.RawMessage
+
+// GetConfigEnvironmentVariableGroup returns the environment variables in the
+// named group.
+func (client *Client) GetConfigEnvironmentVariableGroup(groupName constant.EnvironmentVariableGroupName) (EnvironmentVariableGroup, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetConfigEnvironmentVariableGroupRequest,
+		URIParams:   Params{"group_name": string(groupName)},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var group EnvironmentVariableGroup
+	response := cloudcontroller.Response{
+		Result: &group,
+	}
+
+	err = client.connection.Make(request, &response)
+	return group, response.Warnings, err
+}
+
+// UpdateConfigEnvironmentVariableGroup replaces the environment variables in
+// the named group.
+func (client *Client) UpdateConfigEnvironmentVariableGroup(groupName constant.EnvironmentVariableGroupName, group EnvironmentVariableGroup) (EnvironmentVariableGroup, Warnings, error) {
+	if group == nil {
+		group = EnvironmentVariableGroup{}
+	}
+	bodyBytes, err := json.Marshal(map[string]json.RawMessage(group))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutConfigEnvironmentVariableGroupRequest,
+		URIParams:   Params{"group_name": string(groupName)},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedGroup EnvironmentVariableGroup
+	response := cloudcontroller.Response{
+		Result: &updatedGroup,
+	}
+
+	err = client.connection.Make(request, &response)
+	return updatedGroup, response.Warnings, err
+}
@@ -0,0 +1,88 @@
+package ccv2_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Environment Variable Group", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetConfigEnvironmentVariableGroup", func() {
+		BeforeEach(func() {
+			response := `{
+				"LANG": "en_US.UTF-8",
+				"MAX_THREADS": 16,
+				"DEBUG": false
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/config/environment_variable_groups/running"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the group with every value as its JSON", func() {
+			group, warnings, err := client.GetConfigEnvironmentVariableGroup(constant.RunningEnvironmentVariableGroup)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(group).To(HaveLen(3))
+			Expect(string(group["LANG"])).To(Equal(`"en_US.UTF-8"`))
+			Expect(string(group["MAX_THREADS"])).To(Equal("16"))
+			Expect(string(group["DEBUG"])).To(Equal("false"))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("UpdateConfigEnvironmentVariableGroup", func() {
+		Context("when the group has variables", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/environment_variable_groups/staging"),
+						VerifyJSON(`{"LANG":"en_US.UTF-8","PORT":8080}`),
+						RespondWith(http.StatusOK, `{"LANG":"en_US.UTF-8","PORT":8080}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("replaces the group, keeping the type of each value, and returns warnings", func() {
+				group, warnings, err := client.UpdateConfigEnvironmentVariableGroup(constant.StagingEnvironmentVariableGroup, EnvironmentVariableGroup{
+					"LANG": json.RawMessage(`"en_US.UTF-8"`),
+					"PORT": json.RawMessage("8080"),
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(group["PORT"])).To(Equal("8080"))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the group is empty", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/environment_variable_groups/staging"),
+						VerifyJSON(`{}`),
+						RespondWith(http.StatusOK, `{}`),
+					),
+				)
+			})
+
+			It("sends an empty object", func() {
+				group, _, err := client.UpdateConfigEnvironmentVariableGroup(constant.StagingEnvironmentVariableGroup, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(group).To(BeEmpty())
+			})
+		})
+	})
+})
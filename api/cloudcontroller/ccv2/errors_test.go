@@ -102,6 +102,23 @@ var _ = Describe("Error Wrapper", func() {
 					})
 				})
 
+				Context("when a buildpack name taken error is encountered", func() {
+					BeforeEach(func() {
+						response = `{
+								"code": 290001,
+								"description": "The buildpack name is already in use: ruby_buildpack",
+								"error_code": "CF-BuildpackNameTaken"
+							}`
+					})
+
+					It("returns a BuildpackNameTakenError", func() {
+						_, _, err := client.GetApplications()
+						Expect(err).To(MatchError(ccerror.BuildpackNameTakenError{
+							Message: "The buildpack name is already in use: ruby_buildpack",
+						}))
+					})
+				})
+
 				Context("when an instances error is encountered", func() {
 					BeforeEach(func() {
 						response = `{
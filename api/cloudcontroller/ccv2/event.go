@@ -0,0 +1,83 @@
+package ccv2
+
+import (
+	"encoding/json"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// Event represents a Cloud Controller audit event.
+type Event struct {
+	// GUID is the unique event identifier.
+	GUID string
+
+	// Type is the kind of event, for example audit.app.process.crash.
+	Type string
+
+	// ActeeGUID is the GUID of the resource the event happened to.
+	ActeeGUID string
+
+	// ActeeName is the name of the resource the event happened to.
+	ActeeName string
+
+	// Timestamp is when the event happened.
+	Timestamp time.Time
+
+	// Metadata is the type specific information about the event.
+	Metadata map[string]interface{}
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Event response.
+func (event *Event) UnmarshalJSON(data []byte) error {
+	var ccEvent struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Type      string                 `json:"type"`
+			Actee     string                 `json:"actee"`
+			ActeeName string                 `json:"actee_name"`
+			Timestamp *time.Time             `json:"timestamp"`
+			Metadata  map[string]interface{} `json:"metadata"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccEvent); err != nil {
+		return err
+	}
+
+	event.GUID = ccEvent.Metadata.GUID
+	event.Type = ccEvent.Entity.Type
+	event.ActeeGUID = ccEvent.Entity.Actee
+	event.ActeeName = ccEvent.Entity.ActeeName
+	if ccEvent.Entity.Timestamp != nil {
+		event.Timestamp = *ccEvent.Entity.Timestamp
+	}
+	event.Metadata = ccEvent.Entity.Metadata
+	return nil
+}
+
+// GetEvents returns back a list of Events based off of the provided queries.
+func (client *Client) GetEvents(queries ...Query) ([]Event, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetEventsRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullEventsList []Event
+	warnings, err := client.paginate(request, Event{}, func(item interface{}) error {
+		if event, ok := item.(Event); ok {
+			fullEventsList = append(fullEventsList, event)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Event{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullEventsList, warnings, err
+}
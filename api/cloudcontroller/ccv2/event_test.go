@@ -0,0 +1,137 @@
+package ccv2_test
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Event", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetEvents", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/events?q=actee:some-app-guid&q=type:audit.app.process.crash&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-event-guid-1"
+							},
+							"entity": {
+								"type": "audit.app.process.crash",
+								"actee": "some-app-guid",
+								"actee_name": "some-app",
+								"timestamp": "2017-11-06T20:54:10Z",
+								"metadata": {
+									"index": 1,
+									"exit_status": 137,
+									"exit_description": "APP/PROC/WEB: Exited with status 137 (out of memory)",
+									"reason": "CRASHED"
+								}
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-event-guid-2"
+							},
+							"entity": {
+								"type": "audit.app.process.crash",
+								"actee": "some-app-guid",
+								"actee_name": "some-app",
+								"timestamp": "2017-11-06T21:00:00Z",
+								"metadata": {}
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events", "q=actee:some-app-guid&q=type:audit.app.process.crash"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events", "q=actee:some-app-guid&q=type:audit.app.process.crash&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns paginated results and all warnings", func() {
+				events, warnings, err := client.GetEvents(
+					Query{Filter: ActeeFilter, Operator: EqualOperator, Values: []string{"some-app-guid"}},
+					Query{Filter: TypeFilter, Operator: EqualOperator, Values: []string{"audit.app.process.crash"}},
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(events).To(Equal([]Event{
+					{
+						GUID:      "some-event-guid-1",
+						Type:      "audit.app.process.crash",
+						ActeeGUID: "some-app-guid",
+						ActeeName: "some-app",
+						Timestamp: time.Date(2017, 11, 6, 20, 54, 10, 0, time.UTC),
+						Metadata: map[string]interface{}{
+							"index":            float64(1),
+							"exit_status":      float64(137),
+							"exit_description": "APP/PROC/WEB: Exited with status 137 (out of memory)",
+							"reason":           "CRASHED",
+						},
+					},
+					{
+						GUID:      "some-event-guid-2",
+						Type:      "audit.app.process.crash",
+						ActeeGUID: "some-app-guid",
+						ActeeName: "some-app",
+						Timestamp: time.Date(2017, 11, 6, 21, 0, 0, 0, time.UTC),
+						Metadata:  map[string]interface{}{},
+					},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetEvents()
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,69 @@
+package ccv2
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// FeatureFlag represents a Cloud Controller feature flag.
+type FeatureFlag struct {
+	// Name is the name of the feature flag.
+	Name string `json:"name"`
+
+	// Enabled is true when the feature is turned on.
+	Enabled bool `json:"enabled"`
+
+	// ErrorMessage is the message shown to users who try to use the feature
+	// while it is disabled. It is empty when the default message is used.
+	ErrorMessage string `json:"error_message"`
+}
+
+// GetConfigFeatureFlags returns all the feature flags of the platform.
+func (client *Client) GetConfigFeatureFlags() ([]FeatureFlag, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetConfigFeatureFlagsRequest,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var featureFlags []FeatureFlag
+	response := cloudcontroller.Response{
+		Result: &featureFlags,
+	}
+
+	err = client.connection.Make(request, &response)
+	return featureFlags, response.Warnings, err
+}
+
+// UpdateConfigFeatureFlag turns the named feature flag on or off.
+func (client *Client) UpdateConfigFeatureFlag(name string, enabled bool) (FeatureFlag, Warnings, error) {
+	bodyBytes, err := json.Marshal(struct {
+		Enabled bool `json:"enabled"`
+	}{
+		Enabled: enabled,
+	})
+	if err != nil {
+		return FeatureFlag{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutConfigFeatureFlagRequest,
+		URIParams:   Params{"feature_flag_name": name},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return FeatureFlag{}, nil, err
+	}
+
+	var featureFlag FeatureFlag
+	response := cloudcontroller.Response{
+		Result: &featureFlag,
+	}
+
+	err = client.connection.Make(request, &response)
+	return featureFlag, response.Warnings, err
+}
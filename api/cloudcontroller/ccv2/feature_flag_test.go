@@ -0,0 +1,103 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Feature Flag", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetConfigFeatureFlags", func() {
+		BeforeEach(func() {
+			response := `[
+				{
+					"name": "user_org_creation",
+					"enabled": false,
+					"error_message": null,
+					"url": "/v2/config/feature_flags/user_org_creation"
+				},
+				{
+					"name": "app_bits_upload",
+					"enabled": true,
+					"error_message": "uploads are off for maintenance",
+					"url": "/v2/config/feature_flags/app_bits_upload"
+				}
+			]`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/config/feature_flags"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the feature flags and warnings", func() {
+			featureFlags, warnings, err := client.GetConfigFeatureFlags()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(featureFlags).To(Equal([]FeatureFlag{
+				{Name: "user_org_creation", Enabled: false},
+				{Name: "app_bits_upload", Enabled: true, ErrorMessage: "uploads are off for maintenance"},
+			}))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("UpdateConfigFeatureFlag", func() {
+		Context("when the feature flag exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"name": "user_org_creation",
+					"enabled": true,
+					"error_message": null,
+					"url": "/v2/config/feature_flags/user_org_creation"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/feature_flags/user_org_creation"),
+						VerifyJSON(`{"enabled":true}`),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the updated feature flag and warnings", func() {
+				featureFlag, warnings, err := client.UpdateConfigFeatureFlag("user_org_creation", true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(featureFlag).To(Equal(FeatureFlag{Name: "user_org_creation", Enabled: true}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the feature flag does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 330000,
+					"description": "The feature flag could not be found: not_a_flag",
+					"error_code": "CF-FeatureFlagNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/feature_flags/not_a_flag"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and warnings", func() {
+				_, warnings, err := client.UpdateConfigFeatureFlag("not_a_flag", false)
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The feature flag could not be found: not_a_flag"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -15,62 +15,90 @@ import (
 //
 // The const name should always be the const value + Request.
 const (
-	DeleteOrganizationRequest                = "DeleteOrganization"
-	DeleteRouteAppRequest                    = "DeleteRouteAppRequest"
-	DeleteRouteRequest                       = "DeleteRoute"
-	DeleteRunningSecurityGroupSpaceRequest   = "DeleteRunningSecurityGroupSpace"
-	DeleteSecurityGroupSpaceRequest          = "DeleteSecurityGroupSpace"
-	DeleteServiceBindingRequest              = "DeleteServiceBinding"
-	DeleteSpaceRequest                       = "DeleteSpaceRequest"
-	DeleteStagingSecurityGroupSpaceRequest   = "DeleteStagingSecurityGroupSpace"
-	GetAppInstancesRequest                   = "GetAppInstances"
-	GetAppRequest                            = "GetApp"
-	GetAppRoutesRequest                      = "GetAppRoutes"
-	GetAppStatsRequest                       = "GetAppStats"
-	GetAppsRequest                           = "GetApps"
-	GetInfoRequest                           = "GetInfo"
-	GetJobRequest                            = "GetJob"
-	GetOrganizationPrivateDomainsRequest     = "GetOrganizationPrivateDomains"
-	GetOrganizationQuotaDefinitionRequest    = "GetOrganizationQuotaDefinition"
-	GetOrganizationRequest                   = "GetOrganization"
-	GetOrganizationsRequest                  = "GetOrganizations"
-	GetPrivateDomainRequest                  = "GetPrivateDomain"
-	GetRouteAppsRequest                      = "GetRouteApps"
-	GetRouteReservedDeprecatedRequest        = "GetRouteReservedDeprecated"
-	GetRouteReservedRequest                  = "GetRouteReserved"
-	GetRouteRouteMappingsRequest             = "GetRouteRouteMappings"
-	GetRoutesRequest                         = "GetRoutes"
-	GetSecurityGroupRunningSpacesRequest     = "GetSecurityGroupRunningSpaces"
-	GetSecurityGroupStagingSpacesRequest     = "GetSecurityGroupStagingSpaces"
-	GetSecurityGroupsRequest                 = "GetSecurityGroups"
-	GetServiceBindingsRequest                = "GetServiceBindings"
-	GetServiceInstanceRequest                = "GetServiceInstance"
-	GetServiceInstanceServiceBindingsRequest = "GetServiceInstanceServiceBindings"
-	GetServiceInstancesRequest               = "GetServiceInstances"
-	GetServicePlanRequest                    = "GetServicePlan"
-	GetServiceRequest                        = "GetService"
-	GetSharedDomainRequest                   = "GetSharedDomain"
-	GetSharedDomainsRequest                  = "GetSharedDomains"
-	GetSpaceQuotaDefinitionRequest           = "GetSpaceQuotaDefinition"
-	GetSpaceRoutesRequest                    = "GetSpaceRoutes"
-	GetSpaceRunningSecurityGroupsRequest     = "GetSpaceRunningSecurityGroups"
-	GetSpaceServiceInstancesRequest          = "GetSpaceServiceInstances"
-	GetSpaceStagingSecurityGroupsRequest     = "GetSpaceStagingSecurityGroups"
-	GetSpacesRequest                         = "GetSpaces"
-	GetStackRequest                          = "GetStack"
-	GetStacksRequest                         = "GetStacks"
-	GetUsersRequest                          = "GetUsers"
-	PostAppRequest                           = "PostApp"
-	PostAppRestageRequest                    = "PostAppRestage"
-	PostRouteRequest                         = "PostRoute"
-	PostServiceBindingRequest                = "PostServiceBinding"
-	PostUserRequest                          = "PostUser"
-	PutAppBitsRequest                        = "PutAppBits"
-	PutAppRequest                            = "PutApp"
-	PutResourceMatch                         = "PutResourceMatch"
-	PutRouteAppRequest                       = "PutRouteApp"
-	PutRunningSecurityGroupSpaceRequest      = "PutRunningSecurityGroupSpace"
-	PutStagingSecurityGroupSpaceRequest      = "PutStagingSecurityGroupSpace"
+	DeleteOrganizationRequest                   = "DeleteOrganization"
+	DeleteOrganizationUserByRoleRequest         = "DeleteOrganizationUserByRole"
+	DeleteRouteAppRequest                       = "DeleteRouteAppRequest"
+	DeleteRouteRequest                          = "DeleteRoute"
+	DeleteRunningSecurityGroupSpaceRequest      = "DeleteRunningSecurityGroupSpace"
+	DeleteSecurityGroupSpaceRequest             = "DeleteSecurityGroupSpace"
+	DeleteServiceBindingRequest                 = "DeleteServiceBinding"
+	DeleteSpaceRequest                          = "DeleteSpaceRequest"
+	DeleteSpaceUserByRoleRequest                = "DeleteSpaceUserByRole"
+	DeleteStagingSecurityGroupSpaceRequest      = "DeleteStagingSecurityGroupSpace"
+	GetAppInstancesRequest                      = "GetAppInstances"
+	GetAppRequest                               = "GetApp"
+	GetAppRoutesRequest                         = "GetAppRoutes"
+	GetAppStatsRequest                          = "GetAppStats"
+	GetAppsRequest                              = "GetApps"
+	GetBuildpackBitsRequest                     = "GetBuildpackBits"
+	GetBuildpacksRequest                        = "GetBuildpacks"
+	GetConfigEnvironmentVariableGroupRequest    = "GetConfigEnvironmentVariableGroup"
+	GetConfigFeatureFlagsRequest                = "GetConfigFeatureFlags"
+	GetEventsRequest                            = "GetEvents"
+	GetInfoRequest                              = "GetInfo"
+	GetJobRequest                               = "GetJob"
+	GetOrganizationPrivateDomainsRequest        = "GetOrganizationPrivateDomains"
+	GetOrganizationQuotaDefinitionRequest       = "GetOrganizationQuotaDefinition"
+	GetOrganizationQuotaDefinitionsRequest      = "GetOrganizationQuotaDefinitions"
+	GetOrganizationRequest                      = "GetOrganization"
+	GetOrganizationSpaceQuotaDefinitionsRequest = "GetOrganizationSpaceQuotaDefinitions"
+	GetOrganizationUsersByRoleRequest           = "GetOrganizationUsersByRole"
+	GetOrganizationsRequest                     = "GetOrganizations"
+	GetPrivateDomainRequest                     = "GetPrivateDomain"
+	GetRouteAppsRequest                         = "GetRouteApps"
+	GetRouteReservedDeprecatedRequest           = "GetRouteReservedDeprecated"
+	GetRouteReservedRequest                     = "GetRouteReserved"
+	GetRouteRouteMappingsRequest                = "GetRouteRouteMappings"
+	GetRoutesRequest                            = "GetRoutes"
+	GetSecurityGroupRunningSpacesRequest        = "GetSecurityGroupRunningSpaces"
+	GetSecurityGroupStagingSpacesRequest        = "GetSecurityGroupStagingSpaces"
+	GetSecurityGroupsRequest                    = "GetSecurityGroups"
+	GetServiceBindingsRequest                   = "GetServiceBindings"
+	GetServiceInstanceRequest                   = "GetServiceInstance"
+	GetServiceInstanceServiceBindingsRequest    = "GetServiceInstanceServiceBindings"
+	GetServiceInstancesRequest                  = "GetServiceInstances"
+	GetServicePlanRequest                       = "GetServicePlan"
+	GetServicePlansRequest                      = "GetServicePlans"
+	GetServiceRequest                           = "GetService"
+	GetServicesRequest                          = "GetServices"
+	GetSharedDomainRequest                      = "GetSharedDomain"
+	GetSharedDomainsRequest                     = "GetSharedDomains"
+	GetSpaceQuotaDefinitionRequest              = "GetSpaceQuotaDefinition"
+	GetSpaceRoutesRequest                       = "GetSpaceRoutes"
+	GetSpaceRunningSecurityGroupsRequest        = "GetSpaceRunningSecurityGroups"
+	GetSpaceServiceInstancesRequest             = "GetSpaceServiceInstances"
+	GetSpaceStagingSecurityGroupsRequest        = "GetSpaceStagingSecurityGroups"
+	GetSpaceUsersByRoleRequest                  = "GetSpaceUsersByRole"
+	GetSpacesRequest                            = "GetSpaces"
+	GetStackRequest                             = "GetStack"
+	GetStacksRequest                            = "GetStacks"
+	GetUsersRequest                             = "GetUsers"
+	PostAppRequest                              = "PostApp"
+	PostAppRestageRequest                       = "PostAppRestage"
+	PostBuildpackRequest                        = "PostBuildpack"
+	PostOrganizationRequest                     = "PostOrganization"
+	PostPrivateDomainRequest                    = "PostPrivateDomain"
+	PostRouteRequest                            = "PostRoute"
+	PostServiceBindingRequest                   = "PostServiceBinding"
+	PostServiceInstanceRequest                  = "PostServiceInstance"
+	PostSpaceQuotaDefinitionRequest             = "PostSpaceQuotaDefinition"
+	PostSpaceRequest                            = "PostSpace"
+	PostUserRequest                             = "PostUser"
+	PutAppBitsRequest                           = "PutAppBits"
+	PutAppRequest                               = "PutApp"
+	PutBuildpackBitsRequest                     = "PutBuildpackBits"
+	PutBuildpackRequest                         = "PutBuildpack"
+	PutConfigEnvironmentVariableGroupRequest    = "PutConfigEnvironmentVariableGroup"
+	PutConfigFeatureFlagRequest                 = "PutConfigFeatureFlag"
+	PutOrganizationRequest                      = "PutOrganization"
+	PutOrganizationUserByRoleRequest            = "PutOrganizationUserByRole"
+	PutResourceMatch                            = "PutResourceMatch"
+	PutRouteAppRequest                          = "PutRouteApp"
+	PutRunningSecurityGroupSpaceRequest         = "PutRunningSecurityGroupSpace"
+	PutSpaceQuotaDefinitionRequest              = "PutSpaceQuotaDefinition"
+	PutSpaceQuotaDefinitionSpaceRequest         = "PutSpaceQuotaDefinitionSpace"
+	PutSpaceUserByRoleRequest                   = "PutSpaceUserByRole"
+	PutStagingSecurityGroupSpaceRequest         = "PutStagingSecurityGroupSpace"
 )
 
 // APIRoutes is a list of routes used by the rata library to construct request
@@ -85,24 +113,42 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/apps/:app_guid/restage", Method: http.MethodPost, Name: PostAppRestageRequest},
 	{Path: "/v2/apps/:app_guid/routes", Method: http.MethodGet, Name: GetAppRoutesRequest},
 	{Path: "/v2/apps/:app_guid/stats", Method: http.MethodGet, Name: GetAppStatsRequest},
+	{Path: "/v2/buildpacks", Method: http.MethodGet, Name: GetBuildpacksRequest},
+	{Path: "/v2/buildpacks", Method: http.MethodPost, Name: PostBuildpackRequest},
+	{Path: "/v2/buildpacks/:buildpack_guid", Method: http.MethodPut, Name: PutBuildpackRequest},
+	{Path: "/v2/buildpacks/:buildpack_guid/bits", Method: http.MethodPut, Name: PutBuildpackBitsRequest},
+	{Path: "/v2/buildpacks/:buildpack_guid/download", Method: http.MethodGet, Name: GetBuildpackBitsRequest},
+	{Path: "/v2/config/environment_variable_groups/:group_name", Method: http.MethodGet, Name: GetConfigEnvironmentVariableGroupRequest},
+	{Path: "/v2/config/environment_variable_groups/:group_name", Method: http.MethodPut, Name: PutConfigEnvironmentVariableGroupRequest},
+	{Path: "/v2/config/feature_flags", Method: http.MethodGet, Name: GetConfigFeatureFlagsRequest},
+	{Path: "/v2/config/feature_flags/:feature_flag_name", Method: http.MethodPut, Name: PutConfigFeatureFlagRequest},
+	{Path: "/v2/events", Method: http.MethodGet, Name: GetEventsRequest},
 	{Path: "/v2/info", Method: http.MethodGet, Name: GetInfoRequest},
 	{Path: "/v2/jobs/:job_guid", Method: http.MethodGet, Name: GetJobRequest},
 	{Path: "/v2/organizations", Method: http.MethodGet, Name: GetOrganizationsRequest},
+	{Path: "/v2/organizations", Method: http.MethodPost, Name: PostOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodDelete, Name: DeleteOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodGet, Name: GetOrganizationRequest},
+	{Path: "/v2/organizations/:organization_guid", Method: http.MethodPut, Name: PutOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid/private_domains", Method: http.MethodGet, Name: GetOrganizationPrivateDomainsRequest},
+	{Path: "/v2/organizations/:organization_guid/space_quota_definitions", Method: http.MethodGet, Name: GetOrganizationSpaceQuotaDefinitionsRequest},
+	{Path: "/v2/organizations/:organization_guid/:role", Method: http.MethodGet, Name: GetOrganizationUsersByRoleRequest},
+	{Path: "/v2/organizations/:organization_guid/:role", Method: http.MethodPut, Name: PutOrganizationUserByRoleRequest},
+	{Path: "/v2/organizations/:organization_guid/:role/:user_guid", Method: http.MethodDelete, Name: DeleteOrganizationUserByRoleRequest},
+	{Path: "/v2/private_domains", Method: http.MethodPost, Name: PostPrivateDomainRequest},
 	{Path: "/v2/private_domains/:private_domain_guid", Method: http.MethodGet, Name: GetPrivateDomainRequest},
+	{Path: "/v2/quota_definitions", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionsRequest},
 	{Path: "/v2/quota_definitions/:organization_quota_guid", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionRequest},
 	{Path: "/v2/resource_match", Method: http.MethodPut, Name: PutResourceMatch},
 	{Path: "/v2/routes", Method: http.MethodGet, Name: GetRoutesRequest},
 	{Path: "/v2/routes", Method: http.MethodPost, Name: PostRouteRequest},
+	{Path: "/v2/routes/reserved/domain/:domain_guid", Method: http.MethodGet, Name: GetRouteReservedRequest},
+	{Path: "/v2/routes/reserved/domain/:domain_guid/host/:host", Method: http.MethodGet, Name: GetRouteReservedDeprecatedRequest},
 	{Path: "/v2/routes/:route_guid", Method: http.MethodDelete, Name: DeleteRouteRequest},
 	{Path: "/v2/routes/:route_guid/apps", Method: http.MethodGet, Name: GetRouteAppsRequest},
 	{Path: "/v2/routes/:route_guid/apps/:app_guid", Method: http.MethodDelete, Name: DeleteRouteAppRequest},
 	{Path: "/v2/routes/:route_guid/apps/:app_guid", Method: http.MethodPut, Name: PutRouteAppRequest},
 	{Path: "/v2/routes/:route_guid/route_mappings", Method: http.MethodGet, Name: GetRouteRouteMappingsRequest},
-	{Path: "/v2/routes/reserved/domain/:domain_guid", Method: http.MethodGet, Name: GetRouteReservedRequest},
-	{Path: "/v2/routes/reserved/domain/:domain_guid/host/:host", Method: http.MethodGet, Name: GetRouteReservedDeprecatedRequest},
 	{Path: "/v2/security_groups", Method: http.MethodGet, Name: GetSecurityGroupsRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces", Method: http.MethodGet, Name: GetSecurityGroupRunningSpacesRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces/:space_guid", Method: http.MethodDelete, Name: DeleteRunningSecurityGroupSpaceRequest},
@@ -114,19 +160,29 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/service_bindings", Method: http.MethodPost, Name: PostServiceBindingRequest},
 	{Path: "/v2/service_bindings/:service_binding_guid", Method: http.MethodDelete, Name: DeleteServiceBindingRequest},
 	{Path: "/v2/service_instances", Method: http.MethodGet, Name: GetServiceInstancesRequest},
+	{Path: "/v2/service_instances", Method: http.MethodPost, Name: PostServiceInstanceRequest},
 	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodGet, Name: GetServiceInstanceRequest},
 	{Path: "/v2/service_instances/:service_instance_guid/service_bindings", Method: http.MethodGet, Name: GetServiceInstanceServiceBindingsRequest},
+	{Path: "/v2/service_plans", Method: http.MethodGet, Name: GetServicePlansRequest},
 	{Path: "/v2/service_plans/:service_plan_guid", Method: http.MethodGet, Name: GetServicePlanRequest},
+	{Path: "/v2/services", Method: http.MethodGet, Name: GetServicesRequest},
 	{Path: "/v2/services/:service_guid", Method: http.MethodGet, Name: GetServiceRequest},
 	{Path: "/v2/shared_domains", Method: http.MethodGet, Name: GetSharedDomainsRequest},
 	{Path: "/v2/shared_domains/:shared_domain_guid", Method: http.MethodGet, Name: GetSharedDomainRequest},
+	{Path: "/v2/space_quota_definitions", Method: http.MethodPost, Name: PostSpaceQuotaDefinitionRequest},
 	{Path: "/v2/space_quota_definitions/:space_quota_guid", Method: http.MethodGet, Name: GetSpaceQuotaDefinitionRequest},
+	{Path: "/v2/space_quota_definitions/:space_quota_guid", Method: http.MethodPut, Name: PutSpaceQuotaDefinitionRequest},
+	{Path: "/v2/space_quota_definitions/:space_quota_guid/spaces/:space_guid", Method: http.MethodPut, Name: PutSpaceQuotaDefinitionSpaceRequest},
 	{Path: "/v2/spaces", Method: http.MethodGet, Name: GetSpacesRequest},
+	{Path: "/v2/spaces", Method: http.MethodPost, Name: PostSpaceRequest},
 	{Path: "/v2/spaces/:guid/service_instances", Method: http.MethodGet, Name: GetSpaceServiceInstancesRequest},
 	{Path: "/v2/spaces/:space_guid", Method: http.MethodDelete, Name: DeleteSpaceRequest},
 	{Path: "/v2/spaces/:space_guid/routes", Method: http.MethodGet, Name: GetSpaceRoutesRequest},
 	{Path: "/v2/spaces/:space_guid/security_groups", Method: http.MethodGet, Name: GetSpaceRunningSecurityGroupsRequest},
 	{Path: "/v2/spaces/:space_guid/staging_security_groups", Method: http.MethodGet, Name: GetSpaceStagingSecurityGroupsRequest},
+	{Path: "/v2/spaces/:space_guid/:role", Method: http.MethodGet, Name: GetSpaceUsersByRoleRequest},
+	{Path: "/v2/spaces/:space_guid/:role", Method: http.MethodPut, Name: PutSpaceUserByRoleRequest},
+	{Path: "/v2/spaces/:space_guid/:role/:user_guid", Method: http.MethodDelete, Name: DeleteSpaceUserByRoleRequest},
 	{Path: "/v2/stacks", Method: http.MethodGet, Name: GetStacksRequest},
 	{Path: "/v2/stacks/:stack_guid", Method: http.MethodGet, Name: GetStackRequest},
 	{Path: "/v2/users", Method: http.MethodPost, Name: PostUserRequest},
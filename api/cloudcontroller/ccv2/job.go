@@ -314,7 +314,13 @@ func (client *Client) uploadNewAndExistingResources(appGUID string, existingReso
 	}
 
 	httpErrors := client.uploadBits(request, &response)
+	err = waitForUpload(writeErrors, httpErrors)
+	return job, response.Warnings, err
+}
 
+// waitForUpload returns the first error sent on either channel once both
+// have been closed.
+func waitForUpload(writeErrors <-chan error, httpErrors <-chan error) error {
 	// The following section makes the following assumptions:
 	// 1) If an error occurs during file reading, an EOF is sent to the request
 	// object. Thus ending the request transfer.
@@ -348,5 +354,5 @@ func (client *Client) uploadNewAndExistingResources(appGUID string, existingReso
 		}
 	}
 
-	return job, response.Warnings, firstError
+	return firstError
 }
@@ -1,6 +1,7 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -37,6 +38,69 @@ func (org *Organization) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// organizationRequestBody represents the body of a create or update
+// organization request.
+type organizationRequestBody struct {
+	Name                string `json:"name,omitempty"`
+	QuotaDefinitionGUID string `json:"quota_definition_guid,omitempty"`
+}
+
+// CreateOrganization creates an organization with the provided name. When
+// quotaGUID is empty, the organization gets the default quota.
+func (client *Client) CreateOrganization(orgName string, quotaGUID string) (Organization, Warnings, error) {
+	bodyBytes, err := json.Marshal(organizationRequestBody{
+		Name:                orgName,
+		QuotaDefinitionGUID: quotaGUID,
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostOrganizationRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	var org Organization
+	response := cloudcontroller.Response{
+		Result: &org,
+	}
+
+	err = client.connection.Make(request, &response)
+	return org, response.Warnings, err
+}
+
+// UpdateOrganizationQuota assigns the quota definition with the provided GUID
+// to the organization.
+func (client *Client) UpdateOrganizationQuota(orgGUID string, quotaGUID string) (Organization, Warnings, error) {
+	bodyBytes, err := json.Marshal(organizationRequestBody{
+		QuotaDefinitionGUID: quotaGUID,
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutOrganizationRequest,
+		URIParams:   Params{"organization_guid": orgGUID},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	var org Organization
+	response := cloudcontroller.Response{
+		Result: &org,
+	}
+
+	err = client.connection.Make(request, &response)
+	return org, response.Warnings, err
+}
+
 //go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Organization codetemplates/delete_async_by_guid.go.template delete_organization.go
 //go:generate go run $GOPATH/src/code.cloudfoundry.org/cli/util/codegen/generate.go Organization codetemplates/delete_async_by_guid_test.go.template delete_organization_test.go
 
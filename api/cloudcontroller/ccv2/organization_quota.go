@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
@@ -11,6 +12,14 @@ import (
 type OrganizationQuota struct {
 	GUID string
 	Name string
+
+	// The limits below are -1 when unlimited.
+	MemoryLimitInMB         int
+	InstanceMemoryLimitInMB int
+	TotalRoutes             int
+	TotalServices           int
+	AppInstanceLimit        int
+	TotalReservedRoutePorts int
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller organization quota response.
@@ -18,7 +27,13 @@ func (application *OrganizationQuota) UnmarshalJSON(data []byte) error {
 	var ccOrgQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name                    string `json:"name"`
+			MemoryLimitInMB         int    `json:"memory_limit"`
+			InstanceMemoryLimitInMB int    `json:"instance_memory_limit"`
+			TotalRoutes             int    `json:"total_routes"`
+			TotalServices           int    `json:"total_services"`
+			AppInstanceLimit        int    `json:"app_instance_limit"`
+			TotalReservedRoutePorts int    `json:"total_reserved_route_ports"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccOrgQuota); err != nil {
@@ -27,6 +42,12 @@ func (application *OrganizationQuota) UnmarshalJSON(data []byte) error {
 
 	application.GUID = ccOrgQuota.Metadata.GUID
 	application.Name = ccOrgQuota.Entity.Name
+	application.MemoryLimitInMB = ccOrgQuota.Entity.MemoryLimitInMB
+	application.InstanceMemoryLimitInMB = ccOrgQuota.Entity.InstanceMemoryLimitInMB
+	application.TotalRoutes = ccOrgQuota.Entity.TotalRoutes
+	application.TotalServices = ccOrgQuota.Entity.TotalServices
+	application.AppInstanceLimit = ccOrgQuota.Entity.AppInstanceLimit
+	application.TotalReservedRoutePorts = ccOrgQuota.Entity.TotalReservedRoutePorts
 
 	return nil
 }
@@ -49,3 +70,30 @@ func (client *Client) GetOrganizationQuota(guid string) (OrganizationQuota, Warn
 	err = client.connection.Make(request, &response)
 	return orgQuota, response.Warnings, err
 }
+
+// GetOrganizationQuotas returns back a list of organization quotas (quota
+// definitions) based off of the provided queries.
+func (client *Client) GetOrganizationQuotas(queries ...Query) ([]OrganizationQuota, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationQuotaDefinitionsRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullOrgQuotasList []OrganizationQuota
+	warnings, err := client.paginate(request, OrganizationQuota{}, func(item interface{}) error {
+		if orgQuota, ok := item.(OrganizationQuota); ok {
+			fullOrgQuotasList = append(fullOrgQuotasList, orgQuota)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   OrganizationQuota{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullOrgQuotasList, warnings, err
+}
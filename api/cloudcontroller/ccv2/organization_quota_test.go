@@ -26,7 +26,13 @@ var _ = Describe("OrganizationQuota", func() {
 					"guid": "some-org-quota-guid"
 				},
 				"entity": {
-					"name": "some-org-quota"
+					"name": "some-org-quota",
+					"memory_limit": 10240,
+					"instance_memory_limit": -1,
+					"total_routes": 1000,
+					"total_services": 100,
+					"app_instance_limit": -1,
+					"total_reserved_route_ports": 0
 				}
 			}`
 				server.AppendHandlers(
@@ -42,8 +48,14 @@ var _ = Describe("OrganizationQuota", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(warnings).To(Equal(Warnings{"warning-1"}))
 				Expect(orgQuota).To(Equal(OrganizationQuota{
-					GUID: "some-org-quota-guid",
-					Name: "some-org-quota",
+					GUID:                    "some-org-quota-guid",
+					Name:                    "some-org-quota",
+					MemoryLimitInMB:         10240,
+					InstanceMemoryLimitInMB: -1,
+					TotalRoutes:             1000,
+					TotalServices:           100,
+					AppInstanceLimit:        -1,
+					TotalReservedRoutePorts: 0,
 				}))
 			})
 		})
@@ -73,4 +85,84 @@ var _ = Describe("OrganizationQuota", func() {
 		})
 
 	})
+
+	Describe("GetOrganizationQuotas", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/quota_definitions?q=name:some-org-quota&page=2",
+					"resources": [
+						{
+							"metadata": {"guid": "some-org-quota-guid-1"},
+							"entity": {"name": "some-org-quota"}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {"guid": "some-org-quota-guid-2"},
+							"entity": {"name": "some-org-quota"}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/quota_definitions", "q=name:some-org-quota"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/quota_definitions", "q=name:some-org-quota&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the organization quotas and all warnings", func() {
+				orgQuotas, warnings, err := client.GetOrganizationQuotas(Query{
+					Filter:   NameFilter,
+					Operator: EqualOperator,
+					Values:   []string{"some-org-quota"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(orgQuotas).To(Equal([]OrganizationQuota{
+					{GUID: "some-org-quota-guid-1", Name: "some-org-quota"},
+					{GUID: "some-org-quota-guid-2", Name: "some-org-quota"},
+				}))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/quota_definitions"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetOrganizationQuotas()
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
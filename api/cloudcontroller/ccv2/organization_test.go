@@ -212,4 +212,129 @@ var _ = Describe("Organization", func() {
 			})
 		})
 	})
+
+	Describe("CreateOrganization", func() {
+		Context("when the create is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-org-guid"
+					},
+					"entity": {
+						"name": "some-org",
+						"quota_definition_guid": "some-quota-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/organizations"),
+						VerifyJSON(`{"name": "some-org", "quota_definition_guid": "some-quota-guid"}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created organization and warnings", func() {
+				org, warnings, err := client.CreateOrganization("some-org", "some-quota-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(org).To(Equal(Organization{
+					GUID:                "some-org-guid",
+					Name:                "some-org",
+					QuotaDefinitionGUID: "some-quota-guid",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when no quota is provided", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/organizations"),
+						VerifyJSON(`{"name": "some-org"}`),
+						RespondWith(http.StatusCreated, `{"metadata": {"guid": "some-org-guid"}}`),
+					),
+				)
+			})
+
+			It("does not send a quota", func() {
+				_, _, err := client.CreateOrganization("some-org", "")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the create returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"description": "The organization name is taken: some-org",
+					"error_code": "CF-OrganizationNameTaken",
+					"code": 30002
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/organizations"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CreateOrganization("some-org", "")
+				Expect(err).To(MatchError(ccerror.BadRequestError{Message: "The organization name is taken: some-org"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UpdateOrganizationQuota", func() {
+		Context("when the update is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-org-guid"
+					},
+					"entity": {
+						"name": "some-org",
+						"quota_definition_guid": "some-quota-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid"),
+						VerifyJSON(`{"quota_definition_guid": "some-quota-guid"}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the updated organization and warnings", func() {
+				org, warnings, err := client.UpdateOrganizationQuota("some-org-guid", "some-quota-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(org.QuotaDefinitionGUID).To(Equal("some-quota-guid"))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the update returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"description": "The organization could not be found: some-org-guid",
+					"error_code": "CF-OrganizationNotFound",
+					"code": 30003
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.UpdateOrganizationQuota("some-org-guid", "some-quota-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The organization could not be found: some-org-guid"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
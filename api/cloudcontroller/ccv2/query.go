@@ -13,6 +13,8 @@ type QueryFilter string
 type QueryOperator string
 
 const (
+	// ActeeFilter is the name of the 'actee' filter.
+	ActeeFilter QueryFilter = "actee"
 	// AppGUIDFilter is the name of the 'app_guid' filter.
 	AppGUIDFilter QueryFilter = "app_guid"
 	// DomainGUIDFilter is the name of the 'domain_guid' filter.
@@ -21,17 +23,31 @@ const (
 	OrganizationGUIDFilter QueryFilter = "organization_guid"
 	// RouteGUIDFilter is the name of the 'route_guid' filter.
 	RouteGUIDFilter QueryFilter = "route_guid"
+	// ServiceGUIDFilter is the name of the 'service_guid' filter.
+	ServiceGUIDFilter QueryFilter = "service_guid"
 	// ServiceInstanceGUIDFilter is the name of the 'service_instance_guid' filter.
 	ServiceInstanceGUIDFilter QueryFilter = "service_instance_guid"
 	// SpaceGUIDFilter is the name of the 'space_guid' filter.
 	SpaceGUIDFilter QueryFilter = "space_guid"
+	// StackGUIDFilter is the name of the 'stack_guid' filter.
+	StackGUIDFilter QueryFilter = "stack_guid"
 
 	// NameFilter is the name of the 'name' filter.
 	NameFilter QueryFilter = "name"
+	// LabelFilter is the name of the 'label' filter.
+	LabelFilter QueryFilter = "label"
 	// HostFilter is the name of the 'host' filter.
 	HostFilter QueryFilter = "host"
 	// PathFilter is the name of the 'path' filter.
 	PathFilter QueryFilter = "path"
+	// PortFilter is the name of the 'port' filter.
+	PortFilter QueryFilter = "port"
+	// StackFilter is the name of the 'stack' filter.
+	StackFilter QueryFilter = "stack"
+	// TimestampFilter is the name of the 'timestamp' filter.
+	TimestampFilter QueryFilter = "timestamp"
+	// TypeFilter is the name of the 'type' filter.
+	TypeFilter QueryFilter = "type"
 )
 
 const (
@@ -40,6 +56,9 @@ const (
 
 	// InOperator is the query "IN" operator.
 	InOperator QueryOperator = " IN "
+
+	// GreaterThanOperator is the query greater than operator.
+	GreaterThanOperator QueryOperator = ">"
 )
 
 // Query is a type of filter that can be passed to specific request to narrow
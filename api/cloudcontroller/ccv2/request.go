@@ -25,6 +25,10 @@ type requestOptions struct {
 
 	// URI is the URI of the request.
 	URI string
+	// URL is the fully qualified URL of the request. It is used for endpoints
+	// that do not live on the Cloud Controller, such as the Routing API, and
+	// takes precedence over URI and RequestName.
+	URL string
 	// Method is the HTTP method of the request.
 	Method string
 
@@ -37,7 +41,16 @@ type requestOptions struct {
 func (client Client) newHTTPRequest(passedRequest requestOptions) (*cloudcontroller.Request, error) {
 	var request *http.Request
 	var err error
-	if passedRequest.URI != "" {
+	if passedRequest.URL != "" {
+		request, err = http.NewRequest(
+			passedRequest.Method,
+			passedRequest.URL,
+			passedRequest.Body,
+		)
+		if err == nil {
+			request.URL.RawQuery = passedRequest.Query.Encode()
+		}
+	} else if passedRequest.URI != "" {
 		request, err = http.NewRequest(
 			passedRequest.Method,
 			fmt.Sprintf("%s%s", client.API(), passedRequest.URI),
@@ -0,0 +1,37 @@
+package ccv2
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// RouterGroup represents a Routing API Router Group.
+type RouterGroup struct {
+	GUID            string                   `json:"guid"`
+	Name            string                   `json:"name"`
+	Type            constant.RouterGroupType `json:"type"`
+	ReservablePorts string                   `json:"reservable_ports"`
+}
+
+// GetRouterGroups returns all the Router Groups from the Routing API
+// advertised in the Cloud Controller's /v2/info response.
+func (client *Client) GetRouterGroups() ([]RouterGroup, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		Method: http.MethodGet,
+		URL:    fmt.Sprintf("%s/v1/router_groups", client.routingEndpoint),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var routerGroups []RouterGroup
+	response := cloudcontroller.Response{
+		Result: &routerGroups,
+	}
+
+	err = client.connection.Make(request, &response)
+	return routerGroups, response.Warnings, err
+}
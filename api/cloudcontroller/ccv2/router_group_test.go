@@ -0,0 +1,92 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Router Group", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetRouterGroups", func() {
+		Context("when the routing API returns router groups", func() {
+			BeforeEach(func() {
+				response := `[
+					{
+						"guid": "some-tcp-router-group-guid",
+						"name": "default-tcp",
+						"type": "tcp",
+						"reservable_ports": "1024-1033,1050"
+					},
+					{
+						"guid": "some-http-router-group-guid",
+						"name": "default-http",
+						"type": "http",
+						"reservable_ports": ""
+					}
+				]`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/routing/v1/router_groups"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the router groups and warnings", func() {
+				routerGroups, warnings, err := client.GetRouterGroups()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(routerGroups).To(ConsistOf(
+					RouterGroup{
+						GUID:            "some-tcp-router-group-guid",
+						Name:            "default-tcp",
+						Type:            constant.TCPRouterGroup,
+						ReservablePorts: "1024-1033,1050",
+					},
+					RouterGroup{
+						GUID: "some-http-router-group-guid",
+						Name: "default-http",
+						Type: constant.HTTPRouterGroup,
+					},
+				))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the routing API returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10002,
+					"description": "You are not authorized to perform the requested action",
+					"error_code": "CF-NotAuthorized"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/routing/v1/router_groups"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetRouterGroups()
+				Expect(err).To(MatchError(ccerror.ForbiddenError{
+					Message: "You are not authorized to perform the requested action",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+})
@@ -1,7 +1,9 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
+	"net/url"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -19,6 +21,28 @@ const (
 	ManagedService ServiceInstanceType = "managed_service_instance"
 )
 
+// LastOperationState is the state of the last operation performed on a
+// Service Instance.
+type LastOperationState string
+
+const (
+	// LastOperationInProgress means the service broker is still working on the
+	// operation.
+	LastOperationInProgress LastOperationState = "in progress"
+	// LastOperationSucceeded means the operation completed successfully.
+	LastOperationSucceeded LastOperationState = "succeeded"
+	// LastOperationFailed means the operation failed.
+	LastOperationFailed LastOperationState = "failed"
+)
+
+// LastOperation is the status of the last operation performed on a Service
+// Instance.
+type LastOperation struct {
+	Type        string             `json:"type"`
+	State       LastOperationState `json:"state"`
+	Description string             `json:"description"`
+}
+
 // ServiceInstance represents a Cloud Controller Service Instance.
 type ServiceInstance struct {
 	GUID            string
@@ -28,6 +52,7 @@ type ServiceInstance struct {
 	Type            ServiceInstanceType
 	Tags            []string
 	DashboardURL    string
+	LastOperation   LastOperation
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Service Instance response.
@@ -35,12 +60,13 @@ func (serviceInstance *ServiceInstance) UnmarshalJSON(data []byte) error {
 	var ccServiceInstance struct {
 		Metadata internal.Metadata
 		Entity   struct {
-			Name            string   `json:"name"`
-			SpaceGUID       string   `json:"space_guid"`
-			ServicePlanGUID string   `json:"service_plan_guid"`
-			Type            string   `json:"type"`
-			Tags            []string `json:"tags"`
-			DashboardURL    string   `json:"dashboard_url"`
+			Name            string        `json:"name"`
+			SpaceGUID       string        `json:"space_guid"`
+			ServicePlanGUID string        `json:"service_plan_guid"`
+			Type            string        `json:"type"`
+			Tags            []string      `json:"tags"`
+			DashboardURL    string        `json:"dashboard_url"`
+			LastOperation   LastOperation `json:"last_operation"`
 		}
 	}
 	err := json.Unmarshal(data, &ccServiceInstance)
@@ -55,6 +81,7 @@ func (serviceInstance *ServiceInstance) UnmarshalJSON(data []byte) error {
 	serviceInstance.Type = ServiceInstanceType(ccServiceInstance.Entity.Type)
 	serviceInstance.Tags = ccServiceInstance.Entity.Tags
 	serviceInstance.DashboardURL = ccServiceInstance.Entity.DashboardURL
+	serviceInstance.LastOperation = ccServiceInstance.Entity.LastOperation
 	return nil
 }
 
@@ -69,6 +96,49 @@ func (serviceInstance ServiceInstance) Managed() bool {
 	return serviceInstance.Type == ManagedService
 }
 
+// serviceInstanceRequestBody represents the body of the service instance
+// create request.
+type serviceInstanceRequestBody struct {
+	Name            string                 `json:"name"`
+	SpaceGUID       string                 `json:"space_guid"`
+	ServicePlanGUID string                 `json:"service_plan_guid"`
+	Parameters      map[string]interface{} `json:"parameters,omitempty"`
+	Tags            []string               `json:"tags,omitempty"`
+}
+
+// CreateServiceInstance creates a managed service instance of the given plan
+// in the space. The service broker may provision it asynchronously; poll
+// GetServiceInstance until its LastOperation is no longer in progress.
+func (client *Client) CreateServiceInstance(spaceGUID string, servicePlanGUID string, serviceInstanceName string, parameters map[string]interface{}, tags []string) (ServiceInstance, Warnings, error) {
+	bodyBytes, err := json.Marshal(serviceInstanceRequestBody{
+		Name:            serviceInstanceName,
+		SpaceGUID:       spaceGUID,
+		ServicePlanGUID: servicePlanGUID,
+		Parameters:      parameters,
+		Tags:            tags,
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostServiceInstanceRequest,
+		Body:        bytes.NewReader(bodyBytes),
+		Query:       url.Values{"accepts_incomplete": {"true"}},
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	var serviceInstance ServiceInstance
+	response := cloudcontroller.Response{
+		Result: &serviceInstance,
+	}
+
+	err = client.connection.Make(request, &response)
+	return serviceInstance, response.Warnings, err
+}
+
 // GetServiceInstance returns the service instance with the given GUID. This
 // service can be either a managed or user provided.
 func (client *Client) GetServiceInstance(serviceInstanceGUID string) (ServiceInstance, Warnings, error) {
@@ -119,6 +119,88 @@ var _ = Describe("Service Instance", func() {
 		})
 	})
 
+	Describe("CreateServiceInstance", func() {
+		Context("when the create is successful", func() {
+			BeforeEach(func() {
+				expectedBody := `{
+					"name": "some-service-instance",
+					"space_guid": "some-space-guid",
+					"service_plan_guid": "some-service-plan-guid",
+					"parameters": {"size": "large"},
+					"tags": ["tag-1"]
+				}`
+				response := `{
+					"metadata": {
+						"guid": "some-service-instance-guid"
+					},
+					"entity": {
+						"name": "some-service-instance",
+						"space_guid": "some-space-guid",
+						"service_plan_guid": "some-service-plan-guid",
+						"type": "managed_service_instance",
+						"tags": ["tag-1"],
+						"last_operation": {
+							"type": "create",
+							"state": "in progress",
+							"description": "provisioning"
+						}
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_instances", "accepts_incomplete=true"),
+						VerifyJSON(expectedBody),
+						RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created service instance and warnings", func() {
+				serviceInstance, warnings, err := client.CreateServiceInstance("some-space-guid", "some-service-plan-guid", "some-service-instance", map[string]interface{}{"size": "large"}, []string{"tag-1"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(serviceInstance).To(Equal(ServiceInstance{
+					GUID:            "some-service-instance-guid",
+					Name:            "some-service-instance",
+					SpaceGUID:       "some-space-guid",
+					ServicePlanGUID: "some-service-plan-guid",
+					Type:            ManagedService,
+					Tags:            []string{"tag-1"},
+					LastOperation: LastOperation{
+						Type:        "create",
+						State:       LastOperationInProgress,
+						Description: "provisioning",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the create returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"description": "The service instance name is taken: some-service-instance",
+					"error_code": "CF-ServiceInstanceNameTaken",
+					"code": 60002
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_instances", "accepts_incomplete=true"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CreateServiceInstance("some-space-guid", "some-service-plan-guid", "some-service-instance", nil, nil)
+				Expect(err).To(MatchError(ccerror.BadRequestError{Message: "The service instance name is taken: some-service-instance"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("GetServiceInstance", func() {
 		BeforeEach(func() {
 			response := `{
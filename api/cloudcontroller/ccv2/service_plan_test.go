@@ -73,4 +73,63 @@ var _ = Describe("Service Plan", func() {
 			})
 		})
 	})
+
+	Describe("GetServicePlans", func() {
+		BeforeEach(func() {
+			response1 := `{
+				"next_url": "/v2/service_plans?q=service_guid:some-service-guid&page=2",
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-service-plan-guid-1"
+						},
+						"entity": {
+							"name": "some-service-plan-1",
+							"service_guid": "some-service-guid"
+						}
+					}
+				]
+			}`
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-service-plan-guid-2"
+						},
+						"entity": {
+							"name": "some-service-plan-2",
+							"service_guid": "some-service-guid"
+						}
+					}
+				]
+			}`
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_plans", "q=service_guid:some-service-guid"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_plans", "q=service_guid:some-service-guid&page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+				),
+			)
+		})
+
+		It("returns all the service plans and warnings", func() {
+			servicePlans, warnings, err := client.GetServicePlans(Query{
+				Filter:   ServiceGUIDFilter,
+				Operator: EqualOperator,
+				Values:   []string{"some-service-guid"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(servicePlans).To(ConsistOf(
+				ServicePlan{GUID: "some-service-plan-guid-1", Name: "some-service-plan-1", ServiceGUID: "some-service-guid"},
+				ServicePlan{GUID: "some-service-plan-guid-2", Name: "some-service-plan-2", ServiceGUID: "some-service-guid"},
+			))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+		})
+	})
 })
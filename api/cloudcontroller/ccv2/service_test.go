@@ -75,4 +75,46 @@ var _ = Describe("Service", func() {
 			})
 		})
 	})
+
+	Describe("GetServices", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-service-guid"
+						},
+						"entity": {
+							"label": "some-service",
+							"description": "some-description"
+						}
+					}
+				]
+			}`
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/services", "q=label:some-service"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the matching services and warnings", func() {
+			services, warnings, err := client.GetServices(Query{
+				Filter:   LabelFilter,
+				Operator: EqualOperator,
+				Values:   []string{"some-service"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(services).To(ConsistOf(Service{
+				GUID:        "some-service-guid",
+				Label:       "some-service",
+				Description: "some-description",
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
 })
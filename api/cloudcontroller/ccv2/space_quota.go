@@ -1,15 +1,55 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
+// SpaceQuota is the definition of a quota for a space. Limits of -1 are
+// unlimited.
 type SpaceQuota struct {
-	GUID string
-	Name string
+	GUID                    string
+	Name                    string
+	OrganizationGUID        string
+	MemoryLimitInMB         int
+	InstanceMemoryLimitInMB int
+	TotalRoutes             int
+	TotalServices           int
+	AppInstanceLimit        int
+	NonBasicServicesAllowed bool
+
+	// TotalReservedRoutePorts is only read from the Cloud Controller; it is
+	// left to the Cloud Controller's default when creating or updating.
+	TotalReservedRoutePorts int
+}
+
+type ccSpaceQuotaEntity struct {
+	Name                    string `json:"name"`
+	OrganizationGUID        string `json:"organization_guid,omitempty"`
+	MemoryLimitInMB         int    `json:"memory_limit"`
+	InstanceMemoryLimitInMB int    `json:"instance_memory_limit"`
+	TotalRoutes             int    `json:"total_routes"`
+	TotalServices           int    `json:"total_services"`
+	AppInstanceLimit        int    `json:"app_instance_limit"`
+	NonBasicServicesAllowed bool   `json:"non_basic_services_allowed"`
+}
+
+// MarshalJSON converts a space quota into a Cloud Controller Space Quota.
+func (spaceQuota SpaceQuota) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ccSpaceQuotaEntity{
+		Name:                    spaceQuota.Name,
+		OrganizationGUID:        spaceQuota.OrganizationGUID,
+		MemoryLimitInMB:         spaceQuota.MemoryLimitInMB,
+		InstanceMemoryLimitInMB: spaceQuota.InstanceMemoryLimitInMB,
+		TotalRoutes:             spaceQuota.TotalRoutes,
+		TotalServices:           spaceQuota.TotalServices,
+		AppInstanceLimit:        spaceQuota.AppInstanceLimit,
+		NonBasicServicesAllowed: spaceQuota.NonBasicServicesAllowed,
+	})
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Space Quota response.
@@ -17,7 +57,8 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 	var ccSpaceQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			ccSpaceQuotaEntity
+			TotalReservedRoutePorts int `json:"total_reserved_route_ports"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccSpaceQuota); err != nil {
@@ -26,9 +67,68 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 
 	spaceQuota.GUID = ccSpaceQuota.Metadata.GUID
 	spaceQuota.Name = ccSpaceQuota.Entity.Name
+	spaceQuota.OrganizationGUID = ccSpaceQuota.Entity.OrganizationGUID
+	spaceQuota.MemoryLimitInMB = ccSpaceQuota.Entity.MemoryLimitInMB
+	spaceQuota.InstanceMemoryLimitInMB = ccSpaceQuota.Entity.InstanceMemoryLimitInMB
+	spaceQuota.TotalRoutes = ccSpaceQuota.Entity.TotalRoutes
+	spaceQuota.TotalServices = ccSpaceQuota.Entity.TotalServices
+	spaceQuota.AppInstanceLimit = ccSpaceQuota.Entity.AppInstanceLimit
+	spaceQuota.NonBasicServicesAllowed = ccSpaceQuota.Entity.NonBasicServicesAllowed
+	spaceQuota.TotalReservedRoutePorts = ccSpaceQuota.Entity.TotalReservedRoutePorts
 	return nil
 }
 
+// CreateSpaceQuota creates a space quota in the space quota's organization.
+func (client *Client) CreateSpaceQuota(spaceQuota SpaceQuota) (SpaceQuota, Warnings, error) {
+	bodyBytes, err := json.Marshal(spaceQuota)
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSpaceQuotaDefinitionRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	var createdSpaceQuota SpaceQuota
+	response := cloudcontroller.Response{
+		Result: &createdSpaceQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return createdSpaceQuota, response.Warnings, err
+}
+
+// GetOrganizationSpaceQuotas returns the space quotas defined in an
+// organization.
+func (client *Client) GetOrganizationSpaceQuotas(orgGUID string) ([]SpaceQuota, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationSpaceQuotaDefinitionsRequest,
+		URIParams:   Params{"organization_guid": orgGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullSpaceQuotasList []SpaceQuota
+	warnings, err := client.paginate(request, SpaceQuota{}, func(item interface{}) error {
+		if spaceQuota, ok := item.(SpaceQuota); ok {
+			fullSpaceQuotasList = append(fullSpaceQuotasList, spaceQuota)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   SpaceQuota{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullSpaceQuotasList, warnings, err
+}
+
 // GetSpaceQuota returns a Space Quota.
 func (client *Client) GetSpaceQuota(guid string) (SpaceQuota, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -47,3 +147,51 @@ func (client *Client) GetSpaceQuota(guid string) (SpaceQuota, Warnings, error) {
 	err = client.connection.Make(request, &response)
 	return spaceQuota, response.Warnings, err
 }
+
+// SetSpaceQuota assigns the space quota with the provided GUID to a space.
+func (client *Client) SetSpaceQuota(spaceGUID string, spaceQuotaGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSpaceQuotaDefinitionSpaceRequest,
+		URIParams: Params{
+			"space_quota_guid": spaceQuotaGUID,
+			"space_guid":       spaceGUID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// UpdateSpaceQuota updates the limits of the space quota with the GUID of the
+// provided space quota.
+func (client *Client) UpdateSpaceQuota(spaceQuota SpaceQuota) (SpaceQuota, Warnings, error) {
+	guid := spaceQuota.GUID
+	spaceQuota.OrganizationGUID = ""
+
+	bodyBytes, err := json.Marshal(spaceQuota)
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSpaceQuotaDefinitionRequest,
+		URIParams:   Params{"space_quota_guid": guid},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	var updatedSpaceQuota SpaceQuota
+	response := cloudcontroller.Response{
+		Result: &updatedSpaceQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return updatedSpaceQuota, response.Warnings, err
+}
@@ -27,7 +27,13 @@ var _ = Describe("Space Quotas", func() {
 						"updated_at": null
 					},
 					"entity": {
-						"name": "space-quota"
+						"name": "space-quota",
+						"memory_limit": 2048,
+						"instance_memory_limit": -1,
+						"total_routes": 10,
+						"total_services": 5,
+						"app_instance_limit": -1,
+						"total_reserved_route_ports": 2
 					}
 				}`
 				server.AppendHandlers(
@@ -43,8 +49,14 @@ var _ = Describe("Space Quotas", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 				Expect(spaceQuota).To(Equal(SpaceQuota{
-					Name: "space-quota",
-					GUID: "space-quota-guid",
+					Name:                    "space-quota",
+					GUID:                    "space-quota-guid",
+					MemoryLimitInMB:         2048,
+					InstanceMemoryLimitInMB: -1,
+					TotalRoutes:             10,
+					TotalServices:           5,
+					AppInstanceLimit:        -1,
+					TotalReservedRoutePorts: 2,
 				}))
 			})
 		})
@@ -71,4 +83,276 @@ var _ = Describe("Space Quotas", func() {
 			})
 		})
 	})
+
+	Describe("CreateSpaceQuota", func() {
+		Context("when the create is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-space-quota-guid"
+					},
+					"entity": {
+						"name": "some-space-quota",
+						"organization_guid": "some-org-guid",
+						"memory_limit": 1024,
+						"instance_memory_limit": -1,
+						"total_routes": 10,
+						"total_services": 5,
+						"app_instance_limit": -1,
+						"non_basic_services_allowed": true
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/space_quota_definitions"),
+						VerifyJSON(`{
+						"name": "some-space-quota",
+						"organization_guid": "some-org-guid",
+						"memory_limit": 1024,
+						"instance_memory_limit": -1,
+						"total_routes": 10,
+						"total_services": 5,
+						"app_instance_limit": -1,
+						"non_basic_services_allowed": true
+					}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created space quota and warnings", func() {
+				spaceQuota, warnings, err := client.CreateSpaceQuota(SpaceQuota{
+					Name:                    "some-space-quota",
+					OrganizationGUID:        "some-org-guid",
+					MemoryLimitInMB:         1024,
+					InstanceMemoryLimitInMB: -1,
+					TotalRoutes:             10,
+					TotalServices:           5,
+					AppInstanceLimit:        -1,
+					NonBasicServicesAllowed: true,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(spaceQuota).To(Equal(SpaceQuota{
+					GUID:                    "some-space-quota-guid",
+					Name:                    "some-space-quota",
+					OrganizationGUID:        "some-org-guid",
+					MemoryLimitInMB:         1024,
+					InstanceMemoryLimitInMB: -1,
+					TotalRoutes:             10,
+					TotalServices:           5,
+					AppInstanceLimit:        -1,
+					NonBasicServicesAllowed: true,
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the create returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"description": "The space quota definition name is taken: some-space-quota",
+					"error_code": "CF-SpaceQuotaDefinitionNameTaken",
+					"code": 310001
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/space_quota_definitions"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CreateSpaceQuota(SpaceQuota{Name: "some-space-quota"})
+				Expect(err).To(MatchError(ccerror.BadRequestError{Message: "The space quota definition name is taken: some-space-quota"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetOrganizationSpaceQuotas", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/organizations/some-org-guid/space_quota_definitions?page=2",
+					"resources": [
+						{
+							"metadata": {"guid": "some-space-quota-guid-1"},
+							"entity": {"name": "some-space-quota-1", "organization_guid": "some-org-guid", "memory_limit": 1024}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {"guid": "some-space-quota-guid-2"},
+							"entity": {"name": "some-space-quota-2", "organization_guid": "some-org-guid", "memory_limit": -1}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/space_quota_definitions"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/space_quota_definitions", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the space quotas and all warnings", func() {
+				spaceQuotas, warnings, err := client.GetOrganizationSpaceQuotas("some-org-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(spaceQuotas).To(Equal([]SpaceQuota{
+					{GUID: "some-space-quota-guid-1", Name: "some-space-quota-1", OrganizationGUID: "some-org-guid", MemoryLimitInMB: 1024},
+					{GUID: "some-space-quota-guid-2", Name: "some-space-quota-2", OrganizationGUID: "some-org-guid", MemoryLimitInMB: -1},
+				}))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"description": "The organization could not be found: some-org-guid",
+					"error_code": "CF-OrganizationNotFound",
+					"code": 30003
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/space_quota_definitions"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetOrganizationSpaceQuotas("some-org-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The organization could not be found: some-org-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("SetSpaceQuota", func() {
+		Context("when the space quota is set", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/space_quota_definitions/some-space-quota-guid/spaces/some-space-guid"),
+						RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns warnings", func() {
+				warnings, err := client.SetSpaceQuota("some-space-guid", "some-space-quota-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when setting the space quota returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"description": "The app space could not be found: some-space-guid",
+					"error_code": "CF-SpaceNotFound",
+					"code": 40004
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/space_quota_definitions/some-space-quota-guid/spaces/some-space-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.SetSpaceQuota("some-space-guid", "some-space-quota-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The app space could not be found: some-space-guid"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UpdateSpaceQuota", func() {
+		Context("when the update is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-space-quota-guid"
+					},
+					"entity": {
+						"name": "some-space-quota",
+						"organization_guid": "some-org-guid",
+						"memory_limit": 1024,
+						"instance_memory_limit": -1,
+						"total_routes": 10,
+						"total_services": 5,
+						"app_instance_limit": -1,
+						"non_basic_services_allowed": true
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/space_quota_definitions/some-space-quota-guid"),
+						VerifyJSON(`{
+							"name": "some-space-quota",
+							"memory_limit": 1024,
+							"instance_memory_limit": -1,
+							"total_routes": 10,
+							"total_services": 5,
+							"app_instance_limit": -1,
+							"non_basic_services_allowed": true
+						}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("sends the limits without the organization and returns warnings", func() {
+				spaceQuota, warnings, err := client.UpdateSpaceQuota(SpaceQuota{
+					GUID:                    "some-space-quota-guid",
+					Name:                    "some-space-quota",
+					OrganizationGUID:        "some-org-guid",
+					MemoryLimitInMB:         1024,
+					InstanceMemoryLimitInMB: -1,
+					TotalRoutes:             10,
+					TotalServices:           5,
+					AppInstanceLimit:        -1,
+					NonBasicServicesAllowed: true,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(spaceQuota.GUID).To(Equal("some-space-quota-guid"))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the update returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"description": "Space Quota Definition could not be found: some-space-quota-guid",
+					"error_code": "CF-SpaceQuotaDefinitionNotFound",
+					"code": 310007
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/space_quota_definitions/some-space-quota-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.UpdateSpaceQuota(SpaceQuota{GUID: "some-space-quota-guid"})
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Space Quota Definition could not be found: some-space-quota-guid"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
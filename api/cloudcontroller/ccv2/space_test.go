@@ -437,4 +437,60 @@ var _ = Describe("Space", func() {
 			})
 		})
 	})
+
+	Describe("CreateSpace", func() {
+		Context("when the create is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-space-guid"
+					},
+					"entity": {
+						"name": "some-space",
+						"organization_guid": "some-org-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/spaces"),
+						VerifyJSON(`{"name": "some-space", "organization_guid": "some-org-guid"}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created space and warnings", func() {
+				space, warnings, err := client.CreateSpace("some-space", "some-org-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(space).To(Equal(Space{
+					GUID:             "some-space-guid",
+					Name:             "some-space",
+					OrganizationGUID: "some-org-guid",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the create returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"description": "The app space name is taken: some-space",
+					"error_code": "CF-SpaceNameTaken",
+					"code": 40002
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/spaces"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CreateSpace("some-space", "some-org-guid")
+				Expect(err).To(MatchError(ccerror.BadRequestError{Message: "The app space name is taken: some-space"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
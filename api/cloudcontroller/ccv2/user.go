@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
 // User represents a Cloud Controller User.
 type User struct {
-	GUID string
+	GUID     string
+	Username string
 }
 
 // userRequestBody represents the body of the request.
@@ -22,12 +25,16 @@ type userRequestBody struct {
 func (user *User) UnmarshalJSON(data []byte) error {
 	var ccUser struct {
 		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Username string `json:"username"`
+		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccUser); err != nil {
 		return err
 	}
 
 	user.GUID = ccUser.Metadata.GUID
+	user.Username = ccUser.Entity.Username
 	return nil
 }
 
@@ -61,3 +68,141 @@ func (client *Client) CreateUser(uaaUserID string) (User, Warnings, error) {
 
 	return user, response.Warnings, nil
 }
+
+// userRoleRequestBody represents the body of a request giving a user a role.
+type userRoleRequestBody struct {
+	Username string `json:"username"`
+}
+
+// GetOrganizationUsersByRole returns the users with the provided role in an
+// organization.
+func (client *Client) GetOrganizationUsersByRole(role constant.OrgRole, orgGUID string) ([]User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationUsersByRoleRequest,
+		URIParams:   Params{"organization_guid": orgGUID, "role": string(role)},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullUsersList []User
+	warnings, err := client.paginate(request, User{}, func(item interface{}) error {
+		if user, ok := item.(User); ok {
+			fullUsersList = append(fullUsersList, user)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   User{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullUsersList, warnings, err
+}
+
+// GetSpaceUsersByRole returns the users with the provided role in a space.
+func (client *Client) GetSpaceUsersByRole(role constant.SpaceRole, spaceGUID string) ([]User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetSpaceUsersByRoleRequest,
+		URIParams:   Params{"space_guid": spaceGUID, "role": string(role)},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullUsersList []User
+	warnings, err := client.paginate(request, User{}, func(item interface{}) error {
+		if user, ok := item.(User); ok {
+			fullUsersList = append(fullUsersList, user)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   User{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullUsersList, warnings, err
+}
+
+// UpdateOrganizationUserByRole gives the user with the provided username a
+// role in an organization.
+func (client *Client) UpdateOrganizationUserByRole(role constant.OrgRole, orgGUID string, username string) (Warnings, error) {
+	bodyBytes, err := json.Marshal(userRoleRequestBody{Username: username})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutOrganizationUserByRoleRequest,
+		URIParams:   Params{"organization_guid": orgGUID, "role": string(role)},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// UpdateSpaceUserByRole gives the user with the provided username a role in a
+// space.
+func (client *Client) UpdateSpaceUserByRole(role constant.SpaceRole, spaceGUID string, username string) (Warnings, error) {
+	bodyBytes, err := json.Marshal(userRoleRequestBody{Username: username})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSpaceUserByRoleRequest,
+		URIParams:   Params{"space_guid": spaceGUID, "role": string(role)},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// DeleteOrganizationUserByRole removes a role in an organization from the
+// user with the provided GUID.
+func (client *Client) DeleteOrganizationUserByRole(role constant.OrgRole, orgGUID string, userGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteOrganizationUserByRoleRequest,
+		URIParams:   Params{"organization_guid": orgGUID, "role": string(role), "user_guid": userGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// DeleteSpaceUserByRole removes a role in a space from the user with the
+// provided GUID.
+func (client *Client) DeleteSpaceUserByRole(role constant.SpaceRole, spaceGUID string, userGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteSpaceUserByRoleRequest,
+		URIParams:   Params{"space_guid": spaceGUID, "role": string(role), "user_guid": userGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -87,4 +88,290 @@ var _ = Describe("User", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationUsersByRole", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/organizations/some-org-guid/managers?page=2",
+					"resources": [
+						{
+							"metadata": {"guid": "some-user-guid-1"},
+							"entity": {"username": "some-user-1"}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {"guid": "some-user-guid-2"},
+							"entity": {"username": "some-user-2"}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/managers"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/managers", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the users and all warnings", func() {
+				users, warnings, err := client.GetOrganizationUsersByRole(constant.OrgManagerRole, "some-org-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(users).To(Equal([]User{
+					{GUID: "some-user-guid-1", Username: "some-user-1"},
+					{GUID: "some-user-guid-2", Username: "some-user-2"},
+				}))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"description": "The organization could not be found: some-org-guid",
+					"error_code": "CF-OrganizationNotFound",
+					"code": 30003
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/auditors"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetOrganizationUsersByRole(constant.OrgAuditorRole, "some-org-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The organization could not be found: some-org-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetSpaceUsersByRole", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {"guid": "some-user-guid"},
+							"entity": {"username": "some-user"}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/spaces/some-space-guid/developers"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the users and warnings", func() {
+				users, warnings, err := client.GetSpaceUsersByRole(constant.SpaceDeveloperRole, "some-space-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(users).To(Equal([]User{{GUID: "some-user-guid", Username: "some-user"}}))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"description": "The app space could not be found: some-space-guid",
+					"error_code": "CF-SpaceNotFound",
+					"code": 40004
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/spaces/some-space-guid/managers"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetSpaceUsersByRole(constant.SpaceManagerRole, "some-space-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The app space could not be found: some-space-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("UpdateOrganizationUserByRole", func() {
+		Context("when the role is given", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid/billing_managers"),
+						VerifyJSON(`{"username": "some-user"}`),
+						RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns warnings", func() {
+				warnings, err := client.UpdateOrganizationUserByRole(constant.OrgBillingManagerRole, "some-org-guid", "some-user")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"description": "The user could not be found: some-user",
+					"error_code": "CF-UserNotFound",
+					"code": 20003
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid/users"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.UpdateOrganizationUserByRole(constant.OrgUserRole, "some-org-guid", "some-user")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The user could not be found: some-user"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("UpdateSpaceUserByRole", func() {
+		Context("when the role is given", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/spaces/some-space-guid/auditors"),
+						VerifyJSON(`{"username": "some-user"}`),
+						RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns warnings", func() {
+				warnings, err := client.UpdateSpaceUserByRole(constant.SpaceAuditorRole, "some-space-guid", "some-user")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"description": "The user could not be found: some-user",
+					"error_code": "CF-UserNotFound",
+					"code": 20003
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/spaces/some-space-guid/developers"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.UpdateSpaceUserByRole(constant.SpaceDeveloperRole, "some-space-guid", "some-user")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The user could not be found: some-user"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("DeleteOrganizationUserByRole", func() {
+		Context("when the role is removed", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/organizations/some-org-guid/managers/some-user-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns warnings", func() {
+				warnings, err := client.DeleteOrganizationUserByRole(constant.OrgManagerRole, "some-org-guid", "some-user-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"description": "The user could not be found: some-user-guid",
+					"error_code": "CF-UserNotFound",
+					"code": 20003
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/organizations/some-org-guid/auditors/some-user-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.DeleteOrganizationUserByRole(constant.OrgAuditorRole, "some-org-guid", "some-user-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The user could not be found: some-user-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("DeleteSpaceUserByRole", func() {
+		Context("when the role is removed", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/spaces/some-space-guid/developers/some-user-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns warnings", func() {
+				warnings, err := client.DeleteSpaceUserByRole(constant.SpaceDeveloperRole, "some-space-guid", "some-user-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"description": "The user could not be found: some-user-guid",
+					"error_code": "CF-UserNotFound",
+					"code": 20003
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/spaces/some-space-guid/managers/some-user-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.DeleteSpaceUserByRole(constant.SpaceManagerRole, "some-space-guid", "some-user-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The user could not be found: some-user-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
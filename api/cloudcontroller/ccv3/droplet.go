@@ -1,6 +1,9 @@
 package ccv3
 
 import (
+	"bytes"
+	"encoding/json"
+	"io"
 	"net/url"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -11,10 +14,12 @@ import (
 type DropletState string
 
 const (
-	DropletStateStaged  DropletState = "STAGED"
-	DropletStateFailed  DropletState = "FAILED"
-	DropletStateCopying DropletState = "COPYING"
-	DropletStateExpired DropletState = "EXPIRED"
+	DropletStateStaged           DropletState = "STAGED"
+	DropletStateFailed           DropletState = "FAILED"
+	DropletStateCopying          DropletState = "COPYING"
+	DropletStateExpired          DropletState = "EXPIRED"
+	DropletStateAwaitingUpload   DropletState = "AWAITING_UPLOAD"
+	DropletStateProcessingUpload DropletState = "PROCESSING_UPLOAD"
 )
 
 type Droplet struct {
@@ -75,3 +80,117 @@ func (client *Client) GetDroplet(dropletGUID string) (Droplet, Warnings, error)
 
 	return responseDroplet, response.Warnings, err
 }
+
+// GetApplicationCurrentDroplet returns the current droplet of the given app.
+func (client *Client) GetApplicationCurrentDroplet(appGUID string) (Droplet, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetApplicationCurrentDropletRequest,
+		URIParams:   map[string]string{"app_guid": appGUID},
+	})
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	var responseDroplet Droplet
+	response := cloudcontroller.Response{
+		Result: &responseDroplet,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDroplet, response.Warnings, err
+}
+
+// CreateDroplet creates a droplet, awaiting upload, for the given app.
+func (client *Client) CreateDroplet(appGUID string) (Droplet, Warnings, error) {
+	bodyBytes, err := json.Marshal(struct {
+		Relationships Relationships `json:"relationships"`
+	}{
+		Relationships: Relationships{
+			ApplicationRelationship: Relationship{GUID: appGUID},
+		},
+	})
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDropletRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	var responseDroplet Droplet
+	response := cloudcontroller.Response{
+		Result: &responseDroplet,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDroplet, response.Warnings, err
+}
+
+// UploadDropletBits uploads the droplet tarball at dropletPath to the given
+// droplet. The tarball is streamed to Cloud Controller, so a failed request
+// cannot be retried and returns a PipeSeekError. The droplet is processed
+// asynchronously; poll GetDroplet until it is staged.
+func (client *Client) UploadDropletBits(dropletGUID string, dropletPath string) (Warnings, error) {
+	contentType, body, contentLength, writeErrors, err := client.createPipedUploadStream(dropletPath, "bits")
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDropletBitsRequest,
+		URIParams:   map[string]string{"droplet_guid": dropletGUID},
+		Body:        body,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-Type", contentType)
+	request.ContentLength = contentLength
+
+	response := cloudcontroller.Response{}
+
+	httpErrors := client.uploadBits(request, &response)
+	err = waitForUpload(writeErrors, httpErrors)
+	return response.Warnings, err
+}
+
+// DownloadDroplet writes the contents of the given droplet's tarball to
+// output as they are received.
+func (client *Client) DownloadDroplet(dropletGUID string, output io.Writer) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDropletDownloadRequest,
+		URIParams:   map[string]string{"droplet_guid": dropletGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{
+		Writer: output,
+	}
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
+
+// DeleteDroplet deletes the given droplet and returns the URL of the job
+// tracking the deletion.
+func (client *Client) DeleteDroplet(dropletGUID string) (string, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteDropletRequest,
+		URIParams:   internal.Params{"droplet_guid": dropletGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.ResourceLocationURL, response.Warnings, err
+}
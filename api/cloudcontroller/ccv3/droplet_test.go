@@ -1,14 +1,18 @@
 package ccv3_test
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"os"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
 	. "github.com/onsi/gomega/ghttp"
 )
 
@@ -232,4 +236,273 @@ var _ = Describe("Droplet", func() {
 			})
 		})
 	})
+
+	Describe("GetApplicationCurrentDroplet", func() {
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-droplet-guid",
+					"state": "STAGED",
+					"stack": "some-stack",
+					"created_at": "2016-03-28T23:39:34Z"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/droplets/current"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the current droplet and all warnings", func() {
+				droplet, warnings, err := client.GetApplicationCurrentDroplet("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(droplet).To(Equal(Droplet{
+					GUID:      "some-droplet-guid",
+					Stack:     "some-stack",
+					State:     DropletStateStaged,
+					CreatedAt: "2016-03-28T23:39:34Z",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Droplet not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/droplets/current"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetApplicationCurrentDroplet("some-app-guid")
+				Expect(err).To(MatchError(ccerror.DropletNotFoundError{}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("CreateDroplet", func() {
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-droplet-guid",
+					"state": "AWAITING_UPLOAD"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/droplets"),
+						VerifyJSON(`{"relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the created droplet and all warnings", func() {
+				droplet, warnings, err := client.CreateDroplet("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(droplet).To(Equal(Droplet{
+					GUID:  "some-droplet-guid",
+					State: DropletStateAwaitingUpload,
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The request is semantically invalid: App is invalid",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/droplets"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.CreateDroplet("some-app-guid")
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "The request is semantically invalid: App is invalid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("UploadDropletBits", func() {
+		var dropletFile *os.File
+
+		BeforeEach(func() {
+			var err error
+			dropletFile, err = ioutil.TempFile("", "droplet-upload")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = dropletFile.WriteString("some-droplet-contents")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dropletFile.Close()).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(dropletFile.Name())).To(Succeed())
+		})
+
+		Context("when the upload succeeds", func() {
+			BeforeEach(func() {
+				verifyHeaderAndBody := func(_ http.ResponseWriter, req *http.Request) {
+					contentType := req.Header.Get("Content-Type")
+					Expect(contentType).To(MatchRegexp("multipart/form-data; boundary=[\\w\\d]+"))
+					Expect(req.ContentLength).To(BeNumerically(">", len("some-droplet-contents")))
+
+					defer req.Body.Close()
+					rawBody, err := ioutil.ReadAll(req.Body)
+					Expect(err).NotTo(HaveOccurred())
+					body := BufferWithBytes(rawBody)
+					Expect(body).To(Say(`name="bits"`))
+					Expect(body).To(Say("some-droplet-contents"))
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/droplets/some-droplet-guid/upload"),
+						verifyHeaderAndBody,
+						RespondWith(http.StatusAccepted, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("streams the droplet file with its length and returns all warnings", func() {
+				warnings, err := client.UploadDropletBits("some-droplet-guid", dropletFile.Name())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the droplet file does not exist", func() {
+			It("returns the error", func() {
+				_, err := client.UploadDropletBits("some-droplet-guid", "/does/not/exist")
+				_, ok := err.(*os.PathError)
+				Expect(ok).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("DownloadDroplet", func() {
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid/download"),
+						RespondWith(http.StatusOK, "some-droplet-contents", http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("writes the droplet contents to the output and returns all warnings", func() {
+				output := &bytes.Buffer{}
+				warnings, err := client.DownloadDroplet("some-droplet-guid", output)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(output.String()).To(Equal("some-droplet-contents"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Droplet not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid/download"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				output := &bytes.Buffer{}
+				warnings, err := client.DownloadDroplet("some-droplet-guid", output)
+				Expect(err).To(MatchError(ccerror.DropletNotFoundError{}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(output.Len()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DeleteDroplet", func() {
+		Context("when the droplet is deleted successfully", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/droplets/some-droplet-guid"),
+						RespondWith(http.StatusAccepted, ``,
+							http.Header{
+								"X-Cf-Warnings": {"some-warning"},
+								"Location":      {"/v3/jobs/some-location"},
+							},
+						),
+					),
+				)
+			})
+
+			It("returns the job URL and all warnings", func() {
+				jobURL, warnings, err := client.DeleteDroplet("some-droplet-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal("/v3/jobs/some-location"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		Context("when cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Droplet not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/droplets/some-droplet-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"some-warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.DeleteDroplet("some-droplet-guid")
+				Expect(err).To(MatchError(ccerror.DropletNotFoundError{}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
 })
@@ -13,15 +13,19 @@ import "net/http"
 const (
 	DeleteApplicationProcessInstanceRequest                 = "DeleteApplicationProcessInstanceRequest"
 	DeleteApplicationRequest                                = "DeleteApplication"
+	DeleteDropletRequest                                    = "DeleteDroplet"
 	DeleteIsolationSegmentRelationshipOrganizationRequest   = "DeleteIsolationSegmentRelationshipOrganization"
 	DeleteIsolationSegmentRequest                           = "DeleteIsolationSegment"
+	DeletePackageRequest                                    = "DeletePackage"
 	GetAppDropletsRequest                                   = "GetAppDroplets"
+	GetApplicationCurrentDropletRequest                     = "GetApplicationCurrentDroplet"
 	GetApplicationEnvironmentVariables                      = "GetApplicationEnvironmentVariables"
 	GetApplicationProcessByTypeRequest                      = "GetApplicationProcessByType"
 	GetAppProcessesRequest                                  = "GetAppProcesses"
 	GetAppsRequest                                          = "GetApps"
 	GetAppTasksRequest                                      = "GetAppTasks"
 	GetBuildRequest                                         = "GetBuild"
+	GetDropletDownloadRequest                               = "GetDropletDownload"
 	GetDropletRequest                                       = "GetDroplet"
 	GetIsolationSegmentOrganizationsRequest                 = "GetIsolationSegmentRelationshipOrganizations"
 	GetIsolationSegmentRequest                              = "GetIsolationSegment"
@@ -34,6 +38,7 @@ const (
 	GetSpaceRelationshipIsolationSegmentRequest             = "GetSpaceRelationshipIsolationSegmentRequest"
 	GetSpacesRequest                                        = "GetSpaces"
 	PatchApplicationCurrentDropletRequest                   = "PatchApplicationCurrentDroplet"
+	PatchApplicationProcessCommandRequest                   = "PatchApplicationProcessCommand"
 	PatchApplicationProcessHealthCheckRequest               = "PatchApplicationProcessHealthCheck"
 	PatchApplicationRequest                                 = "PatchApplicationRequest"
 	PatchApplicationUserProvidedEnvironmentVariablesRequest = "PatchApplicationUserProvidedEnvironmentVariablesRequest"
@@ -45,6 +50,8 @@ const (
 	PostApplicationStopRequest                              = "PostApplicationStop"
 	PostAppTasksRequest                                     = "PostAppTasks"
 	PostBuildRequest                                        = "PostBuild"
+	PostDropletBitsRequest                                  = "PostDropletBits"
+	PostDropletRequest                                      = "PostDroplet"
 	PostIsolationSegmentRelationshipOrganizationsRequest    = "PostIsolationSegmentRelationshipOrganizations"
 	PostIsolationSegmentsRequest                            = "PostIsolationSegments"
 	PostPackageRequest                                      = "PostPackageRequest"
@@ -72,6 +79,7 @@ var APIRoutes = []Route{
 	{Path: "/", Method: http.MethodGet, Name: GetSpacesRequest, Resource: SpacesResource},
 	{Path: "/", Method: http.MethodPost, Name: PostApplicationRequest, Resource: AppsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostBuildRequest, Resource: BuildsResource},
+	{Path: "/", Method: http.MethodPost, Name: PostDropletRequest, Resource: DropletsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostIsolationSegmentsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostPackageRequest, Resource: PackagesResource},
 	{Path: "/:app_guid", Method: http.MethodDelete, Name: DeleteApplicationRequest, Resource: AppsResource},
@@ -79,6 +87,7 @@ var APIRoutes = []Route{
 	{Path: "/:app_guid/actions/start", Method: http.MethodPost, Name: PostApplicationStartRequest, Resource: AppsResource},
 	{Path: "/:app_guid/actions/stop", Method: http.MethodPost, Name: PostApplicationStopRequest, Resource: AppsResource},
 	{Path: "/:app_guid/droplets", Method: http.MethodGet, Name: GetAppDropletsRequest, Resource: AppsResource},
+	{Path: "/:app_guid/droplets/current", Method: http.MethodGet, Name: GetApplicationCurrentDropletRequest, Resource: AppsResource},
 	{Path: "/:app_guid/env", Method: http.MethodGet, Name: GetApplicationEnvironmentVariables, Resource: AppsResource},
 	{Path: "/:app_guid/environment_variables", Method: http.MethodPatch, Name: PatchApplicationUserProvidedEnvironmentVariablesRequest, Resource: AppsResource},
 	{Path: "/:app_guid/processes", Method: http.MethodGet, Name: GetAppProcessesRequest, Resource: AppsResource},
@@ -89,7 +98,10 @@ var APIRoutes = []Route{
 	{Path: "/:app_guid/tasks", Method: http.MethodGet, Name: GetAppTasksRequest, Resource: AppsResource},
 	{Path: "/:app_guid/tasks", Method: http.MethodPost, Name: PostAppTasksRequest, Resource: AppsResource},
 	{Path: "/:build_guid", Method: http.MethodGet, Name: GetBuildRequest, Resource: BuildsResource},
+	{Path: "/:droplet_guid", Method: http.MethodDelete, Name: DeleteDropletRequest, Resource: DropletsResource},
 	{Path: "/:droplet_guid", Method: http.MethodGet, Name: GetDropletRequest, Resource: DropletsResource},
+	{Path: "/:droplet_guid/download", Method: http.MethodGet, Name: GetDropletDownloadRequest, Resource: DropletsResource},
+	{Path: "/:droplet_guid/upload", Method: http.MethodPost, Name: PostDropletBitsRequest, Resource: DropletsResource},
 	{Path: "/:isolation_segment_guid", Method: http.MethodDelete, Name: DeleteIsolationSegmentRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:isolation_segment_guid", Method: http.MethodGet, Name: GetIsolationSegmentRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:isolation_segment_guid/organizations", Method: http.MethodGet, Name: GetIsolationSegmentOrganizationsRequest, Resource: IsolationSegmentsResource},
@@ -97,7 +109,9 @@ var APIRoutes = []Route{
 	{Path: "/:isolation_segment_guid/relationships/organizations/:organization_guid", Method: http.MethodDelete, Name: DeleteIsolationSegmentRelationshipOrganizationRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:organization_guid/relationships/default_isolation_segment", Method: http.MethodGet, Name: GetOrganizationDefaultIsolationSegmentRequest, Resource: OrgsResource},
 	{Path: "/:organization_guid/relationships/default_isolation_segment", Method: http.MethodPatch, Name: PatchOrganizationDefaultIsolationSegmentRequest, Resource: OrgsResource},
+	{Path: "/:package_guid", Method: http.MethodDelete, Name: DeletePackageRequest, Resource: PackagesResource},
 	{Path: "/:package_guid", Method: http.MethodGet, Name: GetPackageRequest, Resource: PackagesResource},
+	{Path: "/:process_guid", Method: http.MethodPatch, Name: PatchApplicationProcessCommandRequest, Resource: ProcessesResource},
 	{Path: "/:process_guid", Method: http.MethodPatch, Name: PatchApplicationProcessHealthCheckRequest, Resource: ProcessesResource},
 	{Path: "/:process_guid/stats", Method: http.MethodGet, Name: GetProcessInstancesRequest, Resource: ProcessesResource},
 	{Path: "/:space_guid/relationships/isolation_segment", Method: http.MethodGet, Name: GetSpaceRelationshipIsolationSegmentRequest, Resource: SpacesResource},
@@ -211,6 +211,23 @@ func (client *Client) GetPackages(query url.Values) ([]Package, Warnings, error)
 	return fullPackagesList, warnings, err
 }
 
+// DeletePackage deletes the given package and returns the URL of the job
+// tracking the deletion.
+func (client *Client) DeletePackage(packageGUID string) (string, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeletePackageRequest,
+		URIParams:   internal.Params{"package_guid": packageGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.ResourceLocationURL, response.Warnings, err
+}
+
 func (*Client) createUploadStream(path string, paramName string) (io.ReadSeeker, string, error) {
 	file, err := os.Open(path)
 	if err != nil {
@@ -233,3 +250,116 @@ func (*Client) createUploadStream(path string, paramName string) (io.ReadSeeker,
 
 	return bytes.NewReader(body.Bytes()), writer.FormDataContentType(), err
 }
+
+// createPipedUploadStream returns a multipart body that streams the file at
+// path as paramName, along with the body's length. Errors reading the file are
+// sent on the returned channel, which is closed once the body is written.
+func (*Client) createPipedUploadStream(path string, paramName string) (string, io.ReadSeeker, int64, <-chan error, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", nil, 0, nil, err
+	}
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return "", nil, 0, nil, err
+	}
+
+	sizeCounter := &bytes.Buffer{}
+	sizeForm := multipart.NewWriter(sizeCounter)
+	_, err = sizeForm.CreateFormFile(paramName, filepath.Base(path))
+	if err != nil {
+		file.Close()
+		return "", nil, 0, nil, err
+	}
+	err = sizeForm.Close()
+	if err != nil {
+		file.Close()
+		return "", nil, 0, nil, err
+	}
+
+	writerOutput, writerInput := cloudcontroller.NewPipeBomb()
+	form := multipart.NewWriter(writerInput)
+	err = form.SetBoundary(sizeForm.Boundary())
+	if err != nil {
+		file.Close()
+		return "", nil, 0, nil, err
+	}
+
+	writeErrors := make(chan error)
+
+	go func() {
+		defer close(writeErrors)
+		defer writerInput.Close()
+		defer file.Close()
+
+		part, err := form.CreateFormFile(paramName, filepath.Base(path))
+		if err != nil {
+			writeErrors <- err
+			return
+		}
+
+		_, err = io.Copy(part, file)
+		if err != nil {
+			writeErrors <- err
+			return
+		}
+
+		err = form.Close()
+		if err != nil {
+			writeErrors <- err
+		}
+	}()
+
+	return form.FormDataContentType(), writerOutput, int64(sizeCounter.Len()) + fileInfo.Size(), writeErrors, nil
+}
+
+func (client *Client) uploadBits(request *cloudcontroller.Request, response *cloudcontroller.Response) <-chan error {
+	httpErrors := make(chan error)
+
+	go func() {
+		defer close(httpErrors)
+
+		err := client.connection.Make(request, response)
+		if err != nil {
+			httpErrors <- err
+		}
+	}()
+
+	return httpErrors
+}
+
+// waitForUpload returns the first error sent on either channel once both
+// have been closed.
+func waitForUpload(writeErrors <-chan error, httpErrors <-chan error) error {
+	// If reading the file fails, the pipe is closed and the request ends; if
+	// the request fails, the pipe is closed and writing the file ends.
+	var firstError error
+	var writeClosed, httpClosed bool
+
+	for !writeClosed || !httpClosed {
+		select {
+		case writeErr, ok := <-writeErrors:
+			if !ok {
+				writeClosed = true
+				writeErrors = nil
+				continue
+			}
+			if firstError == nil {
+				firstError = writeErr
+			}
+		case httpErr, ok := <-httpErrors:
+			if !ok {
+				httpClosed = true
+				httpErrors = nil
+				continue
+			}
+			if firstError == nil {
+				firstError = httpErr
+			}
+		}
+	}
+
+	return firstError
+}
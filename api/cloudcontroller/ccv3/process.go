@@ -93,6 +93,29 @@ func (client *Client) GetApplicationProcessByType(appGUID string, processType st
 	return process, response.Warnings, err
 }
 
+// PatchApplicationProcessCommand updates the start command of the process.
+func (client *Client) PatchApplicationProcessCommand(processGUID string, command string) (Warnings, error) {
+	body, err := json.Marshal(map[string]string{
+		"command": command,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchApplicationProcessCommandRequest,
+		Body:        bytes.NewReader(body),
+		URIParams:   internal.Params{"process_guid": processGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // PatchApplicationProcessHealthCheck updates application health check type
 func (client *Client) PatchApplicationProcessHealthCheck(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string) (Warnings, error) {
 	body, err := json.Marshal(Process{
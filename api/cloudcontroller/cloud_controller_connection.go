@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
@@ -99,8 +100,14 @@ func (connection *CloudControllerConnection) populateResponse(response *http.Res
 		passedResponse.ResourceLocationURL = resourceLocationURL
 	}
 
-	rawBytes, err := ioutil.ReadAll(response.Body)
 	defer response.Body.Close()
+
+	if passedResponse.Writer != nil && response.StatusCode < 400 {
+		_, err := io.Copy(passedResponse.Writer, response.Body)
+		return err
+	}
+
+	rawBytes, err := ioutil.ReadAll(response.Body)
 	if err != nil {
 		return err
 	}
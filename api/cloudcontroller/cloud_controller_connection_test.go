@@ -1,6 +1,7 @@
 package cloudcontroller_test
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 	"runtime"
@@ -84,6 +85,61 @@ var _ = Describe("Cloud Controller Connection", func() {
 			})
 		})
 
+		Describe("Response Writer", func() {
+			var request *Request
+
+			BeforeEach(func() {
+				req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo", server.URL()), nil)
+				Expect(err).ToNot(HaveOccurred())
+				request = &Request{Request: req}
+			})
+
+			Context("when the request succeeds", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/foo", ""),
+							RespondWith(http.StatusOK, "some-binary-contents", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
+
+				It("writes the body to the writer instead of keeping it", func() {
+					body := &bytes.Buffer{}
+					response := Response{Writer: body}
+
+					err := connection.Make(request, &response)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body.String()).To(Equal("some-binary-contents"))
+					Expect(response.RawResponse).To(BeEmpty())
+					Expect(response.Warnings).To(ConsistOf("this is a warning"))
+				})
+			})
+
+			Context("when the request fails", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/foo", ""),
+							RespondWith(http.StatusNotFound, `{"code": 10000}`),
+						),
+					)
+				})
+
+				It("keeps the body for the error and writes nothing", func() {
+					body := &bytes.Buffer{}
+					response := Response{Writer: body}
+
+					err := connection.Make(request, &response)
+					Expect(err).To(MatchError(ccerror.RawHTTPStatusError{
+						StatusCode:  http.StatusNotFound,
+						RawResponse: []byte(`{"code": 10000}`),
+					}))
+					Expect(body.Len()).To(Equal(0))
+				})
+			})
+		})
+
 		Describe("HTTP Response", func() {
 			var request *Request
 
@@ -15,6 +15,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
 	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/util/clissh/knownhosts"
 	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
 	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
 	testnet "code.cloudfoundry.org/cli/util/testhelpers/net"
@@ -279,6 +280,15 @@ var _ = Describe("SSH command", func() {
 				deps.Gateways["cloud-controller"] = ccGateway
 			})
 
+			It("checks the host key against the known hosts of the targeted API", func() {
+				runCommand("my-app")
+
+				Expect(fakeSecureShell.UseKnownHostsCallCount()).To(Equal(1))
+				knownHosts := fakeSecureShell.UseKnownHostsArgsForCall(0)
+				Expect(knownHosts).To(BeAssignableToTypeOf(knownhosts.Checker{}))
+				Expect(knownHosts.(knownhosts.Checker).APIEndpoint).To(Equal(testServer.URL))
+			})
+
 			Context("Error when connecting", func() {
 				It("notifies users", func() {
 					fakeSecureShell.ConnectReturns(errors.New("dial errorrr"))
@@ -305,6 +315,30 @@ var _ = Describe("SSH command", func() {
 				})
 			})
 
+			Context("Error port forwarding when -R is provided", func() {
+				It("notifies users", func() {
+					fakeSecureShell.RemotePortForwardReturns(errors.New("tcpip-forward request denied by peer"))
+
+					runCommand("my-app", "-R", "8000:localhost:8000")
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Error forwarding port", "tcpip-forward request denied by peer"},
+					))
+				})
+			})
+
+			Context("Error port forwarding when -D is provided", func() {
+				It("notifies users", func() {
+					fakeSecureShell.DynamicPortForwardReturns(errors.New("listen error"))
+
+					runCommand("my-app", "-D", "1080")
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Error forwarding port", "listen error"},
+					))
+				})
+			})
+
 			Context("when -N is provided", func() {
 				It("calls secureShell.Wait()", func() {
 					fakeSecureShell.ConnectReturns(nil)
@@ -33,6 +33,18 @@ func DefaultFilePath() (string, error) {
 	return filepath.Join(homeDir, ".cf", "config.json"), nil
 }
 
+// SSHKnownHostsFilePath returns the location of the file that records the SSH
+// proxy host keys trusted for each API endpoint.
+func SSHKnownHostsFilePath() (string, error) {
+	homeDir, err := homeDir()
+
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".cf", "ssh_known_hosts.json"), nil
+}
+
 // See: http://stackoverflow.com/questions/7922270/obtain-users-home-directory
 // we can't cross compile using cgo and use user.Current()
 var userHomeDir = func() string {
@@ -29,6 +29,8 @@ type SSHOptions struct {
 	SkipRemoteExecution bool
 	TerminalRequest     TTYRequest
 	ForwardSpecs        []ForwardSpec
+	RemoteForwardSpecs  []ForwardSpec
+	DynamicForwardSpecs []ForwardSpec
 }
 
 func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
@@ -50,6 +52,26 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 		}
 	}
 
+	if fc.IsSet("R") {
+		for _, arg := range fc.StringSlice("R") {
+			forwardSpec, err := sshOptions.parseRemoteForwardingSpec(arg)
+			if err != nil {
+				return sshOptions, err
+			}
+			sshOptions.RemoteForwardSpecs = append(sshOptions.RemoteForwardSpecs, *forwardSpec)
+		}
+	}
+
+	if fc.IsSet("D") {
+		for _, arg := range fc.StringSlice("D") {
+			forwardSpec, err := sshOptions.parseDynamicForwardingSpec(arg)
+			if err != nil {
+				return sshOptions, err
+			}
+			sshOptions.DynamicForwardSpecs = append(sshOptions.DynamicForwardSpecs, *forwardSpec)
+		}
+	}
+
 	if fc.IsSet("t") && fc.Bool("t") {
 		sshOptions.TerminalRequest = RequestTTYYes
 	}
@@ -66,17 +88,47 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 }
 
 func (o *SSHOptions) parseLocalForwardingSpec(arg string) (*ForwardSpec, error) {
+	return o.parseForwardingSpec(arg, "local")
+}
+
+// parseRemoteForwardingSpec parses the same format as a local forward; the
+// listen address is on the remote side of the connection.
+func (o *SSHOptions) parseRemoteForwardingSpec(arg string) (*ForwardSpec, error) {
+	return o.parseForwardingSpec(arg, "remote")
+}
+
+// parseDynamicForwardingSpec parses [bind_address:]port. The connect address
+// is chosen by the SOCKS client for every connection.
+func (o *SSHOptions) parseDynamicForwardingSpec(arg string) (*ForwardSpec, error) {
 	arg = strings.TrimSpace(arg)
 
-	parts := []string{}
-	for remainder := arg; remainder != ""; {
-		part, r, err := tokenizeForward(remainder)
-		if err != nil {
-			return nil, err
+	parts, err := tokenizeForwardingSpec(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	forwardSpec := &ForwardSpec{}
+	switch len(parts) {
+	case 2:
+		if parts[0] == "*" {
+			parts[0] = ""
 		}
+		forwardSpec.ListenAddress = fmt.Sprintf("%s:%s", parts[0], parts[1])
+	case 1:
+		forwardSpec.ListenAddress = fmt.Sprintf("localhost:%s", parts[0])
+	default:
+		return nil, fmt.Errorf("Unable to parse dynamic forwarding argument: %q", arg)
+	}
 
-		parts = append(parts, part)
-		remainder = r
+	return forwardSpec, nil
+}
+
+func (o *SSHOptions) parseForwardingSpec(arg string, forwardType string) (*ForwardSpec, error) {
+	arg = strings.TrimSpace(arg)
+
+	parts, err := tokenizeForwardingSpec(arg)
+	if err != nil {
+		return nil, err
 	}
 
 	forwardSpec := &ForwardSpec{}
@@ -91,12 +143,27 @@ func (o *SSHOptions) parseLocalForwardingSpec(arg string) (*ForwardSpec, error)
 		forwardSpec.ListenAddress = fmt.Sprintf("localhost:%s", parts[0])
 		forwardSpec.ConnectAddress = fmt.Sprintf("%s:%s", parts[1], parts[2])
 	default:
-		return nil, fmt.Errorf("Unable to parse local forwarding argument: %q", arg)
+		return nil, fmt.Errorf("Unable to parse %s forwarding argument: %q", forwardType, arg)
 	}
 
 	return forwardSpec, nil
 }
 
+func tokenizeForwardingSpec(arg string) ([]string, error) {
+	parts := []string{}
+	for remainder := arg; remainder != ""; {
+		part, r, err := tokenizeForward(remainder)
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, part)
+		remainder = r
+	}
+
+	return parts, nil
+}
+
 func tokenizeForward(arg string) (string, string, error) {
 	switch arg[0] {
 	case ':':
@@ -23,6 +23,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/ssh/options"
 	"code.cloudfoundry.org/cli/cf/ssh/sigwinch"
 	"code.cloudfoundry.org/cli/cf/ssh/terminal"
+	"code.cloudfoundry.org/cli/util/clissh/socks5"
 	"github.com/docker/docker/pkg/term"
 )
 
@@ -38,10 +39,21 @@ type SecureShell interface {
 	Connect(opts *options.SSHOptions) error
 	InteractiveSession() error
 	LocalPortForward() error
+	RemotePortForward() error
+	DynamicPortForward() error
+	UseKnownHosts(knownHosts KnownHosts)
 	Wait() error
 	Close() error
 }
 
+//go:generate counterfeiter . KnownHosts
+
+// KnownHosts verifies host keys against the keys trusted on previous
+// connections. firstUse is true when key was trusted for the first time.
+type KnownHosts interface {
+	CheckHostKey(sshEndpoint string, key ssh.PublicKey) (firstUse bool, err error)
+}
+
 //go:generate counterfeiter . SecureDialer
 
 type SecureDialer interface {
@@ -54,6 +66,7 @@ type SecureClient interface {
 	NewSession() (SecureSession, error)
 	Conn() ssh.Conn
 	Dial(network, address string) (net.Conn, error)
+	Listen(network, address string) (net.Listener, error)
 	Wait() error
 	Close() error
 }
@@ -88,9 +101,11 @@ type secureShell struct {
 	sshEndpoint            string
 	token                  string
 	secureClient           SecureClient
+	knownHosts             KnownHosts
 	opts                   *options.SSHOptions
 
-	localListeners []net.Listener
+	localListeners  []net.Listener
+	remoteListeners []net.Listener
 }
 
 func NewSecureShell(
@@ -113,9 +128,16 @@ func NewSecureShell(
 		sshEndpoint:            sshEndpoint,
 		token:                  token,
 		localListeners:         []net.Listener{},
+		remoteListeners:        []net.Listener{},
 	}
 }
 
+// UseKnownHosts makes Connect check the host key against knownHosts after
+// it matches the expected fingerprint.
+func (c *secureShell) UseKnownHosts(knownHosts KnownHosts) {
+	c.knownHosts = knownHosts
+}
+
 func (c *secureShell) Connect(opts *options.SSHOptions) error {
 	err := c.validateTarget(opts)
 	if err != nil {
@@ -127,7 +149,7 @@ func (c *secureShell) Connect(opts *options.SSHOptions) error {
 		Auth: []ssh.AuthMethod{
 			ssh.Password(c.token),
 		},
-		HostKeyCallback: fingerprintCallback(opts, c.sshEndpointFingerprint),
+		HostKeyCallback: fingerprintCallback(opts, c.sshEndpointFingerprint, c.knownHosts),
 	}
 
 	secureClient, err := c.secureDialer.Dial("tcp", c.sshEndpoint, clientConfig)
@@ -144,6 +166,9 @@ func (c *secureShell) Close() error {
 	for _, listener := range c.localListeners {
 		_ = listener.Close()
 	}
+	for _, listener := range c.remoteListeners {
+		_ = listener.Close()
+	}
 	return c.secureClient.Close()
 }
 
@@ -155,13 +180,82 @@ func (c *secureShell) LocalPortForward() error {
 		}
 		c.localListeners = append(c.localListeners, listener)
 
-		go c.localForwardAcceptLoop(listener, forwardSpec.ConnectAddress)
+		go c.forwardAcceptLoop(listener, c.dialRemote(forwardSpec.ConnectAddress))
 	}
 
 	return nil
 }
 
-func (c *secureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
+func (c *secureShell) RemotePortForward() error {
+	for _, forwardSpec := range c.opts.RemoteForwardSpecs {
+		listener, err := c.secureClient.Listen("tcp", forwardSpec.ListenAddress)
+		if err != nil {
+			return fmt.Errorf("remote port forwarding failed for listen address %s: %s", forwardSpec.ListenAddress, err.Error())
+		}
+		c.remoteListeners = append(c.remoteListeners, listener)
+
+		go c.forwardAcceptLoop(listener, dialLocal(forwardSpec.ConnectAddress))
+	}
+
+	return nil
+}
+
+func (c *secureShell) DynamicPortForward() error {
+	for _, forwardSpec := range c.opts.DynamicForwardSpecs {
+		listener, err := c.listenerFactory.Listen("tcp", forwardSpec.ListenAddress)
+		if err != nil {
+			return err
+		}
+		c.localListeners = append(c.localListeners, listener)
+
+		go c.forwardAcceptLoop(listener, c.dialSOCKS)
+	}
+
+	return nil
+}
+
+type dialFunc func(conn net.Conn) (net.Conn, error)
+
+func (c *secureShell) dialRemote(targetAddr string) dialFunc {
+	return func(net.Conn) (net.Conn, error) {
+		target, err := c.secureClient.Dial("tcp", targetAddr)
+		if err != nil {
+			return nil, fmt.Errorf("connect to %s failed: %s", targetAddr, err.Error())
+		}
+		return target, nil
+	}
+}
+
+func dialLocal(targetAddr string) dialFunc {
+	return func(net.Conn) (net.Conn, error) {
+		target, err := net.Dial("tcp", targetAddr)
+		if err != nil {
+			return nil, fmt.Errorf("connect to %s failed: %s", targetAddr, err.Error())
+		}
+		return target, nil
+	}
+}
+
+func (c *secureShell) dialSOCKS(conn net.Conn) (net.Conn, error) {
+	targetAddr, err := socks5.Handshake(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := c.secureClient.Dial("tcp", targetAddr)
+	replyErr := socks5.Reply(conn, err)
+	if err != nil {
+		return nil, fmt.Errorf("connect to %s failed: %s", targetAddr, err.Error())
+	}
+	if replyErr != nil {
+		_ = target.Close()
+		return nil, replyErr
+	}
+
+	return target, nil
+}
+
+func (c *secureShell) forwardAcceptLoop(listener net.Listener, dial dialFunc) {
 	defer listener.Close()
 
 	for {
@@ -174,16 +268,16 @@ func (c *secureShell) localForwardAcceptLoop(listener net.Listener, addr string)
 			return
 		}
 
-		go c.handleForwardConnection(conn, addr)
+		go c.handleForwardConnection(conn, dial)
 	}
 }
 
-func (c *secureShell) handleForwardConnection(conn net.Conn, targetAddr string) {
+func (c *secureShell) handleForwardConnection(conn net.Conn, dial dialFunc) {
 	defer conn.Close()
 
-	target, err := c.secureClient.Dial("tcp", targetAddr)
+	target, err := dial(conn)
 	if err != nil {
-		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		fmt.Println(err.Error())
 		return
 	}
 	defer target.Close()
@@ -349,7 +443,7 @@ func base64Sha256Fingerprint(key ssh.PublicKey) string {
 	return base64.RawStdEncoding.EncodeToString(sum[:])
 }
 
-func fingerprintCallback(opts *options.SSHOptions, expectedFingerprint string) ssh.HostKeyCallback {
+func fingerprintCallback(opts *options.SSHOptions, expectedFingerprint string, knownHosts KnownHosts) ssh.HostKeyCallback {
 	if opts.SkipHostValidation {
 		return nil
 	}
@@ -374,6 +468,18 @@ func fingerprintCallback(opts *options.SSHOptions, expectedFingerprint string) s
 		if fingerprint != expectedFingerprint {
 			return fmt.Errorf("Host key verification failed.\n\nThe fingerprint of the received key was %q.", fingerprint)
 		}
+
+		if knownHosts == nil {
+			return nil
+		}
+
+		firstUse, err := knownHosts.CheckHostKey(hostname, key)
+		if err != nil {
+			return err
+		}
+		if firstUse {
+			fmt.Fprintf(os.Stderr, "Warning: Permanently added the host key for %s (%s) to the list of known hosts.\n", hostname, fingerprint)
+		}
 		return nil
 	}
 }
@@ -477,6 +583,9 @@ func (sc *secureClient) Wait() error    { return sc.client.Wait() }
 func (sc *secureClient) Dial(n, addr string) (net.Conn, error) {
 	return sc.client.Dial(n, addr)
 }
+func (sc *secureClient) Listen(n, addr string) (net.Listener, error) {
+	return sc.client.Listen(n, addr)
+}
 func (sc *secureClient) NewSession() (SecureSession, error) {
 	return sc.client.NewSession()
 }
@@ -40,6 +40,7 @@ var _ = Describe("SSH", func() {
 	var (
 		fakeTerminalHelper  *terminalfakes.FakeTerminalHelper
 		fakeListenerFactory *sshfakes.FakeListenerFactory
+		fakeKnownHosts      *sshfakes.FakeKnownHosts
 
 		fakeConnection    *fake_ssh.FakeConn
 		fakeSecureClient  *sshfakes.FakeSecureClient
@@ -63,6 +64,7 @@ var _ = Describe("SSH", func() {
 		terminalHelper = terminal.DefaultHelper()
 
 		fakeListenerFactory = new(sshfakes.FakeListenerFactory)
+		fakeKnownHosts = nil
 		fakeListenerFactory.ListenStub = net.Listen
 
 		keepAliveDuration = 30 * time.Second
@@ -112,6 +114,9 @@ var _ = Describe("SSH", func() {
 			sshEndpoint,
 			token,
 		)
+		if fakeKnownHosts != nil {
+			secureShell.UseKnownHosts(fakeKnownHosts)
+		}
 	})
 
 	Describe("Validation", func() {
@@ -307,6 +312,32 @@ var _ = Describe("SSH", func() {
 				})
 			})
 
+			Context("when known hosts are in use", func() {
+				BeforeEach(func() {
+					sshEndpointFingerprint = "sp/jrLuj66r+yrLDUKZdJU5tdzt4mq/UaSiNBjpgr+8"
+					fakeKnownHosts = new(sshfakes.FakeKnownHosts)
+				})
+
+				It("checks the host key against the known hosts", func() {
+					Expect(callback("ssh.example.com:22", addr, TestHostKey.PublicKey())).ToNot(HaveOccurred())
+
+					Expect(fakeKnownHosts.CheckHostKeyCallCount()).To(Equal(1))
+					hostnameArg, keyArg := fakeKnownHosts.CheckHostKeyArgsForCall(0)
+					Expect(hostnameArg).To(Equal("ssh.example.com:22"))
+					Expect(keyArg.Marshal()).To(Equal(TestHostKey.PublicKey().Marshal()))
+				})
+
+				Context("when the host key does not match the known hosts", func() {
+					BeforeEach(func() {
+						fakeKnownHosts.CheckHostKeyReturns(false, errors.New("some-host-key-changed-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(callback("ssh.example.com:22", addr, TestHostKey.PublicKey())).To(MatchError("some-host-key-changed-error"))
+					})
+				})
+			})
+
 			Context("when the fingerprint length doesn't make sense", func() {
 				BeforeEach(func() {
 					sshEndpointFingerprint = "garbage"
@@ -1165,6 +1196,115 @@ var _ = Describe("SSH", func() {
 		})
 	})
 
+	Describe("RemotePortForward", func() {
+		var (
+			opts               *options.SSHOptions
+			remoteForwardError error
+			fakeRemoteListener *fake_net.FakeListener
+		)
+
+		BeforeEach(func() {
+			fakeRemoteListener = &fake_net.FakeListener{}
+			fakeRemoteListener.AcceptReturns(nil, errors.New("Not Accepting Connections"))
+			fakeSecureClient.ListenReturns(fakeRemoteListener, nil)
+
+			opts = &options.SSHOptions{
+				AppName: "app-1",
+				RemoteForwardSpecs: []options.ForwardSpec{{
+					ListenAddress:  "localhost:9999",
+					ConnectAddress: "localhost:8888",
+				}},
+			}
+
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(opts)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			remoteForwardError = secureShell.RemotePortForward()
+		})
+
+		It("asks the server to listen on the listen address", func() {
+			Expect(remoteForwardError).NotTo(HaveOccurred())
+
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:9999"))
+		})
+
+		It("closes the remote listener when the secure shell is closed", func() {
+			Eventually(fakeRemoteListener.AcceptCallCount).Should(Equal(1))
+
+			originalCloseCount := fakeRemoteListener.CloseCallCount()
+			err := secureShell.Close()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeRemoteListener.CloseCallCount()).To(Equal(originalCloseCount + 1))
+		})
+
+		Context("when the server refuses to listen", func() {
+			BeforeEach(func() {
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied by peer"))
+			})
+
+			It("returns the error", func() {
+				Expect(remoteForwardError).To(MatchError("remote port forwarding failed for listen address localhost:9999: tcpip-forward request denied by peer"))
+			})
+		})
+	})
+
+	Describe("DynamicPortForward", func() {
+		var (
+			opts                *options.SSHOptions
+			dynamicForwardError error
+			fakeLocalListener   *fake_net.FakeListener
+		)
+
+		BeforeEach(func() {
+			fakeLocalListener = &fake_net.FakeListener{}
+			fakeLocalListener.AcceptReturns(nil, errors.New("Not Accepting Connections"))
+			fakeListenerFactory.ListenStub = nil
+			fakeListenerFactory.ListenReturns(fakeLocalListener, nil)
+
+			opts = &options.SSHOptions{
+				AppName:             "app-1",
+				DynamicForwardSpecs: []options.ForwardSpec{{ListenAddress: "localhost:1080"}},
+			}
+
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(opts)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			dynamicForwardError = secureShell.DynamicPortForward()
+		})
+
+		It("listens on the local address", func() {
+			Expect(dynamicForwardError).NotTo(HaveOccurred())
+
+			Expect(fakeListenerFactory.ListenCallCount()).To(Equal(1))
+			network, addr := fakeListenerFactory.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:1080"))
+		})
+
+		Context("when listen fails", func() {
+			BeforeEach(func() {
+				fakeListenerFactory.ListenReturns(nil, errors.New("failure is an option"))
+			})
+
+			It("returns the error", func() {
+				Expect(dynamicForwardError).To(MatchError("failure is an option"))
+			})
+		})
+	})
+
 	Describe("Wait", func() {
 		var opts *options.SSHOptions
 		var waitErr error
@@ -148,6 +148,15 @@ type FakeConfig struct {
 	hasTargetedSpaceReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsTTYStub        func() bool
+	isTTYMutex       sync.RWMutex
+	isTTYArgsForCall []struct{}
+	isTTYReturns     struct {
+		result1 bool
+	}
+	isTTYReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	LocaleStub        func() string
 	localeMutex       sync.RWMutex
 	localeArgsForCall []struct{}
@@ -225,6 +234,24 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	ResourceCacheFilePathStub        func() string
+	resourceCacheFilePathMutex       sync.RWMutex
+	resourceCacheFilePathArgsForCall []struct{}
+	resourceCacheFilePathReturns     struct {
+		result1 string
+	}
+	resourceCacheFilePathReturnsOnCall map[int]struct {
+		result1 string
+	}
+	RollbackHistoryFilePathStub        func() string
+	rollbackHistoryFilePathMutex       sync.RWMutex
+	rollbackHistoryFilePathArgsForCall []struct{}
+	rollbackHistoryFilePathReturns     struct {
+		result1 string
+	}
+	rollbackHistoryFilePathReturnsOnCall map[int]struct {
+		result1 string
+	}
 	SetAccessTokenStub        func(token string)
 	setAccessTokenMutex       sync.RWMutex
 	setAccessTokenArgsForCall []struct {
@@ -280,6 +307,15 @@ type FakeConfig struct {
 	skipSSLValidationReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	SSHKnownHostsFilePathStub        func() string
+	sSHKnownHostsFilePathMutex       sync.RWMutex
+	sSHKnownHostsFilePathArgsForCall []struct{}
+	sSHKnownHostsFilePathReturns     struct {
+		result1 string
+	}
+	sSHKnownHostsFilePathReturnsOnCall map[int]struct {
+		result1 string
+	}
 	SSHOAuthClientStub        func() string
 	sSHOAuthClientMutex       sync.RWMutex
 	sSHOAuthClientArgsForCall []struct{}
@@ -976,6 +1012,46 @@ func (fake *FakeConfig) HasTargetedSpaceReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) IsTTY() bool {
+	fake.isTTYMutex.Lock()
+	ret, specificReturn := fake.isTTYReturnsOnCall[len(fake.isTTYArgsForCall)]
+	fake.isTTYArgsForCall = append(fake.isTTYArgsForCall, struct{}{})
+	fake.recordInvocation("IsTTY", []interface{}{})
+	fake.isTTYMutex.Unlock()
+	if fake.IsTTYStub != nil {
+		return fake.IsTTYStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.isTTYReturns.result1
+}
+
+func (fake *FakeConfig) IsTTYCallCount() int {
+	fake.isTTYMutex.RLock()
+	defer fake.isTTYMutex.RUnlock()
+	return len(fake.isTTYArgsForCall)
+}
+
+func (fake *FakeConfig) IsTTYReturns(result1 bool) {
+	fake.IsTTYStub = nil
+	fake.isTTYReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) IsTTYReturnsOnCall(i int, result1 bool) {
+	fake.IsTTYStub = nil
+	if fake.isTTYReturnsOnCall == nil {
+		fake.isTTYReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isTTYReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) Locale() string {
 	fake.localeMutex.Lock()
 	ret, specificReturn := fake.localeReturnsOnCall[len(fake.localeArgsForCall)]
@@ -1320,6 +1396,86 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return fake.removePluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) ResourceCacheFilePath() string {
+	fake.resourceCacheFilePathMutex.Lock()
+	ret, specificReturn := fake.resourceCacheFilePathReturnsOnCall[len(fake.resourceCacheFilePathArgsForCall)]
+	fake.resourceCacheFilePathArgsForCall = append(fake.resourceCacheFilePathArgsForCall, struct{}{})
+	fake.recordInvocation("ResourceCacheFilePath", []interface{}{})
+	fake.resourceCacheFilePathMutex.Unlock()
+	if fake.ResourceCacheFilePathStub != nil {
+		return fake.ResourceCacheFilePathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.resourceCacheFilePathReturns.result1
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathCallCount() int {
+	fake.resourceCacheFilePathMutex.RLock()
+	defer fake.resourceCacheFilePathMutex.RUnlock()
+	return len(fake.resourceCacheFilePathArgsForCall)
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathReturns(result1 string) {
+	fake.ResourceCacheFilePathStub = nil
+	fake.resourceCacheFilePathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathReturnsOnCall(i int, result1 string) {
+	fake.ResourceCacheFilePathStub = nil
+	if fake.resourceCacheFilePathReturnsOnCall == nil {
+		fake.resourceCacheFilePathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.resourceCacheFilePathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) RollbackHistoryFilePath() string {
+	fake.rollbackHistoryFilePathMutex.Lock()
+	ret, specificReturn := fake.rollbackHistoryFilePathReturnsOnCall[len(fake.rollbackHistoryFilePathArgsForCall)]
+	fake.rollbackHistoryFilePathArgsForCall = append(fake.rollbackHistoryFilePathArgsForCall, struct{}{})
+	fake.recordInvocation("RollbackHistoryFilePath", []interface{}{})
+	fake.rollbackHistoryFilePathMutex.Unlock()
+	if fake.RollbackHistoryFilePathStub != nil {
+		return fake.RollbackHistoryFilePathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.rollbackHistoryFilePathReturns.result1
+}
+
+func (fake *FakeConfig) RollbackHistoryFilePathCallCount() int {
+	fake.rollbackHistoryFilePathMutex.RLock()
+	defer fake.rollbackHistoryFilePathMutex.RUnlock()
+	return len(fake.rollbackHistoryFilePathArgsForCall)
+}
+
+func (fake *FakeConfig) RollbackHistoryFilePathReturns(result1 string) {
+	fake.RollbackHistoryFilePathStub = nil
+	fake.rollbackHistoryFilePathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) RollbackHistoryFilePathReturnsOnCall(i int, result1 string) {
+	fake.RollbackHistoryFilePathStub = nil
+	if fake.rollbackHistoryFilePathReturnsOnCall == nil {
+		fake.rollbackHistoryFilePathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.rollbackHistoryFilePathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) SetAccessToken(token string) {
 	fake.setAccessTokenMutex.Lock()
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
@@ -1539,6 +1695,46 @@ func (fake *FakeConfig) SkipSSLValidationReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) SSHKnownHostsFilePath() string {
+	fake.sSHKnownHostsFilePathMutex.Lock()
+	ret, specificReturn := fake.sSHKnownHostsFilePathReturnsOnCall[len(fake.sSHKnownHostsFilePathArgsForCall)]
+	fake.sSHKnownHostsFilePathArgsForCall = append(fake.sSHKnownHostsFilePathArgsForCall, struct{}{})
+	fake.recordInvocation("SSHKnownHostsFilePath", []interface{}{})
+	fake.sSHKnownHostsFilePathMutex.Unlock()
+	if fake.SSHKnownHostsFilePathStub != nil {
+		return fake.SSHKnownHostsFilePathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.sSHKnownHostsFilePathReturns.result1
+}
+
+func (fake *FakeConfig) SSHKnownHostsFilePathCallCount() int {
+	fake.sSHKnownHostsFilePathMutex.RLock()
+	defer fake.sSHKnownHostsFilePathMutex.RUnlock()
+	return len(fake.sSHKnownHostsFilePathArgsForCall)
+}
+
+func (fake *FakeConfig) SSHKnownHostsFilePathReturns(result1 string) {
+	fake.SSHKnownHostsFilePathStub = nil
+	fake.sSHKnownHostsFilePathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) SSHKnownHostsFilePathReturnsOnCall(i int, result1 string) {
+	fake.SSHKnownHostsFilePathStub = nil
+	if fake.sSHKnownHostsFilePathReturnsOnCall == nil {
+		fake.sSHKnownHostsFilePathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.sSHKnownHostsFilePathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) SSHOAuthClient() string {
 	fake.sSHOAuthClientMutex.Lock()
 	ret, specificReturn := fake.sSHOAuthClientReturnsOnCall[len(fake.sSHOAuthClientArgsForCall)]
@@ -2007,6 +2203,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.hasTargetedOrganizationMutex.RUnlock()
 	fake.hasTargetedSpaceMutex.RLock()
 	defer fake.hasTargetedSpaceMutex.RUnlock()
+	fake.isTTYMutex.RLock()
+	defer fake.isTTYMutex.RUnlock()
 	fake.localeMutex.RLock()
 	defer fake.localeMutex.RUnlock()
 	fake.minCLIVersionMutex.RLock()
@@ -2025,6 +2223,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.resourceCacheFilePathMutex.RLock()
+	defer fake.resourceCacheFilePathMutex.RUnlock()
+	fake.rollbackHistoryFilePathMutex.RLock()
+	defer fake.rollbackHistoryFilePathMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
@@ -2041,6 +2243,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setUAAEndpointMutex.RUnlock()
 	fake.skipSSLValidationMutex.RLock()
 	defer fake.skipSSLValidationMutex.RUnlock()
+	fake.sSHKnownHostsFilePathMutex.RLock()
+	defer fake.sSHKnownHostsFilePathMutex.RUnlock()
 	fake.sSHOAuthClientMutex.RLock()
 	defer fake.sSHOAuthClientMutex.RUnlock()
 	fake.stagingTimeoutMutex.RLock()
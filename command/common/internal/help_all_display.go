@@ -11,15 +11,15 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "APPS:",
 		CommandList: [][]string{
-			{"apps", "app"},
+			{"apps", "app", "app-diagnose"},
 			{"push", "scale", "delete", "rename"},
 			{"start", "stop", "restart", "restage", "restart-app-instance"},
 			{"run-task", "tasks", "terminate-task"},
 			{"events", "files", "logs"},
 			{"env", "set-env", "unset-env"},
-			{"stacks", "stack"},
-			{"copy-source", "create-app-manifest"},
-			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
+			{"stacks", "stack", "stack-apps", "change-stack"},
+			{"copy-source", "create-app-manifest", "validate-manifest", "ignored-files"},
+			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh", "ssh-copy"},
 		},
 	},
 	{
@@ -37,7 +37,7 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "ORGS:",
 		CommandList: [][]string{
 			{"orgs", "org"},
-			{"create-org", "delete-org", "rename-org"},
+			{"create-org", "delete-org", "rename-org", "apply-org", "export-org"},
 		},
 	},
 	{
@@ -58,7 +58,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ROUTES:",
 		CommandList: [][]string{
-			{"routes", "create-route", "check-route", "map-route", "unmap-route", "delete-route", "delete-orphaned-routes"},
+			{"routes", "tcp-routes", "create-route", "check-route", "map-route", "unmap-route", "delete-route", "delete-orphaned-routes"},
 		},
 	},
 	{
@@ -79,12 +79,13 @@ var HelpCategoryList = []HelpCategory{
 			{"create-user", "delete-user"},
 			{"org-users", "set-org-role", "unset-org-role"},
 			{"space-users", "set-space-role", "unset-space-role"},
+			{"sync-roles", "user-access"},
 		},
 	},
 	{
 		CategoryName: "ORG ADMIN:",
 		CommandList: [][]string{
-			{"quotas", "quota", "set-quota"},
+			{"quotas", "quota", "quota-usage", "set-quota"},
 			{"create-quota", "delete-quota", "update-quota"},
 			{"share-private-domain", "unshare-private-domain"},
 		},
@@ -130,12 +131,14 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "FEATURE FLAGS:",
 		CommandList: [][]string{
 			{"feature-flags", "feature-flag", "enable-feature-flag", "disable-feature-flag"},
+			{"apply-platform-config"},
 		},
 	},
 	{
 		CategoryName: "ADVANCED:",
 		CommandList: [][]string{
 			{"curl", "config", "oauth-token", "ssh-code"},
+			{"ssh-known-hosts", "remove-ssh-known-host"},
 		},
 	},
 	{
@@ -159,10 +162,10 @@ var V3HelpCategoryList = []HelpCategory{
 			{"v3-apps", "v3-app", "v3-create-app"},
 			{"v3-push", "v3-scale", "v3-delete"},
 			{"v3-start", "v3-stop", "v3-restart", "v3-stage", "v3-restart-app-instance"},
-			{"v3-droplets", "v3-set-droplet"},
+			{"v3-droplets", "v3-set-droplet", "v3-download-droplet", "v3-rollback"},
 			{"v3-set-env", "v3-unset-env"},
 			{"v3-get-health-check", "v3-set-health-check"},
-			{"v3-packages", "v3-create-package"},
+			{"v3-packages", "v3-create-package", "v3-cleanup-artifacts"},
 		},
 	},
 }
@@ -25,6 +25,7 @@ type Config interface {
 	GetPluginCaseInsensitive(pluginName string) (configv3.Plugin, bool)
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
+	IsTTY() bool
 	Locale() string
 	MinCLIVersion() string
 	OverallPollingTimeout() time.Duration
@@ -34,6 +35,8 @@ type Config interface {
 	PollingInterval() time.Duration
 	RefreshToken() string
 	RemovePlugin(string)
+	ResourceCacheFilePath() string
+	RollbackHistoryFilePath() string
 	SetAccessToken(token string)
 	SetOrganizationInformation(guid string, name string)
 	SetRefreshToken(token string)
@@ -42,6 +45,7 @@ type Config interface {
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
 	SetUAAEndpoint(uaaEndpoint string)
 	SkipSSLValidation() bool
+	SSHKnownHostsFilePath() string
 	SSHOAuthClient() string
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
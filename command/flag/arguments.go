@@ -40,6 +40,10 @@ type Organization struct {
 	Organization string `positional-arg-name:"ORG" required:"true" description:"The organization"`
 }
 
+type OptionalOrganization struct {
+	Organization string `positional-arg-name:"ORG" description:"The organization"`
+}
+
 type APIPath struct {
 	Path string `positional-arg-name:"PATH" required:"true" description:"The API endpoint"`
 }
@@ -80,6 +84,10 @@ type Username struct {
 	Username string `positional-arg-name:"USERNAME" required:"true" description:"The username"`
 }
 
+type OptionalUsername struct {
+	Username string `positional-arg-name:"USERNAME" description:"The username"`
+}
+
 type APITarget struct {
 	URL string `positional-arg-name:"URL" description:"API URL to target"`
 }
@@ -212,6 +220,11 @@ type SetSpaceQuotaArgs struct {
 	SpaceQuota string `positional-arg-name:"SPACE_QUOTA" required:"true" description:"The space quota"`
 }
 
+type ChangeStackArgs struct {
+	AppName   string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	StackName string `positional-arg-name:"NEW_STACK" required:"true" description:"The stack to move the application to"`
+}
+
 type SetHealthCheckArgs struct {
 	AppName     string          `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	HealthCheck HealthCheckType `positional-arg-name:"HEALTH_CHECK_TYPE" required:"true" description:"Set to 'port' or 'none'"`
@@ -325,3 +338,28 @@ type AddNetworkPolicyArgs struct {
 type RemoveNetworkPolicyArgs struct {
 	SourceApp string
 }
+
+type SSHCopyArgs struct {
+	Source      string `positional-arg-name:"SOURCE" required:"true" description:"The local path, or the app path in the form APP_NAME:[INDEX:]PATH, to copy from"`
+	Destination string `positional-arg-name:"DESTINATION" required:"true" description:"The local path, or the app path in the form APP_NAME:[INDEX:]PATH, to copy to"`
+}
+
+type ValidateManifestArgs struct {
+	PathToManifest PathWithExistenceCheck `positional-arg-name:"PATH_TO_MANIFEST" description:"The path to the manifest"`
+}
+
+type IgnoredFilesArgs struct {
+	Path PathWithExistenceCheck `positional-arg-name:"PATH" description:"The app directory or zip file; defaults to the current directory"`
+}
+
+type ApplyOrgArgs struct {
+	PathToConfig PathWithExistenceCheck `positional-arg-name:"FILE" required:"true" description:"The path to the org config file"`
+}
+
+type ApplyPlatformConfigArgs struct {
+	PathToConfig PathWithExistenceCheck `positional-arg-name:"FILE" required:"true" description:"The path to the platform config file"`
+}
+
+type SyncRolesArgs struct {
+	PathToRoster PathWithExistenceCheck `positional-arg-name:"FILE" required:"true" description:"The path to the roster file"`
+}
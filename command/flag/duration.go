@@ -0,0 +1,26 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type Duration struct {
+	Value time.Duration
+	IsSet bool
+}
+
+func (d *Duration) UnmarshalFlag(val string) error {
+	duration, err := time.ParseDuration(val)
+	if err != nil || duration <= 0 {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: "invalid duration (expected a positive duration like 5s or 1m)",
+		}
+	}
+
+	d.Value = duration
+	d.IsSet = true
+	return nil
+}
@@ -0,0 +1,44 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Duration", func() {
+	var duration Duration
+
+	BeforeEach(func() {
+		duration = Duration{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when a valid duration is provided", func() {
+			It("stores the duration and sets IsSet to true", func() {
+				err := duration.UnmarshalFlag("1m30s")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(duration).To(Equal(Duration{Value: 90 * time.Second, IsSet: true}))
+			})
+		})
+
+		DescribeTable("when an invalid duration is provided",
+			func(val string) {
+				err := duration.UnmarshalFlag(val)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: "invalid duration (expected a positive duration like 5s or 1m)",
+				}))
+				Expect(duration).To(Equal(Duration{}))
+			},
+			Entry("not a duration", "banana"),
+			Entry("no unit", "5"),
+			Entry("zero", "0s"),
+			Entry("negative", "-5s"),
+		)
+	})
+})
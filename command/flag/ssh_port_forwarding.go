@@ -0,0 +1,115 @@
+package flag
+
+import (
+	"fmt"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// SSHPortForwarding is a [BIND_ADDRESS:]PORT:HOST:HOST_PORT specification as
+// used by the -L and -R ssh flags.
+type SSHPortForwarding struct {
+	ListenAddress  string
+	ConnectAddress string
+}
+
+func (s *SSHPortForwarding) UnmarshalFlag(val string) error {
+	parts, err := tokenizeForwardingSpec(val)
+	if err != nil {
+		return err
+	}
+
+	switch len(parts) {
+	case 4:
+		s.ListenAddress = joinBindAddress(parts[0], parts[1])
+		s.ConnectAddress = fmt.Sprintf("%s:%s", parts[2], parts[3])
+	case 3:
+		s.ListenAddress = joinBindAddress("localhost", parts[0])
+		s.ConnectAddress = fmt.Sprintf("%s:%s", parts[1], parts[2])
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: fmt.Sprintf("Port forward specification '%s' must match [BIND_ADDRESS:]PORT:HOST:HOST_PORT", val),
+		}
+	}
+
+	return nil
+}
+
+// SSHDynamicPortForwarding is a [BIND_ADDRESS:]PORT specification as used by
+// the -D ssh flag.
+type SSHDynamicPortForwarding struct {
+	ListenAddress string
+}
+
+func (s *SSHDynamicPortForwarding) UnmarshalFlag(val string) error {
+	parts, err := tokenizeForwardingSpec(val)
+	if err != nil {
+		return err
+	}
+
+	switch len(parts) {
+	case 2:
+		s.ListenAddress = joinBindAddress(parts[0], parts[1])
+	case 1:
+		s.ListenAddress = joinBindAddress("localhost", parts[0])
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: fmt.Sprintf("Dynamic port forward specification '%s' must match [BIND_ADDRESS:]PORT", val),
+		}
+	}
+
+	return nil
+}
+
+// joinBindAddress treats the '*' bind address as all interfaces.
+func joinBindAddress(bindAddress string, port string) string {
+	if bindAddress == "*" {
+		bindAddress = ""
+	}
+	return fmt.Sprintf("%s:%s", bindAddress, port)
+}
+
+// tokenizeForwardingSpec splits a forwarding specification on ':' while
+// keeping bracketed IPv6 addresses intact.
+func tokenizeForwardingSpec(val string) ([]string, error) {
+	parts := []string{}
+	for remainder := strings.TrimSpace(val); remainder != ""; {
+		var part string
+
+		switch remainder[0] {
+		case ':':
+			part, remainder = "", remainder[1:]
+		case '[':
+			bracketed := strings.SplitAfterN(remainder, "]", 2)
+			if len(bracketed) != 2 {
+				return nil, &flags.Error{
+					Type:    flags.ErrRequired,
+					Message: fmt.Sprintf("Port forward specification '%s' is missing a closing bracket", val),
+				}
+			}
+			part, remainder = bracketed[0], bracketed[1]
+			if remainder != "" {
+				if remainder[0] != ':' {
+					return nil, &flags.Error{
+						Type:    flags.ErrRequired,
+						Message: fmt.Sprintf("Port forward specification '%s' has an unexpected token after ']'", val),
+					}
+				}
+				remainder = remainder[1:]
+			}
+		default:
+			split := strings.SplitN(remainder, ":", 2)
+			part, remainder = split[0], ""
+			if len(split) == 2 {
+				remainder = split[1]
+			}
+		}
+
+		parts = append(parts, part)
+	}
+
+	return parts, nil
+}
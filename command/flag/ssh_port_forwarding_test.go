@@ -0,0 +1,78 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SSHPortForwarding", func() {
+	var forward SSHPortForwarding
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			forward = SSHPortForwarding{}
+		})
+
+		DescribeTable("it sets the addresses correctly",
+			func(input string, expectedListen string, expectedConnect string) {
+				err := forward.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHPortForwarding{
+					ListenAddress:  expectedListen,
+					ConnectAddress: expectedConnect,
+				}))
+			},
+			Entry("without a bind address it binds to localhost", "8080:example.com:80", "localhost:8080", "example.com:80"),
+			Entry("with a bind address", "0.0.0.0:8080:example.com:80", "0.0.0.0:8080", "example.com:80"),
+			Entry("with the '*' bind address it binds to all interfaces", "*:8080:example.com:80", ":8080", "example.com:80"),
+			Entry("with an empty bind address it binds to all interfaces", ":8080:example.com:80", ":8080", "example.com:80"),
+			Entry("with IPv6 addresses", "[::1]:8080:[fe80::1]:80", "[::1]:8080", "[fe80::1]:80"),
+		)
+
+		DescribeTable("errors correctly",
+			func(input string, expectedMessage string) {
+				err := forward.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: expectedMessage,
+				}))
+			},
+			Entry("too few parts", "8080:80", "Port forward specification '8080:80' must match [BIND_ADDRESS:]PORT:HOST:HOST_PORT"),
+			Entry("too many parts", "a:1:b:2:3", "Port forward specification 'a:1:b:2:3' must match [BIND_ADDRESS:]PORT:HOST:HOST_PORT"),
+			Entry("missing bracket", "[::1:8080:example.com:80", "Port forward specification '[::1:8080:example.com:80' is missing a closing bracket"),
+			Entry("unexpected token", "[::1]x:8080:example.com:80", "Port forward specification '[::1]x:8080:example.com:80' has an unexpected token after ']'"),
+		)
+	})
+})
+
+var _ = Describe("SSHDynamicPortForwarding", func() {
+	var forward SSHDynamicPortForwarding
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			forward = SSHDynamicPortForwarding{}
+		})
+
+		DescribeTable("it sets the listen address correctly",
+			func(input string, expectedListen string) {
+				err := forward.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward.ListenAddress).To(Equal(expectedListen))
+			},
+			Entry("without a bind address it binds to localhost", "1080", "localhost:1080"),
+			Entry("with a bind address", "127.0.0.1:1080", "127.0.0.1:1080"),
+			Entry("with the '*' bind address it binds to all interfaces", "*:1080", ":1080"),
+		)
+
+		It("errors when there are too many parts", func() {
+			err := forward.UnmarshalFlag("a:1:b")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: "Dynamic port forward specification 'a:1:b' must match [BIND_ADDRESS:]PORT",
+			}))
+		})
+	})
+})
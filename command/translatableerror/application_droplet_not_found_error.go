@@ -0,0 +1,19 @@
+package translatableerror
+
+// ApplicationDropletNotFoundError is returned when the droplet given to
+// v3-download-droplet is not a droplet of the app.
+type ApplicationDropletNotFoundError struct {
+	AppName     string
+	DropletGUID string
+}
+
+func (ApplicationDropletNotFoundError) Error() string {
+	return "Droplet {{.DropletGUID}} is not a droplet of app {{.AppName}}."
+}
+
+func (e ApplicationDropletNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":     e.AppName,
+		"DropletGUID": e.DropletGUID,
+	})
+}
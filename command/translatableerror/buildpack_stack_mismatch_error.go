@@ -0,0 +1,21 @@
+package translatableerror
+
+// BuildpackStackMismatchError is returned when the bits uploaded to a
+// buildpack were built for a different stack than the buildpack's.
+type BuildpackStackMismatchError struct {
+	Name      string
+	Stack     string
+	BitsStack string
+}
+
+func (BuildpackStackMismatchError) Error() string {
+	return "Buildpack {{.Name}} is for stack {{.Stack}}, but the uploaded bits are for stack {{.BitsStack}}."
+}
+
+func (e BuildpackStackMismatchError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":      e.Name,
+		"Stack":     e.Stack,
+		"BitsStack": e.BitsStack,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// ManifestValidationError is returned when validate-manifest finds problems
+// in a manifest; the problems themselves have already been displayed.
+type ManifestValidationError struct {
+	Count int
+}
+
+func (ManifestValidationError) Error() string {
+	return "Manifest is invalid: {{.Count}} error(s) found"
+}
+
+func (e ManifestValidationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Count": e.Count,
+	})
+}
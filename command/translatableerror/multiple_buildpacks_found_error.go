@@ -0,0 +1,17 @@
+package translatableerror
+
+// MultipleBuildpacksFoundError is returned when a buildpack name is used by
+// several stacks and no stack was given.
+type MultipleBuildpacksFoundError struct {
+	Name string
+}
+
+func (MultipleBuildpacksFoundError) Error() string {
+	return "Multiple buildpacks named {{.Name}} found. Specify the stack with -s."
+}
+
+func (e MultipleBuildpacksFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
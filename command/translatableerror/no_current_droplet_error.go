@@ -0,0 +1,17 @@
+package translatableerror
+
+// NoCurrentDropletError is returned when an app has no current droplet to
+// download.
+type NoCurrentDropletError struct {
+	AppName string
+}
+
+func (NoCurrentDropletError) Error() string {
+	return "App {{.AppName}} does not have a current droplet. Stage the app or pass --droplet to select one."
+}
+
+func (e NoCurrentDropletError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
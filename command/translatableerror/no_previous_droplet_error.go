@@ -0,0 +1,17 @@
+package translatableerror
+
+// NoPreviousDropletError is returned when an app has no earlier staged
+// droplet to roll back to.
+type NoPreviousDropletError struct {
+	AppName string
+}
+
+func (NoPreviousDropletError) Error() string {
+	return "App {{.AppName}} has no earlier staged droplet to roll back to."
+}
+
+func (e NoPreviousDropletError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
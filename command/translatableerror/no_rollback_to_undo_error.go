@@ -0,0 +1,17 @@
+package translatableerror
+
+// NoRollbackToUndoError is returned when there is no recorded rollback of an
+// app to undo.
+type NoRollbackToUndoError struct {
+	AppName string
+}
+
+func (NoRollbackToUndoError) Error() string {
+	return "No rollback of app {{.AppName}} to undo. The app has not been rolled back from this machine, or its droplet has changed since."
+}
+
+func (e NoRollbackToUndoError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
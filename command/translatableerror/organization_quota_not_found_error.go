@@ -0,0 +1,15 @@
+package translatableerror
+
+type OrganizationQuotaNotFoundError struct {
+	Name string
+}
+
+func (OrganizationQuotaNotFoundError) Error() string {
+	return "Organization quota '{{.Name}}' not found."
+}
+
+func (e OrganizationQuotaNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
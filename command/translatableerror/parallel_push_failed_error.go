@@ -0,0 +1,19 @@
+package translatableerror
+
+// ParallelPushFailedError is returned when one or more of the apps pushed
+// with --parallel failed to push.
+type ParallelPushFailedError struct {
+	FailedCount int
+	TotalCount  int
+}
+
+func (ParallelPushFailedError) Error() string {
+	return "Failed to push {{.FailedCount}} of {{.TotalCount}} apps"
+}
+
+func (e ParallelPushFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"FailedCount": e.FailedCount,
+		"TotalCount":  e.TotalCount,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+type PortNotReservableError struct {
+	Port            int
+	RouterGroup     string
+	ReservablePorts string
+}
+
+func (PortNotReservableError) Error() string {
+	return "Port {{.Port}} is not available in router group {{.RouterGroup}}. Reservable ports: {{.ReservablePorts}}"
+}
+
+func (e PortNotReservableError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Port":            e.Port,
+		"RouterGroup":     e.RouterGroup,
+		"ReservablePorts": e.ReservablePorts,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// RollbackDropletNotFoundError is returned when the droplet given to
+// v3-rollback is not a staged droplet of the app.
+type RollbackDropletNotFoundError struct {
+	AppName     string
+	DropletGUID string
+}
+
+func (RollbackDropletNotFoundError) Error() string {
+	return "Droplet {{.DropletGUID}} is not a staged droplet of app {{.AppName}}."
+}
+
+func (e RollbackDropletNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":     e.AppName,
+		"DropletGUID": e.DropletGUID,
+	})
+}
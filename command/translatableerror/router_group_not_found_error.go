@@ -0,0 +1,24 @@
+package translatableerror
+
+type RouterGroupNotFoundError struct {
+	Name string
+	GUID string
+}
+
+func (e RouterGroupNotFoundError) Error() string {
+	switch {
+	case e.Name != "":
+		return "Router group {{.Name}} not found"
+	case e.GUID != "":
+		return "Router group with GUID {{.GUID}} not found"
+	default:
+		return "Router group not found"
+	}
+}
+
+func (e RouterGroupNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+		"GUID": e.GUID,
+	})
+}
@@ -0,0 +1,14 @@
+package translatableerror
+
+// RoutingAPINotEnabledError is returned when a command requires the Routing
+// API and the targeted Cloud Controller does not advertise one.
+type RoutingAPINotEnabledError struct {
+}
+
+func (RoutingAPINotEnabledError) Error() string {
+	return "This command requires the Routing API. Your targeted endpoint reports it is not enabled."
+}
+
+func (e RoutingAPINotEnabledError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type ServiceInstanceProvisioningFailedError struct {
+	Name   string
+	Reason string
+}
+
+func (e ServiceInstanceProvisioningFailedError) Error() string {
+	return "Provisioning service instance {{.ServiceInstance}} failed: {{.Reason}}"
+}
+
+func (e ServiceInstanceProvisioningFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ServiceInstance": e.Name,
+		"Reason":          e.Reason,
+	})
+}
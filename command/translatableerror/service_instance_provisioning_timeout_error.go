@@ -0,0 +1,19 @@
+package translatableerror
+
+import "time"
+
+type ServiceInstanceProvisioningTimeoutError struct {
+	Name    string
+	Timeout time.Duration
+}
+
+func (ServiceInstanceProvisioningTimeoutError) Error() string {
+	return "Timed out after {{.Timeout}} waiting for service instance {{.ServiceInstance}} to be provisioned. The service broker may still be provisioning it."
+}
+
+func (e ServiceInstanceProvisioningTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ServiceInstance": e.Name,
+		"Timeout":         e.Timeout,
+	})
+}
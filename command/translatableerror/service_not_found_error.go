@@ -0,0 +1,15 @@
+package translatableerror
+
+type ServiceNotFoundError struct {
+	Name string
+}
+
+func (e ServiceNotFoundError) Error() string {
+	return "Service offering '{{.ServiceName}}' not found"
+}
+
+func (e ServiceNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ServiceName": e.Name,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type ServicePlanNotFoundError struct {
+	PlanName    string
+	ServiceName string
+}
+
+func (e ServicePlanNotFoundError) Error() string {
+	return "Service plan '{{.PlanName}}' not found for service offering '{{.ServiceName}}'"
+}
+
+func (e ServicePlanNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PlanName":    e.PlanName,
+		"ServiceName": e.ServiceName,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type SpaceQuotaNotFoundError struct {
+	Name string
+}
+
+func (SpaceQuotaNotFoundError) Error() string {
+	return "Space quota '{{.Name}}' not found."
+}
+
+func (e SpaceQuotaNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
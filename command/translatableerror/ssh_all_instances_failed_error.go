@@ -0,0 +1,19 @@
+package translatableerror
+
+// SSHAllInstancesFailedError is returned when the command run by
+// ssh --all-instances did not succeed on every instance.
+type SSHAllInstancesFailedError struct {
+	FailedCount int
+	TotalCount  int
+}
+
+func (SSHAllInstancesFailedError) Error() string {
+	return "Command failed on {{.FailedCount}} of {{.TotalCount}} instances"
+}
+
+func (e SSHAllInstancesFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"FailedCount": e.FailedCount,
+		"TotalCount":  e.TotalCount,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+// SSHCopyPathsError is returned when neither or both of the ssh-copy
+// arguments refer to an app instance.
+type SSHCopyPathsError struct{}
+
+func (SSHCopyPathsError) DisplayUsage() {}
+
+func (SSHCopyPathsError) Error() string {
+	return "Incorrect Usage: Exactly one of SOURCE or DESTINATION must be an app path in the form APP_NAME:[INDEX:]PATH"
+}
+
+func (e SSHCopyPathsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
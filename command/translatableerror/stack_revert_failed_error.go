@@ -0,0 +1,32 @@
+package translatableerror
+
+// StackRevertFailedError is returned when an app fails to start on a new
+// stack and then cannot be moved back to its old one.
+type StackRevertFailedError struct {
+	AppName   string
+	NewStack  string
+	OldStack  string
+	Err       error
+	RevertErr error
+}
+
+func (StackRevertFailedError) Error() string {
+	return "App {{.AppName}} failed to start on stack {{.NewStack}}: {{.Error}}\nMoving it back to stack {{.OldStack}} also failed: {{.RevertError}}"
+}
+
+func (e StackRevertFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":     e.AppName,
+		"NewStack":    e.NewStack,
+		"OldStack":    e.OldStack,
+		"Error":       translateWrappedError(e.Err, translate),
+		"RevertError": translateWrappedError(e.RevertErr, translate),
+	})
+}
+
+func translateWrappedError(err error, translate func(string, ...interface{}) string) string {
+	if translatableErr, ok := err.(TranslatableError); ok {
+		return translatableErr.Translate(translate)
+	}
+	return err.Error()
+}
@@ -39,16 +39,20 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("AddPluginRepositoryError", AddPluginRepositoryError{}),
 		Entry("APINotFoundError", APINotFoundError{}),
 		Entry("APIRequestError", APIRequestError{}),
+		Entry("ApplicationDropletNotFoundError", ApplicationDropletNotFoundError{}),
 		Entry("ApplicationNotFoundError", ApplicationNotFoundError{}),
 		Entry("AppNotFoundInManifestError", AppNotFoundInManifestError{}),
 		Entry("ArgumentCombinationError", ArgumentCombinationError{}),
 		Entry("AssignDropletError", AssignDropletError{}),
 		Entry("BadCredentialsError", BadCredentialsError{}),
+		Entry("BuildpackNotFoundError", BuildpackNotFoundError{}),
+		Entry("BuildpackStackMismatchError", BuildpackStackMismatchError{}),
 		Entry("CFNetworkingEndpointNotFoundError", CFNetworkingEndpointNotFoundError{}),
 		Entry("CommandLineArgsWithMultipleAppsError", CommandLineArgsWithMultipleAppsError{}),
 		Entry("DockerPasswordNotSetError", DockerPasswordNotSetError{}),
 		Entry("DownloadPluginHTTPError", DownloadPluginHTTPError{}),
 		Entry("EmptyDirectoryError", EmptyDirectoryError{}),
+		Entry("FeatureFlagNotFoundError", FeatureFlagNotFoundError{}),
 		Entry("FetchingPluginInfoFromRepositoriesError", FetchingPluginInfoFromRepositoriesError{}),
 		Entry("FileChangedError", FileChangedError{}),
 		Entry("FileNotFoundError", FileNotFoundError{}),
@@ -61,17 +65,25 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("JobTimeoutError", JobTimeoutError{}),
 		Entry("JSONSyntaxError", JSONSyntaxError{Err: errors.New("some-error")}),
 		Entry("LifecycleMinimumAPIVersionNotMetError", LifecycleMinimumAPIVersionNotMetError{}),
+		Entry("ManifestValidationError", ManifestValidationError{}),
 		Entry("MinimumAPIVersionNotMetError", MinimumAPIVersionNotMetError{}),
+		Entry("MultipleBuildpacksFoundError", MultipleBuildpacksFoundError{}),
 		Entry("NetworkPolicyProtocolOrPortNotProvidedError", NetworkPolicyProtocolOrPortNotProvidedError{}),
 		Entry("NoAPISetError", NoAPISetError{}),
 		Entry("NoCompatibleBinaryError", NoCompatibleBinaryError{}),
+		Entry("NoCurrentDropletError", NoCurrentDropletError{}),
 		Entry("NoDomainsFoundError", NoDomainsFoundError{}),
 		Entry("NoMatchingDomainError", NoMatchingDomainError{}),
 		Entry("NoOrganizationTargetedError", NoOrganizationTargetedError{}),
+		Entry("NoPreviousDropletError", NoPreviousDropletError{}),
 		Entry("NoPluginRepositoriesError", NoPluginRepositoriesError{}),
+		Entry("NoRollbackToUndoError", NoRollbackToUndoError{}),
+		Entry("NoRunningProcessInstancesError", NoRunningProcessInstancesError{}),
 		Entry("NoSpaceTargetedError", NoSpaceTargetedError{}),
 		Entry("NotLoggedInError", NotLoggedInError{}),
+		Entry("OrganizationQuotaNotFoundError", OrganizationQuotaNotFoundError{}),
 		Entry("OrgNotFoundError", OrganizationNotFoundError{}),
+		Entry("ParallelPushFailedError", ParallelPushFailedError{}),
 		Entry("ParseArgumentError", ParseArgumentError{}),
 		Entry("PluginAlreadyInstalledError", PluginAlreadyInstalledError{}),
 		Entry("PluginBinaryRemoveFailedError", PluginBinaryRemoveFailedError{}),
@@ -83,19 +95,30 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("PluginNotFoundInRepositoryError", PluginNotFoundInRepositoryError{}),
 		Entry("PluginNotFoundOnDiskOrInAnyRepositoryError", PluginNotFoundOnDiskOrInAnyRepositoryError{}),
 		Entry("PortNotAllowedWithHTTPDomainError", PortNotAllowedWithHTTPDomainError{}),
+		Entry("PortNotReservableError", PortNotReservableError{}),
 		Entry("PropertyCombinationError", PropertyCombinationError{Properties: []string{"property-1", "property-2"}}),
 		Entry("RepositoryNameTakenError", RepositoryNameTakenError{}),
 		Entry("RequiredArgumentError", RequiredArgumentError{}),
 		Entry("RequiredFlagsError", RequiredFlagsError{}),
 		Entry("RequiredNameForPushError", RequiredNameForPushError{}),
+		Entry("RollbackDropletNotFoundError", RollbackDropletNotFoundError{}),
 		Entry("RouteInDifferentSpaceError", RouteInDifferentSpaceError{}),
+		Entry("RouterGroupNotFoundError", RouterGroupNotFoundError{}),
+		Entry("RoutingAPINotEnabledError", RoutingAPINotEnabledError{}),
 		Entry("RunTaskError", RunTaskError{}),
 		Entry("SecurityGroupNotFoundError", SecurityGroupNotFoundError{}),
 		Entry("ServiceInstanceNotFoundError", ServiceInstanceNotFoundError{}),
+		Entry("ServiceInstanceProvisioningFailedError", ServiceInstanceProvisioningFailedError{}),
+		Entry("ServiceNotFoundError", ServiceNotFoundError{}),
+		Entry("ServicePlanNotFoundError", ServicePlanNotFoundError{}),
 		Entry("SpaceNotFoundError", SpaceNotFoundError{}),
+		Entry("SpaceQuotaNotFoundError", SpaceQuotaNotFoundError{}),
+		Entry("SSHAllInstancesFailedError", SSHAllInstancesFailedError{}),
+		Entry("SSHCopyPathsError", SSHCopyPathsError{}),
 		Entry("SSLCertError", SSLCertError{}),
 		Entry("StackNotFoundError with name", SpaceNotFoundError{Name: "steve"}),
 		Entry("StackNotFoundError without name", SpaceNotFoundError{}),
+		Entry("StackRevertFailedError", StackRevertFailedError{Err: StagingFailedError{}, RevertErr: errors.New("some-error")}),
 		Entry("StagingFailedError", StagingFailedError{}),
 		Entry("StagingFailedNoAppDetectedError", StagingFailedNoAppDetectedError{}),
 		Entry("StagingTimeoutError", StagingTimeoutError{}),
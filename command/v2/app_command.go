@@ -1,13 +1,25 @@
 package v2
 
 import (
+	"strings"
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+const (
+	defaultWatchInterval = 5 * time.Second
+
+	// maxWatchEvents is the number of instance state changes kept on screen
+	// when --watch redraws in place.
+	maxWatchEvents = 10
+)
+
 //go:generate counterfeiter . AppActor
 
 type AppActor interface {
@@ -16,10 +28,12 @@ type AppActor interface {
 }
 
 type AppCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	GUID            bool         `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
-	usage           interface{}  `usage:"CF_NAME app APP_NAME"`
-	relatedCommands interface{}  `related_commands:"apps, events, logs, map-route, unmap-route, push"`
+	RequiredArgs    flag.AppName  `positional-args:"yes"`
+	GUID            bool          `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
+	Watch           bool          `long:"watch" description:"Refresh the instance table until interrupted, reporting crashes and restarts and highlighting memory or disk usage close to its limit"`
+	Interval        flag.Duration `long:"interval" description:"Time between refreshes when watching, e.g. 10s or 1m (Default: 5s)"`
+	usage           interface{}   `usage:"CF_NAME app APP_NAME [--watch [--interval INTERVAL]]"`
+	relatedCommands interface{}   `related_commands:"apps, events, logs, map-route, unmap-route, push"`
 
 	UI          command.UI
 	Config      command.Config
@@ -42,6 +56,14 @@ func (cmd *AppCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd AppCommand) Execute(args []string) error {
+	if cmd.GUID && cmd.Watch {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--guid", "--watch"}}
+	}
+
+	if cmd.Interval.IsSet && !cmd.Watch {
+		return translatableerror.RequiredFlagsError{Arg1: "--interval", Arg2: "--watch"}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return shared.HandleError(err)
@@ -51,6 +73,10 @@ func (cmd AppCommand) Execute(args []string) error {
 		return cmd.displayAppGUID()
 	}
 
+	if cmd.Watch {
+		return cmd.watchAppSummary()
+	}
+
 	return cmd.displayAppSummary()
 }
 
@@ -71,23 +97,145 @@ func (cmd AppCommand) displayAppSummary() error {
 		return shared.HandleError(err)
 	}
 
+	cmd.displayFlavorText(user.Name)
+	cmd.UI.DisplayNewline()
+
+	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	shared.DisplayAppSummary(cmd.UI, appSummary, false)
+
+	return nil
+}
+
+// watchAppSummary fetches the app summary every interval until it fails. On a
+// TTY the screen is redrawn in place; otherwise each refresh appends a new
+// instance table.
+func (cmd AppCommand) watchAppSummary() error {
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	interval := defaultWatchInterval
+	if cmd.Interval.IsSet {
+		interval = cmd.Interval.Value
+	}
+	isTTY := cmd.Config.IsTTY()
+
+	var (
+		previousInstances []v2action.ApplicationInstanceWithStats
+		events            []string
+	)
+	for refresh := 0; ; refresh++ {
+		if refresh > 0 {
+			time.Sleep(interval)
+		}
+
+		appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+		if err != nil {
+			cmd.UI.DisplayWarnings(warnings)
+			return shared.HandleError(err)
+		}
+
+		refreshedAt := time.Now()
+		newEvents := cmd.instanceEvents(previousInstances, appSummary.RunningInstances, refreshedAt)
+		previousInstances = appSummary.RunningInstances
+
+		if isTTY {
+			events = append(events, newEvents...)
+			if len(events) > maxWatchEvents {
+				events = events[len(events)-maxWatchEvents:]
+			}
+
+			cmd.UI.ClearScreen()
+			cmd.displayFlavorText(user.Name)
+			cmd.UI.DisplayText("Refreshing every {{.Interval}}, last refreshed {{.Time}}. Press Ctrl-C to stop.", map[string]interface{}{
+				"Interval": interval,
+				"Time":     refreshedAt.Format("15:04:05"),
+			})
+			cmd.UI.DisplayNewline()
+			cmd.UI.DisplayWarnings(warnings)
+			shared.DisplayAppSummary(cmd.UI, appSummary, false)
+			cmd.displayEvents(events)
+		} else {
+			cmd.UI.DisplayWarnings(warnings)
+			if refresh == 0 {
+				cmd.displayFlavorText(user.Name)
+				cmd.UI.DisplayNewline()
+				shared.DisplayAppSummary(cmd.UI, appSummary, false)
+			} else {
+				cmd.UI.DisplayNewline()
+				cmd.UI.DisplayText("Refreshed {{.Time}}:", map[string]interface{}{
+					"Time": refreshedAt.Format("15:04:05"),
+				})
+				if len(appSummary.RunningInstances) == 0 {
+					cmd.UI.DisplayText("There are no running instances of this app.")
+				} else {
+					shared.DisplayAppInstances(cmd.UI, appSummary.RunningInstances)
+				}
+			}
+			cmd.displayEvents(newEvents)
+		}
+	}
+}
+
+func (cmd AppCommand) displayFlavorText(username string) {
 	cmd.UI.DisplayTextWithFlavor(
 		"Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
 		map[string]interface{}{
 			"AppName":   cmd.RequiredArgs.AppName,
 			"OrgName":   cmd.Config.TargetedOrganization().Name,
 			"SpaceName": cmd.Config.TargetedSpace().Name,
-			"Username":  user.Name,
+			"Username":  username,
 		})
+}
+
+func (cmd AppCommand) displayEvents(events []string) {
+	if len(events) == 0 {
+		return
+	}
+
 	cmd.UI.DisplayNewline()
+	for _, event := range events {
+		cmd.UI.DisplayText(event)
+	}
+}
 
-	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
-	cmd.UI.DisplayWarnings(warnings)
-	if err != nil {
-		return shared.HandleError(err)
+// instanceEvents describes the instances that changed state or restarted
+// between two refreshes. Instances that only appear in one of the refreshes
+// are ignored.
+func (cmd AppCommand) instanceEvents(previous []v2action.ApplicationInstanceWithStats, current []v2action.ApplicationInstanceWithStats, refreshedAt time.Time) []string {
+	previousByID := map[int]v2action.ApplicationInstanceWithStats{}
+	for _, instance := range previous {
+		previousByID[instance.ID] = instance
 	}
 
-	shared.DisplayAppSummary(cmd.UI, appSummary, false)
+	var events []string
+	for _, instance := range current {
+		previousInstance, exists := previousByID[instance.ID]
+		if !exists {
+			continue
+		}
+
+		switch {
+		case previousInstance.State != instance.State:
+			events = append(events, cmd.UI.TranslateText("{{.Time}} instance #{{.ID}}: {{.OldState}} -> {{.NewState}}", map[string]interface{}{
+				"Time":     refreshedAt.Format("15:04:05"),
+				"ID":       instance.ID,
+				"OldState": cmd.UI.TranslateText(strings.ToLower(string(previousInstance.State))),
+				"NewState": cmd.UI.TranslateText(strings.ToLower(string(instance.State))),
+			}))
+		case previousInstance.Since != instance.Since:
+			events = append(events, cmd.UI.TranslateText("{{.Time}} instance #{{.ID}}: restarted", map[string]interface{}{
+				"Time": refreshedAt.Format("15:04:05"),
+				"ID":   instance.ID,
+			}))
+		}
+	}
 
-	return nil
+	return events
 }
@@ -2,6 +2,7 @@ package v2_test
 
 import (
 	"errors"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -9,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
@@ -70,6 +72,29 @@ var _ = Describe("App Command", func() {
 		})
 	})
 
+	Context("when --guid and --watch are provided", func() {
+		BeforeEach(func() {
+			cmd.GUID = true
+			cmd.Watch = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--guid", "--watch"}}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --interval is provided without --watch", func() {
+		BeforeEach(func() {
+			cmd.Interval = flag.Duration{Value: time.Second, IsSet: true}
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--interval", Arg2: "--watch"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when the user is logged in, and org and space are targeted", func() {
 		BeforeEach(func() {
 			fakeConfig.HasTargetedOrganizationReturns(true)
@@ -162,6 +187,106 @@ var _ = Describe("App Command", func() {
 			})
 		})
 
+		Context("when the --watch flag is provided", func() {
+			var summaries []v2action.ApplicationSummary
+
+			BeforeEach(func() {
+				cmd.Watch = true
+				cmd.Interval = flag.Duration{Value: time.Millisecond, IsSet: true}
+
+				instance := v2action.ApplicationInstanceWithStats{
+					ID:          0,
+					State:       v2action.ApplicationInstanceState(ccv2.ApplicationInstanceRunning),
+					Since:       1403140717,
+					Disk:        50 * bytefmt.MEGABYTE,
+					DiskQuota:   2048 * bytefmt.MEGABYTE,
+					Memory:      100 * bytefmt.MEGABYTE,
+					MemoryQuota: 128 * bytefmt.MEGABYTE,
+				}
+				crashed := instance
+				crashed.State = v2action.ApplicationInstanceState(ccv2.ApplicationInstanceCrashed)
+				restarted := instance
+				restarted.Since = 1403140817
+				restarted.Memory = 125 * bytefmt.MEGABYTE
+
+				summaries = nil
+				for _, instances := range [][]v2action.ApplicationInstanceWithStats{{instance}, {crashed}, {instance}, {restarted}} {
+					summaries = append(summaries, v2action.ApplicationSummary{
+						Application: v2action.Application{
+							Name:      "some-app",
+							Instances: types.NullInt{Value: 1, IsSet: true},
+							State:     "STARTED",
+						},
+						RunningInstances: instances,
+					})
+				}
+
+				fakeActor.GetApplicationSummaryByNameAndSpaceStub = func(string, string) (v2action.ApplicationSummary, v2action.Warnings, error) {
+					call := fakeActor.GetApplicationSummaryByNameAndSpaceCallCount() - 1
+					if call < len(summaries) {
+						return summaries[call], v2action.Warnings{"app-summary-warning"}, nil
+					}
+					return v2action.ApplicationSummary{}, nil, actionerror.ApplicationNotFoundError{Name: "some-app"}
+				}
+			})
+
+			It("refreshes the summary until getting it fails", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(5))
+			})
+
+			Context("when the terminal is not a TTY", func() {
+				It("displays the summary once and appends an instance table for each refresh", func() {
+					Expect(testUI.Out).To(Say("Showing health and status for app some-app in org some-org / space some-space as some-user..."))
+					Expect(testUI.Out).To(Say(`name:\s+some-app`))
+					Expect(testUI.Out).To(Say(`#0\s+running\s+2014-06-19T01:18:37Z`))
+
+					Expect(testUI.Out).To(Say(`Refreshed \d{2}:\d{2}:\d{2}:`))
+					Expect(testUI.Out).To(Say(`#0\s+crashed`))
+					Expect(testUI.Out).To(Say(`\d{2}:\d{2}:\d{2} instance #0: running -> crashed`))
+
+					Expect(testUI.Out).To(Say(`Refreshed \d{2}:\d{2}:\d{2}:`))
+					Expect(testUI.Out).To(Say(`#0\s+running`))
+					Expect(testUI.Out).To(Say(`instance #0: crashed -> running`))
+
+					Expect(testUI.Out).To(Say(`Refreshed \d{2}:\d{2}:\d{2}:`))
+					Expect(testUI.Out).To(Say(`#0\s+running\s+2014-06-19T01:20:17Z`))
+					Expect(testUI.Out).To(Say(`instance #0: restarted`))
+
+					Expect(string(testUI.Out.(*Buffer).Contents())).ToNot(ContainSubstring("\033[2J"))
+					Expect(string(testUI.Out.(*Buffer).Contents())).To(ContainSubstring("Showing health and status"))
+					Expect(testUI.Out).ToNot(Say("name:"))
+				})
+
+				It("shows instances close to their limits in the table rather than as warnings", func() {
+					Expect(testUI.Out).To(Say(`#0\s+running\s+2014-06-19T01:20:17Z\s+\S+\s+125M of 128M`))
+					Expect(testUI.Err).To(Say("app-summary-warning"))
+					Expect(testUI.Err).ToNot(Say("Instance #0"))
+				})
+			})
+
+			Context("when the terminal is a TTY", func() {
+				BeforeEach(func() {
+					fakeConfig.IsTTYReturns(true)
+					testUI.IsTTY = true
+				})
+
+				It("redraws the whole summary on every refresh and keeps the state changes", func() {
+					output := string(testUI.Out.(*Buffer).Contents())
+					Expect(strings.Count(output, "\033[H\033[2J")).To(Equal(4))
+					Expect(strings.Count(output, "Refreshing every 1ms")).To(Equal(4))
+
+					screens := strings.Split(output, "\033[H\033[2J")
+					lastScreen := screens[len(screens)-1]
+					Expect(lastScreen).To(ContainSubstring("name:"))
+					Expect(lastScreen).To(MatchRegexp("instance #0: running -> crashed"))
+					Expect(lastScreen).To(MatchRegexp("instance #0: crashed -> running"))
+					Expect(lastScreen).To(MatchRegexp("instance #0: restarted"))
+					Expect(strings.Count(output, "instance #0: running -> crashed")).To(Equal(3))
+				})
+			})
+		})
+
 		Context("when the --guid flag is not provided", func() {
 			Context("when the app is a buildpack app", func() {
 				Context("when no errors occur", func() {
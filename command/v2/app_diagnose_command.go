@@ -0,0 +1,375 @@
+package v2
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry/bytefmt"
+	"github.com/cloudfoundry/noaa/consumer"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+const (
+	// crashHistory is how far back app-diagnose looks for crashes.
+	crashHistory = 24 * time.Hour
+
+	// maxDisplayedCrashes is the number of crashes listed by app-diagnose.
+	maxDisplayedCrashes = 10
+)
+
+//go:generate counterfeiter . AppDiagnoseActor
+
+type AppDiagnoseActor interface {
+	GetApplicationDiagnosisByNameAndSpace(appName string, spaceGUID string, since time.Time, client v2action.NOAAClient) (v2action.ApplicationDiagnosis, v2action.Warnings, error)
+}
+
+type AppDiagnoseCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME app-diagnose APP_NAME\n\n   Correlates instance states, crashes in the last 24 hours, recent logs and the health check and limits of the app to explain why its instances are failing."`
+	relatedCommands interface{}  `related_commands:"app, events, logs, scale, set-health-check"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       AppDiagnoseActor
+	NOAAClient  *consumer.Consumer
+}
+
+func (cmd *AppDiagnoseCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+
+	return nil
+}
+
+func (cmd AppDiagnoseCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Diagnosing app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	diagnosis, warnings, err := cmd.Actor.GetApplicationDiagnosisByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, time.Now().Add(-crashHistory), cmd.NOAAClient)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.displayConfiguration(diagnosis)
+	cmd.UI.DisplayNewline()
+
+	if len(diagnosis.RunningInstances) > 0 {
+		shared.DisplayAppInstances(cmd.UI, diagnosis.RunningInstances)
+		cmd.UI.DisplayNewline()
+	}
+
+	cmd.displayCrashes(diagnosis.Crashes)
+	cmd.displayInstanceLogs(diagnosis)
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Diagnosis:")
+	for _, finding := range cmd.findings(diagnosis) {
+		cmd.UI.DisplayText("   " + finding)
+	}
+
+	return nil
+}
+
+func (cmd AppDiagnoseCommand) displayConfiguration(diagnosis v2action.ApplicationDiagnosis) {
+	table := [][]string{
+		{cmd.UI.TranslateText("name:"), diagnosis.Name},
+		{cmd.UI.TranslateText("requested state:"), strings.ToLower(string(diagnosis.State))},
+		{cmd.UI.TranslateText("instances:"), fmt.Sprintf("%d/%d", runningInstanceCount(diagnosis.RunningInstances), diagnosis.Application.Instances.Value)},
+		{cmd.UI.TranslateText("memory:"), bytefmt.ByteSize(diagnosis.Memory * bytefmt.MEGABYTE)},
+		{cmd.UI.TranslateText("disk:"), bytefmt.ByteSize(diagnosis.DiskQuota * bytefmt.MEGABYTE)},
+		{cmd.UI.TranslateText("health check:"), cmd.healthCheck(diagnosis.Application)},
+		{cmd.UI.TranslateText("health check timeout:"), cmd.healthCheckTimeout(diagnosis.Application)},
+	}
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+}
+
+func (cmd AppDiagnoseCommand) displayCrashes(crashes []v2action.ApplicationCrash) {
+	if len(crashes) == 0 {
+		cmd.UI.DisplayText("No crashes in the last 24 hours.")
+		return
+	}
+
+	cmd.UI.DisplayText("Crashes in the last 24 hours:")
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("time"),
+			cmd.UI.TranslateText("instance"),
+			cmd.UI.TranslateText("exit status"),
+			cmd.UI.TranslateText("description"),
+		},
+	}
+	for i, crash := range crashes {
+		if i == maxDisplayedCrashes {
+			break
+		}
+		table = append(table, []string{
+			cmd.UI.UserFriendlyDate(crash.Timestamp),
+			fmt.Sprintf("#%d", crash.Index),
+			fmt.Sprint(crash.ExitStatus),
+			crash.ExitDescription,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	if len(crashes) > maxDisplayedCrashes {
+		cmd.UI.DisplayText("Showing the {{.Shown}} most recent of {{.Total}} crashes.", map[string]interface{}{
+			"Shown": maxDisplayedCrashes,
+			"Total": len(crashes),
+		})
+	}
+}
+
+// displayInstanceLogs displays the recent logs of every instance that is not
+// running or has crashed.
+func (cmd AppDiagnoseCommand) displayInstanceLogs(diagnosis v2action.ApplicationDiagnosis) {
+	for _, index := range failingInstanceIndexes(diagnosis) {
+		cmd.UI.DisplayNewline()
+
+		logs := diagnosis.InstanceLogs[index]
+		if len(logs) == 0 {
+			cmd.UI.DisplayText("No recent logs for instance #{{.Index}}.", map[string]interface{}{
+				"Index": index,
+			})
+			continue
+		}
+
+		cmd.UI.DisplayText("Recent logs for instance #{{.Index}}:", map[string]interface{}{
+			"Index": index,
+		})
+		for _, log := range logs {
+			cmd.UI.DisplayLogMessage(log, true)
+		}
+	}
+}
+
+// findings explains why the app's instances are failing, one sentence per
+// problem.
+func (cmd AppDiagnoseCommand) findings(diagnosis v2action.ApplicationDiagnosis) []string {
+	var findings []string
+
+	if diagnosis.State == ccv2.ApplicationStopped {
+		findings = append(findings, cmd.UI.TranslateText("Stopped: the app is stopped. Start it with '{{.BinaryName}} start {{.AppName}}'.", map[string]interface{}{
+			"BinaryName": cmd.Config.BinaryName(),
+			"AppName":    diagnosis.Name,
+		}))
+	}
+
+	crashesByCause := map[v2action.ApplicationCrashCause][]v2action.ApplicationCrash{}
+	for _, crash := range diagnosis.Crashes {
+		crashesByCause[crash.Cause()] = append(crashesByCause[crash.Cause()], crash)
+	}
+
+	if crashes := crashesByCause[v2action.CrashCauseOutOfMemory]; len(crashes) > 0 {
+		findings = append(findings, cmd.UI.TranslateText("Out of memory ({{.Instances}}): crashed {{.Count}} time(s) after exceeding the {{.Memory}} memory limit. Increase it with '{{.BinaryName}} scale {{.AppName}} -m SIZE' or reduce the app's memory use.", map[string]interface{}{
+			"Instances":  cmd.instancesLabel(crashIndexes(crashes)),
+			"Count":      len(crashes),
+			"Memory":     bytefmt.ByteSize(diagnosis.Memory * bytefmt.MEGABYTE),
+			"BinaryName": cmd.Config.BinaryName(),
+			"AppName":    diagnosis.Name,
+		}))
+	}
+
+	if crashes := crashesByCause[v2action.CrashCauseDiskQuota]; len(crashes) > 0 {
+		findings = append(findings, cmd.UI.TranslateText("Disk quota ({{.Instances}}): crashed {{.Count}} time(s) after exceeding the {{.Disk}} disk limit. Increase it with '{{.BinaryName}} scale {{.AppName}} -k SIZE' or write less to the instance's filesystem.", map[string]interface{}{
+			"Instances":  cmd.instancesLabel(crashIndexes(crashes)),
+			"Count":      len(crashes),
+			"Disk":       bytefmt.ByteSize(diagnosis.DiskQuota * bytefmt.MEGABYTE),
+			"BinaryName": cmd.Config.BinaryName(),
+			"AppName":    diagnosis.Name,
+		}))
+	}
+
+	if crashes := crashesByCause[v2action.CrashCauseHealthCheck]; len(crashes) > 0 {
+		findings = append(findings, cmd.UI.TranslateText("Health check ({{.Instances}}): never became healthy {{.Count}} time(s) under the {{.HealthCheck}} health check (timeout: {{.Timeout}}). Make sure the app starts in time and passes the check, or change it with '{{.BinaryName}} set-health-check {{.AppName}}' and the timeout with '{{.BinaryName}} push {{.AppName}} -t'.", map[string]interface{}{
+			"Instances":   cmd.instancesLabel(crashIndexes(crashes)),
+			"Count":       len(crashes),
+			"HealthCheck": cmd.healthCheck(diagnosis.Application),
+			"Timeout":     cmd.healthCheckTimeout(diagnosis.Application),
+			"BinaryName":  cmd.Config.BinaryName(),
+			"AppName":     diagnosis.Name,
+		}))
+	}
+
+	if crashes := crashesByCause[v2action.CrashCauseExited]; len(crashes) > 0 {
+		findings = append(findings, cmd.UI.TranslateText("App exited ({{.Instances}}): the app process exited {{.Count}} time(s) with status {{.ExitStatuses}}. Check the recent logs above for the error.", map[string]interface{}{
+			"Instances":    cmd.instancesLabel(crashIndexes(crashes)),
+			"Count":        len(crashes),
+			"ExitStatuses": exitStatuses(crashes),
+		}))
+	}
+
+	var nearMemoryLimit, nearDiskLimit, downWithoutCrashes []int
+	for _, instance := range diagnosis.RunningInstances {
+		switch instance.State {
+		case v2action.ApplicationInstanceState(ccv2.ApplicationInstanceRunning):
+			if shared.IsNearLimit(instance.Memory, instance.MemoryQuota) {
+				nearMemoryLimit = append(nearMemoryLimit, instance.ID)
+			}
+			if shared.IsNearLimit(instance.Disk, instance.DiskQuota) {
+				nearDiskLimit = append(nearDiskLimit, instance.ID)
+			}
+		case v2action.ApplicationInstanceState(ccv2.ApplicationInstanceDown):
+			if len(diagnosis.CrashesForInstance(instance.ID)) == 0 {
+				downWithoutCrashes = append(downWithoutCrashes, instance.ID)
+			}
+		}
+	}
+
+	if len(nearMemoryLimit) > 0 {
+		findings = append(findings, cmd.UI.TranslateText("Memory ({{.Instances}}): using {{.Threshold}} or more of the {{.Memory}} memory limit; the instance will be killed if usage keeps growing.", map[string]interface{}{
+			"Instances": cmd.instancesLabel(nearMemoryLimit),
+			"Threshold": fmt.Sprintf("%.0f%%", shared.NearLimitThreshold*100),
+			"Memory":    bytefmt.ByteSize(diagnosis.Memory * bytefmt.MEGABYTE),
+		}))
+	}
+
+	if len(nearDiskLimit) > 0 {
+		findings = append(findings, cmd.UI.TranslateText("Disk ({{.Instances}}): using {{.Threshold}} or more of the {{.Disk}} disk limit.", map[string]interface{}{
+			"Instances": cmd.instancesLabel(nearDiskLimit),
+			"Threshold": fmt.Sprintf("%.0f%%", shared.NearLimitThreshold*100),
+			"Disk":      bytefmt.ByteSize(diagnosis.DiskQuota * bytefmt.MEGABYTE),
+		}))
+	}
+
+	if len(downWithoutCrashes) > 0 {
+		findings = append(findings, cmd.UI.TranslateText("Down ({{.Instances}}): not running and no crash was recorded; the platform may not have the capacity to place the instance.", map[string]interface{}{
+			"Instances": cmd.instancesLabel(downWithoutCrashes),
+		}))
+	}
+
+	if len(findings) == 0 {
+		findings = append(findings, cmd.UI.TranslateText("No problems found."))
+	}
+
+	return findings
+}
+
+func (cmd AppDiagnoseCommand) healthCheck(app v2action.Application) string {
+	if app.HealthCheckType == ccv2.ApplicationHealthCheckHTTP && app.HealthCheckHTTPEndpoint != "" {
+		return cmd.UI.TranslateText("{{.Type}} on {{.Endpoint}}", map[string]interface{}{
+			"Type":     app.HealthCheckType,
+			"Endpoint": app.HealthCheckHTTPEndpoint,
+		})
+	}
+	return string(app.HealthCheckType)
+}
+
+func (cmd AppDiagnoseCommand) healthCheckTimeout(app v2action.Application) string {
+	if app.HealthCheckTimeout == 0 {
+		return cmd.UI.TranslateText("default")
+	}
+	return (time.Duration(app.HealthCheckTimeout) * time.Second).String()
+}
+
+func (cmd AppDiagnoseCommand) instancesLabel(indexes []int) string {
+	var labels []string
+	for _, index := range indexes {
+		labels = append(labels, fmt.Sprintf("#%d", index))
+	}
+
+	if len(labels) == 1 {
+		return cmd.UI.TranslateText("instance {{.Indexes}}", map[string]interface{}{
+			"Indexes": labels[0],
+		})
+	}
+	return cmd.UI.TranslateText("instances {{.Indexes}}", map[string]interface{}{
+		"Indexes": strings.Join(labels, ", "),
+	})
+}
+
+// failingInstanceIndexes returns the sorted indexes of the instances that are
+// not running or have crashed.
+func failingInstanceIndexes(diagnosis v2action.ApplicationDiagnosis) []int {
+	failing := map[int]bool{}
+	for _, instance := range diagnosis.RunningInstances {
+		if instance.State != v2action.ApplicationInstanceState(ccv2.ApplicationInstanceRunning) {
+			failing[instance.ID] = true
+		}
+	}
+	for _, crash := range diagnosis.Crashes {
+		failing[crash.Index] = true
+	}
+
+	var indexes []int
+	for index := range failing {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+func crashIndexes(crashes []v2action.ApplicationCrash) []int {
+	seen := map[int]bool{}
+	var indexes []int
+	for _, crash := range crashes {
+		if !seen[crash.Index] {
+			seen[crash.Index] = true
+			indexes = append(indexes, crash.Index)
+		}
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+func exitStatuses(crashes []v2action.ApplicationCrash) string {
+	seen := map[int]bool{}
+	var statuses []int
+	for _, crash := range crashes {
+		if !seen[crash.ExitStatus] {
+			seen[crash.ExitStatus] = true
+			statuses = append(statuses, crash.ExitStatus)
+		}
+	}
+	sort.Ints(statuses)
+
+	var formatted []string
+	for _, status := range statuses {
+		formatted = append(formatted, fmt.Sprint(status))
+	}
+	return strings.Join(formatted, ", ")
+}
+
+func runningInstanceCount(instances []v2action.ApplicationInstanceWithStats) int {
+	count := 0
+	for _, instance := range instances {
+		if instance.State == v2action.ApplicationInstanceState(ccv2.ApplicationInstanceRunning) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,265 @@
+package v2_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bytefmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("app-diagnose Command", func() {
+	var (
+		cmd             AppDiagnoseCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeAppDiagnoseActor
+		diagnosis       v2action.ApplicationDiagnosis
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAppDiagnoseActor)
+
+		cmd = AppDiagnoseCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		diagnosis = v2action.ApplicationDiagnosis{
+			Application: v2action.Application{
+				Name:               "some-app",
+				State:              ccv2.ApplicationStarted,
+				Instances:          types.NullInt{Value: 2, IsSet: true},
+				Memory:             256,
+				DiskQuota:          1024,
+				HealthCheckType:    ccv2.ApplicationHealthCheckHTTP,
+				HealthCheckTimeout: 60,
+			},
+			RunningInstances: []v2action.ApplicationInstanceWithStats{
+				{
+					ID:          0,
+					State:       v2action.ApplicationInstanceState(ccv2.ApplicationInstanceRunning),
+					Memory:      100 * bytefmt.MEGABYTE,
+					MemoryQuota: 256 * bytefmt.MEGABYTE,
+					Disk:        100 * bytefmt.MEGABYTE,
+					DiskQuota:   1024 * bytefmt.MEGABYTE,
+				},
+				{
+					ID:          1,
+					State:       v2action.ApplicationInstanceState(ccv2.ApplicationInstanceCrashed),
+					MemoryQuota: 256 * bytefmt.MEGABYTE,
+					DiskQuota:   1024 * bytefmt.MEGABYTE,
+				},
+			},
+		}
+		diagnosis.HealthCheckHTTPEndpoint = "/health"
+
+		fakeActor.GetApplicationDiagnosisByNameAndSpaceStub = func(string, string, time.Time, v2action.NOAAClient) (v2action.ApplicationDiagnosis, v2action.Warnings, error) {
+			return diagnosis, v2action.Warnings{"diagnosis-warning"}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when getting the diagnosis fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationDiagnosisByNameAndSpaceStub = func(string, string, time.Time, v2action.NOAAClient) (v2action.ApplicationDiagnosis, v2action.Warnings, error) {
+				return v2action.ApplicationDiagnosis{}, v2action.Warnings{"app-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"}
+			}
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("app-warning"))
+		})
+	})
+
+	It("looks for crashes in the last 24 hours", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		appName, spaceGUID, since, _ := fakeActor.GetApplicationDiagnosisByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(since).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Minute))
+	})
+
+	It("displays the configuration and instances of the app", func() {
+		Expect(testUI.Out).To(Say("Diagnosing app some-app in org some-org / space some-space as some-user..."))
+		Expect(testUI.Out).To(Say(`name:\s+some-app`))
+		Expect(testUI.Out).To(Say(`requested state:\s+started`))
+		Expect(testUI.Out).To(Say(`instances:\s+1/2`))
+		Expect(testUI.Out).To(Say(`memory:\s+256M`))
+		Expect(testUI.Out).To(Say(`disk:\s+1G`))
+		Expect(testUI.Out).To(Say(`health check:\s+http on /health`))
+		Expect(testUI.Out).To(Say(`health check timeout:\s+1m0s`))
+		Expect(testUI.Out).To(Say(`state\s+since\s+cpu\s+memory\s+disk\s+details`))
+		Expect(testUI.Out).To(Say(`#0\s+running`))
+		Expect(testUI.Out).To(Say(`#1\s+crashed`))
+		Expect(testUI.Err).To(Say("diagnosis-warning"))
+	})
+
+	Context("when there are no crashes", func() {
+		BeforeEach(func() {
+			diagnosis.RunningInstances = diagnosis.RunningInstances[:1]
+		})
+
+		It("says there are no problems", func() {
+			Expect(testUI.Out).To(Say("No crashes in the last 24 hours."))
+			Expect(testUI.Out).ToNot(Say("Recent logs"))
+			Expect(testUI.Out).To(Say("Diagnosis:"))
+			Expect(testUI.Out).To(Say("No problems found."))
+		})
+	})
+
+	Context("when instances have crashed", func() {
+		BeforeEach(func() {
+			diagnosis.Crashes = []v2action.ApplicationCrash{
+				{Index: 1, ExitStatus: 137, ExitDescription: "APP/PROC/WEB: Exited with status 137 (out of memory)", Timestamp: time.Unix(1500000300, 0)},
+				{Index: 1, ExitStatus: 1, ExitDescription: "APP/PROC/WEB: Exited with status 1", Timestamp: time.Unix(1500000200, 0)},
+				{Index: 0, ExitStatus: 0, ExitDescription: "Instance never healthy after 1m0s: Failed to make HTTP request to '/health'", Timestamp: time.Unix(1500000100, 0)},
+			}
+			diagnosis.InstanceLogs = map[int][]v2action.LogMessage{
+				1: {*v2action.NewLogMessage("panic: something broke", 1, time.Unix(1500000000, 0), "APP/PROC/WEB", "1")},
+			}
+		})
+
+		It("lists the crashes and the recent logs of the failing instances", func() {
+			Expect(testUI.Out).To(Say("Crashes in the last 24 hours:"))
+			Expect(testUI.Out).To(Say(`time\s+instance\s+exit status\s+description`))
+			Expect(testUI.Out).To(Say(`#1\s+137\s+APP/PROC/WEB: Exited with status 137 \(out of memory\)`))
+			Expect(testUI.Out).To(Say(`#1\s+1\s+APP/PROC/WEB: Exited with status 1`))
+			Expect(testUI.Out).To(Say(`#0\s+0\s+Instance never healthy`))
+
+			Expect(testUI.Out).To(Say("No recent logs for instance #0."))
+			Expect(testUI.Out).To(Say("Recent logs for instance #1:"))
+			Expect(testUI.Out).To(Say(`\[APP/PROC/WEB/1\] OUT panic: something broke`))
+		})
+
+		It("explains each cause of the crashes", func() {
+			Expect(testUI.Out).To(Say("Diagnosis:"))
+			Expect(testUI.Out).To(Say(`Out of memory \(instance #1\): crashed 1 time\(s\) after exceeding the 256M memory limit. Increase it with 'faceman scale some-app -m SIZE'`))
+			Expect(testUI.Out).To(Say(`Health check \(instance #0\): never became healthy 1 time\(s\) under the http on /health health check \(timeout: 1m0s\)`))
+			Expect(testUI.Out).To(Say(`App exited \(instance #1\): the app process exited 1 time\(s\) with status 1.`))
+			Expect(testUI.Out).ToNot(Say("No problems found."))
+		})
+	})
+
+	Context("when crashes are spread over several instances", func() {
+		BeforeEach(func() {
+			diagnosis.Crashes = []v2action.ApplicationCrash{
+				{Index: 1, ExitStatus: 2, ExitDescription: "APP/PROC/WEB: Exited with status 2"},
+				{Index: 0, ExitStatus: 1, ExitDescription: "APP/PROC/WEB: Exited with status 1"},
+				{Index: 1, ExitStatus: 1, ExitDescription: "APP/PROC/WEB: Exited with status 1"},
+			}
+		})
+
+		It("groups the instances and exit statuses", func() {
+			Expect(testUI.Out).To(Say(`App exited \(instances #0, #1\): the app process exited 3 time\(s\) with status 1, 2.`))
+		})
+	})
+
+	Context("when there are more crashes than are displayed", func() {
+		BeforeEach(func() {
+			for i := 0; i < 12; i++ {
+				diagnosis.Crashes = append(diagnosis.Crashes, v2action.ApplicationCrash{Index: 1, ExitStatus: 1})
+			}
+		})
+
+		It("says how many crashes were left out", func() {
+			Expect(testUI.Out).To(Say("Showing the 10 most recent of 12 crashes."))
+		})
+	})
+
+	Context("when a running instance is close to its limits", func() {
+		BeforeEach(func() {
+			diagnosis.RunningInstances = diagnosis.RunningInstances[:1]
+			diagnosis.RunningInstances[0].Memory = 250 * bytefmt.MEGABYTE
+			diagnosis.RunningInstances[0].Disk = 1000 * bytefmt.MEGABYTE
+		})
+
+		It("warns about the memory and disk usage", func() {
+			Expect(testUI.Out).To(Say(`Memory \(instance #0\): using %s or more of the 256M memory limit`, "90%"))
+			Expect(testUI.Out).To(Say(`Disk \(instance #0\): using %s or more of the 1G disk limit.`, "90%"))
+		})
+	})
+
+	Context("when an instance is down without crashing", func() {
+		BeforeEach(func() {
+			diagnosis.RunningInstances[1].State = v2action.ApplicationInstanceState(ccv2.ApplicationInstanceDown)
+		})
+
+		It("says the instance could not be placed", func() {
+			Expect(testUI.Out).To(Say(`Down \(instance #1\): not running and no crash was recorded`))
+		})
+	})
+
+	Context("when the app is stopped", func() {
+		BeforeEach(func() {
+			diagnosis.State = ccv2.ApplicationStopped
+			diagnosis.RunningInstances = nil
+			diagnosis.HealthCheckType = ccv2.ApplicationHealthCheckPort
+			diagnosis.HealthCheckTimeout = 0
+		})
+
+		It("says the app is stopped", func() {
+			Expect(testUI.Out).To(Say(`health check:\s+port`))
+			Expect(testUI.Out).To(Say(`health check timeout:\s+default`))
+			Expect(testUI.Out).ToNot(Say(`state\s+since`))
+			Expect(testUI.Out).To(Say("Stopped: the app is stopped. Start it with 'faceman start some-app'."))
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("current-user-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("current-user-error"))
+			Expect(fakeActor.GetApplicationDiagnosisByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,179 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/orgaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/orgconfig"
+)
+
+//go:generate counterfeiter . ApplyOrgActor
+
+type ApplyOrgActor interface {
+	Apply(plan orgaction.Plan) (orgaction.Warnings, error)
+	GetOrgPlan(config orgconfig.Org) (orgaction.Plan, orgaction.Warnings, error)
+	ReadOrgConfig(pathToConfig string) (orgconfig.Org, error)
+}
+
+type ApplyOrgCommand struct {
+	RequiredArgs    flag.ApplyOrgArgs `positional-args:"yes"`
+	DryRun          bool              `long:"dry-run" description:"Show the changes without making them"`
+	usage           interface{}       `usage:"CF_NAME apply-org FILE [--dry-run]\n\n   The file declares an org, its quota, isolation segments, private domains, roles, space quotas and spaces.\n   Anything missing is created or assigned; nothing is ever removed.\n\nEXAMPLE:\n   name: my-org\n   quota: default\n   managers: [alice]\n   space_quotas:\n   - name: small\n     memory: 2G\n     routes: 10\n   spaces:\n   - name: dev\n     quota: small\n     security_groups: [public_networks]\n     developers: [bob]"`
+	relatedCommands interface{}       `related_commands:"create-org, create-space, org, set-org-role, set-space-role"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ApplyOrgActor
+}
+
+func (cmd *ApplyOrgCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = orgaction.NewActor(
+		v2action.NewActor(ccClient, uaaClient, config),
+		v3action.NewActor(ccClientV3, config, nil, nil),
+	)
+	return nil
+}
+
+func (cmd ApplyOrgCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	config, err := cmd.Actor.ReadOrgConfig(string(cmd.RequiredArgs.PathToConfig))
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Planning changes to org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  config.Name,
+		"Username": user.Name,
+	})
+
+	plan, warnings, err := cmd.Actor.GetOrgPlan(config)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(plan.Changes) == 0 {
+		cmd.UI.DisplayText("Org {{.OrgName}} already matches {{.Path}}.", map[string]interface{}{
+			"OrgName": config.Name,
+			"Path":    cmd.RequiredArgs.PathToConfig,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	for _, change := range plan.Changes {
+		cmd.UI.DisplayText("+ "+cmd.describeChange(change), map[string]interface{}{
+			"Name":  change.Name,
+			"Space": change.Space,
+			"Role":  cmd.roleName(change),
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	if cmd.DryRun {
+		cmd.UI.DisplayText("Dry run; no changes were made.")
+		return nil
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying {{.Count}} change(s) to org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"Count":    len(plan.Changes),
+		"OrgName":  config.Name,
+		"Username": user.Name,
+	})
+
+	warnings, err = cmd.Actor.Apply(plan)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (ApplyOrgCommand) describeChange(change orgaction.Change) string {
+	switch change.Type {
+	case orgaction.CreateOrganization:
+		return "create org {{.Name}}"
+	case orgaction.SetOrganizationQuota:
+		return "set org quota {{.Name}}"
+	case orgaction.EntitleIsolationSegment:
+		return "entitle isolation segment {{.Name}}"
+	case orgaction.CreatePrivateDomain:
+		return "create private domain {{.Name}}"
+	case orgaction.AssignOrganizationRole:
+		return "give {{.Name}} the org role {{.Role}}"
+	case orgaction.CreateSpaceQuota:
+		return "create space quota {{.Name}}"
+	case orgaction.UpdateSpaceQuota:
+		return "update space quota {{.Name}}"
+	case orgaction.CreateSpace:
+		return "create space {{.Space}}"
+	case orgaction.SetSpaceQuota:
+		return "space {{.Space}}: set space quota {{.Name}}"
+	case orgaction.AssignSpaceIsolationSegment:
+		return "space {{.Space}}: assign isolation segment {{.Name}}"
+	case orgaction.BindSecurityGroup:
+		return "space {{.Space}}: bind security group {{.Name}}"
+	case orgaction.AssignSpaceRole:
+		return "space {{.Space}}: give {{.Name}} the space role {{.Role}}"
+	}
+	return string(change.Type) + " {{.Name}}"
+}
+
+// roleName returns the role of a change the way set-org-role and
+// set-space-role name it.
+func (ApplyOrgCommand) roleName(change orgaction.Change) string {
+	if change.Type == orgaction.AssignOrganizationRole {
+		switch constant.OrgRole(change.Role) {
+		case constant.OrgUserRole:
+			return "OrgUser"
+		case constant.OrgManagerRole:
+			return "OrgManager"
+		case constant.OrgBillingManagerRole:
+			return "BillingManager"
+		case constant.OrgAuditorRole:
+			return "OrgAuditor"
+		}
+	}
+
+	switch constant.SpaceRole(change.Role) {
+	case constant.SpaceManagerRole:
+		return "SpaceManager"
+	case constant.SpaceDeveloperRole:
+		return "SpaceDeveloper"
+	case constant.SpaceAuditorRole:
+		return "SpaceAuditor"
+	}
+	return change.Role
+}
@@ -0,0 +1,170 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/orgaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/orgconfig"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-org Command", func() {
+	var (
+		cmd             ApplyOrgCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeApplyOrgActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeApplyOrgActor)
+
+		cmd = ApplyOrgCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.PathToConfig = flag.PathWithExistenceCheck("org.yml")
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.ReadOrgConfigReturns(orgconfig.Org{Name: "some-org"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the config cannot be read", func() {
+		BeforeEach(func() {
+			fakeActor.ReadOrgConfigReturns(orgconfig.Org{}, orgconfig.InvalidOrgConfigError{Reason: "name is required"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(orgconfig.InvalidOrgConfigError{Reason: "name is required"}))
+			Expect(fakeActor.ReadOrgConfigArgsForCall(0)).To(Equal("org.yml"))
+			Expect(fakeActor.GetOrgPlanCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the plan has changes", func() {
+		var plan orgaction.Plan
+
+		BeforeEach(func() {
+			plan = orgaction.Plan{
+				OrgName: "some-org",
+				Changes: []orgaction.Change{
+					{Type: orgaction.CreateOrganization, Name: "some-org"},
+					{Type: orgaction.AssignOrganizationRole, Name: "alice", Role: "billing_managers"},
+					{Type: orgaction.CreateSpace, Space: "dev", Name: "dev"},
+					{Type: orgaction.BindSecurityGroup, Space: "dev", Name: "public_networks"},
+					{Type: orgaction.AssignSpaceRole, Space: "dev", Name: "bob", Role: "developers"},
+				},
+			}
+			fakeActor.GetOrgPlanReturns(plan, orgaction.Warnings{"plan-warning"}, nil)
+			fakeActor.ApplyReturns(orgaction.Warnings{"apply-warning"}, nil)
+		})
+
+		It("displays the plan, applies it and displays OK", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Planning changes to org some-org as some-user..."))
+			Expect(testUI.Err).To(Say("plan-warning"))
+			Expect(testUI.Out).To(Say(`\+ create org some-org`))
+			Expect(testUI.Out).To(Say(`\+ give alice the org role BillingManager`))
+			Expect(testUI.Out).To(Say(`\+ create space dev`))
+			Expect(testUI.Out).To(Say(`\+ space dev: bind security group public_networks`))
+			Expect(testUI.Out).To(Say(`\+ space dev: give bob the space role SpaceDeveloper`))
+			Expect(testUI.Out).To(Say(`Applying 5 change\(s\) to org some-org as some-user\.\.\.`))
+			Expect(testUI.Err).To(Say("apply-warning"))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.GetOrgPlanArgsForCall(0)).To(Equal(orgconfig.Org{Name: "some-org"}))
+			Expect(fakeActor.ApplyCallCount()).To(Equal(1))
+			Expect(fakeActor.ApplyArgsForCall(0)).To(Equal(plan))
+		})
+
+		Context("when --dry-run is given", func() {
+			BeforeEach(func() {
+				cmd.DryRun = true
+			})
+
+			It("displays the plan without applying it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`\+ create org some-org`))
+				Expect(testUI.Out).To(Say("Dry run; no changes were made."))
+				Expect(testUI.Out).ToNot(Say("OK"))
+				Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when applying the plan fails", func() {
+			BeforeEach(func() {
+				fakeActor.ApplyReturns(orgaction.Warnings{"apply-warning"}, v2action.SpaceQuotaNotFoundError{Name: "small"})
+			})
+
+			It("returns the translated error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.SpaceQuotaNotFoundError{Name: "small"}))
+				Expect(testUI.Err).To(Say("apply-warning"))
+			})
+		})
+	})
+
+	Context("when the org already matches the config", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrgPlanReturns(orgaction.Plan{OrgName: "some-org", OrgGUID: "some-org-guid"}, nil, nil)
+		})
+
+		It("displays that nothing changes and OK", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Org some-org already matches org.yml."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when planning fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrgPlanReturns(orgaction.Plan{}, orgaction.Warnings{"plan-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("plan-warning"))
+			Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+		})
+	})
+})
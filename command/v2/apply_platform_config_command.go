@@ -0,0 +1,138 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/platformconfig"
+)
+
+//go:generate counterfeiter . ApplyPlatformConfigActor
+
+type ApplyPlatformConfigActor interface {
+	ApplyPlatformConfigPlan(plan v2action.PlatformConfigPlan) (v2action.Warnings, error)
+	GetPlatformConfigPlan(config platformconfig.Platform, merge bool) (v2action.PlatformConfigPlan, v2action.Warnings, error)
+	ReadPlatformConfig(pathToConfig string) (platformconfig.Platform, error)
+}
+
+type ApplyPlatformConfigCommand struct {
+	RequiredArgs    flag.ApplyPlatformConfigArgs `positional-args:"yes"`
+	DryRun          bool                         `long:"dry-run" description:"Show the changes without making them"`
+	Merge           bool                         `long:"merge" description:"Keep the variables in each environment variable group that the file does not mention"`
+	usage           interface{}                  `usage:"CF_NAME apply-platform-config FILE [--merge] [--dry-run]\n\n   The file declares feature flags and the running and staging environment variable groups.\n   A section left out of the file is not changed. Without --merge, variables missing from a group in the file are removed.\n\nEXAMPLE:\n   feature_flags:\n     user_org_creation: false\n     diego_docker: true\n   running_environment_variables:\n     LANG: en_US.UTF-8\n   staging_environment_variables:\n     BP_DEBUG: \"false\""`
+	relatedCommands interface{}                  `related_commands:"feature-flags, running-environment-variable-group, set-running-environment-variable-group, set-staging-environment-variable-group, staging-environment-variable-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ApplyPlatformConfigActor
+}
+
+func (cmd *ApplyPlatformConfigCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd ApplyPlatformConfigCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	config, err := cmd.Actor.ReadPlatformConfig(string(cmd.RequiredArgs.PathToConfig))
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Planning changes to the platform as {{.Username}}...", map[string]interface{}{
+		"Username": user.Name,
+	})
+
+	plan, warnings, err := cmd.Actor.GetPlatformConfigPlan(config, cmd.Merge)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !plan.HasChanges() {
+		cmd.UI.DisplayText("The platform already matches {{.Path}}.", map[string]interface{}{
+			"Path": cmd.RequiredArgs.PathToConfig,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(plan.FeatureFlags) > 0 {
+		cmd.UI.DisplayText("feature flags:")
+		for _, change := range plan.FeatureFlags {
+			cmd.UI.DisplayText("~ {{.Name}}: {{.OldState}} -> {{.NewState}}", map[string]interface{}{
+				"Name":     change.Name,
+				"OldState": featureFlagState(cmd.UI, !change.Enabled),
+				"NewState": featureFlagState(cmd.UI, change.Enabled),
+			})
+		}
+	}
+	cmd.displayEnvironmentVariableChanges("running environment variable group:", plan.RunningEnvironmentVariables)
+	cmd.displayEnvironmentVariableChanges("staging environment variable group:", plan.StagingEnvironmentVariables)
+	cmd.UI.DisplayNewline()
+
+	if cmd.DryRun {
+		cmd.UI.DisplayText("Dry run; no changes were made.")
+		return nil
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying changes to the platform as {{.Username}}...", map[string]interface{}{
+		"Username": user.Name,
+	})
+
+	warnings, err = cmd.Actor.ApplyPlatformConfigPlan(plan)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd ApplyPlatformConfigCommand) displayEnvironmentVariableChanges(heading string, changes []v2action.EnvironmentVariableChange) {
+	if len(changes) == 0 {
+		return
+	}
+
+	cmd.UI.DisplayText(heading)
+	for _, change := range changes {
+		var template string
+		switch change.Type {
+		case v2action.AddEnvironmentVariable:
+			template = "+ {{.Name}}: {{.NewValue}}"
+		case v2action.UpdateEnvironmentVariable:
+			template = "~ {{.Name}}: {{.OldValue}} -> {{.NewValue}}"
+		case v2action.RemoveEnvironmentVariable:
+			template = "- {{.Name}}"
+		}
+
+		cmd.UI.DisplayText(template, map[string]interface{}{
+			"Name":     change.Name,
+			"OldValue": environmentVariableValue(change.OldValue),
+			"NewValue": environmentVariableValue(change.NewValue),
+		})
+	}
+}
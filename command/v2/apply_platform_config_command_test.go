@@ -0,0 +1,162 @@
+package v2_test
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/platformconfig"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-platform-config Command", func() {
+	var (
+		cmd             ApplyPlatformConfigCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeApplyPlatformConfigActor
+		executeErr      error
+		platform        platformconfig.Platform
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeApplyPlatformConfigActor)
+
+		cmd = ApplyPlatformConfigCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.PathToConfig = "platform.yml"
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		platform = platformconfig.Platform{FeatureFlags: map[string]bool{"diego_docker": true}}
+		fakeActor.ReadPlatformConfigReturns(platform, nil)
+		fakeActor.GetPlatformConfigPlanReturns(
+			v2action.PlatformConfigPlan{
+				FeatureFlags: []v2action.FeatureFlagChange{{Name: "diego_docker", Enabled: true}},
+				RunningEnvironmentVariables: []v2action.EnvironmentVariableChange{
+					{Type: v2action.UpdateEnvironmentVariable, Name: "DEBUG", OldValue: json.RawMessage("false"), NewValue: json.RawMessage("true")},
+					{Type: v2action.AddEnvironmentVariable, Name: "MAX_THREADS", NewValue: json.RawMessage("16")},
+				},
+				StagingEnvironmentVariables: []v2action.EnvironmentVariableChange{
+					{Type: v2action.RemoveEnvironmentVariable, Name: "BP_DEBUG", OldValue: json.RawMessage(`"1"`)},
+				},
+			},
+			v2action.Warnings{"plan-warning"},
+			nil,
+		)
+		fakeActor.ApplyPlatformConfigPlanReturns(v2action.Warnings{"apply-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	Context("when the config file is invalid", func() {
+		BeforeEach(func() {
+			fakeActor.ReadPlatformConfigReturns(platformconfig.Platform{}, platformconfig.InvalidPlatformConfigError{Reason: "a feature flag has no name"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(platformconfig.InvalidPlatformConfigError{Reason: "a feature flag has no name"}))
+			Expect(fakeActor.GetPlatformConfigPlanCallCount()).To(Equal(0))
+		})
+	})
+
+	It("displays each change and applies the plan", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.ReadPlatformConfigArgsForCall(0)).To(Equal("platform.yml"))
+		config, merge := fakeActor.GetPlatformConfigPlanArgsForCall(0)
+		Expect(config).To(Equal(platform))
+		Expect(merge).To(BeFalse())
+
+		Expect(testUI.Out).To(Say("Planning changes to the platform as some-user..."))
+		Expect(testUI.Out).To(Say("feature flags:"))
+		Expect(testUI.Out).To(Say("~ diego_docker: disabled -> enabled"))
+		Expect(testUI.Out).To(Say("running environment variable group:"))
+		Expect(testUI.Out).To(Say("~ DEBUG: false -> true"))
+		Expect(testUI.Out).To(Say(`\+ MAX_THREADS: 16`))
+		Expect(testUI.Out).To(Say("staging environment variable group:"))
+		Expect(testUI.Out).To(Say("- BP_DEBUG"))
+		Expect(testUI.Out).To(Say("Applying changes to the platform as some-user..."))
+		Expect(testUI.Out).To(Say("OK"))
+
+		Expect(testUI.Err).To(Say("plan-warning"))
+		Expect(testUI.Err).To(Say("apply-warning"))
+		Expect(fakeActor.ApplyPlatformConfigPlanCallCount()).To(Equal(1))
+	})
+
+	Context("when --merge is given", func() {
+		BeforeEach(func() {
+			cmd.Merge = true
+		})
+
+		It("plans with merging", func() {
+			_, merge := fakeActor.GetPlatformConfigPlanArgsForCall(0)
+			Expect(merge).To(BeTrue())
+		})
+	})
+
+	Context("when --dry-run is given", func() {
+		BeforeEach(func() {
+			cmd.DryRun = true
+		})
+
+		It("displays the changes without applying them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("~ diego_docker: disabled -> enabled"))
+			Expect(testUI.Out).To(Say("Dry run; no changes were made."))
+			Expect(fakeActor.ApplyPlatformConfigPlanCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the platform already matches the config", func() {
+		BeforeEach(func() {
+			fakeActor.GetPlatformConfigPlanReturns(v2action.PlatformConfigPlan{}, nil, nil)
+		})
+
+		It("says so without applying anything", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("The platform already matches platform.yml."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.ApplyPlatformConfigPlanCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a feature flag in the config does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetPlatformConfigPlanReturns(v2action.PlatformConfigPlan{}, v2action.Warnings{"plan-warning"}, v2action.FeatureFlagNotFoundError{Name: "not_a_flag"})
+		})
+
+		It("returns a FeatureFlagNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.FeatureFlagNotFoundError{Name: "not_a_flag"}))
+			Expect(testUI.Err).To(Say("plan-warning"))
+		})
+	})
+})
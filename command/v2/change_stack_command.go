@@ -0,0 +1,173 @@
+package v2
+
+import (
+	"github.com/cloudfoundry/noaa/consumer"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . ChangeStackActor
+
+type ChangeStackActor interface {
+	RestageActor
+	GetStack(guid string) (v2action.Stack, v2action.Warnings, error)
+	GetStackByName(stackName string) (v2action.Stack, v2action.Warnings, error)
+	UpdateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error)
+}
+
+type ChangeStackCommand struct {
+	RequiredArgs        flag.ChangeStackArgs `positional-args:"yes"`
+	usage               interface{}          `usage:"CF_NAME change-stack APP_NAME NEW_STACK\n\n   A started app is restaged on the new stack. If staging or startup fails, the app is moved back to its old stack and restaged."`
+	relatedCommands     interface{}          `related_commands:"restage, stack-apps, stacks"`
+	envCFStagingTimeout interface{}          `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}          `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ChangeStackActor
+	NOAAClient  *consumer.Consumer
+}
+
+func (cmd *ChangeStackCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+
+	return nil
+}
+
+func (cmd ChangeStackCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Changing stack of app {{.AppName}} to {{.Stack}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"AppName":     cmd.RequiredArgs.AppName,
+			"Stack":       cmd.RequiredArgs.StackName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": user.Name,
+		})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	newStack, warnings, err := cmd.Actor.GetStackByName(cmd.RequiredArgs.StackName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if app.StackGUID == newStack.GUID {
+		cmd.UI.DisplayText("App {{.AppName}} is already on stack {{.Stack}}.", map[string]interface{}{
+			"AppName": app.Name,
+			"Stack":   newStack.Name,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	oldStack, warnings, err := cmd.Actor.GetStack(app.StackGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = cmd.setStack(app, newStack)
+	if err != nil {
+		return err
+	}
+
+	if app.Stopped() {
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("App {{.AppName}} is stopped and will be staged on stack {{.Stack}} when it is next started.", map[string]interface{}{
+			"AppName": app.Name,
+			"Stack":   newStack.Name,
+		})
+		return nil
+	}
+
+	err = cmd.restage(app)
+	if err != nil {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayWarning("App {{.AppName}} failed to start on stack {{.NewStack}}; moving it back to stack {{.OldStack}}.", map[string]interface{}{
+			"AppName":  app.Name,
+			"NewStack": newStack.Name,
+			"OldStack": oldStack.Name,
+		})
+
+		revertErr := cmd.setStack(app, oldStack)
+		if revertErr == nil {
+			revertErr = cmd.restage(app)
+		}
+		if revertErr != nil {
+			return translatableerror.StackRevertFailedError{
+				AppName:   app.Name,
+				NewStack:  newStack.Name,
+				OldStack:  oldStack.Name,
+				Err:       err,
+				RevertErr: revertErr,
+			}
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("App {{.AppName}} was moved back to stack {{.Stack}}.", map[string]interface{}{
+			"AppName": app.Name,
+			"Stack":   oldStack.Name,
+		})
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	shared.DisplayAppSummary(cmd.UI, appSummary, true)
+
+	return nil
+}
+
+func (cmd ChangeStackCommand) setStack(app v2action.Application, stack v2action.Stack) error {
+	_, warnings, err := cmd.Actor.UpdateApplication(v2action.Application{
+		GUID:      app.GUID,
+		StackGUID: stack.GUID,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	return nil
+}
+
+func (cmd ChangeStackCommand) restage(app v2action.Application) error {
+	messages, logErrs, appState, apiWarnings, errs := cmd.Actor.RestageApplication(app, cmd.NOAAClient, cmd.Config)
+	return shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
+}
@@ -0,0 +1,247 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("change-stack Command", func() {
+	var (
+		cmd             ChangeStackCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeChangeStackActor
+		binaryName      string
+		executeErr      error
+		restageErrs     []error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeChangeStackActor)
+
+		cmd = ChangeStackCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.StackName = "new-stack"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app", StackGUID: "old-stack-guid", State: ccv2.ApplicationStarted},
+			v2action.Warnings{"get-app-warning"},
+			nil,
+		)
+		fakeActor.GetStackByNameReturns(v2action.Stack{GUID: "new-stack-guid", Name: "new-stack"}, v2action.Warnings{"get-stack-warning"}, nil)
+		fakeActor.GetStackReturns(v2action.Stack{GUID: "old-stack-guid", Name: "old-stack"}, nil, nil)
+
+		restageErrs = nil
+		fakeActor.RestageApplicationStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
+			var restageErr error
+			if call := fakeActor.RestageApplicationCallCount() - 1; call < len(restageErrs) {
+				restageErr = restageErrs[call]
+			}
+
+			messages := make(chan *v2action.LogMessage)
+			logErrs := make(chan error)
+			appState := make(chan v2action.ApplicationStateChange)
+			warnings := make(chan string)
+			errs := make(chan error)
+
+			go func() {
+				appState <- v2action.ApplicationStateStaging
+				if restageErr != nil {
+					errs <- restageErr
+					return
+				}
+				close(messages)
+				close(logErrs)
+				close(appState)
+				close(warnings)
+				close(errs)
+			}()
+
+			return messages, logErrs, appState, warnings, errs
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("displays flavor text", func() {
+		Expect(testUI.Out).To(Say("Changing stack of app some-app to new-stack in org some-org / space some-space as some-user..."))
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, v2action.Warnings{"get-app-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the stack does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetStackByNameReturns(v2action.Stack{}, v2action.Warnings{"get-stack-warning"}, v2action.StackNotFoundError{Name: "new-stack"})
+		})
+
+		It("returns a StackNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.StackNotFoundError{Name: "new-stack"}))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the app is already on the stack", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-app-guid", Name: "some-app", StackGUID: "new-stack-guid"}, nil, nil)
+		})
+
+		It("does not update or restage the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("App some-app is already on stack new-stack."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+			Expect(fakeActor.RestageApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the app is stopped", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-app-guid", Name: "some-app", StackGUID: "old-stack-guid", State: ccv2.ApplicationStopped}, nil, nil)
+		})
+
+		It("updates the stack without restaging", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.UpdateApplicationArgsForCall(0)).To(Equal(v2action.Application{GUID: "some-app-guid", StackGUID: "new-stack-guid"}))
+			Expect(fakeActor.RestageApplicationCallCount()).To(Equal(0))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("App some-app is stopped and will be staged on stack new-stack when it is next started."))
+		})
+	})
+
+	Context("when the app restages on the new stack", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSummaryByNameAndSpaceReturns(v2action.ApplicationSummary{
+				Application: v2action.Application{Name: "some-app"},
+				Stack:       v2action.Stack{Name: "new-stack"},
+			}, v2action.Warnings{"summary-warning"}, nil)
+		})
+
+		It("updates the stack, restages and displays the app summary", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(1))
+			Expect(fakeActor.UpdateApplicationArgsForCall(0)).To(Equal(v2action.Application{GUID: "some-app-guid", StackGUID: "new-stack-guid"}))
+			Expect(fakeActor.RestageApplicationCallCount()).To(Equal(1))
+
+			Expect(testUI.Out).To(Say("Staging app and tracing logs..."))
+			Expect(testUI.Out).To(Say(`name:\s+some-app`))
+			Expect(testUI.Out).To(Say(`stack:\s+new-stack`))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-stack-warning"))
+			Expect(testUI.Err).To(Say("summary-warning"))
+		})
+	})
+
+	Context("when the app fails to stage on the new stack", func() {
+		BeforeEach(func() {
+			restageErrs = []error{actionerror.StagingFailedError{Reason: "no buildpack for new-stack"}}
+		})
+
+		It("moves the app back to the old stack, restages it and returns the error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.StagingFailedError{Message: "no buildpack for new-stack"}))
+
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(2))
+			Expect(fakeActor.UpdateApplicationArgsForCall(0)).To(Equal(v2action.Application{GUID: "some-app-guid", StackGUID: "new-stack-guid"}))
+			Expect(fakeActor.UpdateApplicationArgsForCall(1)).To(Equal(v2action.Application{GUID: "some-app-guid", StackGUID: "old-stack-guid"}))
+			Expect(fakeActor.RestageApplicationCallCount()).To(Equal(2))
+
+			Expect(testUI.Err).To(Say("App some-app failed to start on stack new-stack; moving it back to stack old-stack."))
+			Expect(testUI.Out).To(Say("App some-app was moved back to stack old-stack."))
+			Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+		})
+
+		Context("when moving the app back fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationStub = func(app v2action.Application) (v2action.Application, v2action.Warnings, error) {
+					if app.StackGUID == "old-stack-guid" {
+						return v2action.Application{}, nil, errors.New("revert-error")
+					}
+					return app, nil, nil
+				}
+			})
+
+			It("returns both the original error and the revert error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.StackRevertFailedError{
+					AppName:   "some-app",
+					NewStack:  "new-stack",
+					OldStack:  "old-stack",
+					Err:       translatableerror.StagingFailedError{Message: "no buildpack for new-stack"},
+					RevertErr: errors.New("revert-error"),
+				}))
+				Expect(fakeActor.RestageApplicationCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the app also fails to start on the old stack", func() {
+			BeforeEach(func() {
+				restageErrs = append(restageErrs, actionerror.StartupTimeoutError{Name: "some-app"})
+			})
+
+			It("returns both the original error and the error from restaging on the old stack", func() {
+				Expect(executeErr).To(MatchError(translatableerror.StackRevertFailedError{
+					AppName:   "some-app",
+					NewStack:  "new-stack",
+					OldStack:  "old-stack",
+					Err:       translatableerror.StagingFailedError{Message: "no buildpack for new-stack"},
+					RevertErr: translatableerror.StartupTimeoutError{AppName: "some-app", BinaryName: "faceman"},
+				}))
+				Expect(testUI.Out).ToNot(Say("was moved back"))
+			})
+		})
+	})
+})
@@ -1,28 +1,71 @@
 package v2
 
 import (
+	"io"
+	"io/ioutil"
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/downloader"
+	"code.cloudfoundry.org/cli/util/progressbar"
 )
 
+//go:generate counterfeiter . CreateBuildpackActor
+
+type CreateBuildpackActor interface {
+	CloudControllerAPIVersion() string
+	CreateBuildpack(buildpack v2action.Buildpack) (v2action.Buildpack, v2action.Warnings, error)
+	GetBuildpackBitsStack(zipPath string) (string, error)
+	PrepareBuildpackBits(inputPath string, tmpDirPath string, downloader v2action.Downloader) (string, error)
+	UploadBuildpack(buildpackGUID string, zipPath string, progressBar v2action.ProgressBar) (v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . BuildpackProgressBar
+
+type BuildpackProgressBar interface {
+	NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader
+	Complete()
+}
+
 type CreateBuildpackCommand struct {
 	RequiredArgs    flag.CreateBuildpackArgs `positional-args:"yes"`
 	Disable         bool                     `long:"disable" description:"Disable the buildpack from being used for staging"`
 	Enable          bool                     `long:"enable" description:"Enable the buildpack to be used for staging"`
-	usage           interface{}              `usage:"CF_NAME create-buildpack BUILDPACK PATH POSITION [--enable|--disable]\n\nTIP:\n   Path should be a zip file, a url to a zip file, or a local directory. Position is a positive integer, sets priority, and is sorted from lowest to highest."`
-	relatedCommands interface{}              `related_commands:"buildpacks, push"`
+	Stack           string                   `short:"s" description:"Stack the buildpack is for (Default: the stack in the buildpack's manifest.yml, if any)"`
+	usage           interface{}              `usage:"CF_NAME create-buildpack BUILDPACK PATH POSITION [-s STACK] [--enable|--disable]\n\nTIP:\n   Path should be a zip file, a url to a zip file, or a local directory. Position is a positive integer, sets priority, and is sorted from lowest to highest."`
+	relatedCommands interface{}              `related_commands:"buildpacks, push, update-buildpack"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateBuildpackActor
+	ProgressBar BuildpackProgressBar
 }
 
-func (CreateBuildpackCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *CreateBuildpackCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+	cmd.ProgressBar = progressbar.NewTransferProgressBar()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (c CreateBuildpackCommand) Execute(args []string) error {
-	_, err := flag.ParseStringToInt(c.RequiredArgs.Position)
+func (cmd CreateBuildpackCommand) Execute(args []string) error {
+	position, err := flag.ParseStringToInt(cmd.RequiredArgs.Position)
 	if err != nil {
 		return translatableerror.ParseArgumentError{
 			ArgumentName: "POSITION",
@@ -30,6 +73,123 @@ func (c CreateBuildpackCommand) Execute(args []string) error {
 		}
 	}
 
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	if cmd.Enable && cmd.Disable {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--enable", "--disable"}}
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	stackErr := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionBuildpackStackAssociationV2, "Option '-s'")
+	if cmd.Stack != "" && stackErr != nil {
+		return stackErr
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	tmpDirPath, err := ioutil.TempDir("", "buildpack-upload")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDirPath)
+
+	bitsPath, err := cmd.Actor.PrepareBuildpackBits(string(cmd.RequiredArgs.Path), tmpDirPath, downloader.NewDownloader(tmpDirPath))
+	if err != nil {
+		return err
+	}
+
+	// Targets that cannot associate buildpacks with stacks ignore the stack
+	// named in the buildpack's manifest.
+	var stack string
+	if stackErr == nil {
+		stack, err = cmd.bitsStack(bitsPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	buildpack := v2action.Buildpack{
+		Name:     cmd.RequiredArgs.Buildpack,
+		Position: types.NullInt{IsSet: true, Value: position},
+		Stack:    stack,
+	}
+	if cmd.Enable || cmd.Disable {
+		buildpack.Enabled = types.NullBool{IsSet: true, Value: cmd.Enable}
+	}
+
+	if stack == "" {
+		cmd.UI.DisplayTextWithFlavor("Creating buildpack {{.Buildpack}} as {{.Username}}...", map[string]interface{}{
+			"Buildpack": buildpack.Name,
+			"Username":  user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Creating buildpack {{.Buildpack}} for stack {{.Stack}} as {{.Username}}...", map[string]interface{}{
+			"Buildpack": buildpack.Name,
+			"Stack":     stack,
+			"Username":  user.Name,
+		})
+	}
+
+	createdBuildpack, warnings, err := cmd.Actor.CreateBuildpack(buildpack)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v2action.BuildpackNameTakenError); ok {
+			cmd.UI.DisplayWarning("Buildpack {{.Buildpack}} already exists.", map[string]interface{}{
+				"Buildpack": buildpack.Name,
+			})
+			cmd.UI.DisplayText("TIP: use '{{.Command}}' to update this buildpack", map[string]interface{}{
+				"Command": cmd.Config.BinaryName() + " update-buildpack",
+			})
+			cmd.UI.DisplayOK()
+			return nil
+		}
+
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayTextWithFlavor("Uploading buildpack {{.Buildpack}} as {{.Username}}...", map[string]interface{}{
+		"Buildpack": buildpack.Name,
+		"Username":  user.Name,
+	})
+
+	warnings, err = cmd.Actor.UploadBuildpack(createdBuildpack.GUID, bitsPath, cmd.ProgressBar)
+	cmd.ProgressBar.Complete()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
 	return nil
 }
+
+// bitsStack returns the stack given with -s, or the one named in the
+// buildpack's manifest when -s is not given. The two must agree when both
+// are present.
+func (cmd CreateBuildpackCommand) bitsStack(bitsPath string) (string, error) {
+	bitsStack, err := cmd.Actor.GetBuildpackBitsStack(bitsPath)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case cmd.Stack == "":
+		return bitsStack, nil
+	case bitsStack != "" && bitsStack != cmd.Stack:
+		return "", translatableerror.BuildpackStackMismatchError{
+			Name:      cmd.RequiredArgs.Buildpack,
+			Stack:     cmd.Stack,
+			BitsStack: bitsStack,
+		}
+	default:
+		return cmd.Stack, nil
+	}
+}
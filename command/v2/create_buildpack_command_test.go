@@ -0,0 +1,220 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-buildpack Command", func() {
+	var (
+		cmd             CreateBuildpackCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCreateBuildpackActor
+		fakeProgressBar *v2fakes.FakeBuildpackProgressBar
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCreateBuildpackActor)
+		fakeProgressBar = new(v2fakes.FakeBuildpackProgressBar)
+
+		cmd = CreateBuildpackCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+		cmd.RequiredArgs.Buildpack = "some-buildpack"
+		cmd.RequiredArgs.Path = flag.PathWithExistenceCheckOrURL("/some/path")
+		cmd.RequiredArgs.Position = "3"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionBuildpackStackAssociationV2)
+		fakeActor.PrepareBuildpackBitsReturns("/tmp/some-buildpack.zip", nil)
+		fakeActor.CreateBuildpackReturns(v2action.Buildpack{GUID: "some-buildpack-guid"}, v2action.Warnings{"create-warning"}, nil)
+		fakeActor.UploadBuildpackReturns(v2action.Warnings{"upload-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the position is not an integer", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Position = "first"
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{ArgumentName: "POSITION", ExpectedType: "integer"}))
+		})
+	})
+
+	Context("when --enable and --disable are both provided", func() {
+		BeforeEach(func() {
+			cmd.Enable = true
+			cmd.Disable = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--enable", "--disable"}}))
+		})
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the buildpack's manifest names a stack", func() {
+		BeforeEach(func() {
+			cmd.Disable = true
+			fakeActor.GetBuildpackBitsStackReturns("cflinuxfs2", nil)
+		})
+
+		It("creates the buildpack for that stack and uploads its bits", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Creating buildpack some-buildpack for stack cflinuxfs2 as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Uploading buildpack some-buildpack as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("create-warning"))
+			Expect(testUI.Err).To(Say("upload-warning"))
+
+			inputPath, _, _ := fakeActor.PrepareBuildpackBitsArgsForCall(0)
+			Expect(inputPath).To(Equal("/some/path"))
+			Expect(fakeActor.GetBuildpackBitsStackArgsForCall(0)).To(Equal("/tmp/some-buildpack.zip"))
+
+			Expect(fakeActor.CreateBuildpackArgsForCall(0)).To(Equal(v2action.Buildpack{
+				Name:     "some-buildpack",
+				Position: types.NullInt{IsSet: true, Value: 3},
+				Enabled:  types.NullBool{IsSet: true, Value: false},
+				Stack:    "cflinuxfs2",
+			}))
+
+			guid, zipPath, progressBar := fakeActor.UploadBuildpackArgsForCall(0)
+			Expect(guid).To(Equal("some-buildpack-guid"))
+			Expect(zipPath).To(Equal("/tmp/some-buildpack.zip"))
+			Expect(progressBar).To(Equal(fakeProgressBar))
+			Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+		})
+
+		Context("when -s names a different stack", func() {
+			BeforeEach(func() {
+				cmd.Stack = "windows2012R2"
+			})
+
+			It("returns a BuildpackStackMismatchError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.BuildpackStackMismatchError{
+					Name:      "some-buildpack",
+					Stack:     "windows2012R2",
+					BitsStack: "cflinuxfs2",
+				}))
+				Expect(fakeActor.CreateBuildpackCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when a stack is given with -s", func() {
+		BeforeEach(func() {
+			cmd.Stack = "windows2012R2"
+		})
+
+		It("creates the buildpack for that stack", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.CreateBuildpackArgsForCall(0).Stack).To(Equal("windows2012R2"))
+		})
+	})
+
+	Context("when the API does not support buildpack stacks", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("2.100.0")
+			fakeActor.GetBuildpackBitsStackReturns("cflinuxfs2", nil)
+		})
+
+		It("creates the buildpack without the stack from its manifest", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Creating buildpack some-buildpack as some-user..."))
+			Expect(fakeActor.GetBuildpackBitsStackCallCount()).To(Equal(0))
+			Expect(fakeActor.CreateBuildpackArgsForCall(0).Stack).To(BeEmpty())
+		})
+
+		Context("when a stack is given with -s", func() {
+			BeforeEach(func() {
+				cmd.Stack = "windows2012R2"
+			})
+
+			It("returns a MinimumAPIVersionNotMetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+					Command:        "Option '-s'",
+					CurrentVersion: "2.100.0",
+					MinimumVersion: ccversion.MinVersionBuildpackStackAssociationV2,
+				}))
+				Expect(fakeActor.CreateBuildpackCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the buildpack already exists", func() {
+		BeforeEach(func() {
+			fakeActor.CreateBuildpackReturns(v2action.Buildpack{}, v2action.Warnings{"create-warning"}, v2action.BuildpackNameTakenError{Name: "some-buildpack"})
+		})
+
+		It("displays a warning and does not upload", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Creating buildpack some-buildpack as some-user..."))
+			Expect(testUI.Err).To(Say("Buildpack some-buildpack already exists."))
+			Expect(testUI.Out).To(Say("TIP: use 'faceman update-buildpack' to update this buildpack"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.UploadBuildpackCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the upload fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("upload failed")
+			fakeActor.UploadBuildpackReturns(v2action.Warnings{"upload-warning"}, expectedErr)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("upload-warning"))
+			Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -3,26 +3,122 @@ package v2
 import (
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . DeleteRouteActor
+
+type DeleteRouteActor interface {
+	CloudControllerAPIVersion() string
+	DeleteRoute(routeGUID string) (v2action.Warnings, error)
+	GetRouteByComponents(orgGUID string, route v2action.Route) (v2action.Route, v2action.Warnings, error)
+}
+
 type DeleteRouteCommand struct {
 	RequiredArgs    flag.Domain `positional-args:"yes"`
 	Force           bool        `short:"f" description:"Force deletion without confirmation"`
 	Hostname        string      `long:"hostname" short:"n" description:"Hostname used to identify the HTTP route"`
 	Path            string      `long:"path" description:"Path used to identify the HTTP route"`
-	Port            int         `long:"port" description:"Port used to identify the TCP route"`
+	Port            flag.Port   `long:"port" description:"Port used to identify the TCP route"`
 	usage           interface{} `usage:"Delete an HTTP route:\n      CF_NAME delete-route DOMAIN [--hostname HOSTNAME] [--path PATH] [-f]\n\n   Delete a TCP route:\n      CF_NAME delete-route DOMAIN --port PORT [-f]\n\nEXAMPLES:\n   CF_NAME delete-route example.com                              # example.com\n   CF_NAME delete-route example.com --hostname myhost            # myhost.example.com\n   CF_NAME delete-route example.com --hostname myhost --path foo # myhost.example.com/foo\n   CF_NAME delete-route example.com --port 5000                  # example.com:5000"`
 	relatedCommands interface{} `related_commands:"delete-orphaned-routes, routes, unmap-route"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DeleteRouteActor
 }
 
-func (DeleteRouteCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *DeleteRouteCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (DeleteRouteCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd DeleteRouteCommand) Execute(args []string) error {
+	if !cmd.Config.Experimental() {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	if cmd.Port.IsSet && (cmd.Hostname != "" || cmd.Path != "") {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--hostname", "--path", "--port"}}
+	}
+
+	if cmd.Port.IsSet {
+		err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionTCPRouting, "Option '--port'")
+		if err != nil {
+			return err
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	route := v2action.Route{
+		Domain: v2action.Domain{Name: cmd.RequiredArgs.Domain},
+		Host:   cmd.Hostname,
+		Path:   cmd.Path,
+		Port:   cmd.Port.NullInt,
+	}
+
+	if !cmd.Force {
+		deleteRoute, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the route {{.Route}}?", map[string]interface{}{
+			"Route": route,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteRoute {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayText("Deleting route {{.Route}}...", map[string]interface{}{
+		"Route": route,
+	})
+
+	foundRoute, warnings, err := cmd.Actor.GetRouteByComponents(cmd.Config.TargetedOrganization().GUID, route)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v2action.RouteNotFoundError); ok {
+			cmd.UI.DisplayWarning("Unable to delete, route '{{.Route}}' does not exist.", map[string]interface{}{
+				"Route": route,
+			})
+			cmd.UI.DisplayOK()
+			return nil
+		}
+		return shared.HandleError(err)
+	}
+
+	warnings, err = cmd.Actor.DeleteRoute(foundRoute.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
 	return nil
 }
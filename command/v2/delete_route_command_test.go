@@ -0,0 +1,174 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-route Command", func() {
+	var (
+		cmd             DeleteRouteCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeDeleteRouteActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeDeleteRouteActor)
+
+		cmd = DeleteRouteCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.Domain = "some-domain.com"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.ExperimentalReturns(true)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionTCPRouting)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the port and hostname are both provided", func() {
+		BeforeEach(func() {
+			cmd.Hostname = "some-host"
+			cmd.Port = flag.Port{NullInt: types.NullInt{IsSet: true, Value: 1024}}
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--hostname", "--path", "--port"}}))
+		})
+	})
+
+	Context("when the port is provided and the API version is too low", func() {
+		BeforeEach(func() {
+			cmd.Port = flag.Port{NullInt: types.NullInt{IsSet: true, Value: 1024}}
+			fakeActor.CloudControllerAPIVersionReturns("2.52.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '--port'",
+				CurrentVersion: "2.52.0",
+				MinimumVersion: ccversion.MinVersionTCPRouting,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the user declines the prompt", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not delete the route", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Really delete the route some-domain\\.com\\?"))
+			Expect(testUI.Out).To(Say("Delete cancelled"))
+			Expect(fakeActor.GetRouteByComponentsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the force flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+			cmd.Port = flag.Port{NullInt: types.NullInt{IsSet: true, Value: 1024}}
+		})
+
+		Context("when the route exists", func() {
+			BeforeEach(func() {
+				fakeActor.GetRouteByComponentsReturns(v2action.Route{GUID: "some-route-guid"}, v2action.Warnings{"get-route-warning"}, nil)
+				fakeActor.DeleteRouteReturns(v2action.Warnings{"delete-route-warning"}, nil)
+			})
+
+			It("deletes the route without prompting", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Really delete"))
+				Expect(testUI.Out).To(Say("Deleting route some-domain\\.com:1024\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("get-route-warning"))
+				Expect(testUI.Err).To(Say("delete-route-warning"))
+
+				Expect(fakeActor.GetRouteByComponentsCallCount()).To(Equal(1))
+				orgGUID, route := fakeActor.GetRouteByComponentsArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(route.Domain.Name).To(Equal("some-domain.com"))
+				Expect(route.Port).To(Equal(types.NullInt{IsSet: true, Value: 1024}))
+
+				Expect(fakeActor.DeleteRouteCallCount()).To(Equal(1))
+				Expect(fakeActor.DeleteRouteArgsForCall(0)).To(Equal("some-route-guid"))
+			})
+		})
+
+		Context("when the route does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetRouteByComponentsReturns(v2action.Route{}, nil, v2action.RouteNotFoundError{})
+			})
+
+			It("warns and returns OK", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Unable to delete, route 'some-domain\\.com:1024' does not exist\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(fakeActor.DeleteRouteCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when deleting the route fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("delete-route-error")
+				fakeActor.GetRouteByComponentsReturns(v2action.Route{GUID: "some-route-guid"}, nil, nil)
+				fakeActor.DeleteRouteReturns(v2action.Warnings{"delete-route-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("delete-route-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,83 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("disable-feature-flag Command", func() {
+	var (
+		cmd             DisableFeatureFlagCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeDisableFeatureFlagActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeDisableFeatureFlagActor)
+
+		cmd = DisableFeatureFlagCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Feature = "diego_docker"
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.DisableFeatureFlagCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the feature flag exists", func() {
+		BeforeEach(func() {
+			fakeActor.DisableFeatureFlagReturns(v2action.Warnings{"disable-warning"}, nil)
+		})
+
+		It("disables the feature flag", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.DisableFeatureFlagArgsForCall(0)).To(Equal("diego_docker"))
+			Expect(testUI.Out).To(Say("Disabling feature flag diego_docker as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("disable-warning"))
+		})
+	})
+
+	Context("when the feature flag does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.DisableFeatureFlagReturns(v2action.Warnings{"disable-warning"}, v2action.FeatureFlagNotFoundError{Name: "diego_docker"})
+		})
+
+		It("returns a FeatureFlagNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.FeatureFlagNotFoundError{Name: "diego_docker"}))
+			Expect(testUI.Err).To(Say("disable-warning"))
+		})
+	})
+})
@@ -1,24 +1,66 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . EnableFeatureFlagActor
+
+type EnableFeatureFlagActor interface {
+	EnableFeatureFlag(name string) (v2action.Warnings, error)
+}
+
 type EnableFeatureFlagCommand struct {
 	RequiredArgs    flag.Feature `positional-args:"yes"`
 	usage           interface{}  `usage:"CF_NAME enable-feature-flag FEATURE_NAME"`
 	relatedCommands interface{}  `related_commands:"disable-feature-flag, feature-flags"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       EnableFeatureFlagActor
 }
 
-func (EnableFeatureFlagCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *EnableFeatureFlagCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (EnableFeatureFlagCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd EnableFeatureFlagCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Enabling feature flag {{.FeatureFlag}} as {{.Username}}...", map[string]interface{}{
+		"FeatureFlag": cmd.RequiredArgs.Feature,
+		"Username":    user.Name,
+	})
+
+	warnings, err := cmd.Actor.EnableFeatureFlag(cmd.RequiredArgs.Feature)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
 	return nil
 }
@@ -0,0 +1,95 @@
+package v2
+
+import (
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/orgaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/orgconfig"
+)
+
+//go:generate counterfeiter . ExportOrgActor
+
+type ExportOrgActor interface {
+	GetOrgConfig(orgName string) (orgconfig.Org, orgaction.Warnings, error)
+	WriteOrgConfig(config orgconfig.Org, pathToConfig string) error
+}
+
+type ExportOrgCommand struct {
+	RequiredArgs    flag.Organization `positional-args:"yes"`
+	FilePath        flag.Path         `short:"p" description:"Specify a path for file creation. Files ending in .json are written as JSON, anything else as YAML. If path not specified, the file is created in current working directory."`
+	usage           interface{}       `usage:"CF_NAME export-org ORG [-p /path/to/<org-name>_org.yml]"`
+	relatedCommands interface{}       `related_commands:"apply-org, org, space"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ExportOrgActor
+}
+
+func (cmd *ExportOrgCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = orgaction.NewActor(
+		v2action.NewActor(ccClient, uaaClient, config),
+		v3action.NewActor(ccClientV3, config, nil, nil),
+	)
+	return nil
+}
+
+func (cmd ExportOrgCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Exporting org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  cmd.RequiredArgs.Organization,
+		"Username": user.Name,
+	})
+
+	config, warnings, err := cmd.Actor.GetOrgConfig(cmd.RequiredArgs.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	configPath := cmd.FilePath.String()
+	if configPath == "" {
+		configPath = fmt.Sprintf(".%s%s_org.yml", string(os.PathSeparator), cmd.RequiredArgs.Organization)
+	}
+	err = cmd.Actor.WriteOrgConfig(config, configPath)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("Org config created successfully at {{.FilePath}}", map[string]interface{}{
+		"FilePath": configPath,
+	})
+
+	return nil
+}
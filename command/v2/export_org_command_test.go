@@ -0,0 +1,136 @@
+package v2_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/orgaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/orgconfig"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-org Command", func() {
+	var (
+		cmd             ExportOrgCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeExportOrgActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeExportOrgActor)
+
+		cmd = ExportOrgCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Organization = "some-org"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the org does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrgConfigReturns(orgconfig.Org{}, orgaction.Warnings{"some-warning"}, v2action.OrganizationNotFoundError{Name: "some-org"})
+		})
+
+		It("returns an OrganizationNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "some-org"}))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(fakeActor.WriteOrgConfigCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the org config is retrieved", func() {
+		var config orgconfig.Org
+
+		BeforeEach(func() {
+			config = orgconfig.Org{Name: "some-org", Quota: "some-quota"}
+			fakeActor.GetOrgConfigReturns(config, orgaction.Warnings{"some-warning"}, nil)
+		})
+
+		Context("when no path is provided", func() {
+			It("writes the config to the current directory", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Exporting org some-org as some-user..."))
+				Expect(testUI.Err).To(Say("some-warning"))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Org config created successfully at %s", fmt.Sprintf(".%ssome-org_org.yml", string(os.PathSeparator))))
+
+				Expect(fakeActor.GetOrgConfigArgsForCall(0)).To(Equal("some-org"))
+				writtenConfig, path := fakeActor.WriteOrgConfigArgsForCall(0)
+				Expect(writtenConfig).To(Equal(config))
+				Expect(path).To(Equal(fmt.Sprintf(".%ssome-org_org.yml", string(os.PathSeparator))))
+			})
+		})
+
+		Context("when a path is provided", func() {
+			BeforeEach(func() {
+				cmd.FilePath = flag.Path("some-dir/org.json")
+			})
+
+			It("writes the config to the path", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Org config created successfully at some-dir/org.json"))
+
+				_, path := fakeActor.WriteOrgConfigArgsForCall(0)
+				Expect(path).To(Equal("some-dir/org.json"))
+			})
+		})
+
+		Context("when writing the config fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("disk full")
+				fakeActor.WriteOrgConfigReturns(expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
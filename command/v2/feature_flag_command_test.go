@@ -0,0 +1,72 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("feature-flag Command", func() {
+	var (
+		cmd             FeatureFlagCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeFeatureFlagActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeFeatureFlagActor)
+
+		cmd = FeatureFlagCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Feature = "diego_docker"
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the feature flag exists", func() {
+		BeforeEach(func() {
+			fakeActor.GetFeatureFlagReturns(v2action.FeatureFlag{Name: "diego_docker", Enabled: true}, v2action.Warnings{"feature-flag-warning"}, nil)
+		})
+
+		It("displays the feature flag and its state", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetFeatureFlagArgsForCall(0)).To(Equal("diego_docker"))
+			Expect(testUI.Out).To(Say("Getting info for feature flag diego_docker as some-user..."))
+			Expect(testUI.Out).To(Say(`features\s+state`))
+			Expect(testUI.Out).To(Say(`diego_docker\s+enabled`))
+			Expect(testUI.Err).To(Say("feature-flag-warning"))
+		})
+	})
+
+	Context("when the feature flag does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetFeatureFlagReturns(v2action.FeatureFlag{}, v2action.Warnings{"feature-flag-warning"}, v2action.FeatureFlagNotFoundError{Name: "diego_docker"})
+		})
+
+		It("returns a FeatureFlagNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.FeatureFlagNotFoundError{Name: "diego_docker"}))
+			Expect(testUI.Err).To(Say("feature-flag-warning"))
+		})
+	})
+})
@@ -1,22 +1,86 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . FeatureFlagsActor
+
+type FeatureFlagsActor interface {
+	GetFeatureFlags() ([]v2action.FeatureFlag, v2action.Warnings, error)
+}
+
 type FeatureFlagsCommand struct {
 	usage           interface{} `usage:"CF_NAME feature-flags"`
-	relatedCommands interface{} `related_commands:"disable-feature-flag, enable-feature-flag"`
+	relatedCommands interface{} `related_commands:"apply-platform-config, disable-feature-flag, enable-feature-flag, feature-flag"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       FeatureFlagsActor
 }
 
-func (FeatureFlagsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *FeatureFlagsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (FeatureFlagsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd FeatureFlagsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting feature flags as {{.Username}}...", map[string]interface{}{
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	featureFlags, warnings, err := cmd.Actor.GetFeatureFlags()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	displayFeatureFlagTable(cmd.UI, featureFlags)
 	return nil
 }
+
+// displayFeatureFlagTable displays the feature flags and whether each is
+// enabled.
+func displayFeatureFlagTable(ui command.UI, featureFlags []v2action.FeatureFlag) {
+	table := [][]string{
+		{
+			ui.TranslateText("features"),
+			ui.TranslateText("state"),
+		},
+	}
+	for _, featureFlag := range featureFlags {
+		table = append(table, []string{featureFlag.Name, featureFlagState(ui, featureFlag.Enabled)})
+	}
+	ui.DisplayTableWithHeader("", table, 3)
+}
+
+func featureFlagState(ui command.UI, enabled bool) string {
+	if enabled {
+		return ui.TranslateText("enabled")
+	}
+	return ui.TranslateText("disabled")
+}
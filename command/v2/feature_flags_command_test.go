@@ -0,0 +1,95 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("feature-flags Command", func() {
+	var (
+		cmd             FeatureFlagsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeFeatureFlagsActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeFeatureFlagsActor)
+
+		cmd = FeatureFlagsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when getting the feature flags succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.GetFeatureFlagsReturns(
+				[]v2action.FeatureFlag{
+					{Name: "user_org_creation", Enabled: false},
+					{Name: "diego_docker", Enabled: true},
+				},
+				v2action.Warnings{"feature-flags-warning"},
+				nil,
+			)
+		})
+
+		It("displays each feature flag and its state", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting feature flags as some-user..."))
+			Expect(testUI.Out).To(Say(`features\s+state`))
+			Expect(testUI.Out).To(Say(`user_org_creation\s+disabled`))
+			Expect(testUI.Out).To(Say(`diego_docker\s+enabled`))
+			Expect(testUI.Err).To(Say("feature-flags-warning"))
+		})
+	})
+
+	Context("when getting the feature flags fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetFeatureFlagsReturns(nil, v2action.Warnings{"feature-flags-warning"}, errors.New("get-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("get-error"))
+			Expect(testUI.Err).To(Say("feature-flags-warning"))
+		})
+	})
+})
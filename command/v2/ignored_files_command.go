@@ -0,0 +1,52 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . IgnoredFilesActor
+
+type IgnoredFilesActor interface {
+	GetIgnoreStatuses(path string) ([]sharedaction.FileIgnoreStatus, error)
+}
+
+type IgnoredFilesCommand struct {
+	OptionalArgs    flag.IgnoredFilesArgs `positional-args:"yes"`
+	usage           interface{}           `usage:"CF_NAME ignored-files [PATH]\n\n   Files are ignored by the default rules and by the .cfignore files of the app. A .cfignore applies to the files in its own directory and below."`
+	relatedCommands interface{}           `related_commands:"push"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  IgnoredFilesActor
+}
+
+func (cmd *IgnoredFilesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pushaction.NewActor(nil, sharedaction.NewActor(config, nil))
+	return nil
+}
+
+func (cmd IgnoredFilesCommand) Execute(args []string) error {
+	path := string(cmd.OptionalArgs.Path)
+	if path == "" {
+		path = "."
+	}
+
+	cmd.UI.DisplayText("Checking which files of {{.Path}} push would upload...", map[string]interface{}{
+		"Path": path,
+	})
+	cmd.UI.DisplayNewline()
+
+	statuses, err := cmd.Actor.GetIgnoreStatuses(path)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	shared.DisplayIgnoreStatuses(cmd.UI, statuses)
+	return nil
+}
@@ -0,0 +1,86 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ignored-files Command", func() {
+	var (
+		cmd        IgnoredFilesCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *v2fakes.FakeIgnoredFilesActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(v2fakes.FakeIgnoredFilesActor)
+
+		cmd = IgnoredFilesCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+
+		fakeActor.GetIgnoreStatusesReturns([]sharedaction.FileIgnoreStatus{
+			{Filename: ".cfignore", Ignored: true, Rule: sharedaction.IgnoreRule{Pattern: ".cfignore"}},
+			{Filename: "lib/keep.log", Rule: sharedaction.IgnoreRule{Source: "lib/.cfignore", Line: 1, Pattern: "!keep.log"}},
+			{Filename: "server.rb"},
+		}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when no path is given", func() {
+		It("lists the files of the current directory with their rules", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Checking which files of \. push would upload\.\.\.`))
+			Expect(testUI.Out).To(Say(`file\s+status\s+rule`))
+			Expect(testUI.Out).To(Say(`\.cfignore\s+ignored\s+\(default\) \.cfignore`))
+			Expect(testUI.Out).To(Say(`lib/keep\.log\s+uploaded\s+lib/\.cfignore:1: !keep\.log`))
+			Expect(testUI.Out).To(Say(`server\.rb\s+uploaded`))
+
+			Expect(fakeActor.GetIgnoreStatusesCallCount()).To(Equal(1))
+			Expect(fakeActor.GetIgnoreStatusesArgsForCall(0)).To(Equal("."))
+		})
+	})
+
+	Context("when a path is given", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.Path = flag.PathWithExistenceCheck("some-app.zip")
+		})
+
+		It("lists the files of that path", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetIgnoreStatusesArgsForCall(0)).To(Equal("some-app.zip"))
+		})
+	})
+
+	Context("when gathering the files fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeActor.GetIgnoreStatusesReturns(nil, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+})
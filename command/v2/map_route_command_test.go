@@ -0,0 +1,200 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("map-route Command", func() {
+	var (
+		cmd             MapRouteCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeMapRouteActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeMapRouteActor)
+
+		cmd = MapRouteCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.App = "some-app"
+		cmd.RequiredArgs.Domain = "some-domain.com"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.ExperimentalReturns(true)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionTCPRouting)
+		fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-app-guid", Name: "some-app"}, v2action.Warnings{"get-app-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the port and random port flags are both provided", func() {
+		BeforeEach(func() {
+			cmd.Port = flag.Port{NullInt: types.NullInt{IsSet: true, Value: 1024}}
+			cmd.RandomPort = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--port", "--random-port"}}))
+		})
+	})
+
+	Context("when the random port flag is provided and the API version is too low", func() {
+		BeforeEach(func() {
+			cmd.RandomPort = true
+			fakeActor.CloudControllerAPIVersionReturns("2.52.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '--random-port'",
+				CurrentVersion: "2.52.0",
+				MinimumVersion: ccversion.MinVersionTCPRouting,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, v2action.Warnings{"get-app-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+		})
+	})
+
+	Context("when the route is created", func() {
+		BeforeEach(func() {
+			cmd.RandomPort = true
+			fakeActor.CreateRouteWithExistenceCheckReturns(v2action.Route{
+				GUID:   "some-route-guid",
+				Domain: v2action.Domain{Name: "some-domain.com"},
+				Port:   types.NullInt{IsSet: true, Value: 1030},
+			}, v2action.Warnings{"create-route-warning"}, nil)
+			fakeActor.MapRouteToApplicationReturns(v2action.Warnings{"map-route-warning"}, nil)
+		})
+
+		It("maps the created route to the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("create-route-warning"))
+			Expect(testUI.Err).To(Say("map-route-warning"))
+			Expect(testUI.Out).To(Say("Adding route some-domain\\.com:1030 to app some-app in org some-org / space some-space as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.CreateRouteWithExistenceCheckCallCount()).To(Equal(1))
+			orgGUID, spaceName, route, generatePort := fakeActor.CreateRouteWithExistenceCheckArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(route.Domain.Name).To(Equal("some-domain.com"))
+			Expect(generatePort).To(BeTrue())
+
+			Expect(fakeActor.MapRouteToApplicationCallCount()).To(Equal(1))
+			routeGUID, appGUID := fakeActor.MapRouteToApplicationArgsForCall(0)
+			Expect(routeGUID).To(Equal("some-route-guid"))
+			Expect(appGUID).To(Equal("some-app-guid"))
+		})
+	})
+
+	Context("when the route already exists", func() {
+		BeforeEach(func() {
+			cmd.Port = flag.Port{NullInt: types.NullInt{IsSet: true, Value: 1024}}
+			fakeActor.CreateRouteWithExistenceCheckReturns(v2action.Route{}, nil, v2action.RouteAlreadyExistsError{
+				Route: v2action.Route{
+					GUID:   "existing-route-guid",
+					Domain: v2action.Domain{Name: "some-domain.com"},
+					Port:   types.NullInt{IsSet: true, Value: 1024},
+				},
+			})
+		})
+
+		It("maps the existing route to the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Adding route some-domain\\.com:1024 to app some-app"))
+
+			routeGUID, _ := fakeActor.MapRouteToApplicationArgsForCall(0)
+			Expect(routeGUID).To(Equal("existing-route-guid"))
+		})
+	})
+
+	Context("when the port is not reservable", func() {
+		BeforeEach(func() {
+			cmd.Port = flag.Port{NullInt: types.NullInt{IsSet: true, Value: 80}}
+			fakeActor.CreateRouteWithExistenceCheckReturns(v2action.Route{}, v2action.Warnings{"create-route-warning"}, actionerror.PortNotReservableError{
+				Port:            80,
+				RouterGroup:     "default-tcp",
+				ReservablePorts: "1024-1033",
+			})
+		})
+
+		It("returns a PortNotReservableError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.PortNotReservableError{
+				Port:            80,
+				RouterGroup:     "default-tcp",
+				ReservablePorts: "1024-1033",
+			}))
+			Expect(testUI.Err).To(Say("create-route-warning"))
+			Expect(fakeActor.MapRouteToApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when mapping the route fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("map-route-error")
+			fakeActor.CreateRouteWithExistenceCheckReturns(v2action.Route{GUID: "some-route-guid"}, nil, nil)
+			fakeActor.MapRouteToApplicationReturns(v2action.Warnings{"map-route-warning"}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("map-route-warning"))
+		})
+	})
+})
@@ -0,0 +1,235 @@
+package v2
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+//go:generate counterfeiter . QuotaUsageActor
+
+type QuotaUsageActor interface {
+	GetOrganizationQuotaUsage(orgName string) (v2action.OrganizationQuotaUsage, v2action.Warnings, error)
+}
+
+type QuotaUsageCommand struct {
+	RequiredArgs    flag.OptionalOrganization `positional-args:"yes"`
+	Threshold       int                       `long:"threshold" default:"80" description:"Highlight usage at or above this percentage of a limit"`
+	usage           interface{}               `usage:"CF_NAME quota-usage [ORG] [--threshold PERCENT]\n\n   Without ORG, the targeted org is used. Spaces without a space quota are compared with the org quota."`
+	relatedCommands interface{}               `related_commands:"org, quota, space-quota"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       QuotaUsageActor
+}
+
+// quotaLimit is one quota-limited resource, what is used of it and its limit.
+type quotaLimit struct {
+	resource string
+	used     int
+	limit    int
+	memory   bool
+}
+
+func (cmd *QuotaUsageCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd QuotaUsageCommand) Execute(args []string) error {
+	if cmd.Threshold < 1 || cmd.Threshold > 100 {
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--threshold",
+			ExpectedType: "an integer between 1 and 100",
+		}
+	}
+
+	orgName := cmd.RequiredArgs.Organization
+	err := cmd.SharedActor.CheckTarget(orgName == "", false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	if orgName == "" {
+		orgName = cmd.Config.TargetedOrganization().Name
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting quota usage for org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  orgName,
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	usage, warnings, err := cmd.Actor.GetOrganizationQuotaUsage(orgName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	orgLimits := orgQuotaLimits(usage.Usage, usage.Quota)
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("org quota:"), usage.Quota.Name},
+	}, 3)
+	cmd.UI.DisplayNewline()
+
+	orgTable := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("used"),
+			cmd.UI.TranslateText("limit"),
+			cmd.UI.TranslateText("usage"),
+		},
+	}
+	for _, limit := range orgLimits {
+		orgTable = append(orgTable, []string{
+			cmd.UI.TranslateText(limit.resource),
+			cmd.formatUsed(limit),
+			cmd.formatLimit(limit),
+			cmd.formatPercent(limit),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", orgTable, 3)
+
+	if len(usage.Spaces) > 0 {
+		cmd.UI.DisplayNewline()
+
+		spaceTable := [][]string{
+			{
+				cmd.UI.TranslateText("space"),
+				cmd.UI.TranslateText("space quota"),
+				cmd.UI.TranslateText("memory"),
+				cmd.UI.TranslateText("instance memory"),
+				cmd.UI.TranslateText("app instances"),
+				cmd.UI.TranslateText("routes"),
+				cmd.UI.TranslateText("service instances"),
+				cmd.UI.TranslateText("reserved route ports"),
+			},
+		}
+		for _, space := range usage.Spaces {
+			row := []string{space.SpaceName, space.Quota.Name}
+			for _, limit := range spaceLimits(space, usage.Quota) {
+				row = append(row, cmd.UI.TranslateText("{{.Used}} of {{.Limit}}", map[string]interface{}{
+					"Used":  cmd.formatUsed(limit),
+					"Limit": cmd.formatLimit(limit),
+				}))
+			}
+			spaceTable = append(spaceTable, row)
+		}
+		cmd.UI.DisplayTableWithHeader("", spaceTable, 3)
+	}
+
+	cmd.warnAboveThreshold(cmd.UI.TranslateText("Org {{.Name}}", map[string]interface{}{"Name": orgName}), orgLimits)
+	for _, space := range usage.Spaces {
+		name := cmd.UI.TranslateText("Space {{.Name}}", map[string]interface{}{"Name": space.SpaceName})
+		cmd.warnAboveThreshold(name, spaceLimits(space, usage.Quota))
+	}
+
+	return nil
+}
+
+func (cmd QuotaUsageCommand) warnAboveThreshold(name string, limits []quotaLimit) {
+	for _, limit := range limits {
+		if cmd.aboveThreshold(limit) {
+			cmd.UI.DisplayWarning("{{.Name}} is using {{.Used}} of its {{.Limit}} {{.Resource}} limit.", map[string]interface{}{
+				"Name":     name,
+				"Used":     cmd.formatUsed(limit),
+				"Limit":    cmd.formatLimit(limit),
+				"Resource": cmd.UI.TranslateText(limit.resource),
+			})
+		}
+	}
+}
+
+func (cmd QuotaUsageCommand) aboveThreshold(limit quotaLimit) bool {
+	switch {
+	case limit.limit < 0:
+		return false
+	case limit.limit == 0:
+		return limit.used > 0
+	default:
+		return limit.used*100 >= cmd.Threshold*limit.limit
+	}
+}
+
+func (QuotaUsageCommand) formatUsed(limit quotaLimit) string {
+	if limit.memory {
+		return formatMegabytes(limit.used)
+	}
+	return fmt.Sprint(limit.used)
+}
+
+func (cmd QuotaUsageCommand) formatLimit(limit quotaLimit) string {
+	if limit.limit < 0 {
+		return cmd.UI.TranslateText("unlimited")
+	}
+	if limit.memory {
+		return formatMegabytes(limit.limit)
+	}
+	return fmt.Sprint(limit.limit)
+}
+
+func (QuotaUsageCommand) formatPercent(limit quotaLimit) string {
+	if limit.limit <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d%%", limit.used*100/limit.limit)
+}
+
+func formatMegabytes(megabytes int) string {
+	if megabytes == 0 {
+		return "0"
+	}
+	return bytefmt.ByteSize(uint64(megabytes) * bytefmt.MEGABYTE)
+}
+
+func orgQuotaLimits(usage v2action.QuotaUsage, quota v2action.OrganizationQuota) []quotaLimit {
+	return []quotaLimit{
+		{resource: "memory", used: usage.MemoryInMB, limit: quota.MemoryLimitInMB, memory: true},
+		{resource: "instance memory", used: usage.LargestInstanceMemoryInMB, limit: quota.InstanceMemoryLimitInMB, memory: true},
+		{resource: "app instances", used: usage.AppInstances, limit: quota.AppInstanceLimit},
+		{resource: "routes", used: usage.Routes, limit: quota.TotalRoutes},
+		{resource: "service instances", used: usage.ServiceInstances, limit: quota.TotalServices},
+		{resource: "reserved route ports", used: usage.ReservedRoutePorts, limit: quota.TotalReservedRoutePorts},
+	}
+}
+
+// spaceLimits returns the space's usage against its space quota, or against
+// the org quota when the space does not have one.
+func spaceLimits(space v2action.SpaceQuotaUsage, orgQuota v2action.OrganizationQuota) []quotaLimit {
+	if space.Quota.GUID != "" {
+		return spaceQuotaLimits(space.Usage, space.Quota)
+	}
+	return orgQuotaLimits(space.Usage, orgQuota)
+}
+
+func spaceQuotaLimits(usage v2action.QuotaUsage, quota v2action.SpaceQuota) []quotaLimit {
+	return []quotaLimit{
+		{resource: "memory", used: usage.MemoryInMB, limit: quota.MemoryLimitInMB, memory: true},
+		{resource: "instance memory", used: usage.LargestInstanceMemoryInMB, limit: quota.InstanceMemoryLimitInMB, memory: true},
+		{resource: "app instances", used: usage.AppInstances, limit: quota.AppInstanceLimit},
+		{resource: "routes", used: usage.Routes, limit: quota.TotalRoutes},
+		{resource: "service instances", used: usage.ServiceInstances, limit: quota.TotalServices},
+		{resource: "reserved route ports", used: usage.ReservedRoutePorts, limit: quota.TotalReservedRoutePorts},
+	}
+}
@@ -0,0 +1,207 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("quota-usage Command", func() {
+	var (
+		cmd             QuotaUsageCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeQuotaUsageActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeQuotaUsageActor)
+
+		cmd = QuotaUsageCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Threshold:   80,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "targeted-org"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the threshold is out of range", func() {
+		BeforeEach(func() {
+			cmd.Threshold = 101
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "--threshold",
+				ExpectedType: "an integer between 1 and 100",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when an org is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Organization = "some-org"
+		})
+
+		It("does not require a targeted org", func() {
+			checkTargetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(fakeActor.GetOrganizationQuotaUsageArgsForCall(0)).To(Equal("some-org"))
+		})
+
+		Context("when getting the usage fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationQuotaUsageReturns(v2action.OrganizationQuotaUsage{}, v2action.Warnings{"some-warning"}, v2action.OrganizationNotFoundError{Name: "some-org"})
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+	})
+
+	Context("when no org is provided", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationQuotaUsageReturns(
+				v2action.OrganizationQuotaUsage{
+					OrgName: "targeted-org",
+					Quota: v2action.OrganizationQuota{
+						Name:                    "default",
+						MemoryLimitInMB:         10240,
+						InstanceMemoryLimitInMB: -1,
+						AppInstanceLimit:        -1,
+						TotalRoutes:             1000,
+						TotalServices:           100,
+						TotalReservedRoutePorts: 0,
+					},
+					Usage: v2action.QuotaUsage{
+						MemoryInMB:                2560,
+						LargestInstanceMemoryInMB: 1024,
+						AppInstances:              4,
+						Routes:                    3,
+						ServiceInstances:          2,
+					},
+					Spaces: []v2action.SpaceQuotaUsage{
+						{
+							SpaceName: "dev",
+							Quota: v2action.SpaceQuota{
+								GUID:                    "small-guid",
+								Name:                    "small",
+								MemoryLimitInMB:         2048,
+								InstanceMemoryLimitInMB: -1,
+								AppInstanceLimit:        -1,
+								TotalRoutes:             10,
+								TotalServices:           -1,
+								TotalReservedRoutePorts: -1,
+							},
+							Usage: v2action.QuotaUsage{
+								MemoryInMB:                2048,
+								LargestInstanceMemoryInMB: 1024,
+								AppInstances:              2,
+								Routes:                    1,
+							},
+						},
+						{
+							SpaceName: "prod",
+							Usage: v2action.QuotaUsage{
+								MemoryInMB:                512,
+								LargestInstanceMemoryInMB: 256,
+								AppInstances:              2,
+								Routes:                    2,
+								ServiceInstances:          90,
+							},
+						},
+					},
+				},
+				v2action.Warnings{"some-warning"},
+				nil,
+			)
+		})
+
+		It("displays the usage of the targeted org and its spaces", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetOrganizationQuotaUsageArgsForCall(0)).To(Equal("targeted-org"))
+
+			Expect(testUI.Out).To(Say("Getting quota usage for org targeted-org as some-user..."))
+			Expect(testUI.Err).To(Say("some-warning"))
+
+			Expect(testUI.Out).To(Say(`org quota:\s+default`))
+			Expect(testUI.Out).To(Say(`used\s+limit\s+usage`))
+			Expect(testUI.Out).To(Say(`memory\s+2.5G\s+10G\s+%s`, "25%"))
+			Expect(testUI.Out).To(Say(`instance memory\s+1G\s+unlimited`))
+			Expect(testUI.Out).To(Say(`app instances\s+4\s+unlimited`))
+			Expect(testUI.Out).To(Say(`routes\s+3\s+1000\s+%s`, "0%"))
+			Expect(testUI.Out).To(Say(`service instances\s+2\s+100\s+%s`, "2%"))
+			Expect(testUI.Out).To(Say(`reserved route ports\s+0\s+0`))
+
+			Expect(testUI.Out).To(Say(`space\s+space quota\s+memory\s+instance memory\s+app instances\s+routes\s+service instances\s+reserved route ports`))
+			Expect(testUI.Out).To(Say(`dev\s+small\s+2G of 2G\s+1G of unlimited\s+2 of unlimited\s+1 of 10\s+0 of unlimited\s+0 of unlimited`))
+			Expect(testUI.Out).To(Say(`prod\s+512M of 10G\s+256M of unlimited\s+2 of unlimited\s+2 of 1000\s+90 of 100\s+0 of 0`))
+		})
+
+		It("warns about the space quota limits at or above the threshold", func() {
+			Expect(testUI.Err).ToNot(Say("Org targeted-org"))
+			Expect(testUI.Err).To(Say("Space dev is using 2G of its 2G memory limit."))
+		})
+
+		It("warns about spaces without a space quota that are at or above the threshold of the org quota limits", func() {
+			Expect(testUI.Err).To(Say("Space prod is using 90 of its 100 service instances limit."))
+			Expect(testUI.Err).ToNot(Say("Space prod"))
+		})
+	})
+
+	Context("when getting the usage returns a generic error", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeActor.GetOrganizationQuotaUsageReturns(v2action.OrganizationQuotaUsage{}, nil, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+})
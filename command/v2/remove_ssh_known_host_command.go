@@ -0,0 +1,61 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+//go:generate counterfeiter . RemoveSSHKnownHostActor
+
+type RemoveSSHKnownHostActor interface {
+	RemoveSSHKnownHost(apiEndpoint string) error
+}
+
+type RemoveSSHKnownHostCommand struct {
+	OptionalArgs    flag.APITarget `positional-args:"yes"`
+	usage           interface{}    `usage:"CF_NAME remove-ssh-known-host [URL]\n\nTIP:\n   Use this command when the SSH host key of an API endpoint has been rotated. The key presented on the next connection is trusted. Without URL, the key of the targeted API endpoint is removed."`
+	relatedCommands interface{}    `related_commands:"ssh, ssh-known-hosts"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  RemoveSSHKnownHostActor
+}
+
+func (cmd *RemoveSSHKnownHostCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = sharedaction.NewActor(config, nil)
+
+	return nil
+}
+
+func (cmd RemoveSSHKnownHostCommand) Execute(args []string) error {
+	apiEndpoint := cmd.OptionalArgs.URL
+	if apiEndpoint == "" {
+		apiEndpoint = cmd.Config.Target()
+	}
+	if apiEndpoint == "" {
+		return translatableerror.NoAPISetError{BinaryName: cmd.Config.BinaryName()}
+	}
+
+	cmd.UI.DisplayText("Removing trusted SSH host key for {{.APIEndpoint}}...", map[string]interface{}{
+		"APIEndpoint": apiEndpoint,
+	})
+
+	err := cmd.Actor.RemoveSSHKnownHost(apiEndpoint)
+	switch err.(type) {
+	case nil:
+	case sharedaction.SSHKnownHostNotFoundError:
+		cmd.UI.DisplayText("No SSH host key is trusted for {{.APIEndpoint}}.", map[string]interface{}{
+			"APIEndpoint": apiEndpoint,
+		})
+	default:
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
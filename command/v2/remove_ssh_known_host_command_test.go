@@ -0,0 +1,99 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("remove-ssh-known-host Command", func() {
+	var (
+		cmd        RemoveSSHKnownHostCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *v2fakes.FakeRemoveSSHKnownHostActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(v2fakes.FakeRemoveSSHKnownHostActor)
+
+		cmd = RemoveSSHKnownHostCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetReturns("https://api.example.com")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when no URL is provided", func() {
+		It("removes the host key of the targeted API", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Removing trusted SSH host key for https://api\.example\.com\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.RemoveSSHKnownHostCallCount()).To(Equal(1))
+			Expect(fakeActor.RemoveSSHKnownHostArgsForCall(0)).To(Equal("https://api.example.com"))
+		})
+
+		Context("when no API is targeted", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetReturns("")
+			})
+
+			It("returns a NoAPISetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NoAPISetError{BinaryName: "faceman"}))
+				Expect(fakeActor.RemoveSSHKnownHostCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when a URL is provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.URL = "https://api.other.example.com"
+		})
+
+		It("removes the host key of that API", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.RemoveSSHKnownHostArgsForCall(0)).To(Equal("https://api.other.example.com"))
+		})
+	})
+
+	Context("when no host key is trusted for the API", func() {
+		BeforeEach(func() {
+			fakeActor.RemoveSSHKnownHostReturns(sharedaction.SSHKnownHostNotFoundError{APIEndpoint: "https://api.example.com"})
+		})
+
+		It("says so and succeeds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No SSH host key is trusted for https://api\.example\.com\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	Context("when removing the host key fails", func() {
+		BeforeEach(func() {
+			fakeActor.RemoveSSHKnownHostReturns(errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+})
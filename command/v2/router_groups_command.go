@@ -3,20 +3,96 @@ package v2
 import (
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
+//go:generate counterfeiter . RouterGroupsActor
+
+type RouterGroupsActor interface {
+	GetRouterGroups() ([]v2action.RouterGroup, v2action.Warnings, error)
+}
+
 type RouterGroupsCommand struct {
 	usage           interface{} `usage:"CF_NAME router-groups"`
-	relatedCommands interface{} `related_commands:"create-domain, domains"`
+	relatedCommands interface{} `related_commands:"create-domain, domains, tcp-routes"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RouterGroupsActor
 }
 
-func (RouterGroupsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RouterGroupsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (RouterGroupsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd RouterGroupsCommand) Execute(args []string) error {
+	if !cmd.Config.Experimental() {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting router groups as {{.Username}}...", map[string]interface{}{
+		"Username": user.Name,
+	})
+
+	routerGroups, warnings, err := cmd.Actor.GetRouterGroups()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if len(routerGroups) == 0 {
+		cmd.UI.DisplayText("No router groups found")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("type"),
+			cmd.UI.TranslateText("reservable ports"),
+		},
+	}
+	for _, routerGroup := range routerGroups {
+		table = append(table, []string{
+			routerGroup.Name,
+			string(routerGroup.Type),
+			routerGroup.ReservablePorts,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
 	return nil
 }
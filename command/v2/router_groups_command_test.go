@@ -0,0 +1,125 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("router-groups Command", func() {
+	var (
+		cmd             RouterGroupsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRouterGroupsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRouterGroupsActor)
+
+		cmd = RouterGroupsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.ExperimentalReturns(true)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("getting current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when the routing API is not enabled", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouterGroupsReturns(nil, v2action.Warnings{"some-warning"}, actionerror.RoutingEndpointNotSetError{})
+		})
+
+		It("returns a RoutingAPINotEnabledError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RoutingAPINotEnabledError{}))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	Context("when there are no router groups", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouterGroupsReturns(nil, nil, nil)
+		})
+
+		It("displays a message", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting router groups as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("No router groups found"))
+		})
+	})
+
+	Context("when there are router groups", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouterGroupsReturns([]v2action.RouterGroup{
+				{Name: "default-tcp", Type: constant.TCPRouterGroup, ReservablePorts: "1024-1033"},
+				{Name: "default-http", Type: constant.HTTPRouterGroup},
+			}, v2action.Warnings{"some-warning"}, nil)
+		})
+
+		It("displays the router groups and their reservable ports", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(testUI.Out).To(Say("Getting router groups as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("name\\s+type\\s+reservable ports"))
+			Expect(testUI.Out).To(Say("default-tcp\\s+tcp\\s+1024-1033"))
+			Expect(testUI.Out).To(Say("default-http\\s+http"))
+		})
+	})
+})
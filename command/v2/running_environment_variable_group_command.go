@@ -1,22 +1,99 @@
 package v2
 
 import (
-	"os"
+	"encoding/json"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . EnvironmentVariableGroupActor
+
+type EnvironmentVariableGroupActor interface {
+	GetEnvironmentVariables(groupName constant.EnvironmentVariableGroupName) ([]v2action.EnvironmentVariable, v2action.Warnings, error)
+}
+
 type RunningEnvironmentVariableGroupCommand struct {
 	usage           interface{} `usage:"CF_NAME running-environment-variable-group"`
 	relatedCommands interface{} `related_commands:"env, staging-environment-variable-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       EnvironmentVariableGroupActor
 }
 
-func (RunningEnvironmentVariableGroupCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RunningEnvironmentVariableGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (RunningEnvironmentVariableGroupCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd RunningEnvironmentVariableGroupCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting the running environment variable group as {{.Username}}...", map[string]interface{}{
+		"Username": user.Name,
+	})
+
+	variables, warnings, err := cmd.Actor.GetEnvironmentVariables(constant.RunningEnvironmentVariableGroup)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if len(variables) == 0 {
+		cmd.UI.DisplayText("No running environment variable group has been set.")
+		return nil
+	}
+
+	displayEnvironmentVariableGroup(cmd.UI, variables)
 	return nil
 }
+
+// displayEnvironmentVariableGroup displays the variables in the order they
+// are provided.
+func displayEnvironmentVariableGroup(ui command.UI, variables []v2action.EnvironmentVariable) {
+	table := [][]string{
+		{
+			ui.TranslateText("variable name"),
+			ui.TranslateText("assigned value"),
+		},
+	}
+	for _, variable := range variables {
+		table = append(table, []string{variable.Name, environmentVariableValue(variable.Value)})
+	}
+	ui.DisplayTableWithHeader("", table, 3)
+}
+
+// environmentVariableValue returns the variable's JSON value as it is
+// displayed: strings without their quotes and other values as JSON.
+func environmentVariableValue(value json.RawMessage) string {
+	var stringValue string
+	if err := json.Unmarshal(value, &stringValue); err == nil {
+		return stringValue
+	}
+	return string(value)
+}
@@ -1,24 +1,100 @@
 package v2
 
 import (
-	"os"
+	"encoding/json"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . SetEnvironmentVariableGroupActor
+
+type SetEnvironmentVariableGroupActor interface {
+	MergeEnvironmentVariableGroup(groupName constant.EnvironmentVariableGroupName, group v2action.EnvironmentVariableGroup) (v2action.Warnings, error)
+	SetEnvironmentVariableGroup(groupName constant.EnvironmentVariableGroupName, group v2action.EnvironmentVariableGroup) (v2action.Warnings, error)
+}
+
 type SetRunningEnvironmentVariableGroupCommand struct {
 	RequiredArgs    flag.ParamsAsJSON `positional-args:"yes"`
-	usage           interface{}       `usage:"CF_NAME set-running-environment-variable-group '{\"name\":\"value\",\"name\":\"value\"}'"`
-	relatedCommands interface{}       `related_commands:"set-env, running-environment-variable-group"`
+	Merge           bool              `long:"merge" description:"Set only the given variables and keep the others in the group"`
+	usage           interface{}       `usage:"CF_NAME set-running-environment-variable-group '{\"name\":\"value\",\"name\":\"value\"}' [--merge]\n\n   Without --merge, the group is replaced by the given variables."`
+	relatedCommands interface{}       `related_commands:"apply-platform-config, set-env, running-environment-variable-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SetEnvironmentVariableGroupActor
 }
 
-func (SetRunningEnvironmentVariableGroupCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *SetRunningEnvironmentVariableGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (SetRunningEnvironmentVariableGroupCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd SetRunningEnvironmentVariableGroupCommand) Execute(args []string) error {
+	group, err := parseEnvironmentVariableGroup(cmd.RequiredArgs.JSON)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	var warnings v2action.Warnings
+	if cmd.Merge {
+		cmd.UI.DisplayTextWithFlavor("Merging variables into the running environment variable group as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		warnings, err = cmd.Actor.MergeEnvironmentVariableGroup(constant.RunningEnvironmentVariableGroup, group)
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Setting the contents of the running environment variable group as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		warnings, err = cmd.Actor.SetEnvironmentVariableGroup(constant.RunningEnvironmentVariableGroup, group)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
 	return nil
 }
+
+// parseEnvironmentVariableGroup parses the JSON object of variable names and
+// values given to the set-*-environment-variable-group commands.
+func parseEnvironmentVariableGroup(rawJSON string) (v2action.EnvironmentVariableGroup, error) {
+	var group ccv2.EnvironmentVariableGroup
+	err := json.Unmarshal([]byte(rawJSON), &group)
+	// JSON null decodes without error to a nil group, which would remove every
+	// variable in the group.
+	if err != nil || group == nil {
+		return nil, translatableerror.ParseArgumentError{
+			ArgumentName: "JSON",
+			ExpectedType: `a JSON object of names and values, such as '{"name":"value"}'`,
+		}
+	}
+	return v2action.EnvironmentVariableGroup(group), nil
+}
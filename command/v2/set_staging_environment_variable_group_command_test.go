@@ -0,0 +1,147 @@
+package v2_test
+
+import (
+	"encoding/json"
+
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-staging-environment-variable-group Command", func() {
+	var (
+		cmd             SetStagingEnvironmentVariableGroupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSetEnvironmentVariableGroupActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSetEnvironmentVariableGroupActor)
+
+		cmd = SetStagingEnvironmentVariableGroupCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.JSON = `{"LANG":"en_US.UTF-8","MAX_THREADS":16}`
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the JSON is invalid", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.JSON = `{"LANG":`
+		})
+
+		It("returns a ParseArgumentError without checking the target", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "JSON",
+				ExpectedType: `a JSON object of names and values, such as '{"name":"value"}'`,
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the JSON is null", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.JSON = "null"
+		})
+
+		It("returns a ParseArgumentError without changing the group", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "JSON",
+				ExpectedType: `a JSON object of names and values, such as '{"name":"value"}'`,
+			}))
+			Expect(fakeActor.SetEnvironmentVariableGroupCallCount()).To(Equal(0))
+			Expect(fakeActor.MergeEnvironmentVariableGroupCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the JSON is an array", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.JSON = `["LANG"]`
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "JSON",
+				ExpectedType: `a JSON object of names and values, such as '{"name":"value"}'`,
+			}))
+		})
+	})
+
+	Context("when --merge is not given", func() {
+		BeforeEach(func() {
+			fakeActor.SetEnvironmentVariableGroupReturns(v2action.Warnings{"set-warning"}, nil)
+		})
+
+		It("replaces the group", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Setting the contents of the staging environment variable group as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("set-warning"))
+
+			groupName, group := fakeActor.SetEnvironmentVariableGroupArgsForCall(0)
+			Expect(groupName).To(Equal(constant.StagingEnvironmentVariableGroup))
+			Expect(group).To(Equal(v2action.EnvironmentVariableGroup{
+				"LANG":        json.RawMessage(`"en_US.UTF-8"`),
+				"MAX_THREADS": json.RawMessage("16"),
+			}))
+			Expect(fakeActor.MergeEnvironmentVariableGroupCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --merge is given", func() {
+		BeforeEach(func() {
+			cmd.Merge = true
+			fakeActor.MergeEnvironmentVariableGroupReturns(v2action.Warnings{"merge-warning"}, nil)
+		})
+
+		It("merges the variables into the group", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Merging variables into the staging environment variable group as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("merge-warning"))
+
+			groupName, group := fakeActor.MergeEnvironmentVariableGroupArgsForCall(0)
+			Expect(groupName).To(Equal(constant.StagingEnvironmentVariableGroup))
+			Expect(group).To(Equal(v2action.EnvironmentVariableGroup{
+				"LANG":        json.RawMessage(`"en_US.UTF-8"`),
+				"MAX_THREADS": json.RawMessage("16"),
+			}))
+			Expect(fakeActor.SetEnvironmentVariableGroupCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when setting the group fails", func() {
+		BeforeEach(func() {
+			fakeActor.SetEnvironmentVariableGroupReturns(v2action.Warnings{"set-warning"}, errors.New("set-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("set-error"))
+			Expect(testUI.Err).To(Say("set-warning"))
+		})
+	})
+})
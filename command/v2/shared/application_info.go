@@ -10,6 +10,10 @@ import (
 	"github.com/cloudfoundry/bytefmt"
 )
 
+// NearLimitThreshold is the fraction of an instance's memory or disk quota at
+// or above which the instance is highlighted as near its limit.
+const NearLimitThreshold = 0.9
+
 // DisplayAppSummary displays the application summary to the UI, and optionally
 // the command to start the app.
 func DisplayAppSummary(ui command.UI, appSummary v2action.ApplicationSummary, displayStartCommand bool) {
@@ -60,11 +64,13 @@ func DisplayAppSummary(ui command.UI, appSummary v2action.ApplicationSummary, di
 	if len(appSummary.RunningInstances) == 0 {
 		ui.DisplayText("There are no running instances of this app.")
 	} else {
-		displayAppInstances(ui, appSummary.RunningInstances)
+		DisplayAppInstances(ui, appSummary.RunningInstances)
 	}
 }
 
-func displayAppInstances(ui command.UI, instances []v2action.ApplicationInstanceWithStats) {
+// DisplayAppInstances displays the state and resource usage of each app
+// instance in a table, highlighting memory and disk usage near its limit.
+func DisplayAppInstances(ui command.UI, instances []v2action.ApplicationInstanceWithStats) {
 	table := [][]string{
 		{
 			"",
@@ -77,7 +83,16 @@ func displayAppInstances(ui command.UI, instances []v2action.ApplicationInstance
 		},
 	}
 
+	nearLimit := map[int][]int{}
 	for _, instance := range instances {
+		row := len(table)
+		if IsNearLimit(instance.Memory, instance.MemoryQuota) {
+			nearLimit[row] = append(nearLimit[row], 4)
+		}
+		if IsNearLimit(instance.Disk, instance.DiskQuota) {
+			nearLimit[row] = append(nearLimit[row], 5)
+		}
+
 		table = append(
 			table,
 			[]string{
@@ -91,7 +106,13 @@ func displayAppInstances(ui command.UI, instances []v2action.ApplicationInstance
 			})
 	}
 
-	ui.DisplayInstancesTableForApp(table)
+	ui.DisplayInstancesTableForAppWithLimits(table, nearLimit)
+}
+
+// IsNearLimit returns true when usage is at or above NearLimitThreshold of a
+// set quota.
+func IsNearLimit(usage int, quota int) bool {
+	return quota > 0 && float64(usage)/float64(quota) >= NearLimitThreshold
 }
 
 // zuluDate converts the time to UTC and then formats it to ISO8601.
@@ -40,14 +40,28 @@ func HandleError(err error) error {
 
 	case actionerror.ApplicationNotFoundError:
 		return translatableerror.ApplicationNotFoundError{Name: e.Name}
+	case v2action.BuildpackNotFoundError:
+		return translatableerror.BuildpackNotFoundError(e)
+	case v2action.FeatureFlagNotFoundError:
+		return translatableerror.FeatureFlagNotFoundError(e)
+	case v2action.MultipleBuildpacksFoundError:
+		return translatableerror.MultipleBuildpacksFoundError(e)
 	case v2action.OrganizationNotFoundError:
 		return translatableerror.OrganizationNotFoundError{Name: e.Name}
+	case v2action.OrganizationQuotaNotFoundError:
+		if e.Name != "" {
+			return translatableerror.OrganizationQuotaNotFoundError{Name: e.Name}
+		}
 	case v2action.SecurityGroupNotFoundError:
 		return translatableerror.SecurityGroupNotFoundError(e)
 	case v2action.ServiceInstanceNotFoundError:
 		return translatableerror.ServiceInstanceNotFoundError(e)
 	case v2action.SpaceNotFoundError:
 		return translatableerror.SpaceNotFoundError{Name: e.Name}
+	case v2action.SpaceQuotaNotFoundError:
+		if e.Name != "" {
+			return translatableerror.SpaceQuotaNotFoundError{Name: e.Name}
+		}
 	case v2action.StackNotFoundError:
 		return translatableerror.StackNotFoundError(e)
 	case actionerror.HTTPHealthCheckInvalidError:
@@ -64,6 +78,14 @@ func HandleError(err error) error {
 		return translatableerror.NoMatchingDomainError(e)
 	case actionerror.InvalidHTTPRouteSettings:
 		return translatableerror.PortNotAllowedWithHTTPDomainError(e)
+	case actionerror.PortNotReservableError:
+		return translatableerror.PortNotReservableError(e)
+	case actionerror.RouterGroupNotFoundError:
+		return translatableerror.RouterGroupNotFoundError(e)
+	case actionerror.RoutingEndpointNotSetError:
+		return translatableerror.RoutingAPINotEnabledError{}
+	case actionerror.IsolationSegmentNotFoundError:
+		return translatableerror.IsolationSegmentNotFoundError(e)
 
 	case actionerror.AppNotFoundInManifestError:
 		return translatableerror.AppNotFoundInManifestError(e)
@@ -81,6 +103,14 @@ func HandleError(err error) error {
 		return translatableerror.PropertyCombinationError(e)
 	case actionerror.DockerPasswordNotSetError:
 		return translatableerror.DockerPasswordNotSetError{}
+	case actionerror.ServiceInstanceProvisioningFailedError:
+		return translatableerror.ServiceInstanceProvisioningFailedError(e)
+	case actionerror.ServiceInstanceProvisioningTimeoutError:
+		return translatableerror.ServiceInstanceProvisioningTimeoutError(e)
+	case actionerror.ServiceNotFoundError:
+		return translatableerror.ServiceNotFoundError(e)
+	case actionerror.ServicePlanNotFoundError:
+		return translatableerror.ServicePlanNotFoundError(e)
 
 	case manifest.ManifestCreationError:
 		return translatableerror.ManifestCreationError(e)
@@ -2,6 +2,7 @@ package shared_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -35,6 +36,26 @@ var _ = Describe("HandleError", func() {
 			translatableerror.DockerPasswordNotSetError{},
 		),
 
+		Entry("actionerror.ServiceInstanceProvisioningFailedError -> ServiceInstanceProvisioningFailedError",
+			actionerror.ServiceInstanceProvisioningFailedError{Name: "some-service-instance", Reason: "some-reason"},
+			translatableerror.ServiceInstanceProvisioningFailedError{Name: "some-service-instance", Reason: "some-reason"},
+		),
+
+		Entry("actionerror.ServiceInstanceProvisioningTimeoutError -> ServiceInstanceProvisioningTimeoutError",
+			actionerror.ServiceInstanceProvisioningTimeoutError{Name: "some-service-instance", Timeout: time.Minute},
+			translatableerror.ServiceInstanceProvisioningTimeoutError{Name: "some-service-instance", Timeout: time.Minute},
+		),
+
+		Entry("actionerror.ServiceNotFoundError -> ServiceNotFoundError",
+			actionerror.ServiceNotFoundError{Name: "some-service"},
+			translatableerror.ServiceNotFoundError{Name: "some-service"},
+		),
+
+		Entry("actionerror.ServicePlanNotFoundError -> ServicePlanNotFoundError",
+			actionerror.ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"},
+			translatableerror.ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"},
+		),
+
 		Entry("ccerror.RequestError -> APIRequestError",
 			ccerror.RequestError{Err: err},
 			translatableerror.APIRequestError{Err: err}),
@@ -55,6 +76,18 @@ var _ = Describe("HandleError", func() {
 			actionerror.ApplicationNotFoundError{Name: "some-app"},
 			translatableerror.ApplicationNotFoundError{Name: "some-app"}),
 
+		Entry("v2action.BuildpackNotFoundError -> BuildpackNotFoundError",
+			v2action.BuildpackNotFoundError{Name: "some-buildpack", Stack: "some-stack"},
+			translatableerror.BuildpackNotFoundError{Name: "some-buildpack", Stack: "some-stack"}),
+
+		Entry("v2action.MultipleBuildpacksFoundError -> MultipleBuildpacksFoundError",
+			v2action.MultipleBuildpacksFoundError{Name: "some-buildpack"},
+			translatableerror.MultipleBuildpacksFoundError{Name: "some-buildpack"}),
+
+		Entry("v2action.FeatureFlagNotFoundError -> FeatureFlagNotFoundError",
+			v2action.FeatureFlagNotFoundError{Name: "some-feature-flag"},
+			translatableerror.FeatureFlagNotFoundError{Name: "some-feature-flag"}),
+
 		Entry("v2action.SecurityGroupNotFoundError -> SecurityGroupNotFoundError",
 			v2action.SecurityGroupNotFoundError{Name: "some-security-group"},
 			translatableerror.SecurityGroupNotFoundError{Name: "some-security-group"}),
@@ -83,6 +116,22 @@ var _ = Describe("HandleError", func() {
 			v2action.SpaceNotFoundError{Name: "some-space"},
 			translatableerror.SpaceNotFoundError{Name: "some-space"}),
 
+		Entry("v2action.OrganizationQuotaNotFoundError with a name -> OrganizationQuotaNotFoundError",
+			v2action.OrganizationQuotaNotFoundError{Name: "some-quota"},
+			translatableerror.OrganizationQuotaNotFoundError{Name: "some-quota"}),
+
+		Entry("v2action.OrganizationQuotaNotFoundError with a GUID -> itself",
+			v2action.OrganizationQuotaNotFoundError{GUID: "some-quota-guid"},
+			v2action.OrganizationQuotaNotFoundError{GUID: "some-quota-guid"}),
+
+		Entry("v2action.SpaceQuotaNotFoundError with a name -> SpaceQuotaNotFoundError",
+			v2action.SpaceQuotaNotFoundError{Name: "some-quota"},
+			translatableerror.SpaceQuotaNotFoundError{Name: "some-quota"}),
+
+		Entry("actionerror.IsolationSegmentNotFoundError -> IsolationSegmentNotFoundError",
+			actionerror.IsolationSegmentNotFoundError{Name: "some-iso-seg"},
+			translatableerror.IsolationSegmentNotFoundError{Name: "some-iso-seg"}),
+
 		Entry("sharedaction.NotLoggedInError -> NotLoggedInError",
 			sharedaction.NotLoggedInError{BinaryName: "faceman"},
 			translatableerror.NotLoggedInError{BinaryName: "faceman"}),
@@ -150,6 +199,21 @@ var _ = Describe("HandleError", func() {
 			translatableerror.PortNotAllowedWithHTTPDomainError{Domain: "some-domain"},
 		),
 
+		Entry("actionerror.PortNotReservableError -> PortNotReservableError",
+			actionerror.PortNotReservableError{Port: 1234, RouterGroup: "some-router-group", ReservablePorts: "1024-1033"},
+			translatableerror.PortNotReservableError{Port: 1234, RouterGroup: "some-router-group", ReservablePorts: "1024-1033"},
+		),
+
+		Entry("actionerror.RouterGroupNotFoundError -> RouterGroupNotFoundError",
+			actionerror.RouterGroupNotFoundError{Name: "some-router-group"},
+			translatableerror.RouterGroupNotFoundError{Name: "some-router-group"},
+		),
+
+		Entry("actionerror.RoutingEndpointNotSetError -> RoutingAPINotEnabledError",
+			actionerror.RoutingEndpointNotSetError{},
+			translatableerror.RoutingAPINotEnabledError{},
+		),
+
 		Entry("actionerror.MissingNameError -> RequiredNameForPushError",
 			actionerror.MissingNameError{},
 			translatableerror.RequiredNameForPushError{},
@@ -0,0 +1,35 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// DisplayIgnoreStatuses displays whether each file of an app is uploaded by
+// push, along with the .cfignore rule that decided it.
+func DisplayIgnoreStatuses(commandUI command.UI, statuses []sharedaction.FileIgnoreStatus) {
+	table := [][]string{
+		{
+			commandUI.TranslateText("file"),
+			commandUI.TranslateText("status"),
+			commandUI.TranslateText("rule"),
+		},
+	}
+
+	for _, status := range statuses {
+		state := commandUI.TranslateText("uploaded")
+		if status.Ignored {
+			state = commandUI.TranslateText("ignored")
+		}
+
+		var rule string
+		if status.Rule.Pattern != "" {
+			rule = status.Rule.String()
+		}
+
+		table = append(table, []string{status.Filename, state, rule})
+	}
+
+	commandUI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
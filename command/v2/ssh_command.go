@@ -3,30 +3,138 @@ package v2
 import (
 	"os"
 
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/clissh"
 )
 
+//go:generate counterfeiter . SSHActorV3
+
+type SSHActorV3 interface {
+	CloudControllerAPIVersion() string
+	ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessType(appName string, spaceGUID string, processType string, sshOptions v3action.SSHOptions, maxInFlight int, outputs v3action.SSHOutputFunc) ([]v3action.SSHInstanceResult, v3action.Warnings, error)
+}
+
 type SSHCommand struct {
 	RequiredArgs        flag.AppName `positional-args:"yes"`
+	AllInstances        bool         `long:"all-instances" description:"Run the command on every running instance of the app"`
 	AppInstanceIndex    int          `long:"app-instance-index" short:"i" description:"Application instance index (Default: 0)"`
-	Command             string       `long:"command" short:"c" description:"Command to run. This flag can be defined more than once."`
+	Command             []string     `long:"command" short:"c" description:"Command to run. This flag can be defined more than once."`
 	DisablePseudoTTY    bool         `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
+	DynamicPort         string       `short:"D" description:"Dynamic SOCKS5 port forward specification. This flag can be defined more than once."`
 	ForcePseudoTTY      bool         `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
 	LocalPort           string       `short:"L" description:"Local port forward specification. This flag can be defined more than once."`
+	RemotePort          string       `short:"R" description:"Remote port forward specification. This flag can be defined more than once."`
 	RemotePseudoTTY     bool         `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
 	SkipHostValidation  bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
 	SkipRemoteExecution bool         `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
-	usage               interface{}  `usage:"CF_NAME ssh APP_NAME [-i INDEX] [-c COMMAND]... [-L [BIND_ADDRESS:]PORT:HOST:HOST_PORT] [--skip-host-validation] [--skip-remote-execution] [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty]"`
+	usage               interface{}  `usage:"CF_NAME ssh APP_NAME [-i INDEX] [-c COMMAND]... [-L [BIND_ADDRESS:]PORT:HOST:HOST_PORT] [-R [BIND_ADDRESS:]PORT:HOST:HOST_PORT] [-D [BIND_ADDRESS:]PORT] [--skip-host-validation] [--skip-remote-execution] [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty]\n\n   CF_NAME ssh APP_NAME --all-instances -c COMMAND... [--skip-host-validation]"`
 	relatedCommands     interface{}  `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	ActorV3     SSHActorV3
 }
 
-func (SSHCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *SSHCommand) Setup(config command.Config, ui command.UI) error {
+	if !cmd.AllInstances {
+		return nil
+	}
+
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config, clissh.NewDefaultSecureShell(ui.GetIn(), ui.GetOut(), ui.GetErr()))
+	cmd.SharedActor = sharedActor
+
+	ccClientV3, uaaClient, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); ok {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+		return err
+	}
+	cmd.ActorV3 = v3action.NewActor(ccClientV3, config, sharedActor, uaaClient)
+
 	return nil
 }
 
-func (SSHCommand) Execute(args []string) error {
+func (cmd SSHCommand) Execute(args []string) error {
+	if !cmd.AllInstances {
+		cmd.legacyMain()
+		return nil
+	}
+
+	err := cmd.validateAllInstancesFlags()
+	if err != nil {
+		return err
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return sharedV3.HandleError(err)
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return sharedV3.HandleError(err)
+	}
+
+	return sharedV3.AllInstancesSSHRunner{
+		UI:                 cmd.UI,
+		Config:             cmd.Config,
+		Actor:              cmd.ActorV3,
+		AppName:            cmd.RequiredArgs.AppName,
+		ProcessType:        "web",
+		Commands:           cmd.Command,
+		SkipHostValidation: cmd.SkipHostValidation,
+	}.Run()
+}
+
+func (SSHCommand) legacyMain() {
 	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+}
+
+func (cmd SSHCommand) validateAllInstancesFlags() error {
+	if len(cmd.Command) == 0 {
+		return translatableerror.RequiredFlagsError{Arg1: "--all-instances", Arg2: "--command, -c"}
+	}
+
+	conflicts := []string{"--all-instances"}
+	if cmd.AppInstanceIndex != 0 {
+		conflicts = append(conflicts, "--app-instance-index, -i")
+	}
+	if cmd.LocalPort != "" {
+		conflicts = append(conflicts, "-L")
+	}
+	if cmd.RemotePort != "" {
+		conflicts = append(conflicts, "-R")
+	}
+	if cmd.DynamicPort != "" {
+		conflicts = append(conflicts, "-D")
+	}
+	if cmd.SkipRemoteExecution {
+		conflicts = append(conflicts, "--skip-remote-execution, -N")
+	}
+	if cmd.DisablePseudoTTY {
+		conflicts = append(conflicts, "--disable-pseudo-tty, -T")
+	}
+	if cmd.ForcePseudoTTY {
+		conflicts = append(conflicts, "--force-pseudo-tty")
+	}
+	if cmd.RemotePseudoTTY {
+		conflicts = append(conflicts, "--request-pseudo-tty, -t")
+	}
+
+	if len(conflicts) > 1 {
+		return translatableerror.ArgumentCombinationError{Args: conflicts}
+	}
+
 	return nil
 }
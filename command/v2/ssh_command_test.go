@@ -0,0 +1,125 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ssh Command", func() {
+	var (
+		cmd             SSHCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActorV3     *v2fakes.FakeSSHActorV3
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActorV3 = new(v2fakes.FakeSSHActorV3)
+
+		cmd = SSHCommand{
+			RequiredArgs:       flag.AppName{AppName: "some-app"},
+			AllInstances:       true,
+			Command:            []string{"some-command"},
+			SkipHostValidation: true,
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			ActorV3:     fakeActorV3,
+		}
+
+		fakeActorV3.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when --all-instances is provided", func() {
+		Context("when no command is provided", func() {
+			BeforeEach(func() {
+				cmd.Command = nil
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--all-instances", Arg2: "--command, -c"}))
+			})
+		})
+
+		Context("when flags that conflict with --all-instances are provided", func() {
+			BeforeEach(func() {
+				cmd.LocalPort = "8080:localhost:80"
+				cmd.SkipRemoteExecution = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{
+					"--all-instances", "-L", "--skip-remote-execution, -N",
+				}}))
+			})
+		})
+
+		Context("when the API version is below the minimum", func() {
+			BeforeEach(func() {
+				fakeActorV3.CloudControllerAPIVersionReturns("0.0.0")
+			})
+
+			It("returns a MinimumAPIVersionNotMetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+					CurrentVersion: "0.0.0",
+					MinimumVersion: ccversion.MinVersionV3,
+				}))
+			})
+		})
+
+		Context("when checking the target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+				Expect(fakeActorV3.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the command runs on every instance", func() {
+			BeforeEach(func() {
+				fakeActorV3.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeReturns([]v3action.SSHInstanceResult{{Index: 0}, {Index: 1, ExitStatus: 127}}, v3action.Warnings{"some-warnings"}, nil)
+			})
+
+			It("runs the command on the web process instances and aggregates the exit statuses", func() {
+				Expect(executeErr).To(MatchError(translatableerror.SSHAllInstancesFailedError{FailedCount: 1, TotalCount: 2}))
+				Expect(testUI.Err).To(Say("some-warnings"))
+				Expect(testUI.Out).To(Say(`#1\s+127`))
+
+				Expect(fakeActorV3.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeCallCount()).To(Equal(1))
+				appNameArg, spaceGUIDArg, processTypeArg, sshOptionsArg, _, _ := fakeActorV3.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeArgsForCall(0)
+				Expect(appNameArg).To(Equal("some-app"))
+				Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+				Expect(processTypeArg).To(Equal("web"))
+				Expect(sshOptionsArg).To(Equal(v3action.SSHOptions{
+					Commands:           []string{"some-command"},
+					SkipHostValidation: true,
+				}))
+			})
+		})
+	})
+})
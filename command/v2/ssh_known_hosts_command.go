@@ -0,0 +1,69 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . SSHKnownHostsActor
+
+type SSHKnownHostsActor interface {
+	GetSSHKnownHosts() ([]sharedaction.SSHKnownHost, error)
+}
+
+type SSHKnownHostsCommand struct {
+	usage           interface{} `usage:"CF_NAME ssh-known-hosts"`
+	relatedCommands interface{} `related_commands:"remove-ssh-known-host, ssh"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  SSHKnownHostsActor
+}
+
+func (cmd *SSHKnownHostsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = sharedaction.NewActor(config, nil)
+
+	return nil
+}
+
+func (cmd SSHKnownHostsCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Getting trusted SSH host keys...")
+	cmd.UI.DisplayNewline()
+
+	hosts, err := cmd.Actor.GetSSHKnownHosts()
+	if err != nil {
+		return err
+	}
+
+	if len(hosts) == 0 {
+		cmd.UI.DisplayText("No SSH host keys trusted.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("api endpoint"),
+			cmd.UI.TranslateText("ssh endpoint"),
+			cmd.UI.TranslateText("key type"),
+			cmd.UI.TranslateText("fingerprint"),
+			cmd.UI.TranslateText("trusted since"),
+		},
+	}
+
+	for _, host := range hosts {
+		table = append(table, []string{
+			host.APIEndpoint,
+			host.SSHEndpoint,
+			host.KeyType,
+			host.Fingerprint,
+			cmd.UI.UserFriendlyDate(host.TrustedAt),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package v2_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ssh-known-hosts Command", func() {
+	var (
+		cmd        SSHKnownHostsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *v2fakes.FakeSSHKnownHostsActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(v2fakes.FakeSSHKnownHostsActor)
+
+		cmd = SSHKnownHostsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when reading the known hosts fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSSHKnownHostsReturns(nil, errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+
+	Context("when no host keys are trusted", func() {
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting trusted SSH host keys..."))
+			Expect(testUI.Out).To(Say("No SSH host keys trusted."))
+		})
+	})
+
+	Context("when host keys are trusted", func() {
+		BeforeEach(func() {
+			fakeActor.GetSSHKnownHostsReturns([]sharedaction.SSHKnownHost{
+				{
+					APIEndpoint: "https://api.a.example.com",
+					SSHEndpoint: "ssh.a.example.com:2222",
+					KeyType:     "ssh-rsa",
+					Fingerprint: "SHA256:some-fingerprint",
+					TrustedAt:   time.Now(),
+				},
+				{
+					APIEndpoint: "https://api.b.example.com",
+					SSHEndpoint: "ssh.b.example.com:2222",
+					KeyType:     "ecdsa-sha2-nistp256",
+					Fingerprint: "SHA256:some-other-fingerprint",
+					TrustedAt:   time.Now(),
+				},
+			}, nil)
+		})
+
+		It("displays a table of the trusted host keys", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`api endpoint\s+ssh endpoint\s+key type\s+fingerprint\s+trusted since`))
+			Expect(testUI.Out).To(Say(`https://api\.a\.example\.com\s+ssh\.a\.example\.com:2222\s+ssh-rsa\s+SHA256:some-fingerprint`))
+			Expect(testUI.Out).To(Say(`https://api\.b\.example\.com\s+ssh\.b\.example\.com:2222\s+ecdsa-sha2-nistp256\s+SHA256:some-other-fingerprint`))
+		})
+	})
+})
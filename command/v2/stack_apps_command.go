@@ -0,0 +1,103 @@
+package v2
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . StackAppsActor
+
+type StackAppsActor interface {
+	GetStackApplications(stackName string, orgGUID string, spaceGUID string) ([]v2action.StackApplication, v2action.Warnings, error)
+}
+
+type StackAppsCommand struct {
+	RequiredArgs    flag.StackName `positional-args:"yes"`
+	AllSpaces       bool           `long:"all-spaces" description:"List the apps on the stack in every space of the targeted org"`
+	usage           interface{}    `usage:"CF_NAME stack-apps STACK_NAME [--all-spaces]"`
+	relatedCommands interface{}    `related_commands:"change-stack, stack, stacks"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       StackAppsActor
+}
+
+func (cmd *StackAppsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd StackAppsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, !cmd.AllSpaces)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	var spaceGUID string
+	if cmd.AllSpaces {
+		cmd.UI.DisplayTextWithFlavor("Getting apps on stack {{.Stack}} in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"Stack":       cmd.RequiredArgs.StackName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"CurrentUser": user.Name,
+		})
+	} else {
+		spaceGUID = cmd.Config.TargetedSpace().GUID
+		cmd.UI.DisplayTextWithFlavor("Getting apps on stack {{.Stack}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"Stack":       cmd.RequiredArgs.StackName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": user.Name,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	apps, warnings, err := cmd.Actor.GetStackApplications(cmd.RequiredArgs.StackName, cmd.Config.TargetedOrganization().GUID, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(apps) == 0 {
+		cmd.UI.DisplayText("No apps found")
+		return nil
+	}
+
+	var header []string
+	if cmd.AllSpaces {
+		header = append(header, cmd.UI.TranslateText("space"))
+	}
+	header = append(header, cmd.UI.TranslateText("name"), cmd.UI.TranslateText("requested state"))
+
+	table := [][]string{header}
+	for _, app := range apps {
+		var row []string
+		if cmd.AllSpaces {
+			row = append(row, app.SpaceName)
+		}
+		row = append(row, app.Name, cmd.UI.TranslateText(strings.ToLower(string(app.State))))
+		table = append(table, row)
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
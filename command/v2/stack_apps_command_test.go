@@ -0,0 +1,145 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("stack-apps Command", func() {
+	var (
+		cmd             StackAppsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeStackAppsActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeStackAppsActor)
+
+		cmd = StackAppsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.StackName = "cflinuxfs2"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when listing the targeted space", func() {
+		BeforeEach(func() {
+			fakeActor.GetStackApplicationsReturns(
+				[]v2action.StackApplication{
+					{Application: v2action.Application{Name: "app-1", State: ccv2.ApplicationStarted}, SpaceName: "some-space"},
+					{Application: v2action.Application{Name: "app-2", State: ccv2.ApplicationStopped}, SpaceName: "some-space"},
+				},
+				v2action.Warnings{"stack-apps-warning"},
+				nil,
+			)
+		})
+
+		It("displays the apps on the stack in the space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			stackName, orgGUID, spaceGUID := fakeActor.GetStackApplicationsArgsForCall(0)
+			Expect(stackName).To(Equal("cflinuxfs2"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say("Getting apps on stack cflinuxfs2 in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say(`name\s+requested state`))
+			Expect(testUI.Out).To(Say(`app-1\s+started`))
+			Expect(testUI.Out).To(Say(`app-2\s+stopped`))
+			Expect(testUI.Err).To(Say("stack-apps-warning"))
+		})
+	})
+
+	Context("when --all-spaces is given", func() {
+		BeforeEach(func() {
+			cmd.AllSpaces = true
+			fakeActor.GetStackApplicationsReturns(
+				[]v2action.StackApplication{
+					{Application: v2action.Application{Name: "app-1", State: ccv2.ApplicationStarted}, SpaceName: "space-1"},
+					{Application: v2action.Application{Name: "app-2", State: ccv2.ApplicationStarted}, SpaceName: "space-2"},
+				},
+				nil,
+				nil,
+			)
+		})
+
+		It("only requires an org to be targeted", func() {
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+
+		It("displays the apps on the stack in every space of the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, orgGUID, spaceGUID := fakeActor.GetStackApplicationsArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceGUID).To(BeEmpty())
+
+			Expect(testUI.Out).To(Say("Getting apps on stack cflinuxfs2 in org some-org as some-user..."))
+			Expect(testUI.Out).To(Say(`space\s+name\s+requested state`))
+			Expect(testUI.Out).To(Say(`space-1\s+app-1\s+started`))
+			Expect(testUI.Out).To(Say(`space-2\s+app-2\s+started`))
+		})
+	})
+
+	Context("when there are no apps on the stack", func() {
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No apps found"))
+		})
+	})
+
+	Context("when the stack does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetStackApplicationsReturns(nil, v2action.Warnings{"stack-warning"}, v2action.StackNotFoundError{Name: "cflinuxfs2"})
+		})
+
+		It("returns a StackNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.StackNotFoundError{Name: "cflinuxfs2"}))
+			Expect(testUI.Err).To(Say("stack-warning"))
+		})
+	})
+})
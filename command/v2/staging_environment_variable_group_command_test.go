@@ -0,0 +1,92 @@
+package v2_test
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("staging-environment-variable-group Command", func() {
+	var (
+		cmd             StagingEnvironmentVariableGroupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeEnvironmentVariableGroupActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeEnvironmentVariableGroupActor)
+
+		cmd = StagingEnvironmentVariableGroupCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	Context("when the group has variables", func() {
+		BeforeEach(func() {
+			fakeActor.GetEnvironmentVariablesReturns(
+				[]v2action.EnvironmentVariable{
+					{Name: "DEBUG", Value: json.RawMessage("false")},
+					{Name: "LANG", Value: json.RawMessage(`"en_US.UTF-8"`)},
+					{Name: "LIMITS", Value: json.RawMessage(`{"cpu":2}`)},
+				},
+				v2action.Warnings{"group-warning"},
+				nil,
+			)
+		})
+
+		It("displays the variables in order", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetEnvironmentVariablesArgsForCall(0)).To(Equal(constant.StagingEnvironmentVariableGroup))
+			Expect(testUI.Out).To(Say("Getting the staging environment variable group as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`variable name\s+assigned value`))
+			Expect(testUI.Out).To(Say(`DEBUG\s+false`))
+			Expect(testUI.Out).To(Say(`LANG\s+en_US.UTF-8`))
+			Expect(testUI.Out).To(Say(`LIMITS\s+\{"cpu":2\}`))
+			Expect(testUI.Err).To(Say("group-warning"))
+		})
+	})
+
+	Context("when the group is empty", func() {
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No staging environment variable group has been set."))
+		})
+	})
+})
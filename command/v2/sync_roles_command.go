@@ -0,0 +1,153 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/orgaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/roster"
+)
+
+//go:generate counterfeiter . SyncRolesActor
+
+type SyncRolesActor interface {
+	ApplyRolePlan(changes []orgaction.RoleChange) (orgaction.Warnings, error)
+	GetRolePlan(entries []roster.Entry, revoke bool) ([]orgaction.RoleChange, orgaction.Warnings, error)
+	ReadRoster(pathToRoster string) ([]roster.Entry, error)
+}
+
+type SyncRolesCommand struct {
+	RequiredArgs    flag.SyncRolesArgs `positional-args:"yes"`
+	DryRun          bool               `long:"dry-run" description:"Show the changes without making them"`
+	Revoke          bool               `long:"revoke" description:"Remove roles held by users left out of the roster, in the orgs and spaces the roster names"`
+	usage           interface{}        `usage:"CF_NAME sync-roles FILE [--revoke] [--dry-run]\n\n   The roster lists one role per entry, as a YAML list or as CSV with a header row.\n   Roles are OrgUser, OrgManager, BillingManager, OrgAuditor, SpaceManager, SpaceDeveloper and SpaceAuditor.\n   Users no org knows about are created when the entry has a password or an origin.\n\nEXAMPLES:\n   username,org,space,role\n   alice,my-org,,OrgManager\n   bob,my-org,dev,SpaceDeveloper"`
+	relatedCommands interface{}        `related_commands:"create-user, org-users, set-org-role, set-space-role, space-users"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SyncRolesActor
+}
+
+func (cmd *SyncRolesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = orgaction.NewActor(
+		v2action.NewActor(ccClient, uaaClient, config),
+		v3action.NewActor(ccClientV3, config, nil, nil),
+	)
+	return nil
+}
+
+func (cmd SyncRolesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	entries, err := cmd.Actor.ReadRoster(string(cmd.RequiredArgs.PathToRoster))
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Planning role changes from {{.Path}} as {{.Username}}...", map[string]interface{}{
+		"Path":     cmd.RequiredArgs.PathToRoster,
+		"Username": user.Name,
+	})
+
+	changes, warnings, err := cmd.Actor.GetRolePlan(entries, cmd.Revoke)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(changes) == 0 {
+		cmd.UI.DisplayText("Roles already match {{.Path}}.", map[string]interface{}{
+			"Path": cmd.RequiredArgs.PathToRoster,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	var created, granted, revoked int
+	for _, change := range changes {
+		switch change.Type {
+		case orgaction.CreateUser:
+			created++
+		case orgaction.GrantRole:
+			granted++
+		case orgaction.RevokeRole:
+			revoked++
+		}
+		cmd.UI.DisplayText(cmd.describeChange(change), map[string]interface{}{
+			"Username": change.Username,
+			"Role":     change.Role,
+			"Org":      change.Org,
+			"Space":    change.Space,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	summary := map[string]interface{}{
+		"Created": created,
+		"Granted": granted,
+		"Revoked": revoked,
+	}
+
+	if cmd.DryRun {
+		cmd.UI.DisplayText("Users to create: {{.Created}}, roles to grant: {{.Granted}}, roles to revoke: {{.Revoked}}", summary)
+		cmd.UI.DisplayText("Dry run; no changes were made.")
+		return nil
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying {{.Count}} role change(s) as {{.Username}}...", map[string]interface{}{
+		"Count":    len(changes),
+		"Username": user.Name,
+	})
+
+	warnings, err = cmd.Actor.ApplyRolePlan(changes)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("Users created: {{.Created}}, roles granted: {{.Granted}}, roles revoked: {{.Revoked}}", summary)
+	return nil
+}
+
+func (SyncRolesCommand) describeChange(change orgaction.RoleChange) string {
+	switch {
+	case change.Type == orgaction.CreateUser:
+		return "+ create user {{.Username}}"
+	case change.Type == orgaction.GrantRole && change.Space == "":
+		return "+ give {{.Username}} the role {{.Role}} in org {{.Org}}"
+	case change.Type == orgaction.GrantRole:
+		return "+ give {{.Username}} the role {{.Role}} in org {{.Org}} / space {{.Space}}"
+	case change.Space == "":
+		return "- remove the role {{.Role}} from {{.Username}} in org {{.Org}}"
+	default:
+		return "- remove the role {{.Role}} from {{.Username}} in org {{.Org}} / space {{.Space}}"
+	}
+}
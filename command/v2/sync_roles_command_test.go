@@ -0,0 +1,165 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/orgaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/roster"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("sync-roles Command", func() {
+	var (
+		cmd             SyncRolesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSyncRolesActor
+		binaryName      string
+		executeErr      error
+		entries         []roster.Entry
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSyncRolesActor)
+
+		cmd = SyncRolesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.PathToRoster = flag.PathWithExistenceCheck("roster.csv")
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		entries = []roster.Entry{{Username: "alice", Org: "some-org", Role: roster.OrgManager}}
+		fakeActor.ReadRosterReturns(entries, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the roster cannot be read", func() {
+		BeforeEach(func() {
+			fakeActor.ReadRosterReturns(nil, roster.InvalidRosterError{Entry: 2, Reason: "org is required"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(roster.InvalidRosterError{Entry: 2, Reason: "org is required"}))
+			Expect(fakeActor.ReadRosterArgsForCall(0)).To(Equal("roster.csv"))
+			Expect(fakeActor.GetRolePlanCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the roles already match", func() {
+		BeforeEach(func() {
+			fakeActor.GetRolePlanReturns(nil, orgaction.Warnings{"plan-warning"}, nil)
+		})
+
+		It("says so and displays OK", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("plan-warning"))
+			Expect(testUI.Out).To(Say("Roles already match roster.csv."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.ApplyRolePlanCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the plan has changes", func() {
+		var changes []orgaction.RoleChange
+
+		BeforeEach(func() {
+			cmd.Revoke = true
+			changes = []orgaction.RoleChange{
+				{Type: orgaction.CreateUser, Username: "carol"},
+				{Type: orgaction.GrantRole, Username: "carol", Org: "some-org", Role: roster.OrgUser},
+				{Type: orgaction.GrantRole, Username: "carol", Org: "some-org", Space: "dev", Role: roster.SpaceAuditor},
+				{Type: orgaction.RevokeRole, Username: "dave", Org: "some-org", Role: roster.OrgManager},
+				{Type: orgaction.RevokeRole, Username: "dave", Org: "some-org", Space: "dev", Role: roster.SpaceDeveloper},
+			}
+			fakeActor.GetRolePlanReturns(changes, orgaction.Warnings{"plan-warning"}, nil)
+			fakeActor.ApplyRolePlanReturns(orgaction.Warnings{"apply-warning"}, nil)
+		})
+
+		It("displays the changes, applies them and summarizes them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Planning role changes from roster.csv as some-user..."))
+			Expect(testUI.Err).To(Say("plan-warning"))
+			Expect(testUI.Out).To(Say(`\+ create user carol`))
+			Expect(testUI.Out).To(Say(`\+ give carol the role OrgUser in org some-org`))
+			Expect(testUI.Out).To(Say(`\+ give carol the role SpaceAuditor in org some-org / space dev`))
+			Expect(testUI.Out).To(Say(`- remove the role OrgManager from dave in org some-org`))
+			Expect(testUI.Out).To(Say(`- remove the role SpaceDeveloper from dave in org some-org / space dev`))
+			Expect(testUI.Out).To(Say(`Applying 5 role change\(s\) as some-user...`))
+			Expect(testUI.Err).To(Say("apply-warning"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Users created: 1, roles granted: 2, roles revoked: 2"))
+
+			passedEntries, revoke := fakeActor.GetRolePlanArgsForCall(0)
+			Expect(passedEntries).To(Equal(entries))
+			Expect(revoke).To(BeTrue())
+			Expect(fakeActor.ApplyRolePlanArgsForCall(0)).To(Equal(changes))
+		})
+
+		Context("when it is a dry run", func() {
+			BeforeEach(func() {
+				cmd.DryRun = true
+			})
+
+			It("displays the changes without applying them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`\+ create user carol`))
+				Expect(testUI.Out).To(Say("Users to create: 1, roles to grant: 2, roles to revoke: 2"))
+				Expect(testUI.Out).To(Say("Dry run; no changes were made."))
+				Expect(fakeActor.ApplyRolePlanCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when applying the changes fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("apply failed")
+				fakeActor.ApplyRolePlanReturns(orgaction.Warnings{"apply-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("apply-warning"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
@@ -0,0 +1,105 @@
+package v2
+
+import (
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . TCPRoutesActor
+
+type TCPRoutesActor interface {
+	CloudControllerAPIVersion() string
+	GetSpaceTCPRouteSummaries(spaceGUID string, routerGroupName string) ([]v2action.TCPRouteSummary, v2action.Warnings, error)
+}
+
+type TCPRoutesCommand struct {
+	RouterGroup     string      `long:"router-group" description:"Only list routes whose domain belongs to this router group"`
+	usage           interface{} `usage:"CF_NAME tcp-routes [--router-group ROUTER_GROUP]"`
+	relatedCommands interface{} `related_commands:"create-route, delete-route, map-route, router-groups, routes"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       TCPRoutesActor
+}
+
+func (cmd *TCPRoutesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd TCPRoutesCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionTCPRouting)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting TCP routes for org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	summaries, warnings, err := cmd.Actor.GetSpaceTCPRouteSummaries(cmd.Config.TargetedSpace().GUID, cmd.RouterGroup)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if len(summaries) == 0 {
+		cmd.UI.DisplayText("No TCP routes found")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("domain"),
+			cmd.UI.TranslateText("port"),
+			cmd.UI.TranslateText("router group"),
+			cmd.UI.TranslateText("apps"),
+		},
+	}
+	for _, summary := range summaries {
+		table = append(table, []string{
+			summary.Domain.Name,
+			strconv.Itoa(summary.Port.Value),
+			summary.RouterGroupName,
+			strings.Join(summary.AppNames, ", "),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
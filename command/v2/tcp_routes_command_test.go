@@ -0,0 +1,130 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("tcp-routes Command", func() {
+	var (
+		cmd             TCPRoutesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeTCPRoutesActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeTCPRoutesActor)
+
+		cmd = TCPRoutesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionTCPRouting)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("2.52.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "2.52.0",
+				MinimumVersion: ccversion.MinVersionTCPRouting,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the router group does not exist", func() {
+		BeforeEach(func() {
+			cmd.RouterGroup = "some-group"
+			fakeActor.GetSpaceTCPRouteSummariesReturns(nil, v2action.Warnings{"some-warning"}, actionerror.RouterGroupNotFoundError{Name: "some-group"})
+		})
+
+		It("returns a RouterGroupNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RouterGroupNotFoundError{Name: "some-group"}))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	Context("when there are no TCP routes", func() {
+		It("displays a message", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting TCP routes for org some-org / space some-space as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("No TCP routes found"))
+		})
+	})
+
+	Context("when there are TCP routes", func() {
+		BeforeEach(func() {
+			cmd.RouterGroup = "default-tcp"
+			fakeActor.GetSpaceTCPRouteSummariesReturns([]v2action.TCPRouteSummary{
+				{
+					Route: v2action.Route{
+						Domain: v2action.Domain{Name: "tcp.com"},
+						Port:   types.NullInt{IsSet: true, Value: 1024},
+					},
+					RouterGroupName: "default-tcp",
+					AppNames:        []string{"app-1", "app-2"},
+				},
+			}, v2action.Warnings{"some-warning"}, nil)
+		})
+
+		It("displays the routes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(testUI.Out).To(Say("domain\\s+port\\s+router group\\s+apps"))
+			Expect(testUI.Out).To(Say("tcp.com\\s+1024\\s+default-tcp\\s+app-1, app-2"))
+
+			Expect(fakeActor.GetSpaceTCPRouteSummariesCallCount()).To(Equal(1))
+			spaceGUID, routerGroupName := fakeActor.GetSpaceTCPRouteSummariesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(routerGroupName).To(Equal("default-tcp"))
+		})
+	})
+})
@@ -1,13 +1,33 @@
 package v2
 
 import (
+	"io/ioutil"
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/downloader"
+	"code.cloudfoundry.org/cli/util/progressbar"
 )
 
+//go:generate counterfeiter . UpdateBuildpackActor
+
+type UpdateBuildpackActor interface {
+	BuildpackBitsMatch(buildpack v2action.Buildpack, zipPath string) (bool, v2action.Warnings, error)
+	CloudControllerAPIVersion() string
+	GetBuildpackBitsStack(zipPath string) (string, error)
+	GetBuildpackByNameAndStack(buildpackName string, stackName string) (v2action.Buildpack, v2action.Warnings, error)
+	PrepareBuildpackBits(inputPath string, tmpDirPath string, downloader v2action.Downloader) (string, error)
+	UpdateBuildpack(buildpack v2action.Buildpack) (v2action.Buildpack, v2action.Warnings, error)
+	UploadBuildpack(buildpackGUID string, zipPath string, progressBar v2action.ProgressBar) (v2action.Warnings, error)
+}
+
 type UpdateBuildpackCommand struct {
 	RequiredArgs    flag.BuildpackName               `positional-args:"yes"`
 	Disable         bool                             `long:"disable" description:"Disable the buildpack from being used for staging"`
@@ -15,16 +35,173 @@ type UpdateBuildpackCommand struct {
 	Order           int                              `short:"i" description:"The order in which the buildpacks are checked during buildpack auto-detection"`
 	Lock            bool                             `long:"lock" description:"Lock the buildpack to prevent updates"`
 	Path            flag.PathWithExistenceCheckOrURL `short:"p" description:"Path to directory or zip file"`
+	Stack           string                           `short:"s" description:"Stack of the buildpack to update, when several buildpacks share its name"`
 	Unlock          bool                             `long:"unlock" description:"Unlock the buildpack to enable updates"`
-	usage           interface{}                      `usage:"CF_NAME update-buildpack BUILDPACK [-p PATH] [-i POSITION] [--enable|--disable] [--lock|--unlock]\n\nTIP:\n   Path should be a zip file, a url to a zip file, or a local directory. Position is a positive integer, sets priority, and is sorted from lowest to highest."`
-	relatedCommands interface{}                      `related_commands:"buildpacks, rename-buildpack"`
+	usage           interface{}                      `usage:"CF_NAME update-buildpack BUILDPACK [-p PATH] [-s STACK] [-i POSITION] [--enable|--disable] [--lock|--unlock]\n\nTIP:\n   Path should be a zip file, a url to a zip file, or a local directory. Position is a positive integer, sets priority, and is sorted from lowest to highest.\n   The upload is skipped when the buildpack already has the same bits."`
+	relatedCommands interface{}                      `related_commands:"buildpacks, create-buildpack, rename-buildpack"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UpdateBuildpackActor
+	ProgressBar BuildpackProgressBar
 }
 
-func (UpdateBuildpackCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *UpdateBuildpackCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+	cmd.ProgressBar = progressbar.NewTransferProgressBar()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (UpdateBuildpackCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd UpdateBuildpackCommand) Execute(args []string) error {
+	if cmd.Enable && cmd.Disable {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--enable", "--disable"}}
+	}
+	if cmd.Lock && cmd.Unlock {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--lock", "--unlock"}}
+	}
+
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	stackErr := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionBuildpackStackAssociationV2, "Option '-s'")
+	if cmd.Stack != "" && stackErr != nil {
+		return stackErr
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Stack == "" {
+		cmd.UI.DisplayTextWithFlavor("Updating buildpack {{.Buildpack}} as {{.Username}}...", map[string]interface{}{
+			"Buildpack": cmd.RequiredArgs.Buildpack,
+			"Username":  user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Updating buildpack {{.Buildpack}} with stack {{.Stack}} as {{.Username}}...", map[string]interface{}{
+			"Buildpack": cmd.RequiredArgs.Buildpack,
+			"Stack":     cmd.Stack,
+			"Username":  user.Name,
+		})
+	}
+
+	buildpack, warnings, err := cmd.Actor.GetBuildpackByNameAndStack(cmd.RequiredArgs.Buildpack, cmd.Stack)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	update := v2action.Buildpack{GUID: buildpack.GUID}
+	if cmd.Order != 0 {
+		update.Position = types.NullInt{IsSet: true, Value: cmd.Order}
+	}
+	if cmd.Enable || cmd.Disable {
+		update.Enabled = types.NullBool{IsSet: true, Value: cmd.Enable}
+	}
+	// A buildpack being locked is only locked once its new bits are uploaded.
+	lockAfterUpload := cmd.Lock && cmd.Path != ""
+	if cmd.Unlock || (cmd.Lock && !lockAfterUpload) {
+		update.Locked = types.NullBool{IsSet: true, Value: cmd.Lock}
+	}
+
+	var bitsPath string
+	if cmd.Path != "" {
+		tmpDirPath, tmpErr := ioutil.TempDir("", "buildpack-upload")
+		if tmpErr != nil {
+			return tmpErr
+		}
+		defer os.RemoveAll(tmpDirPath)
+
+		bitsPath, err = cmd.Actor.PrepareBuildpackBits(string(cmd.Path), tmpDirPath, downloader.NewDownloader(tmpDirPath))
+		if err != nil {
+			return err
+		}
+
+		// Targets that cannot associate buildpacks with stacks ignore the
+		// stack named in the buildpack's manifest.
+		if stackErr == nil {
+			bitsStack, bitsStackErr := cmd.Actor.GetBuildpackBitsStack(bitsPath)
+			if bitsStackErr != nil {
+				return bitsStackErr
+			}
+
+			switch {
+			case bitsStack == "" || bitsStack == buildpack.Stack:
+			case buildpack.Stack == "":
+				update.Stack = bitsStack
+			default:
+				return translatableerror.BuildpackStackMismatchError{
+					Name:      buildpack.Name,
+					Stack:     buildpack.Stack,
+					BitsStack: bitsStack,
+				}
+			}
+		}
+	}
+
+	if update.Position.IsSet || update.Enabled.IsSet || update.Locked.IsSet || update.Stack != "" {
+		_, warnings, err = cmd.Actor.UpdateBuildpack(update)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
+	if bitsPath == "" {
+		return nil
+	}
+	cmd.UI.DisplayNewline()
+
+	unchanged, warnings, err := cmd.Actor.BuildpackBitsMatch(buildpack, bitsPath)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if unchanged {
+		cmd.UI.DisplayText("Buildpack {{.Buildpack}} already has these bits; skipping upload.", map[string]interface{}{
+			"Buildpack": buildpack.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Uploading buildpack {{.Buildpack}} as {{.Username}}...", map[string]interface{}{
+			"Buildpack": buildpack.Name,
+			"Username":  user.Name,
+		})
+
+		warnings, err = cmd.Actor.UploadBuildpack(buildpack.GUID, bitsPath, cmd.ProgressBar)
+		cmd.ProgressBar.Complete()
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
+	if lockAfterUpload {
+		_, warnings, err = cmd.Actor.UpdateBuildpack(v2action.Buildpack{
+			GUID:   buildpack.GUID,
+			Locked: types.NullBool{IsSet: true, Value: true},
+		})
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
+	cmd.UI.DisplayOK()
 	return nil
 }
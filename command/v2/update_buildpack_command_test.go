@@ -0,0 +1,224 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-buildpack Command", func() {
+	var (
+		cmd             UpdateBuildpackCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUpdateBuildpackActor
+		fakeProgressBar *v2fakes.FakeBuildpackProgressBar
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUpdateBuildpackActor)
+		fakeProgressBar = new(v2fakes.FakeBuildpackProgressBar)
+
+		cmd = UpdateBuildpackCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+		cmd.RequiredArgs.Buildpack = "some-buildpack"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionBuildpackStackAssociationV2)
+		fakeActor.GetBuildpackByNameAndStackReturns(
+			v2action.Buildpack{GUID: "some-buildpack-guid", Name: "some-buildpack", Stack: "cflinuxfs2"},
+			v2action.Warnings{"get-warning"},
+			nil,
+		)
+		fakeActor.UpdateBuildpackReturns(v2action.Buildpack{}, v2action.Warnings{"update-warning"}, nil)
+		fakeActor.PrepareBuildpackBitsReturns("/tmp/some-buildpack.zip", nil)
+		fakeActor.UploadBuildpackReturns(v2action.Warnings{"upload-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when --lock and --unlock are both provided", func() {
+		BeforeEach(func() {
+			cmd.Lock = true
+			cmd.Unlock = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--lock", "--unlock"}}))
+		})
+	})
+
+	Context("when the buildpack does not exist", func() {
+		BeforeEach(func() {
+			cmd.Stack = "cflinuxfs2"
+			fakeActor.GetBuildpackByNameAndStackReturns(v2action.Buildpack{}, v2action.Warnings{"get-warning"}, v2action.BuildpackNotFoundError{Name: "some-buildpack", Stack: "cflinuxfs2"})
+		})
+
+		It("returns a BuildpackNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.BuildpackNotFoundError{Name: "some-buildpack", Stack: "cflinuxfs2"}))
+			Expect(testUI.Out).To(Say("Updating buildpack some-buildpack with stack cflinuxfs2 as some-user..."))
+			Expect(testUI.Err).To(Say("get-warning"))
+
+			name, stack := fakeActor.GetBuildpackByNameAndStackArgsForCall(0)
+			Expect(name).To(Equal("some-buildpack"))
+			Expect(stack).To(Equal("cflinuxfs2"))
+		})
+	})
+
+	Context("when only settings are changed", func() {
+		BeforeEach(func() {
+			cmd.Order = 2
+			cmd.Enable = true
+			cmd.Lock = true
+		})
+
+		It("updates the buildpack without uploading", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Updating buildpack some-buildpack as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("update-warning"))
+
+			Expect(fakeActor.UpdateBuildpackArgsForCall(0)).To(Equal(v2action.Buildpack{
+				GUID:     "some-buildpack-guid",
+				Position: types.NullInt{IsSet: true, Value: 2},
+				Enabled:  types.NullBool{IsSet: true, Value: true},
+				Locked:   types.NullBool{IsSet: true, Value: true},
+			}))
+			Expect(fakeActor.PrepareBuildpackBitsCallCount()).To(Equal(0))
+			Expect(fakeActor.UploadBuildpackCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a path is provided", func() {
+		BeforeEach(func() {
+			cmd.Path = flag.PathWithExistenceCheckOrURL("/some/path")
+		})
+
+		Context("when the bits have changed", func() {
+			BeforeEach(func() {
+				cmd.Lock = true
+			})
+
+			It("uploads the bits and then locks the buildpack", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Uploading buildpack some-buildpack as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("upload-warning"))
+
+				guid, zipPath, _ := fakeActor.UploadBuildpackArgsForCall(0)
+				Expect(guid).To(Equal("some-buildpack-guid"))
+				Expect(zipPath).To(Equal("/tmp/some-buildpack.zip"))
+				Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+
+				Expect(fakeActor.UpdateBuildpackCallCount()).To(Equal(1))
+				Expect(fakeActor.UpdateBuildpackArgsForCall(0)).To(Equal(v2action.Buildpack{
+					GUID:   "some-buildpack-guid",
+					Locked: types.NullBool{IsSet: true, Value: true},
+				}))
+			})
+		})
+
+		Context("when the buildpack already has the bits", func() {
+			BeforeEach(func() {
+				fakeActor.BuildpackBitsMatchReturns(true, v2action.Warnings{"match-warning"}, nil)
+			})
+
+			It("skips the upload", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Buildpack some-buildpack already has these bits; skipping upload."))
+				Expect(testUI.Err).To(Say("match-warning"))
+				Expect(fakeActor.UploadBuildpackCallCount()).To(Equal(0))
+
+				buildpack, zipPath := fakeActor.BuildpackBitsMatchArgsForCall(0)
+				Expect(buildpack.GUID).To(Equal("some-buildpack-guid"))
+				Expect(zipPath).To(Equal("/tmp/some-buildpack.zip"))
+			})
+		})
+
+		Context("when the bits are for another stack", func() {
+			BeforeEach(func() {
+				fakeActor.GetBuildpackBitsStackReturns("windows2012R2", nil)
+			})
+
+			It("returns a BuildpackStackMismatchError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.BuildpackStackMismatchError{
+					Name:      "some-buildpack",
+					Stack:     "cflinuxfs2",
+					BitsStack: "windows2012R2",
+				}))
+				Expect(fakeActor.UploadBuildpackCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the buildpack has no stack", func() {
+			BeforeEach(func() {
+				fakeActor.GetBuildpackByNameAndStackReturns(v2action.Buildpack{GUID: "some-buildpack-guid", Name: "some-buildpack"}, nil, nil)
+				fakeActor.GetBuildpackBitsStackReturns("cflinuxfs2", nil)
+			})
+
+			It("assigns the stack from the bits", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.UpdateBuildpackArgsForCall(0)).To(Equal(v2action.Buildpack{
+					GUID:  "some-buildpack-guid",
+					Stack: "cflinuxfs2",
+				}))
+				Expect(fakeActor.UploadBuildpackCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the API does not support buildpack stacks", func() {
+			BeforeEach(func() {
+				fakeActor.CloudControllerAPIVersionReturns("2.100.0")
+				fakeActor.GetBuildpackByNameAndStackReturns(v2action.Buildpack{GUID: "some-buildpack-guid", Name: "some-buildpack"}, nil, nil)
+				fakeActor.GetBuildpackBitsStackReturns("cflinuxfs2", nil)
+			})
+
+			It("uploads the bits without assigning a stack", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.GetBuildpackBitsStackCallCount()).To(Equal(0))
+				Expect(fakeActor.UpdateBuildpackCallCount()).To(Equal(0))
+				Expect(fakeActor.UploadBuildpackCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when a stack is given with -s and the API does not support buildpack stacks", func() {
+		BeforeEach(func() {
+			cmd.Stack = "cflinuxfs2"
+			fakeActor.CloudControllerAPIVersionReturns("2.100.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError without looking up the buildpack", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '-s'",
+				CurrentVersion: "2.100.0",
+				MinimumVersion: ccversion.MinVersionBuildpackStackAssociationV2,
+			}))
+			Expect(fakeActor.GetBuildpackByNameAndStackCallCount()).To(Equal(0))
+		})
+	})
+})
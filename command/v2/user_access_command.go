@@ -0,0 +1,205 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/orgaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/roster"
+)
+
+//go:generate counterfeiter . UserAccessActor
+
+type UserAccessActor interface {
+	GetRoleHolders(orgName string, role roster.Role) ([]orgaction.RoleAssignment, orgaction.Warnings, error)
+	GetUserRoleAssignments(username string) ([]orgaction.RoleAssignment, orgaction.Warnings, error)
+	RevokeRoleAssignments(assignments []orgaction.RoleAssignment) (orgaction.Warnings, error)
+}
+
+type UserAccessCommand struct {
+	RequiredArgs    flag.OptionalUsername `positional-args:"yes"`
+	Role            string                `long:"role" choice:"OrgUser" choice:"OrgManager" choice:"BillingManager" choice:"OrgAuditor" choice:"SpaceManager" choice:"SpaceDeveloper" choice:"SpaceAuditor" description:"List the users holding this role instead of the roles of one user"`
+	Org             string                `short:"o" description:"Org to list role holders in (Default: targeted org)"`
+	RevokeAll       bool                  `long:"revoke-all" description:"Remove every org and space role the user holds"`
+	Force           bool                  `short:"f" description:"Force revocation without confirmation"`
+	usage           interface{}           `usage:"CF_NAME user-access USERNAME [--revoke-all [-f]]\n   CF_NAME user-access --role ROLE [-o ORG]\n\n   Only the orgs visible to you are searched."`
+	relatedCommands interface{}           `related_commands:"org-users, space-users, sync-roles, unset-org-role, unset-space-role"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UserAccessActor
+}
+
+func (cmd *UserAccessCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = orgaction.NewActor(
+		v2action.NewActor(ccClient, uaaClient, config),
+		v3action.NewActor(ccClientV3, config, nil, nil),
+	)
+	return nil
+}
+
+func (cmd UserAccessCommand) Execute(args []string) error {
+	switch {
+	case cmd.RequiredArgs.Username != "" && cmd.Role != "":
+		return translatableerror.ArgumentCombinationError{Args: []string{"USERNAME", "--role"}}
+	case cmd.RequiredArgs.Username == "" && cmd.Role == "":
+		return translatableerror.RequiredArgumentError{ArgumentName: "USERNAME"}
+	case cmd.Role != "" && cmd.RevokeAll:
+		return translatableerror.ArgumentCombinationError{Args: []string{"--role", "--revoke-all"}}
+	case cmd.Role == "" && cmd.Org != "":
+		return translatableerror.ArgumentCombinationError{Args: []string{"USERNAME", "-o"}}
+	}
+
+	if cmd.Role != "" {
+		return cmd.displayRoleHolders()
+	}
+	return cmd.displayUserAccess()
+}
+
+func (cmd UserAccessCommand) displayRoleHolders() error {
+	err := cmd.SharedActor.CheckTarget(cmd.Org == "", false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	orgName := cmd.Org
+	if orgName == "" {
+		orgName = cmd.Config.TargetedOrganization().Name
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting users with role {{.Role}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"Role":     cmd.Role,
+		"OrgName":  orgName,
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	role := roster.Role(cmd.Role)
+	assignments, warnings, err := cmd.Actor.GetRoleHolders(orgName, role)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(assignments) == 0 {
+		cmd.UI.DisplayText("No users found.")
+		return nil
+	}
+
+	var table [][]string
+	if role.IsSpaceRole() {
+		table = append(table, []string{cmd.UI.TranslateText("space"), cmd.UI.TranslateText("user")})
+		for _, assignment := range assignments {
+			table = append(table, []string{assignment.Space, assignment.Username})
+		}
+	} else {
+		table = append(table, []string{cmd.UI.TranslateText("user")})
+		for _, assignment := range assignments {
+			table = append(table, []string{assignment.Username})
+		}
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
+
+func (cmd UserAccessCommand) displayUserAccess() error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting roles of user {{.TargetUser}} as {{.Username}}...", map[string]interface{}{
+		"TargetUser": cmd.RequiredArgs.Username,
+		"Username":   user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	assignments, warnings, err := cmd.Actor.GetUserRoleAssignments(cmd.RequiredArgs.Username)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(assignments) == 0 {
+		cmd.UI.DisplayText("User {{.TargetUser}} holds no org or space roles.", map[string]interface{}{
+			"TargetUser": cmd.RequiredArgs.Username,
+		})
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("role"),
+		},
+	}
+	for _, assignment := range assignments {
+		table = append(table, []string{assignment.Org, assignment.Space, string(assignment.Role)})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	if !cmd.RevokeAll {
+		return nil
+	}
+	cmd.UI.DisplayNewline()
+
+	if !cmd.Force {
+		revoke, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really remove all {{.Count}} role(s) from user {{.TargetUser}}?", map[string]interface{}{
+			"Count":      len(assignments),
+			"TargetUser": cmd.RequiredArgs.Username,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !revoke {
+			cmd.UI.DisplayText("Roles have not been revoked.")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Revoking roles of user {{.TargetUser}} as {{.Username}}...", map[string]interface{}{
+		"TargetUser": cmd.RequiredArgs.Username,
+		"Username":   user.Name,
+	})
+
+	warnings, err = cmd.Actor.RevokeRoleAssignments(assignments)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
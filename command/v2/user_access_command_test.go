@@ -0,0 +1,230 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/orgaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/roster"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("user-access Command", func() {
+	var (
+		cmd             UserAccessCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUserAccessActor
+		binaryName      string
+		executeErr      error
+		assignments     []orgaction.RoleAssignment
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUserAccessActor)
+
+		cmd = UserAccessCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		assignments = []orgaction.RoleAssignment{
+			{Username: "alice", UserGUID: "alice-guid", Org: "org-1", OrgGUID: "org-1-guid", Role: roster.OrgUser},
+			{Username: "alice", UserGUID: "alice-guid", Org: "org-1", OrgGUID: "org-1-guid", Space: "dev", SpaceGUID: "dev-guid", Role: roster.SpaceDeveloper},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when neither a username nor a role is provided", func() {
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "USERNAME"}))
+		})
+	})
+
+	Context("when both a username and a role are provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Username = "alice"
+			cmd.Role = "SpaceDeveloper"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"USERNAME", "--role"}}))
+		})
+	})
+
+	Context("when listing the roles of a user", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Username = "alice"
+			fakeActor.GetUserRoleAssignmentsReturns(assignments, orgaction.Warnings{"get-warning"}, nil)
+		})
+
+		Context("when checking the target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeFalse())
+				Expect(checkTargetedSpace).To(BeFalse())
+			})
+		})
+
+		It("displays the roles the user holds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting roles of user alice as some-user..."))
+			Expect(testUI.Out).To(Say(`org\s+space\s+role`))
+			Expect(testUI.Out).To(Say(`org-1\s+OrgUser`))
+			Expect(testUI.Out).To(Say(`org-1\s+dev\s+SpaceDeveloper`))
+			Expect(testUI.Err).To(Say("get-warning"))
+			Expect(fakeActor.GetUserRoleAssignmentsArgsForCall(0)).To(Equal("alice"))
+			Expect(fakeActor.RevokeRoleAssignmentsCallCount()).To(Equal(0))
+		})
+
+		Context("when the user holds no roles", func() {
+			BeforeEach(func() {
+				fakeActor.GetUserRoleAssignmentsReturns(nil, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("User alice holds no org or space roles."))
+			})
+		})
+
+		Context("when getting the roles fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get roles failed")
+				fakeActor.GetUserRoleAssignmentsReturns(nil, orgaction.Warnings{"get-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("get-warning"))
+			})
+		})
+
+		Context("when --revoke-all is provided", func() {
+			BeforeEach(func() {
+				cmd.RevokeAll = true
+				fakeActor.RevokeRoleAssignmentsReturns(orgaction.Warnings{"revoke-warning"}, nil)
+			})
+
+			Context("when the user confirms", func() {
+				BeforeEach(func() {
+					input.Write([]byte("y\n"))
+				})
+
+				It("revokes every role", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`Really remove all 2 role\(s\) from user alice\?`))
+					Expect(testUI.Out).To(Say("Revoking roles of user alice as some-user..."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("revoke-warning"))
+					Expect(fakeActor.RevokeRoleAssignmentsArgsForCall(0)).To(Equal(assignments))
+				})
+			})
+
+			Context("when the user declines", func() {
+				BeforeEach(func() {
+					input.Write([]byte("n\n"))
+				})
+
+				It("does not revoke any roles", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Roles have not been revoked."))
+					Expect(fakeActor.RevokeRoleAssignmentsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when -f is provided", func() {
+				BeforeEach(func() {
+					cmd.Force = true
+				})
+
+				It("revokes without prompting", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).ToNot(Say("Really remove"))
+					Expect(fakeActor.RevokeRoleAssignmentsCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+
+	Context("when listing the holders of a role", func() {
+		BeforeEach(func() {
+			cmd.Role = "SpaceDeveloper"
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "org-1"})
+			fakeActor.GetRoleHoldersReturns(assignments[1:], orgaction.Warnings{"holders-warning"}, nil)
+		})
+
+		It("displays the users holding the role in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+
+			Expect(testUI.Out).To(Say("Getting users with role SpaceDeveloper in org org-1 as some-user..."))
+			Expect(testUI.Out).To(Say(`space\s+user`))
+			Expect(testUI.Out).To(Say(`dev\s+alice`))
+			Expect(testUI.Err).To(Say("holders-warning"))
+
+			orgName, role := fakeActor.GetRoleHoldersArgsForCall(0)
+			Expect(orgName).To(Equal("org-1"))
+			Expect(role).To(Equal(roster.SpaceDeveloper))
+		})
+
+		Context("when an org is provided", func() {
+			BeforeEach(func() {
+				cmd.Org = "org-2"
+			})
+
+			It("does not require a targeted org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				checkTargetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeFalse())
+
+				orgName, _ := fakeActor.GetRoleHoldersArgsForCall(0)
+				Expect(orgName).To(Equal("org-2"))
+			})
+		})
+
+		Context("when --revoke-all is also provided", func() {
+			BeforeEach(func() {
+				cmd.RevokeAll = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--role", "--revoke-all"}}))
+			})
+		})
+	})
+})
@@ -1,12 +1,16 @@
 package v2
 
 import (
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
 	oldcmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
@@ -15,6 +19,7 @@ import (
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/progressbar"
+	"code.cloudfoundry.org/cli/util/ui"
 	"github.com/cloudfoundry/noaa/consumer"
 	log "github.com/sirupsen/logrus"
 )
@@ -25,13 +30,29 @@ type ProgressBar interface {
 	pushaction.ProgressBar
 	Complete()
 	Ready()
+	Retry()
 }
 
+// silentProgressBar is used in place of the terminal progress bar when apps
+// are pushed in parallel, as concurrent uploads cannot share a single bar.
+type silentProgressBar struct{}
+
+func (silentProgressBar) NewProgressBarWrapper(reader io.Reader, _ int64) io.Reader {
+	return reader
+}
+
+func (silentProgressBar) Complete() {}
+
+func (silentProgressBar) Ready() {}
+
+func (silentProgressBar) Retry() {}
+
 //go:generate counterfeiter . V2PushActor
 
 type V2PushActor interface {
 	Apply(config pushaction.ApplicationConfig, progressBar pushaction.ProgressBar) (<-chan pushaction.ApplicationConfig, <-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
 	ConvertToApplicationConfigs(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
+	GetIgnoreStatuses(path string) ([]sharedaction.FileIgnoreStatus, error)
 	MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
 	ReadManifest(pathToManifest string) ([]manifest.Application, error)
 }
@@ -49,20 +70,23 @@ type V2PushCommand struct {
 	Instances  flag.Instances              `short:"i" description:"Number of instances"`
 	DiskQuota  flag.Megabytes              `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	Memory     flag.Megabytes              `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	NoCache    bool                        `long:"no-cache" description:"Hash and upload all app files, ignoring the results of previous pushes"`
 	NoHostname bool                        `long:"no-hostname" description:"Map the root domain to this app"`
 	NoManifest bool                        `long:"no-manifest" description:"Ignore manifest file"`
 	NoRoute    bool                        `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
 	NoStart    bool                        `long:"no-start" description:"Do not start an app after pushing"`
 	AppPath    flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
+	Parallel   int                         `long:"parallel" description:"Number of apps from a manifest with multiple apps to push at the same time (Default: 1)"`
 	// RandomRoute          bool                        `long:"random-route" description:"Create a random route for this app"`
 	// RoutePath            string                      `long:"route-path" description:"Path for the route"`
+	ShowIgnored         bool        `long:"show-ignored" description:"List the files that would be uploaded or ignored, with the matching .cfignore rule, without pushing"`
 	StackName           string      `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
 	HealthCheckTimeout  int         `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
 	envCFStagingTimeout interface{} `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 	dockerPassword      interface{} `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
 
-	usage           interface{} `usage:"cf v2-push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]"`
+	usage           interface{} `usage:"cf v2-push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--no-cache] [--show-ignored]\n\n   cf v2-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start] [--parallel NUM_APPS]"`
 	relatedCommands interface{} `related_commands:"apps, create-app-manifest, logs, ssh, start"`
 
 	UI          command.UI
@@ -73,12 +97,19 @@ type V2PushCommand struct {
 
 	RestartActor RestartActor
 	NOAAClient   *consumer.Consumer
+
+	// dopplerEndpoint and uaaClient are used to create a NOAA client for
+	// each app pushed in parallel, as closing a client stops all of its log
+	// streams.
+	dopplerEndpoint string
+	uaaClient       *uaa.Client
 }
 
 func (cmd *V2PushCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
 	sharedActor := sharedaction.NewActor(config, nil)
+	sharedActor.SkipResourceCache = cmd.NoCache
 
 	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
 	if err != nil {
@@ -89,6 +120,8 @@ func (cmd *V2PushCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Actor = pushaction.NewActor(v2Actor, sharedActor)
 	cmd.SharedActor = sharedActor
 	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+	cmd.dopplerEndpoint = ccClient.DopplerEndpoint()
+	cmd.uaaClient = uaaClient
 
 	cmd.ProgressBar = progressbar.NewProgressBar()
 	return nil
@@ -144,6 +177,10 @@ func (cmd V2PushCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if cmd.ShowIgnored {
+		return cmd.displayIgnoredFiles(manifestApplications)
+	}
+
 	cmd.UI.DisplayText("Getting app info...")
 
 	log.Info("converting manifests to ApplicationConfigs")
@@ -175,43 +212,148 @@ func (cmd V2PushCommand) Execute(args []string) error {
 		cmd.UI.DisplayNewline()
 	}
 
+	if cmd.Parallel > 1 && len(appConfigs) > 1 {
+		return cmd.pushApplicationsInParallel(user, appConfigs)
+	}
+
 	for appNumber, appConfig := range appConfigs {
-		if appConfig.CreatingApplication() {
-			cmd.UI.DisplayTextWithFlavor("Creating app {{.AppName}}...", map[string]interface{}{
-				"AppName": appConfig.DesiredApplication.Name,
-			})
-		} else {
-			cmd.UI.DisplayTextWithFlavor("Updating app {{.AppName}}...", map[string]interface{}{
-				"AppName": appConfig.DesiredApplication.Name,
-			})
+		err := cmd.pushApplication(user, appConfig)
+		if err != nil {
+			return err
 		}
 
-		configStream, eventStream, warningsStream, errorStream := cmd.Actor.Apply(appConfig, cmd.ProgressBar)
-		updatedConfig, err := cmd.processApplyStreams(user, appConfig, configStream, eventStream, warningsStream, errorStream)
-		if err != nil {
-			log.Errorln("process apply stream:", err)
-			return shared.HandleError(err)
+		if appNumber+1 <= len(appConfigs) {
+			cmd.UI.DisplayNewline()
 		}
+	}
 
-		if !cmd.NoStart {
-			messages, logErrs, appState, apiWarnings, errs := cmd.RestartActor.RestartApplication(updatedConfig.CurrentApplication.Application, cmd.NOAAClient, cmd.Config)
-			err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
-			if err != nil {
-				return err
-			}
+	return nil
+}
+
+// displayIgnoredFiles lists which files of each app would be uploaded,
+// without pushing anything.
+func (cmd V2PushCommand) displayIgnoredFiles(apps []manifest.Application) error {
+	for _, app := range apps {
+		if app.DockerImage != "" {
+			continue
 		}
 
+		cmd.UI.DisplayText("Files of app {{.AppName}} in {{.Path}}:", map[string]interface{}{
+			"AppName": app.Name,
+			"Path":    app.Path,
+		})
 		cmd.UI.DisplayNewline()
-		appSummary, warnings, err := cmd.RestartActor.GetApplicationSummaryByNameAndSpace(appConfig.DesiredApplication.Name, cmd.Config.TargetedSpace().GUID)
-		cmd.UI.DisplayWarnings(warnings)
+
+		statuses, err := cmd.Actor.GetIgnoreStatuses(app.Path)
 		if err != nil {
+			log.Errorln("gathering ignored files:", err)
 			return shared.HandleError(err)
 		}
 
-		shared.DisplayAppSummary(cmd.UI, appSummary, true)
+		shared.DisplayIgnoreStatuses(cmd.UI, statuses)
+		cmd.UI.DisplayNewline()
+	}
 
-		if appNumber+1 <= len(appConfigs) {
-			cmd.UI.DisplayNewline()
+	return nil
+}
+
+func (cmd V2PushCommand) pushApplication(user configv3.User, appConfig pushaction.ApplicationConfig) error {
+	if appConfig.CreatingApplication() {
+		cmd.UI.DisplayTextWithFlavor("Creating app {{.AppName}}...", map[string]interface{}{
+			"AppName": appConfig.DesiredApplication.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Updating app {{.AppName}}...", map[string]interface{}{
+			"AppName": appConfig.DesiredApplication.Name,
+		})
+	}
+
+	configStream, eventStream, warningsStream, errorStream := cmd.Actor.Apply(appConfig, cmd.ProgressBar)
+	updatedConfig, err := cmd.processApplyStreams(user, appConfig, configStream, eventStream, warningsStream, errorStream)
+	if err != nil {
+		log.Errorln("process apply stream:", err)
+		return shared.HandleError(err)
+	}
+
+	if !cmd.NoStart {
+		messages, logErrs, appState, apiWarnings, errs := cmd.RestartActor.RestartApplication(updatedConfig.CurrentApplication.Application, cmd.NOAAClient, cmd.Config)
+		err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	appSummary, warnings, err := cmd.RestartActor.GetApplicationSummaryByNameAndSpace(appConfig.DesiredApplication.Name, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	shared.DisplayAppSummary(cmd.UI, appSummary, true)
+	return nil
+}
+
+// pushApplicationsInParallel pushes up to cmd.Parallel apps at a time. The
+// output of each app is prefixed with its name; a failing app does not stop
+// the others from being pushed.
+func (cmd V2PushCommand) pushApplicationsInParallel(user configv3.User, appConfigs []pushaction.ApplicationConfig) error {
+	cmd.UI.DisplayText("Pushing {{.AppCount}} apps, {{.Parallel}} at a time...", map[string]interface{}{
+		"AppCount": len(appConfigs),
+		"Parallel": cmd.Parallel,
+	})
+	cmd.UI.DisplayNewline()
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, cmd.Parallel)
+	errs := make([]error, len(appConfigs))
+
+	for i, appConfig := range appConfigs {
+		wg.Add(1)
+		go func(i int, appConfig pushaction.ApplicationConfig) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			appCmd := cmd
+			appCmd.UI = cmd.UI.WithLinePrefix(fmt.Sprintf("[%s] ", appConfig.DesiredApplication.Name))
+			appCmd.ProgressBar = silentProgressBar{}
+			appCmd.NOAAClient = shared.NewNOAAClient(cmd.dopplerEndpoint, cmd.Config, cmd.uaaClient, appCmd.UI)
+			defer appCmd.UI.Flush()
+
+			errs[i] = appCmd.pushApplication(user, appConfig)
+			if errs[i] != nil {
+				log.Errorf("pushing %s: %s", appConfig.DesiredApplication.Name, errs[i])
+				appCmd.UI.DisplayError(errs[i])
+			}
+		}(i, appConfig)
+	}
+	wg.Wait()
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("status"),
+		},
+	}
+
+	var failed int
+	for i, appConfig := range appConfigs {
+		status := cmd.UI.TranslateText("pushed")
+		if errs[i] != nil {
+			status = cmd.UI.TranslateText("failed")
+			failed++
+		}
+		table = append(table, []string{appConfig.DesiredApplication.Name, status})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	if failed > 0 {
+		return translatableerror.ParallelPushFailedError{
+			FailedCount: failed,
+			TotalCount:  len(appConfigs),
 		}
 	}
 
@@ -377,6 +519,8 @@ func (cmd V2PushCommand) processEvent(user configv3.User, appConfig pushaction.A
 		cmd.UI.DisplayText("Mapping routes...")
 	case pushaction.UnmappingRoutes:
 		cmd.UI.DisplayText("Unmapping routes...")
+	case pushaction.CreatingServiceInstances:
+		cmd.UI.DisplayText("Creating service instances...")
 	case pushaction.ConfiguringServices:
 		cmd.UI.DisplayText("Binding services...")
 	case pushaction.ResourceMatching:
@@ -390,7 +534,11 @@ func (cmd V2PushCommand) processEvent(user configv3.User, appConfig pushaction.A
 		cmd.UI.DisplayText("Uploading files...")
 		log.Debug("starting progress bar")
 		cmd.ProgressBar.Ready()
+	case pushaction.UploadSkipped:
+		cmd.UI.DisplayText("App files unchanged since the last push; skipping upload.")
 	case pushaction.RetryUpload:
+		cmd.ProgressBar.Retry()
+		cmd.UI.DisplayNewline()
 		cmd.UI.DisplayText("Retrying upload due to an error...")
 	case pushaction.UploadWithArchiveComplete:
 		cmd.ProgressBar.Complete()
@@ -427,6 +575,11 @@ func (cmd V2PushCommand) validateArgs() error {
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{"--no-hostname", "--no-route"},
 		}
+	case cmd.Parallel < 0:
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--parallel",
+			ExpectedType: "a non-negative integer",
+		}
 	}
 
 	return nil
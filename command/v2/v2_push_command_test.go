@@ -115,6 +115,45 @@ var _ = Describe("v2-push Command", func() {
 					fakeActor.MergeAndValidateSettingsAndManifestsReturns(appManifests, nil)
 				})
 
+				Context("when --show-ignored is provided", func() {
+					BeforeEach(func() {
+						cmd.ShowIgnored = true
+						appManifests = append(appManifests, manifest.Application{Name: "some-docker-app", DockerImage: "some-image"})
+						fakeActor.MergeAndValidateSettingsAndManifestsReturns(appManifests, nil)
+						fakeActor.GetIgnoreStatusesReturns([]sharedaction.FileIgnoreStatus{
+							{Filename: "some-file"},
+							{Filename: "some-log", Ignored: true, Rule: sharedaction.IgnoreRule{Source: ".cfignore", Line: 2, Pattern: "*.log"}},
+						}, nil)
+					})
+
+					It("lists the ignored files of each non-docker app without pushing", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Files of app %s in %s:", appName, regexp.QuoteMeta(pwd)))
+						Expect(testUI.Out).To(Say(`file\s+status\s+rule`))
+						Expect(testUI.Out).To(Say(`some-file\s+uploaded`))
+						Expect(testUI.Out).To(Say(`some-log\s+ignored\s+\.cfignore:2: \*\.log`))
+
+						Expect(fakeActor.GetIgnoreStatusesCallCount()).To(Equal(1))
+						Expect(fakeActor.GetIgnoreStatusesArgsForCall(0)).To(Equal(pwd))
+						Expect(fakeActor.ConvertToApplicationConfigsCallCount()).To(Equal(0))
+						Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+					})
+
+					Context("when gathering the files fails", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = errors.New("some-error")
+							fakeActor.GetIgnoreStatusesReturns(nil, expectedErr)
+						})
+
+						It("returns the error", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+						})
+					})
+				})
+
 				Context("when the settings can be converted to a valid config", func() {
 					var appConfigs []pushaction.ApplicationConfig
 
@@ -165,8 +204,11 @@ var _ = Describe("v2-push Command", func() {
 									Eventually(eventStream).Should(BeSent(pushaction.CreatedRoutes))
 									Eventually(eventStream).Should(BeSent(pushaction.BoundRoutes))
 									Eventually(eventStream).Should(BeSent(pushaction.UnmappingRoutes))
+									Eventually(eventStream).Should(BeSent(pushaction.CreatingServiceInstances))
+									Eventually(eventStream).Should(BeSent(pushaction.CreatedServiceInstances))
 									Eventually(eventStream).Should(BeSent(pushaction.ConfiguringServices))
 									Eventually(eventStream).Should(BeSent(pushaction.BoundServices))
+									Eventually(eventStream).Should(BeSent(pushaction.UploadSkipped))
 									Eventually(eventStream).Should(BeSent(pushaction.ResourceMatching))
 									Eventually(eventStream).Should(BeSent(pushaction.UploadingApplication))
 									Eventually(eventStream).Should(BeSent(pushaction.CreatingArchive))
@@ -491,13 +533,16 @@ var _ = Describe("v2-push Command", func() {
 								Expect(testUI.Out).To(Say("Creating app with these attributes\\.\\.\\."))
 								Expect(testUI.Out).To(Say("Mapping routes\\.\\.\\."))
 								Expect(testUI.Out).To(Say("Unmapping routes\\.\\.\\."))
+								Expect(testUI.Out).To(Say("Creating service instances\\.\\.\\."))
 								Expect(testUI.Out).To(Say("Binding services\\.\\.\\."))
+								Expect(testUI.Out).To(Say("App files unchanged since the last push; skipping upload\\."))
 								Expect(testUI.Out).To(Say("Comparing local files to remote cache\\.\\.\\."))
 								Expect(testUI.Out).To(Say("All files found in remote cache; nothing to upload."))
 								Expect(testUI.Out).To(Say("Waiting for API to complete processing files\\.\\.\\."))
 								Expect(testUI.Out).To(Say("Packaging files to upload\\.\\.\\."))
 								Expect(testUI.Out).To(Say("Uploading files\\.\\.\\."))
 								Expect(testUI.Out).To(Say("Retrying upload due to an error\\.\\.\\."))
+								Expect(fakeProgressBar.RetryCallCount()).To(Equal(1))
 								Expect(testUI.Out).To(Say("Waiting for API to complete processing files\\.\\.\\."))
 								Expect(testUI.Out).To(Say("Stopping app\\.\\.\\."))
 
@@ -668,6 +713,146 @@ var _ = Describe("v2-push Command", func() {
 						})
 					})
 
+					Context("when --parallel is set and the manifest has multiple apps", func() {
+						BeforeEach(func() {
+							cmd.Parallel = 2
+							cmd.NoStart = true
+
+							appConfigs = []pushaction.ApplicationConfig{
+								{
+									DesiredApplication: pushaction.Application{Application: v2action.Application{Name: "app-1"}},
+									TargetedSpaceGUID:  "some-space-guid",
+									Path:               pwd,
+								},
+								{
+									DesiredApplication: pushaction.Application{Application: v2action.Application{Name: "app-2"}},
+									TargetedSpaceGUID:  "some-space-guid",
+									Path:               pwd,
+								},
+							}
+							fakeActor.ConvertToApplicationConfigsReturns(appConfigs, nil, nil)
+
+							fakeActor.ApplyStub = func(config pushaction.ApplicationConfig, _ pushaction.ProgressBar) (<-chan pushaction.ApplicationConfig, <-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+								configStream := make(chan pushaction.ApplicationConfig)
+								eventStream := make(chan pushaction.Event)
+								warningsStream := make(chan pushaction.Warnings)
+								errorStream := make(chan error)
+
+								go func() {
+									defer GinkgoRecover()
+
+									Eventually(warningsStream).Should(BeSent(pushaction.Warnings{"apply-warning"}))
+									if config.DesiredApplication.Name == "app-2" {
+										Eventually(errorStream).Should(BeSent(errors.New("no wayz dude")))
+									} else {
+										Eventually(eventStream).Should(BeSent(pushaction.UploadingApplicationWithArchive))
+										Eventually(eventStream).Should(BeSent(pushaction.UploadWithArchiveComplete))
+										Eventually(configStream).Should(BeSent(config))
+										Eventually(eventStream).Should(BeSent(pushaction.Complete))
+									}
+									close(configStream)
+									close(eventStream)
+									close(warningsStream)
+									close(errorStream)
+								}()
+
+								return configStream, eventStream, warningsStream, errorStream
+							}
+
+							fakeRestartActor.GetApplicationSummaryByNameAndSpaceStub = func(name string, _ string) (v2action.ApplicationSummary, v2action.Warnings, error) {
+								return v2action.ApplicationSummary{
+									Application: v2action.Application{
+										Name:  name,
+										State: "STOPPED",
+									},
+								}, nil, nil
+							}
+						})
+
+						It("pushes every app, prefixing the output of each with its name", func() {
+							Expect(fakeActor.ApplyCallCount()).To(Equal(2))
+
+							out := string(testUI.Out.(*Buffer).Contents())
+							Expect(out).To(ContainSubstring("Pushing 2 apps, 2 at a time..."))
+							Expect(out).To(ContainSubstring("[app-1] Creating app app-1..."))
+							Expect(out).To(ContainSubstring("[app-1] Uploading files..."))
+							Expect(out).To(ContainSubstring("[app-2] Creating app app-2..."))
+							Expect(out).To(ContainSubstring("[app-2] FAILED"))
+							Expect(out).To(MatchRegexp(`\[app-1\] name:\s+app-1`))
+
+							errOut := string(testUI.Err.(*Buffer).Contents())
+							Expect(errOut).To(ContainSubstring("[app-1] apply-warning"))
+							Expect(errOut).To(ContainSubstring("[app-2] apply-warning"))
+							Expect(errOut).To(ContainSubstring("[app-2] no wayz dude"))
+						})
+
+						It("does not use the progress bar", func() {
+							Expect(fakeProgressBar.ReadyCallCount()).To(Equal(0))
+							Expect(fakeProgressBar.CompleteCallCount()).To(Equal(0))
+						})
+
+						It("displays a summary of the pushed apps and returns an error for the failures", func() {
+							Expect(executeErr).To(MatchError(translatableerror.ParallelPushFailedError{
+								FailedCount: 1,
+								TotalCount:  2,
+							}))
+
+							Expect(testUI.Out).To(Say(`name\s+status`))
+							Expect(testUI.Out).To(Say(`app-1\s+pushed`))
+							Expect(testUI.Out).To(Say(`app-2\s+failed`))
+						})
+
+						Context("when the apps are started", func() {
+							BeforeEach(func() {
+								cmd.NoStart = false
+
+								fakeActor.ApplyStub = func(config pushaction.ApplicationConfig, _ pushaction.ProgressBar) (<-chan pushaction.ApplicationConfig, <-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+									configStream := make(chan pushaction.ApplicationConfig)
+									eventStream := make(chan pushaction.Event)
+									warningsStream := make(chan pushaction.Warnings)
+									errorStream := make(chan error)
+
+									go func() {
+										defer GinkgoRecover()
+
+										Eventually(configStream).Should(BeSent(config))
+										Eventually(eventStream).Should(BeSent(pushaction.Complete))
+										close(configStream)
+										close(eventStream)
+										close(warningsStream)
+										close(errorStream)
+									}()
+
+									return configStream, eventStream, warningsStream, errorStream
+								}
+
+								fakeRestartActor.RestartApplicationStub = func(v2action.Application, v2action.NOAAClient, v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
+									messages := make(chan *v2action.LogMessage)
+									logErrs := make(chan error)
+									appState := make(chan v2action.ApplicationStateChange)
+									warnings := make(chan string)
+									errs := make(chan error)
+									close(messages)
+									close(logErrs)
+									close(appState)
+									close(warnings)
+									close(errs)
+									return messages, logErrs, appState, warnings, errs
+								}
+							})
+
+							It("streams the logs of each app with its own NOAA client", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(fakeRestartActor.RestartApplicationCallCount()).To(Equal(2))
+
+								_, firstClient, _ := fakeRestartActor.RestartApplicationArgsForCall(0)
+								_, secondClient, _ := fakeRestartActor.RestartApplicationArgsForCall(1)
+								Expect(firstClient).ToNot(BeNil())
+								Expect(secondClient).ToNot(BeNil())
+								Expect(firstClient).ToNot(BeIdenticalTo(secondClient))
+							})
+						})
+					})
 				})
 
 				Context("when there is an error converting the app setting into a config", func() {
@@ -870,6 +1055,12 @@ var _ = Describe("v2-push Command", func() {
 					cmd.NoRoute = true
 				},
 				translatableerror.ArgumentCombinationError{Args: []string{"--no-hostname", "--no-route"}}),
+
+			Entry("negative --parallel",
+				func() {
+					cmd.Parallel = -1
+				},
+				translatableerror.ParseArgumentError{ArgumentName: "--parallel", ExpectedType: "a non-negative integer"}),
 		)
 	})
 })
@@ -27,6 +27,9 @@ type FakeProgressBar struct {
 	ReadyStub           func()
 	readyMutex          sync.RWMutex
 	readyArgsForCall    []struct{}
+	RetryStub           func()
+	retryMutex          sync.RWMutex
+	retryArgsForCall    []struct{}
 	invocations         map[string][][]interface{}
 	invocationsMutex    sync.RWMutex
 }
@@ -112,6 +115,22 @@ func (fake *FakeProgressBar) ReadyCallCount() int {
 	return len(fake.readyArgsForCall)
 }
 
+func (fake *FakeProgressBar) Retry() {
+	fake.retryMutex.Lock()
+	fake.retryArgsForCall = append(fake.retryArgsForCall, struct{}{})
+	fake.recordInvocation("Retry", []interface{}{})
+	fake.retryMutex.Unlock()
+	if fake.RetryStub != nil {
+		fake.RetryStub()
+	}
+}
+
+func (fake *FakeProgressBar) RetryCallCount() int {
+	fake.retryMutex.RLock()
+	defer fake.retryMutex.RUnlock()
+	return len(fake.retryArgsForCall)
+}
+
 func (fake *FakeProgressBar) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -121,6 +140,8 @@ func (fake *FakeProgressBar) Invocations() map[string][][]interface{} {
 	defer fake.completeMutex.RUnlock()
 	fake.readyMutex.RLock()
 	defer fake.readyMutex.RUnlock()
+	fake.retryMutex.RLock()
+	defer fake.retryMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/util/manifest"
 )
@@ -46,6 +47,19 @@ type FakeV2PushActor struct {
 		result2 pushaction.Warnings
 		result3 error
 	}
+	GetIgnoreStatusesStub        func(path string) ([]sharedaction.FileIgnoreStatus, error)
+	getIgnoreStatusesMutex       sync.RWMutex
+	getIgnoreStatusesArgsForCall []struct {
+		path string
+	}
+	getIgnoreStatusesReturns struct {
+		result1 []sharedaction.FileIgnoreStatus
+		result2 error
+	}
+	getIgnoreStatusesReturnsOnCall map[int]struct {
+		result1 []sharedaction.FileIgnoreStatus
+		result2 error
+	}
 	MergeAndValidateSettingsAndManifestsStub        func(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
 	mergeAndValidateSettingsAndManifestsMutex       sync.RWMutex
 	mergeAndValidateSettingsAndManifestsArgsForCall []struct {
@@ -197,6 +211,57 @@ func (fake *FakeV2PushActor) ConvertToApplicationConfigsReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2PushActor) GetIgnoreStatuses(path string) ([]sharedaction.FileIgnoreStatus, error) {
+	fake.getIgnoreStatusesMutex.Lock()
+	ret, specificReturn := fake.getIgnoreStatusesReturnsOnCall[len(fake.getIgnoreStatusesArgsForCall)]
+	fake.getIgnoreStatusesArgsForCall = append(fake.getIgnoreStatusesArgsForCall, struct {
+		path string
+	}{path})
+	fake.recordInvocation("GetIgnoreStatuses", []interface{}{path})
+	fake.getIgnoreStatusesMutex.Unlock()
+	if fake.GetIgnoreStatusesStub != nil {
+		return fake.GetIgnoreStatusesStub(path)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getIgnoreStatusesReturns.result1, fake.getIgnoreStatusesReturns.result2
+}
+
+func (fake *FakeV2PushActor) GetIgnoreStatusesCallCount() int {
+	fake.getIgnoreStatusesMutex.RLock()
+	defer fake.getIgnoreStatusesMutex.RUnlock()
+	return len(fake.getIgnoreStatusesArgsForCall)
+}
+
+func (fake *FakeV2PushActor) GetIgnoreStatusesArgsForCall(i int) string {
+	fake.getIgnoreStatusesMutex.RLock()
+	defer fake.getIgnoreStatusesMutex.RUnlock()
+	return fake.getIgnoreStatusesArgsForCall[i].path
+}
+
+func (fake *FakeV2PushActor) GetIgnoreStatusesReturns(result1 []sharedaction.FileIgnoreStatus, result2 error) {
+	fake.GetIgnoreStatusesStub = nil
+	fake.getIgnoreStatusesReturns = struct {
+		result1 []sharedaction.FileIgnoreStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) GetIgnoreStatusesReturnsOnCall(i int, result1 []sharedaction.FileIgnoreStatus, result2 error) {
+	fake.GetIgnoreStatusesStub = nil
+	if fake.getIgnoreStatusesReturnsOnCall == nil {
+		fake.getIgnoreStatusesReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.FileIgnoreStatus
+			result2 error
+		})
+	}
+	fake.getIgnoreStatusesReturnsOnCall[i] = struct {
+		result1 []sharedaction.FileIgnoreStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV2PushActor) MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error) {
 	var appsCopy []manifest.Application
 	if apps != nil {
@@ -312,6 +377,8 @@ func (fake *FakeV2PushActor) Invocations() map[string][][]interface{} {
 	defer fake.applyMutex.RUnlock()
 	fake.convertToApplicationConfigsMutex.RLock()
 	defer fake.convertToApplicationConfigsMutex.RUnlock()
+	fake.getIgnoreStatusesMutex.RLock()
+	defer fake.getIgnoreStatusesMutex.RUnlock()
 	fake.mergeAndValidateSettingsAndManifestsMutex.RLock()
 	defer fake.mergeAndValidateSettingsAndManifestsMutex.RUnlock()
 	fake.readManifestMutex.RLock()
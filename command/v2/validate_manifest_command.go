@@ -0,0 +1,87 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/manifest"
+)
+
+//go:generate counterfeiter . ValidateManifestActor
+
+type ValidateManifestActor interface {
+	ManifestJSONSchema() ([]byte, error)
+	ValidateManifest(pathToManifest string) ([]manifest.ValidationError, error)
+}
+
+type ValidateManifestCommand struct {
+	RequiredArgs    flag.ValidateManifestArgs `positional-args:"yes"`
+	Schema          bool                      `long:"schema" description:"Print a JSON Schema for manifests, for use by editors"`
+	usage           interface{}               `usage:"CF_NAME validate-manifest PATH_TO_MANIFEST\n   CF_NAME validate-manifest --schema"`
+	relatedCommands interface{}               `related_commands:"create-app-manifest, push"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  ValidateManifestActor
+}
+
+func (cmd *ValidateManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pushaction.NewActor(nil, nil)
+	return nil
+}
+
+func (cmd ValidateManifestCommand) Execute(args []string) error {
+	pathToManifest := string(cmd.RequiredArgs.PathToManifest)
+
+	if cmd.Schema {
+		if pathToManifest != "" {
+			return translatableerror.ArgumentCombinationError{Args: []string{"PATH_TO_MANIFEST", "--schema"}}
+		}
+
+		schema, err := cmd.Actor.ManifestJSONSchema()
+		if err != nil {
+			return err
+		}
+		cmd.UI.DisplayText(string(schema))
+		return nil
+	}
+
+	if pathToManifest == "" {
+		return translatableerror.RequiredArgumentError{ArgumentName: "PATH_TO_MANIFEST"}
+	}
+
+	cmd.UI.DisplayText("Validating manifest {{.Path}}...", map[string]interface{}{
+		"Path": pathToManifest,
+	})
+
+	validationErrors, err := cmd.Actor.ValidateManifest(pathToManifest)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(validationErrors) > 0 {
+		for _, validationErr := range validationErrors {
+			cmd.UI.DisplayText("{{.Path}}:{{.Line}}:{{.Column}}: {{.Message}}", map[string]interface{}{
+				"Path":    pathToManifest,
+				"Line":    validationErr.Line,
+				"Column":  validationErr.Column,
+				"Message": describeValidationError(validationErr),
+			})
+		}
+		return translatableerror.ManifestValidationError{Count: len(validationErrors)}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func describeValidationError(validationErr manifest.ValidationError) string {
+	if validationErr.Path == "" {
+		return validationErr.Message
+	}
+	return validationErr.Path + ": " + validationErr.Message
+}
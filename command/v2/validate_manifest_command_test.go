@@ -0,0 +1,119 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("validate-manifest Command", func() {
+	var (
+		cmd        ValidateManifestCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *v2fakes.FakeValidateManifestActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(v2fakes.FakeValidateManifestActor)
+
+		cmd = ValidateManifestCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+
+		cmd.RequiredArgs.PathToManifest = flag.PathWithExistenceCheck("some-manifest.yml")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the manifest is valid", func() {
+		It("displays OK", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Validating manifest some-manifest.yml..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.ValidateManifestCallCount()).To(Equal(1))
+			Expect(fakeActor.ValidateManifestArgsForCall(0)).To(Equal("some-manifest.yml"))
+		})
+	})
+
+	Context("when the manifest has errors", func() {
+		BeforeEach(func() {
+			fakeActor.ValidateManifestReturns([]manifest.ValidationError{
+				{Line: 4, Column: 3, Path: "applications[0].memroy", Message: `unknown key "memroy"`},
+				{Line: 9, Column: 1, Message: "did not find expected key"},
+			}, nil)
+		})
+
+		It("displays every error and returns a ManifestValidationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ManifestValidationError{Count: 2}))
+			Expect(testUI.Out).To(Say(`some-manifest.yml:4:3: applications\[0\].memroy: unknown key "memroy"`))
+			Expect(testUI.Out).To(Say("some-manifest.yml:9:1: did not find expected key"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+
+	Context("when the manifest cannot be read", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("read error")
+			fakeActor.ValidateManifestReturns(nil, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when no manifest is given", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.PathToManifest = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "PATH_TO_MANIFEST"}))
+			Expect(fakeActor.ValidateManifestCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --schema is given", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.PathToManifest = ""
+			cmd.Schema = true
+			fakeActor.ManifestJSONSchemaReturns([]byte(`{"title": "some-schema"}`), nil)
+		})
+
+		It("displays the schema", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`{"title": "some-schema"}`))
+			Expect(fakeActor.ValidateManifestCallCount()).To(Equal(0))
+		})
+
+		Context("when a manifest is also given", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.PathToManifest = flag.PathWithExistenceCheck("some-manifest.yml")
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"PATH_TO_MANIFEST", "--schema"}}))
+			})
+		})
+	})
+})
@@ -34,20 +34,38 @@ func HandleError(err error) error {
 	case sharedaction.EmptyDirectoryError:
 		return translatableerror.EmptyDirectoryError(e)
 
+	case actionerror.AppNotFoundInManifestError:
+		return translatableerror.AppNotFoundInManifestError(e)
+	case actionerror.ApplicationDropletNotFoundError:
+		return translatableerror.ApplicationDropletNotFoundError(e)
 	case actionerror.ApplicationNotFoundError:
 		return translatableerror.ApplicationNotFoundError(e)
 	case actionerror.AssignDropletError:
 		return translatableerror.AssignDropletError(e)
+	case actionerror.HTTPHealthCheckInvalidError:
+		return translatableerror.HTTPHealthCheckInvalidError{}
 	case actionerror.IsolationSegmentNotFoundError:
 		return translatableerror.IsolationSegmentNotFoundError(e)
+	case actionerror.NoCurrentDropletError:
+		return translatableerror.NoCurrentDropletError(e)
+	case actionerror.NoPreviousDropletError:
+		return translatableerror.NoPreviousDropletError(e)
+	case actionerror.NoRollbackToUndoError:
+		return translatableerror.NoRollbackToUndoError(e)
+	case actionerror.NoRunningProcessInstancesError:
+		return translatableerror.NoRunningProcessInstancesError(e)
 	case actionerror.OrganizationNotFoundError:
 		return translatableerror.OrganizationNotFoundError(e)
 	case actionerror.ProcessInstanceNotFoundError:
 		return translatableerror.ProcessInstanceNotFoundError(e)
 	case actionerror.ProcessNotFoundError:
 		return translatableerror.ProcessNotFoundError(e)
+	case actionerror.RollbackDropletNotFoundError:
+		return translatableerror.RollbackDropletNotFoundError(e)
 	case actionerror.StagingTimeoutError:
 		return translatableerror.StagingTimeoutError(e)
+	case actionerror.UploadFailedError:
+		return translatableerror.UploadFailedError{Err: HandleError(e.Err)}
 	case actionerror.TaskWorkersUnavailableError:
 		return translatableerror.RunTaskError{Message: "Task workers are unavailable."}
 	}
@@ -53,6 +53,18 @@ var _ = Describe("HandleError", func() {
 			ccerror.APINotFoundError{URL: "some-url"},
 			translatableerror.APINotFoundError{URL: "some-url"}),
 
+		Entry("actionerror.AppNotFoundInManifestError -> AppNotFoundInManifestError",
+			actionerror.AppNotFoundInManifestError{Name: "some-app"},
+			translatableerror.AppNotFoundInManifestError{Name: "some-app"}),
+
+		Entry("actionerror.HTTPHealthCheckInvalidError -> HTTPHealthCheckInvalidError",
+			actionerror.HTTPHealthCheckInvalidError{},
+			translatableerror.HTTPHealthCheckInvalidError{}),
+
+		Entry("actionerror.ApplicationDropletNotFoundError -> ApplicationDropletNotFoundError",
+			actionerror.ApplicationDropletNotFoundError{AppName: "some-app", DropletGUID: "some-droplet-guid"},
+			translatableerror.ApplicationDropletNotFoundError{AppName: "some-app", DropletGUID: "some-droplet-guid"}),
+
 		Entry("actionerror.ApplicationNotFoundError -> ApplicationNotFoundError",
 			actionerror.ApplicationNotFoundError{Name: "some-app"},
 			translatableerror.ApplicationNotFoundError{Name: "some-app"}),
@@ -85,6 +97,26 @@ var _ = Describe("HandleError", func() {
 			actionerror.ProcessNotFoundError{ProcessType: "some-process-type"},
 			translatableerror.ProcessNotFoundError{ProcessType: "some-process-type"}),
 
+		Entry("actionerror.NoCurrentDropletError -> NoCurrentDropletError",
+			actionerror.NoCurrentDropletError{AppName: "some-app"},
+			translatableerror.NoCurrentDropletError{AppName: "some-app"}),
+
+		Entry("actionerror.NoPreviousDropletError -> NoPreviousDropletError",
+			actionerror.NoPreviousDropletError{AppName: "some-app"},
+			translatableerror.NoPreviousDropletError{AppName: "some-app"}),
+
+		Entry("actionerror.NoRollbackToUndoError -> NoRollbackToUndoError",
+			actionerror.NoRollbackToUndoError{AppName: "some-app"},
+			translatableerror.NoRollbackToUndoError{AppName: "some-app"}),
+
+		Entry("actionerror.RollbackDropletNotFoundError -> RollbackDropletNotFoundError",
+			actionerror.RollbackDropletNotFoundError{AppName: "some-app", DropletGUID: "some-droplet-guid"},
+			translatableerror.RollbackDropletNotFoundError{AppName: "some-app", DropletGUID: "some-droplet-guid"}),
+
+		Entry("actionerror.NoRunningProcessInstancesError -> NoRunningProcessInstancesError",
+			actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"},
+			translatableerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"}),
+
 		Entry("actionerror.ProcessInstanceNotFoundError -> ProcessInstanceNotFoundError",
 			actionerror.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42},
 			translatableerror.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42}),
@@ -93,6 +125,10 @@ var _ = Describe("HandleError", func() {
 			actionerror.StagingTimeoutError{AppName: "some-app", Timeout: time.Nanosecond},
 			translatableerror.StagingTimeoutError{AppName: "some-app", Timeout: time.Nanosecond}),
 
+		Entry("actionerror.UploadFailedError -> UploadFailedError",
+			actionerror.UploadFailedError{Err: errors.New("some-error")},
+			translatableerror.UploadFailedError{Err: errors.New("some-error")}),
+
 		Entry("v3action.EmptyDirectoryError -> EmptyDirectoryError",
 			sharedaction.EmptyDirectoryError{Path: "some-path"},
 			translatableerror.EmptyDirectoryError{Path: "some-path"}),
@@ -0,0 +1,98 @@
+package shared
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// maxInFlightSSHSessions bounds the number of instances that are connected to
+// at the same time.
+const maxInFlightSSHSessions = 10
+
+//go:generate counterfeiter . AllInstancesSSHActor
+
+type AllInstancesSSHActor interface {
+	ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessType(appName string, spaceGUID string, processType string, sshOptions v3action.SSHOptions, maxInFlight int, outputs v3action.SSHOutputFunc) ([]v3action.SSHInstanceResult, v3action.Warnings, error)
+}
+
+// AllInstancesSSHRunner runs a command on every running instance of an app's
+// process, prefixing each line of output with the index of the instance that
+// produced it.
+type AllInstancesSSHRunner struct {
+	UI                 command.UI
+	Config             command.Config
+	Actor              AllInstancesSSHActor
+	AppName            string
+	ProcessType        string
+	Commands           []string
+	SkipHostValidation bool
+}
+
+func (runner AllInstancesSSHRunner) Run() error {
+	var (
+		lock    sync.Mutex
+		writers []*ui.LinePrefixWriter
+	)
+	outputs := func(index uint) (io.Writer, io.Writer) {
+		prefix := fmt.Sprintf("[%d] ", index)
+		stdOut := ui.NewLinePrefixWriter(runner.UI.GetOut(), &lock, prefix)
+		stdErr := ui.NewLinePrefixWriter(runner.UI.GetErr(), &lock, prefix)
+
+		lock.Lock()
+		writers = append(writers, stdOut, stdErr)
+		lock.Unlock()
+
+		return stdOut, stdErr
+	}
+
+	results, warnings, err := runner.Actor.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessType(runner.AppName, runner.Config.TargetedSpace().GUID, runner.ProcessType, v3action.SSHOptions{
+		Commands:           runner.Commands,
+		SkipHostValidation: runner.SkipHostValidation,
+	}, maxInFlightSSHSessions, outputs)
+	for _, writer := range writers {
+		_ = writer.Flush()
+	}
+	runner.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return HandleError(err)
+	}
+
+	table := [][]string{
+		{
+			runner.UI.TranslateText("instance"),
+			runner.UI.TranslateText("exit status"),
+		},
+	}
+
+	var failed int
+	for _, result := range results {
+		status := strconv.Itoa(result.ExitStatus)
+		if result.Err != nil {
+			status = result.Err.Error()
+		}
+		if result.Err != nil || result.ExitStatus != 0 {
+			failed++
+		}
+
+		table = append(table, []string{fmt.Sprintf("#%d", result.Index), status})
+	}
+
+	runner.UI.DisplayNewline()
+	runner.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	if failed > 0 {
+		return translatableerror.SSHAllInstancesFailedError{
+			FailedCount: failed,
+			TotalCount:  len(results),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package shared_test
+
+import (
+	"errors"
+	"io"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("all instances ssh runner", func() {
+	var (
+		runner     AllInstancesSSHRunner
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *sharedfakes.FakeAllInstancesSSHActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(sharedfakes.FakeAllInstancesSSHActor)
+
+		runner = AllInstancesSSHRunner{
+			UI:                 testUI,
+			Config:             fakeConfig,
+			Actor:              fakeActor,
+			AppName:            "some-app",
+			ProcessType:        "some-process-type",
+			Commands:           []string{"some-command"},
+			SkipHostValidation: true,
+		}
+
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = runner.Run()
+	})
+
+	It("runs the command on every instance of the process", func() {
+		Expect(fakeActor.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeCallCount()).To(Equal(1))
+		appNameArg, spaceGUIDArg, processTypeArg, sshOptionsArg, maxInFlightArg, _ := fakeActor.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeArgsForCall(0)
+		Expect(appNameArg).To(Equal("some-app"))
+		Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+		Expect(processTypeArg).To(Equal("some-process-type"))
+		Expect(sshOptionsArg).To(Equal(v3action.SSHOptions{
+			Commands:           []string{"some-command"},
+			SkipHostValidation: true,
+		}))
+		Expect(maxInFlightArg).To(BeNumerically(">", 0))
+	})
+
+	Context("when every instance succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeStub = func(_ string, _ string, _ string, _ v3action.SSHOptions, _ int, outputs v3action.SSHOutputFunc) ([]v3action.SSHInstanceResult, v3action.Warnings, error) {
+				stdOut0, stdErr0 := outputs(0)
+				stdOut1, _ := outputs(1)
+				_, _ = io.WriteString(stdOut0, "hello\nwor")
+				_, _ = io.WriteString(stdOut1, "other\n")
+				_, _ = io.WriteString(stdOut0, "ld\nno newline")
+				_, _ = io.WriteString(stdErr0, "some-stderr\n")
+
+				return []v3action.SSHInstanceResult{{Index: 0}, {Index: 1}}, v3action.Warnings{"some-warning"}, nil
+			}
+		})
+
+		It("prefixes the output of each instance and displays a summary", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`\[0\] hello`))
+			Expect(testUI.Out).To(Say(`\[1\] other`))
+			Expect(testUI.Out).To(Say(`\[0\] world`))
+			Expect(testUI.Out).To(Say(`\[0\] no newline`))
+			Expect(testUI.Out).To(Say(`instance\s+exit status`))
+			Expect(testUI.Out).To(Say(`#0\s+0`))
+			Expect(testUI.Out).To(Say(`#1\s+0`))
+
+			Expect(testUI.Err).To(Say(`\[0\] some-stderr`))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	Context("when some instances fail", func() {
+		BeforeEach(func() {
+			fakeActor.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeReturns([]v3action.SSHInstanceResult{
+				{Index: 0},
+				{Index: 1, ExitStatus: 2},
+				{Index: 2, Err: errors.New("some-connection-error")},
+			}, nil, nil)
+		})
+
+		It("displays each instance's outcome and returns an SSHAllInstancesFailedError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SSHAllInstancesFailedError{FailedCount: 2, TotalCount: 3}))
+
+			Expect(testUI.Out).To(Say(`#0\s+0`))
+			Expect(testUI.Out).To(Say(`#1\s+2`))
+			Expect(testUI.Out).To(Say(`#2\s+some-connection-error`))
+		})
+	})
+
+	Context("when the actor returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeReturns(nil, v3action.Warnings{"some-warning"}, actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"})
+		})
+
+		It("displays the warnings and returns the translated error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"}))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
@@ -0,0 +1,162 @@
+package v3
+
+import (
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/progressbar"
+)
+
+//go:generate counterfeiter . SSHCopyActor
+
+type SSHCopyActor interface {
+	CloudControllerAPIVersion() string
+	ExecuteSecureCopyByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint, copyOptions v3action.SecureCopyOptions, progressBar sharedaction.ProgressBar) (v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . SSHCopyProgressBar
+
+type SSHCopyProgressBar interface {
+	NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader
+	Complete()
+}
+
+type SSHCopyCommand struct {
+	RequiredArgs       flag.SSHCopyArgs `positional-args:"yes"`
+	ProcessType        string           `long:"process" description:"App process name (Default: web)"`
+	Recursive          bool             `long:"recursive" short:"r" description:"Recursively copy directories"`
+	SkipHostValidation bool             `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	usage              interface{}      `usage:"Copy from an app instance:\n      CF_NAME ssh-copy APP_NAME:[INDEX:]REMOTE_PATH LOCAL_PATH [--process PROCESS] [-r] [--skip-host-validation]\n\n   Copy to an app instance:\n      CF_NAME ssh-copy LOCAL_PATH APP_NAME:[INDEX:]REMOTE_PATH [--process PROCESS] [-r] [--skip-host-validation]\n\nEXAMPLES:\n   CF_NAME ssh-copy my-app:2:/home/vcap/app/heap.hprof .\n   CF_NAME ssh-copy -r ./config my-app:/home/vcap/app"`
+	relatedCommands    interface{}      `related_commands:"enable-ssh, ssh, ssh-enabled"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SSHCopyActor
+	ProgressBar SSHCopyProgressBar
+}
+
+func (cmd *SSHCopyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config, clissh.NewDefaultSecureShell(ui.GetIn(), ui.GetOut(), ui.GetErr()))
+	cmd.SharedActor = sharedActor
+	cmd.ProgressBar = progressbar.NewTransferProgressBar()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+
+	cmd.Actor = v3action.NewActor(ccClient, config, sharedActor, uaaClient)
+
+	return nil
+}
+
+func (cmd SSHCopyCommand) Execute(args []string) error {
+	appPath, localPath, direction, err := cmd.parsePaths()
+	if err != nil {
+		return err
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.ProcessType == "" {
+		cmd.ProcessType = "web"
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Copying {{.Source}} to {{.Destination}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Source":      cmd.RequiredArgs.Source,
+		"Destination": cmd.RequiredArgs.Destination,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	})
+
+	warnings, err := cmd.Actor.ExecuteSecureCopyByApplicationNameSpaceProcessTypeAndIndex(appPath.AppName, cmd.Config.TargetedSpace().GUID, cmd.ProcessType, appPath.Index, v3action.SecureCopyOptions{
+		Direction:          direction,
+		LocalPath:          localPath,
+		RemotePath:         appPath.Path,
+		Recursive:          cmd.Recursive,
+		SkipHostValidation: cmd.SkipHostValidation,
+	}, cmd.ProgressBar)
+	cmd.ProgressBar.Complete()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+type appInstancePath struct {
+	AppName string
+	Index   uint
+	Path    string
+}
+
+// parsePaths determines which of the arguments refers to the app instance.
+// Exactly one of them must be of the form APP_NAME:[INDEX:]PATH.
+func (cmd SSHCopyCommand) parsePaths() (appInstancePath, string, sharedaction.FileTransferDirection, error) {
+	sourceAppPath, sourceIsApp := parseAppInstancePath(cmd.RequiredArgs.Source)
+	destinationAppPath, destinationIsApp := parseAppInstancePath(cmd.RequiredArgs.Destination)
+
+	switch {
+	case sourceIsApp && !destinationIsApp:
+		return sourceAppPath, cmd.RequiredArgs.Destination, sharedaction.FileTransferDownload, nil
+	case destinationIsApp && !sourceIsApp:
+		return destinationAppPath, cmd.RequiredArgs.Source, sharedaction.FileTransferUpload, nil
+	default:
+		return appInstancePath{}, "", 0, translatableerror.SSHCopyPathsError{}
+	}
+}
+
+func parseAppInstancePath(arg string) (appInstancePath, bool) {
+	if filepath.VolumeName(arg) != "" {
+		return appInstancePath{}, false
+	}
+
+	parts := strings.SplitN(arg, ":", 3)
+	if len(parts) < 2 || parts[0] == "" {
+		return appInstancePath{}, false
+	}
+
+	if len(parts) == 3 {
+		if index, err := strconv.ParseUint(parts[1], 10, 32); err == nil {
+			return appInstancePath{AppName: parts[0], Index: uint(index), Path: parts[2]}, true
+		}
+	}
+
+	return appInstancePath{AppName: parts[0], Path: strings.Join(parts[1:], ":")}, true
+}
@@ -0,0 +1,170 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ssh-copy Command", func() {
+	var (
+		cmd             v3.SSHCopyCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeSSHCopyActor
+		fakeProgressBar *v3fakes.FakeSSHCopyProgressBar
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeSSHCopyActor)
+		fakeProgressBar = new(v3fakes.FakeSSHCopyProgressBar)
+
+		cmd = v3.SSHCopyCommand{
+			RequiredArgs: flag.SSHCopyArgs{
+				Source:      "some-app:2:/home/vcap/app/some-file",
+				Destination: "some-local-file",
+			},
+			ProcessType:        "some-process-type",
+			Recursive:          true,
+			SkipHostValidation: true,
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	DescribeTable("invalid path combinations",
+		func(source string, destination string) {
+			cmd.RequiredArgs = flag.SSHCopyArgs{Source: source, Destination: destination}
+			Expect(cmd.Execute(nil)).To(MatchError(translatableerror.SSHCopyPathsError{}))
+		},
+		Entry("both local", "some-file", "some-other-file"),
+		Entry("both remote", "some-app:/some-file", "some-other-app:/some-file"),
+		Entry("empty app name", ":/some-file", "some-file"),
+	)
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "steve"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "steve"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is targeted to an organization and space", func() {
+		Context("when copying from the app instance", func() {
+			BeforeEach(func() {
+				fakeActor.ExecuteSecureCopyByApplicationNameSpaceProcessTypeAndIndexReturns(v3action.Warnings{"some-warnings"}, nil)
+			})
+
+			It("downloads the file and displays all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Copying some-app:2:/home/vcap/app/some-file to some-local-file in org some-org / space some-space as steve..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("some-warnings"))
+
+				Expect(fakeActor.ExecuteSecureCopyByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(1))
+				appNameArg, spaceGUIDArg, processTypeArg, processIndexArg, copyOptionsArg, progressBarArg := fakeActor.ExecuteSecureCopyByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+				Expect(appNameArg).To(Equal("some-app"))
+				Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+				Expect(processTypeArg).To(Equal("some-process-type"))
+				Expect(processIndexArg).To(Equal(uint(2)))
+				Expect(copyOptionsArg).To(Equal(v3action.SecureCopyOptions{
+					Direction:          sharedaction.FileTransferDownload,
+					LocalPath:          "some-local-file",
+					RemotePath:         "/home/vcap/app/some-file",
+					Recursive:          true,
+					SkipHostValidation: true,
+				}))
+				Expect(progressBarArg).To(Equal(fakeProgressBar))
+				Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when copying to the app instance", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs = flag.SSHCopyArgs{
+					Source:      "some-local-dir",
+					Destination: "some-app:some:path",
+				}
+				cmd.ProcessType = ""
+			})
+
+			It("uploads to index 0 of the web process", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.ExecuteSecureCopyByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(1))
+				appNameArg, _, processTypeArg, processIndexArg, copyOptionsArg, _ := fakeActor.ExecuteSecureCopyByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+				Expect(appNameArg).To(Equal("some-app"))
+				Expect(processTypeArg).To(Equal("web"))
+				Expect(processIndexArg).To(Equal(uint(0)))
+				Expect(copyOptionsArg.Direction).To(Equal(sharedaction.FileTransferUpload))
+				Expect(copyOptionsArg.LocalPath).To(Equal("some-local-dir"))
+				Expect(copyOptionsArg.RemotePath).To(Equal("some:path"))
+			})
+		})
+
+		Context("when the copy fails", func() {
+			BeforeEach(func() {
+				fakeActor.ExecuteSecureCopyByApplicationNameSpaceProcessTypeAndIndexReturns(v3action.Warnings{"some-warnings"}, errors.New("some-error"))
+			})
+
+			It("returns the error, completes the progress bar and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(errors.New("some-error")))
+				Expect(testUI.Err).To(Say("some-warnings"))
+				Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
@@ -0,0 +1,237 @@
+package v3
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . V3CleanupArtifactsActor
+
+type V3CleanupArtifactsActor interface {
+	CloudControllerAPIVersion() string
+	CleanupArtifacts(cleanup v3action.ArtifactCleanup) (v3action.Warnings, error)
+	GetApplicationArtifactsToCleanup(appName string, spaceGUID string, keep int) (v3action.ArtifactCleanup, v3action.Warnings, error)
+	GetSpaceArtifactsToCleanup(spaceGUID string, keep int) ([]v3action.ArtifactCleanup, v3action.Warnings, error)
+}
+
+type V3CleanupArtifactsCommand struct {
+	RequiredArgs    flag.OptionalAppName `positional-args:"yes"`
+	Keep            int                  `long:"keep" required:"true" description:"Number of most recent droplets and packages to keep, in addition to the current droplet"`
+	AllApps         bool                 `long:"all-apps" description:"Clean up the artifacts of every app in the targeted space"`
+	DryRun          bool                 `long:"dry-run" description:"List the artifacts that would be deleted without deleting them"`
+	Force           bool                 `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}          `usage:"CF_NAME v3-cleanup-artifacts APP_NAME --keep NUMBER [--dry-run] [-f]\n   CF_NAME v3-cleanup-artifacts --all-apps --keep NUMBER [--dry-run] [-f]"`
+	relatedCommands interface{}          `related_commands:"v3-droplets, v3-packages"`
+
+	UI          command.UI
+	Config      command.Config
+	Actor       V3CleanupArtifactsActor
+	SharedActor command.SharedActor
+}
+
+func (cmd *V3CleanupArtifactsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd V3CleanupArtifactsCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+	cmd.UI.DisplayNewline()
+
+	err := cmd.validateArgs()
+	if err != nil {
+		return err
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var (
+		cleanups []v3action.ArtifactCleanup
+		warnings v3action.Warnings
+	)
+	if cmd.AllApps {
+		cmd.UI.DisplayTextWithFlavor("Finding artifacts to clean up for all apps in org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...", map[string]interface{}{
+			"CurrentSpace": cmd.Config.TargetedSpace().Name,
+			"CurrentOrg":   cmd.Config.TargetedOrganization().Name,
+			"CurrentUser":  user.Name,
+		})
+
+		cleanups, warnings, err = cmd.Actor.GetSpaceArtifactsToCleanup(cmd.Config.TargetedSpace().GUID, cmd.Keep)
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Finding artifacts to clean up for app {{.AppName}} in org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...", map[string]interface{}{
+			"AppName":      cmd.RequiredArgs.AppName,
+			"CurrentSpace": cmd.Config.TargetedSpace().Name,
+			"CurrentOrg":   cmd.Config.TargetedOrganization().Name,
+			"CurrentUser":  user.Name,
+		})
+
+		var cleanup v3action.ArtifactCleanup
+		cleanup, warnings, err = cmd.Actor.GetApplicationArtifactsToCleanup(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.Keep)
+		if !cleanup.Empty() {
+			cleanups = append(cleanups, cleanup)
+		}
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	cmd.UI.DisplayNewline()
+
+	if len(cleanups) == 0 {
+		cmd.UI.DisplayText("No artifacts to clean up")
+		return nil
+	}
+
+	count, err := cmd.displayArtifacts(cleanups)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayNewline()
+
+	if cmd.DryRun {
+		cmd.UI.DisplayText("Dry run; no artifacts were deleted")
+		return nil
+	}
+
+	if !cmd.Force {
+		response, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete these {{.Count}} artifacts?", map[string]interface{}{
+			"Count": count,
+		})
+
+		if promptErr != nil {
+			return shared.HandleError(promptErr)
+		}
+
+		if !response {
+			cmd.UI.DisplayText("Cleanup cancelled")
+			return nil
+		}
+	}
+
+	for _, cleanup := range cleanups {
+		cmd.UI.DisplayTextWithFlavor("Deleting old artifacts of app {{.AppName}}...", map[string]interface{}{
+			"AppName": cleanup.AppName,
+		})
+
+		warnings, err = cmd.Actor.CleanupArtifacts(cleanup)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd V3CleanupArtifactsCommand) validateArgs() error {
+	switch {
+	case cmd.AllApps && cmd.RequiredArgs.AppName != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"APP_NAME", "--all-apps"},
+		}
+	case !cmd.AllApps && cmd.RequiredArgs.AppName == "":
+		return translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	case cmd.Keep < 0:
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--keep",
+			ExpectedType: "a non-negative integer",
+		}
+	}
+	return nil
+}
+
+func (cmd V3CleanupArtifactsCommand) displayArtifacts(cleanups []v3action.ArtifactCleanup) (int, error) {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("type"),
+			cmd.UI.TranslateText("guid"),
+			cmd.UI.TranslateText("state"),
+			cmd.UI.TranslateText("created"),
+		},
+	}
+
+	for _, cleanup := range cleanups {
+		for _, droplet := range cleanup.Droplets {
+			created, err := cmd.createdAt(droplet.CreatedAt)
+			if err != nil {
+				return 0, err
+			}
+
+			table = append(table, []string{
+				cleanup.AppName,
+				cmd.UI.TranslateText("droplet"),
+				droplet.GUID,
+				cmd.UI.TranslateText(strings.ToLower(string(droplet.State))),
+				created,
+			})
+		}
+
+		for _, pkg := range cleanup.Packages {
+			created, err := cmd.createdAt(pkg.CreatedAt)
+			if err != nil {
+				return 0, err
+			}
+
+			table = append(table, []string{
+				cleanup.AppName,
+				cmd.UI.TranslateText("package"),
+				pkg.GUID,
+				cmd.UI.TranslateText(strings.ToLower(string(pkg.State))),
+				created,
+			})
+		}
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return len(table) - 1, nil
+}
+
+func (cmd V3CleanupArtifactsCommand) createdAt(timestamp string) (string, error) {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return "", err
+	}
+
+	return cmd.UI.UserFriendlyDate(t), nil
+}
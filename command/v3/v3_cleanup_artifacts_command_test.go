@@ -0,0 +1,271 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-cleanup-artifacts Command", func() {
+	var (
+		cmd             v3.V3CleanupArtifactsCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3CleanupArtifactsActor
+		binaryName      string
+		executeErr      error
+		cleanup         v3action.ArtifactCleanup
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3CleanupArtifactsActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.V3CleanupArtifactsCommand{
+			RequiredArgs: flag.OptionalAppName{AppName: "some-app"},
+			Keep:         2,
+			UI:           testUI,
+			Config:       fakeConfig,
+			Actor:        fakeActor,
+			SharedActor:  fakeSharedActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+			GUID: "some-org-guid",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			Name: "some-space",
+			GUID: "some-space-guid",
+		})
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+
+		cleanup = v3action.ArtifactCleanup{
+			AppName: "some-app",
+			Droplets: []v3action.Droplet{
+				{GUID: "old-droplet-guid", State: v3action.DropletStateStaged, CreatedAt: "2017-08-14T21:16:42Z"},
+			},
+			Packages: []v3action.Package{
+				{GUID: "old-package-guid", State: ccv3.PackageStateReady, CreatedAt: "2017-08-14T21:16:42Z"},
+			},
+		}
+		fakeActor.GetApplicationArtifactsToCleanupReturns(cleanup, v3action.Warnings{"get-warning"}, nil)
+		fakeActor.CleanupArtifactsReturns(v3action.Warnings{"cleanup-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when both an app name and --all-apps are provided", func() {
+		BeforeEach(func() {
+			cmd.AllApps = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"APP_NAME", "--all-apps"},
+			}))
+		})
+	})
+
+	Context("when neither an app name nor --all-apps are provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+		})
+	})
+
+	Context("when --keep is negative", func() {
+		BeforeEach(func() {
+			cmd.Keep = -1
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "--keep",
+				ExpectedType: "a non-negative integer",
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the user is not logged in", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("return an error", func() {
+			Expect(executeErr).To(Equal(expectedErr))
+		})
+	})
+
+	Context("when there is nothing to clean up", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationArtifactsToCleanupReturns(v3action.ArtifactCleanup{AppName: "some-app"}, v3action.Warnings{"get-warning"}, nil)
+		})
+
+		It("says so and does not delete anything", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No artifacts to clean up"))
+			Expect(testUI.Err).To(Say("get-warning"))
+			Expect(fakeActor.CleanupArtifactsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --dry-run is provided", func() {
+		BeforeEach(func() {
+			cmd.DryRun = true
+		})
+
+		It("lists the artifacts without deleting them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Finding artifacts to clean up for app some-app in org some-org / space some-space as steve\\.\\.\\."))
+			Expect(testUI.Out).To(Say("app\\s+type\\s+guid\\s+state\\s+created"))
+			Expect(testUI.Out).To(Say("some-app\\s+droplet\\s+old-droplet-guid\\s+staged"))
+			Expect(testUI.Out).To(Say("some-app\\s+package\\s+old-package-guid\\s+ready"))
+			Expect(testUI.Out).To(Say("Dry run; no artifacts were deleted"))
+
+			Expect(fakeActor.GetApplicationArtifactsToCleanupCallCount()).To(Equal(1))
+			appName, spaceGUID, keep := fakeActor.GetApplicationArtifactsToCleanupArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(keep).To(Equal(2))
+
+			Expect(fakeActor.CleanupArtifactsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the user confirms the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deletes the artifacts", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Really delete these 2 artifacts\\?"))
+			Expect(testUI.Out).To(Say("Deleting old artifacts of app some-app\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("get-warning"))
+			Expect(testUI.Err).To(Say("cleanup-warning"))
+
+			Expect(fakeActor.CleanupArtifactsCallCount()).To(Equal(1))
+			Expect(fakeActor.CleanupArtifactsArgsForCall(0)).To(Equal(cleanup))
+		})
+
+		Context("when the deletion fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeActor.CleanupArtifactsReturns(v3action.Warnings{"cleanup-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("cleanup-warning"))
+			})
+		})
+	})
+
+	Context("when the user declines the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not delete anything", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Cleanup cancelled"))
+			Expect(fakeActor.CleanupArtifactsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --all-apps and -f are provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+			cmd.AllApps = true
+			cmd.Force = true
+
+			fakeActor.GetSpaceArtifactsToCleanupReturns(
+				[]v3action.ArtifactCleanup{
+					cleanup,
+					{AppName: "other-app", Packages: []v3action.Package{{GUID: "other-package-guid", State: ccv3.PackageStateFailed, CreatedAt: "2017-08-14T21:16:42Z"}}},
+				},
+				v3action.Warnings{"get-space-warning"},
+				nil,
+			)
+		})
+
+		It("deletes the artifacts of every app in the space without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Finding artifacts to clean up for all apps in org some-org / space some-space as steve\\.\\.\\."))
+			Expect(testUI.Out).To(Say("other-app\\s+package\\s+other-package-guid\\s+failed"))
+			Expect(testUI.Out).ToNot(Say("Really delete"))
+			Expect(testUI.Err).To(Say("get-space-warning"))
+
+			spaceGUID, keep := fakeActor.GetSpaceArtifactsToCleanupArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(keep).To(Equal(2))
+			Expect(fakeActor.GetApplicationArtifactsToCleanupCallCount()).To(Equal(0))
+
+			Expect(fakeActor.CleanupArtifactsCallCount()).To(Equal(2))
+			Expect(fakeActor.CleanupArtifactsArgsForCall(1).AppName).To(Equal("other-app"))
+		})
+	})
+})
@@ -0,0 +1,93 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . V3DownloadDropletActor
+
+type V3DownloadDropletActor interface {
+	CloudControllerAPIVersion() string
+	DownloadApplicationDroplet(appName string, spaceGUID string, dropletGUID string, dropletPath string) (v3action.Droplet, v3action.Warnings, error)
+}
+
+type V3DownloadDropletCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	DropletGUID     string       `long:"droplet" description:"GUID of the droplet to download (Default: the app's current droplet)"`
+	Path            flag.Path    `short:"p" required:"true" description:"Path of the file to write the droplet to"`
+	usage           interface{}  `usage:"CF_NAME v3-download-droplet APP_NAME [--droplet DROPLET_GUID] -p PATH\n\nEXAMPLES:\n   CF_NAME v3-download-droplet my-app -p my-app-droplet.tgz\n   CF_NAME v3-download-droplet my-app --droplet 3b7ad8f7-5e7d-4e11-9e8f-17d2c7f8e6b1 -p old-droplet.tgz"`
+	relatedCommands interface{}  `related_commands:"v3-droplets, v3-push"`
+
+	UI          command.UI
+	Config      command.Config
+	Actor       V3DownloadDropletActor
+	SharedActor command.SharedActor
+}
+
+func (cmd *V3DownloadDropletCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd V3DownloadDropletCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+	cmd.UI.DisplayNewline()
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Downloading droplet of app {{.AppName}} in org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":      cmd.RequiredArgs.AppName,
+		"CurrentSpace": cmd.Config.TargetedSpace().Name,
+		"CurrentOrg":   cmd.Config.TargetedOrganization().Name,
+		"CurrentUser":  user.Name,
+	})
+
+	droplet, warnings, err := cmd.Actor.DownloadApplicationDroplet(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.DropletGUID, string(cmd.Path))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayText("Droplet {{.DropletGUID}} saved to {{.Path}}", map[string]interface{}{
+		"DropletGUID": droplet.GUID,
+		"Path":        cmd.Path,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
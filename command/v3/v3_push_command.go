@@ -27,11 +27,14 @@ type V2PushActor interface {
 
 type V3PushActor interface {
 	CloudControllerAPIVersion() string
+	ConfigureApplicationProcess(appGUID string, config v3action.ProcessConfiguration) (v3action.Warnings, error)
 	CreateAndUploadBitsPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (v3action.Package, v3action.Warnings, error)
+	CreateAndUploadDropletByApplicationNameAndSpace(appName string, spaceGUID string, dropletPath string) (v3action.Droplet, v3action.Warnings, error)
 	CreateDockerPackageByApplicationNameAndSpace(appName string, spaceGUID string, dockerImageCredentials v3action.DockerImageCredentials) (v3action.Package, v3action.Warnings, error)
 	CreateApplicationInSpace(app v3action.Application, spaceGUID string) (v3action.Application, v3action.Warnings, error)
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
 	GetApplicationSummaryByNameAndSpace(appName string, spaceGUID string) (v3action.ApplicationSummary, v3action.Warnings, error)
+	GetManifestProcessConfigurations(pathToManifest string, appName string) ([]v3action.ProcessConfiguration, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error)
 	PollStart(appGUID string, warnings chan<- v3action.Warnings) error
 	SetApplicationDroplet(appName string, spaceGUID string, dropletGUID string) (v3action.Warnings, error)
@@ -48,9 +51,11 @@ type V3PushCommand struct {
 	DockerUsername string                      `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
 	NoRoute        bool                        `long:"no-route" description:"Do not map a route to this app"`
 	AppPath        flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
+	DropletPath    flag.PathWithExistenceCheck `long:"droplet" description:"Path to a tgz file with a pre-staged app, e.g. one saved with v3-download-droplet"`
+	PathToManifest flag.PathWithExistenceCheck `short:"f" description:"Path to manifest; the processes section of APP_NAME configures each process type after staging"`
 	dockerPassword interface{}                 `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
 
-	usage               interface{} `usage:"cf v3-push APP_NAME [-b BUILDPACK]... [-p APP_PATH] [--no-route]\n   cf v3-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME] [--no-route]"`
+	usage               interface{} `usage:"cf v3-push APP_NAME [-b BUILDPACK]... [-p APP_PATH] [-f MANIFEST_PATH] [--no-route]\n   cf v3-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME] [-f MANIFEST_PATH] [--no-route]\n   cf v3-push APP_NAME --droplet DROPLET_PATH [-f MANIFEST_PATH] [--no-route]"`
 	envCFStagingTimeout interface{} `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
@@ -132,6 +137,14 @@ func (cmd V3PushCommand) Execute(args []string) error {
 		return translatableerror.ConflictingBuildpacksError{}
 	}
 
+	var processConfigs []v3action.ProcessConfiguration
+	if cmd.PathToManifest != "" {
+		processConfigs, err = cmd.Actor.GetManifestProcessConfigurations(string(cmd.PathToManifest), cmd.RequiredArgs.AppName)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
 	var app v3action.Application
 	app, err = cmd.getApplication()
 	if _, ok := err.(actionerror.ApplicationNotFoundError); ok {
@@ -148,14 +161,23 @@ func (cmd V3PushCommand) Execute(args []string) error {
 		}
 	}
 
-	pkg, err := cmd.uploadPackage()
-	if err != nil {
-		return shared.HandleError(err)
-	}
+	var dropletGUID string
+	if cmd.DropletPath != "" {
+		dropletGUID, err = cmd.uploadDroplet(user.Name)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	} else {
+		var pkg v3action.Package
+		pkg, err = cmd.uploadPackage()
+		if err != nil {
+			return shared.HandleError(err)
+		}
 
-	dropletGUID, err := cmd.stagePackage(pkg, user.Name)
-	if err != nil {
-		return shared.HandleError(err)
+		dropletGUID, err = cmd.stagePackage(pkg, user.Name)
+		if err != nil {
+			return shared.HandleError(err)
+		}
 	}
 
 	if app.Started() {
@@ -170,6 +192,13 @@ func (cmd V3PushCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	for _, processConfig := range processConfigs {
+		err = cmd.configureProcess(app.GUID, processConfig, user.Name)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
 	if !cmd.NoRoute {
 		err = cmd.createAndMapRoutes(app)
 		if err != nil {
@@ -232,6 +261,18 @@ func (cmd V3PushCommand) validateArgs() error {
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{"-b", "--docker-image", "-o"},
 		}
+	case cmd.DropletPath != "" && cmd.AppPath != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--droplet", "-p"},
+		}
+	case cmd.DropletPath != "" && cmd.DockerImage.Path != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--docker-image", "-o", "--droplet"},
+		}
+	case cmd.DropletPath != "" && len(cmd.Buildpacks) > 0:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"-b", "--droplet"},
+		}
 	case cmd.DockerUsername != "" && cmd.DockerImage.Path == "":
 		return translatableerror.RequiredFlagsError{
 			Arg1: "--docker-image, -o", Arg2: "--docker-username",
@@ -368,6 +409,25 @@ func (cmd V3PushCommand) uploadPackage() (v3action.Package, error) {
 	return pkg, nil
 }
 
+func (cmd V3PushCommand) uploadDroplet(userName string) (string, error) {
+	cmd.UI.DisplayTextWithFlavor("Uploading droplet for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  userName,
+	})
+
+	droplet, warnings, err := cmd.Actor.CreateAndUploadDropletByApplicationNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, string(cmd.DropletPath))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return "", err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return droplet.GUID, nil
+}
+
 func (cmd V3PushCommand) stagePackage(pkg v3action.Package, userName string) (string, error) {
 	cmd.UI.DisplayTextWithFlavor("Staging package for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"AppName":   cmd.RequiredArgs.AppName,
@@ -413,6 +473,26 @@ func (cmd V3PushCommand) setApplicationDroplet(dropletGUID string, userName stri
 	return nil
 }
 
+func (cmd V3PushCommand) configureProcess(appGUID string, processConfig v3action.ProcessConfiguration, userName string) error {
+	cmd.UI.DisplayTextWithFlavor("Configuring process {{.ProcessType}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ProcessType": processConfig.Type,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    userName,
+	})
+
+	warnings, err := cmd.Actor.ConfigureApplicationProcess(appGUID, processConfig)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
 func (cmd V3PushCommand) startApplication(appGUID string, userName string) error {
 	cmd.UI.DisplayTextWithFlavor("Starting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"AppName":   cmd.RequiredArgs.AppName,
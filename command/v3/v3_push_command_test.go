@@ -173,6 +173,33 @@ var _ = Describe("v3-push Command", func() {
 			}),
 	)
 
+	Context("when --droplet is combined with another source flag", func() {
+		BeforeEach(func() {
+			cmd.DropletPath = "some-droplet.tgz"
+		})
+
+		It("rejects -p", func() {
+			cmd.AppPath = "some/app/path"
+			Expect(cmd.Execute(nil)).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--droplet", "-p"},
+			}))
+		})
+
+		It("rejects --docker-image", func() {
+			cmd.DockerImage.Path = "some-docker-image"
+			Expect(cmd.Execute(nil)).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--docker-image", "-o", "--droplet"},
+			}))
+		})
+
+		It("rejects -b", func() {
+			cmd.Buildpacks = []string{"ruby_buildpack"}
+			Expect(cmd.Execute(nil)).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"-b", "--droplet"},
+			}))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
@@ -958,6 +985,119 @@ var _ = Describe("v3-push Command", func() {
 						Expect(fakeActor.StartApplicationCallCount()).To(Equal(1), "Expected StartApplication to be called")
 					})
 				})
+
+				Context("when a droplet path is provided", func() {
+					BeforeEach(func() {
+						cmd.DropletPath = "some-droplet.tgz"
+						fakeActor.UpdateApplicationReturns(v3action.Application{GUID: "some-app-guid", State: "STARTED"}, nil, nil)
+						fakeActor.CreateAndUploadDropletByApplicationNameAndSpaceReturns(
+							v3action.Droplet{GUID: "some-droplet-guid", State: v3action.DropletStateStaged},
+							v3action.Warnings{"upload-droplet-warning"},
+							nil,
+						)
+					})
+
+					It("uploads the droplet instead of staging and sets it as the current droplet", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say("Uploading droplet for app some-app in org some-org / space some-space as banana\\.\\.\\."))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Out).To(Say("Stopping app some-app"))
+						Expect(testUI.Out).To(Say("Setting app some-app to droplet some-droplet-guid"))
+						Expect(testUI.Err).To(Say("upload-droplet-warning"))
+
+						Expect(fakeActor.CreateAndUploadDropletByApplicationNameAndSpaceCallCount()).To(Equal(1))
+						appName, spaceGUID, dropletPath := fakeActor.CreateAndUploadDropletByApplicationNameAndSpaceArgsForCall(0)
+						Expect(appName).To(Equal("some-app"))
+						Expect(spaceGUID).To(Equal("some-space-guid"))
+						Expect(dropletPath).To(Equal("some-droplet.tgz"))
+
+						Expect(fakeActor.CreateAndUploadBitsPackageByApplicationNameAndSpaceCallCount()).To(Equal(0))
+						Expect(fakeActor.StagePackageCallCount()).To(Equal(0))
+
+						Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(1))
+						_, _, dropletGUID := fakeActor.SetApplicationDropletArgsForCall(0)
+						Expect(dropletGUID).To(Equal("some-droplet-guid"))
+						Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+					})
+
+					Context("when uploading the droplet fails", func() {
+						BeforeEach(func() {
+							fakeActor.CreateAndUploadDropletByApplicationNameAndSpaceReturns(
+								v3action.Droplet{},
+								v3action.Warnings{"upload-droplet-warning"},
+								errors.New("some-droplet-error"),
+							)
+						})
+
+						It("returns the error without changing the app's droplet", func() {
+							Expect(executeErr).To(MatchError("some-droplet-error"))
+							Expect(testUI.Err).To(Say("upload-droplet-warning"))
+							Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+							Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(0))
+						})
+					})
+				})
+
+				Context("when a manifest path is provided", func() {
+					BeforeEach(func() {
+						cmd.PathToManifest = "some-manifest.yml"
+						fakeActor.UpdateApplicationReturns(v3action.Application{GUID: "some-app-guid", State: "STOPPED"}, nil, nil)
+						fakeActor.GetManifestProcessConfigurationsReturns(
+							[]v3action.ProcessConfiguration{
+								{Type: "web", Instances: types.NullInt{IsSet: true, Value: 2}},
+								{Type: "worker", Command: "bundle exec sidekiq"},
+							},
+							nil,
+						)
+						fakeActor.ConfigureApplicationProcessReturns(v3action.Warnings{"configure-process-warning"}, nil)
+					})
+
+					It("configures each process type after setting the droplet", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say("Setting app some-app to droplet"))
+						Expect(testUI.Out).To(Say("Configuring process web of app some-app in org some-org / space some-space as banana\\.\\.\\."))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Out).To(Say("Configuring process worker of app some-app in org some-org / space some-space as banana\\.\\.\\."))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Err).To(Say("configure-process-warning"))
+
+						pathToManifest, appName := fakeActor.GetManifestProcessConfigurationsArgsForCall(0)
+						Expect(pathToManifest).To(Equal("some-manifest.yml"))
+						Expect(appName).To(Equal("some-app"))
+
+						Expect(fakeActor.ConfigureApplicationProcessCallCount()).To(Equal(2))
+						appGUID, processConfig := fakeActor.ConfigureApplicationProcessArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(processConfig.Type).To(Equal("web"))
+						_, processConfig = fakeActor.ConfigureApplicationProcessArgsForCall(1)
+						Expect(processConfig.Command).To(Equal("bundle exec sidekiq"))
+
+						Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+					})
+
+					Context("when the app is not in the manifest", func() {
+						BeforeEach(func() {
+							fakeActor.GetManifestProcessConfigurationsReturns(nil, actionerror.AppNotFoundInManifestError{Name: "some-app"})
+						})
+
+						It("returns the error before pushing", func() {
+							Expect(executeErr).To(MatchError(translatableerror.AppNotFoundInManifestError{Name: "some-app"}))
+							Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when configuring a process fails", func() {
+						BeforeEach(func() {
+							fakeActor.ConfigureApplicationProcessReturns(v3action.Warnings{"configure-process-warning"}, actionerror.ProcessNotFoundError{ProcessType: "web"})
+						})
+
+						It("returns the error without starting the app", func() {
+							Expect(executeErr).To(MatchError(translatableerror.ProcessNotFoundError{ProcessType: "web"}))
+							Expect(testUI.Err).To(Say("configure-process-warning"))
+							Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+						})
+					})
+				})
 			})
 		})
 	})
@@ -0,0 +1,217 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . V3RollbackActor
+
+type V3RollbackActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationRollback(appName string, spaceGUID string, dropletGUID string, steps int) (v3action.Rollback, v3action.Warnings, error)
+	GetApplicationRollbackUndo(appName string, spaceGUID string) (v3action.Rollback, v3action.Warnings, error)
+	PollStart(appGUID string, warnings chan<- v3action.Warnings) error
+	RecordApplicationRollback(rollback v3action.Rollback) error
+	SetApplicationDroplet(appName string, spaceGUID string, dropletGUID string) (v3action.Warnings, error)
+	StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
+	StopApplication(appGUID string) (v3action.Warnings, error)
+}
+
+type V3RollbackCommand struct {
+	RequiredArgs        flag.AppName `positional-args:"yes"`
+	DropletGUID         string       `long:"to" description:"GUID of the staged droplet to roll back to"`
+	Steps               int          `long:"steps" description:"Number of staged droplets to go back from the current one (Default: 1)"`
+	Undo                bool         `long:"undo" description:"Restore the droplet the app ran before its last rollback"`
+	usage               interface{}  `usage:"CF_NAME v3-rollback APP_NAME [--to DROPLET_GUID | --steps NUMBER | --undo]"`
+	relatedCommands     interface{}  `related_commands:"v3-droplets, v3-set-droplet"`
+	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3RollbackActor
+}
+
+func (cmd *V3RollbackCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config, nil)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd V3RollbackCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+	cmd.UI.DisplayNewline()
+
+	err := cmd.validateArgs()
+	if err != nil {
+		return err
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Rolling back app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	var (
+		rollback v3action.Rollback
+		warnings v3action.Warnings
+	)
+	if cmd.Undo {
+		rollback, warnings, err = cmd.Actor.GetApplicationRollbackUndo(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	} else {
+		steps := cmd.Steps
+		if steps == 0 {
+			steps = 1
+		}
+		rollback, warnings, err = cmd.Actor.GetApplicationRollback(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.DropletGUID, steps)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if rollback.FromDroplet.GUID == "" {
+		cmd.UI.DisplayText("Setting droplet {{.ToDropletGUID}}; the app has no current droplet", map[string]interface{}{
+			"ToDropletGUID": rollback.ToDroplet.GUID,
+		})
+	} else {
+		cmd.UI.DisplayText("Replacing droplet {{.FromDropletGUID}} with droplet {{.ToDropletGUID}}", map[string]interface{}{
+			"FromDropletGUID": rollback.FromDroplet.GUID,
+			"ToDropletGUID":   rollback.ToDroplet.GUID,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	if rollback.Application.Started() {
+		cmd.UI.DisplayText("Stopping app...")
+		warnings, err = cmd.Actor.StopApplication(rollback.Application.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
+	warnings, err = cmd.Actor.SetApplicationDroplet(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, rollback.ToDroplet.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if rollback.FromDroplet.GUID != "" {
+		err = cmd.Actor.RecordApplicationRollback(rollback)
+		if err != nil {
+			cmd.UI.DisplayWarning("Unable to record the rollback, so it cannot be undone with --undo: {{.Error}}", map[string]interface{}{
+				"Error": err.Error(),
+			})
+		}
+	}
+
+	cmd.UI.DisplayText("Starting app...")
+	_, warnings, err = cmd.Actor.StartApplication(rollback.Application.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayText("Waiting for app to start...")
+
+	pollWarnings := make(chan v3action.Warnings)
+	done := make(chan bool)
+	go func() {
+		for {
+			select {
+			case message := <-pollWarnings:
+				cmd.UI.DisplayWarnings(message)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err = cmd.Actor.PollStart(rollback.Application.GUID, pollWarnings)
+	done <- true
+
+	if err != nil {
+		if _, ok := err.(v3action.StartupTimeoutError); ok {
+			return translatableerror.StartupTimeoutError{
+				AppName:    cmd.RequiredArgs.AppName,
+				BinaryName: cmd.Config.BinaryName(),
+			}
+		}
+
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	if rollback.FromDroplet.GUID != "" {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("To undo this rollback, run: {{.BinaryName}} v3-rollback {{.AppName}} --undo", map[string]interface{}{
+			"BinaryName": cmd.Config.BinaryName(),
+			"AppName":    cmd.RequiredArgs.AppName,
+		})
+	}
+
+	return nil
+}
+
+func (cmd V3RollbackCommand) validateArgs() error {
+	switch {
+	case cmd.DropletGUID != "" && cmd.Steps != 0:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--to", "--steps"},
+		}
+	case cmd.Undo && cmd.DropletGUID != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--to", "--undo"},
+		}
+	case cmd.Undo && cmd.Steps != 0:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--steps", "--undo"},
+		}
+	case cmd.Steps < 0:
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--steps",
+			ExpectedType: "a positive integer",
+		}
+	}
+	return nil
+}
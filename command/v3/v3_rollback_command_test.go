@@ -0,0 +1,273 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-rollback Command", func() {
+	var (
+		cmd             v3.V3RollbackCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3RollbackActor
+		binaryName      string
+		executeErr      error
+		rollback        v3action.Rollback
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3RollbackActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.V3RollbackCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			Actor:        fakeActor,
+			SharedActor:  fakeSharedActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+			GUID: "some-org-guid",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			Name: "some-space",
+			GUID: "some-space-guid",
+		})
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+
+		rollback = v3action.Rollback{
+			Application: v3action.Application{Name: "some-app", GUID: "some-app-guid", State: "STARTED"},
+			FromDroplet: v3action.Droplet{GUID: "new-droplet-guid"},
+			ToDroplet:   v3action.Droplet{GUID: "old-droplet-guid"},
+		}
+		fakeActor.GetApplicationRollbackReturns(rollback, v3action.Warnings{"get-rollback-warning"}, nil)
+		fakeActor.StopApplicationReturns(v3action.Warnings{"stop-warning"}, nil)
+		fakeActor.SetApplicationDropletReturns(v3action.Warnings{"set-droplet-warning"}, nil)
+		fakeActor.StartApplicationReturns(v3action.Application{}, v3action.Warnings{"start-warning"}, nil)
+		fakeActor.PollStartStub = func(appGUID string, warnings chan<- v3action.Warnings) error {
+			warnings <- v3action.Warnings{"poll-warning"}
+			return nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when --to and --steps are both provided", func() {
+		BeforeEach(func() {
+			cmd.DropletGUID = "some-droplet-guid"
+			cmd.Steps = 2
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--to", "--steps"},
+			}))
+		})
+	})
+
+	Context("when --undo and --to are both provided", func() {
+		BeforeEach(func() {
+			cmd.DropletGUID = "some-droplet-guid"
+			cmd.Undo = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--to", "--undo"},
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when there is no droplet to roll back to", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationRollbackReturns(v3action.Rollback{}, v3action.Warnings{"get-rollback-warning"}, actionerror.NoPreviousDropletError{AppName: "some-app"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoPreviousDropletError{AppName: "some-app"}))
+			Expect(testUI.Err).To(Say("get-rollback-warning"))
+			Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when rolling back by steps", func() {
+		It("sets the earlier droplet, records the rollback and restarts the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetApplicationRollbackCallCount()).To(Equal(1))
+			appName, spaceGUID, dropletGUID, steps := fakeActor.GetApplicationRollbackArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(dropletGUID).To(BeEmpty())
+			Expect(steps).To(Equal(1))
+
+			Expect(testUI.Out).To(Say("Rolling back app some-app in org some-org / space some-space as steve\\.\\.\\."))
+			Expect(testUI.Out).To(Say("Replacing droplet new-droplet-guid with droplet old-droplet-guid"))
+			Expect(testUI.Out).To(Say("Stopping app\\.\\.\\."))
+			Expect(testUI.Out).To(Say("Starting app\\.\\.\\."))
+			Expect(testUI.Out).To(Say("Waiting for app to start\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("To undo this rollback, run: faceman v3-rollback some-app --undo"))
+
+			Expect(testUI.Err).To(Say("get-rollback-warning"))
+			Expect(testUI.Err).To(Say("stop-warning"))
+			Expect(testUI.Err).To(Say("set-droplet-warning"))
+			Expect(testUI.Err).To(Say("start-warning"))
+			Expect(testUI.Err).To(Say("poll-warning"))
+
+			Expect(fakeActor.StopApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+			_, _, setDropletGUID := fakeActor.SetApplicationDropletArgsForCall(0)
+			Expect(setDropletGUID).To(Equal("old-droplet-guid"))
+			Expect(fakeActor.RecordApplicationRollbackArgsForCall(0)).To(Equal(rollback))
+			Expect(fakeActor.StartApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+			appGUID, _ := fakeActor.PollStartArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+		})
+
+		Context("when --steps is provided", func() {
+			BeforeEach(func() {
+				cmd.Steps = 3
+			})
+
+			It("passes the steps to the actor", func() {
+				_, _, _, steps := fakeActor.GetApplicationRollbackArgsForCall(0)
+				Expect(steps).To(Equal(3))
+			})
+		})
+
+		Context("when recording the rollback fails", func() {
+			BeforeEach(func() {
+				fakeActor.RecordApplicationRollbackReturns(errors.New("disk full"))
+			})
+
+			It("warns and still restarts the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Unable to record the rollback, so it cannot be undone with --undo: disk full"))
+				Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the app is stopped", func() {
+			BeforeEach(func() {
+				rollback.Application.State = "STOPPED"
+				fakeActor.GetApplicationRollbackReturns(rollback, nil, nil)
+			})
+
+			It("does not stop it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the app does not start in time", func() {
+			BeforeEach(func() {
+				fakeActor.PollStartReturns(v3action.StartupTimeoutError{})
+				fakeActor.PollStartStub = nil
+			})
+
+			It("returns a StartupTimeoutError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{
+					AppName:    "some-app",
+					BinaryName: binaryName,
+				}))
+			})
+		})
+	})
+
+	Context("when rolling back to a specific droplet", func() {
+		BeforeEach(func() {
+			cmd.DropletGUID = "old-droplet-guid"
+		})
+
+		It("passes the droplet GUID to the actor", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			_, _, dropletGUID, _ := fakeActor.GetApplicationRollbackArgsForCall(0)
+			Expect(dropletGUID).To(Equal("old-droplet-guid"))
+		})
+	})
+
+	Context("when undoing a rollback", func() {
+		BeforeEach(func() {
+			cmd.Undo = true
+			fakeActor.GetApplicationRollbackUndoReturns(v3action.Rollback{
+				Application: rollback.Application,
+				FromDroplet: rollback.ToDroplet,
+				ToDroplet:   rollback.FromDroplet,
+			}, v3action.Warnings{"get-undo-warning"}, nil)
+		})
+
+		It("restores the droplet from before the rollback", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetApplicationRollbackCallCount()).To(Equal(0))
+
+			appName, spaceGUID := fakeActor.GetApplicationRollbackUndoArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say("Replacing droplet old-droplet-guid with droplet new-droplet-guid"))
+			Expect(testUI.Err).To(Say("get-undo-warning"))
+			_, _, setDropletGUID := fakeActor.SetApplicationDropletArgsForCall(0)
+			Expect(setDropletGUID).To(Equal("new-droplet-guid"))
+		})
+
+		Context("when there is nothing to undo", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationRollbackUndoReturns(v3action.Rollback{}, nil, actionerror.NoRollbackToUndoError{AppName: "some-app"})
+			})
+
+			It("returns a NoRollbackToUndoError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NoRollbackToUndoError{AppName: "some-app"}))
+			})
+		})
+	})
+})
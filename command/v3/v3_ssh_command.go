@@ -19,21 +19,25 @@ import (
 type V3SSHActor interface {
 	CloudControllerAPIVersion() string
 	ExecuteSecureShellByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint, sshOptions v3action.SSHOptions) (v3action.Warnings, error)
+	ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessType(appName string, spaceGUID string, processType string, sshOptions v3action.SSHOptions, maxInFlight int, outputs v3action.SSHOutputFunc) ([]v3action.SSHInstanceResult, v3action.Warnings, error)
 }
 
 type V3SSHCommand struct {
-	RequiredArgs        flag.AppName `positional-args:"yes"`
-	ProcessIndex        uint         `long:"app-instance-index" short:"i" description:"App process instance index (Default: 0)"`
-	Commands            []string     `long:"command" short:"c" description:"Command to run"`
-	DisablePseudoTTY    bool         `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
-	ForcePseudoTTY      bool         `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
-	ForwardSpecs        []string     `short:"L" description:"Local port forward specification"`
-	ProcessType         string       `long:"process" description:"App process name (Default: web)"`
-	RequestPseudoTTY    bool         `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
-	SkipHostValidation  bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
-	SkipRemoteExecution bool         `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
-
-	usage           interface{} `usage:"cf v3-ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]\n"`
+	RequiredArgs        flag.AppName                    `positional-args:"yes"`
+	AllInstances        bool                            `long:"all-instances" description:"Run the command on every running instance of the process"`
+	ProcessIndex        uint                            `long:"app-instance-index" short:"i" description:"App process instance index (Default: 0)"`
+	Commands            []string                        `long:"command" short:"c" description:"Command to run"`
+	DisablePseudoTTY    bool                            `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
+	ForcePseudoTTY      bool                            `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
+	DynamicForwardSpecs []flag.SSHDynamicPortForwarding `short:"D" description:"Dynamic SOCKS5 port forward specification"`
+	ForwardSpecs        []flag.SSHPortForwarding        `short:"L" description:"Local port forward specification"`
+	ProcessType         string                          `long:"process" description:"App process name (Default: web)"`
+	RemoteForwardSpecs  []flag.SSHPortForwarding        `short:"R" description:"Remote port forward specification"`
+	RequestPseudoTTY    bool                            `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
+	SkipHostValidation  bool                            `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	SkipRemoteExecution bool                            `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
+
+	usage           interface{} `usage:"cf v3-ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]...\n   [-R [BIND_ADDRESS:]REMOTE_PORT:LOCAL_HOST:LOCAL_PORT]... [-D [BIND_ADDRESS:]LOCAL_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]\n\n   cf v3-ssh APP_NAME --all-instances [--process PROCESS] -c COMMAND... [--skip-host-validation]\n"`
 	relatedCommands interface{} `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
 
 	UI          command.UI
@@ -63,6 +67,13 @@ func (cmd *V3SSHCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd V3SSHCommand) Execute(args []string) error {
+	if cmd.AllInstances {
+		err := cmd.validateAllInstancesFlags()
+		if err != nil {
+			return err
+		}
+	}
+
 	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
 	if err != nil {
 		return shared.HandleError(err)
@@ -73,20 +84,35 @@ func (cmd V3SSHCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if cmd.ProcessType == "" {
+		cmd.ProcessType = "web"
+	}
+
+	if cmd.AllInstances {
+		return shared.AllInstancesSSHRunner{
+			UI:                 cmd.UI,
+			Config:             cmd.Config,
+			Actor:              cmd.Actor,
+			AppName:            cmd.RequiredArgs.AppName,
+			ProcessType:        cmd.ProcessType,
+			Commands:           cmd.Commands,
+			SkipHostValidation: cmd.SkipHostValidation,
+		}.Run()
+	}
+
 	ttyOption, err := cmd.evaluateTTYOption()
 	if err != nil {
 		return shared.HandleError(err)
 	}
 
-	if cmd.ProcessType == "" {
-		cmd.ProcessType = "web"
-	}
-
 	warnings, err := cmd.Actor.ExecuteSecureShellByApplicationNameSpaceProcessTypeAndIndex(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.ProcessType, cmd.ProcessIndex, v3action.SSHOptions{
-		Commands:            cmd.Commands,
-		SkipHostValidation:  cmd.SkipHostValidation,
-		SkipRemoteExecution: cmd.SkipRemoteExecution,
-		TTYOption:           ttyOption,
+		Commands:                cmd.Commands,
+		LocalPortForwardSpecs:   cmd.localForwardSpecs(),
+		RemotePortForwardSpecs:  cmd.remoteForwardSpecs(),
+		DynamicPortForwardSpecs: cmd.dynamicForwardSpecs(),
+		SkipHostValidation:      cmd.SkipHostValidation,
+		SkipRemoteExecution:     cmd.SkipRemoteExecution,
+		TTYOption:               ttyOption,
 	})
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -96,8 +122,77 @@ func (cmd V3SSHCommand) Execute(args []string) error {
 	return nil
 }
 
-func (cmd V3SSHCommand) parseForwardSpecs() ([]sharedaction.LocalPortForward, error) {
-	return nil, nil
+func (cmd V3SSHCommand) localForwardSpecs() []sharedaction.LocalPortForward {
+	var specs []sharedaction.LocalPortForward
+	for _, spec := range cmd.ForwardSpecs {
+		specs = append(specs, sharedaction.LocalPortForward{
+			LocalAddress:  spec.ListenAddress,
+			RemoteAddress: spec.ConnectAddress,
+		})
+	}
+	return specs
+}
+
+func (cmd V3SSHCommand) remoteForwardSpecs() []sharedaction.RemotePortForward {
+	var specs []sharedaction.RemotePortForward
+	for _, spec := range cmd.RemoteForwardSpecs {
+		specs = append(specs, sharedaction.RemotePortForward{
+			RemoteAddress: spec.ListenAddress,
+			LocalAddress:  spec.ConnectAddress,
+		})
+	}
+	return specs
+}
+
+func (cmd V3SSHCommand) dynamicForwardSpecs() []sharedaction.DynamicPortForward {
+	var specs []sharedaction.DynamicPortForward
+	for _, spec := range cmd.DynamicForwardSpecs {
+		specs = append(specs, sharedaction.DynamicPortForward{
+			LocalAddress: spec.ListenAddress,
+		})
+	}
+	return specs
+}
+
+// --all-instances runs a single non-interactive command on each instance, so
+// it cannot be combined with flags that pick an instance, forward ports or
+// allocate a tty.
+func (cmd V3SSHCommand) validateAllInstancesFlags() error {
+	if len(cmd.Commands) == 0 {
+		return translatableerror.RequiredFlagsError{Arg1: "--all-instances", Arg2: "--command, -c"}
+	}
+
+	conflicts := []string{"--all-instances"}
+	if cmd.ProcessIndex != 0 {
+		conflicts = append(conflicts, "--app-instance-index, -i")
+	}
+	if len(cmd.ForwardSpecs) > 0 {
+		conflicts = append(conflicts, "-L")
+	}
+	if len(cmd.RemoteForwardSpecs) > 0 {
+		conflicts = append(conflicts, "-R")
+	}
+	if len(cmd.DynamicForwardSpecs) > 0 {
+		conflicts = append(conflicts, "-D")
+	}
+	if cmd.SkipRemoteExecution {
+		conflicts = append(conflicts, "--skip-remote-execution, -N")
+	}
+	if cmd.DisablePseudoTTY {
+		conflicts = append(conflicts, "--disable-pseudo-tty, -T")
+	}
+	if cmd.ForcePseudoTTY {
+		conflicts = append(conflicts, "--force-pseudo-tty")
+	}
+	if cmd.RequestPseudoTTY {
+		conflicts = append(conflicts, "--request-pseudo-tty, -t")
+	}
+
+	if len(conflicts) > 1 {
+		return translatableerror.ArgumentCombinationError{Args: conflicts}
+	}
+
+	return nil
 }
 
 // tty options are mutually exclusive
@@ -40,10 +40,18 @@ var _ = Describe("v3-ssh Command", func() {
 		cmd = v3.V3SSHCommand{
 			RequiredArgs: flag.AppName{AppName: appName},
 
-			ProcessType:         "some-process-type",
-			ProcessIndex:        1,
-			Commands:            []string{"some", "commands"},
-			ForwardSpecs:        []string{"some", "ports"},
+			ProcessType:  "some-process-type",
+			ProcessIndex: 1,
+			Commands:     []string{"some", "commands"},
+			ForwardSpecs: []flag.SSHPortForwarding{
+				{ListenAddress: "localhost:8080", ConnectAddress: "localhost:80"},
+			},
+			RemoteForwardSpecs: []flag.SSHPortForwarding{
+				{ListenAddress: "localhost:9090", ConnectAddress: "localhost:90"},
+			},
+			DynamicForwardSpecs: []flag.SSHDynamicPortForwarding{
+				{ListenAddress: "localhost:1080"},
+			},
 			SkipHostValidation:  true,
 			SkipRemoteExecution: true,
 
@@ -121,8 +129,17 @@ var _ = Describe("v3-ssh Command", func() {
 				Expect(processTypeArg).To(Equal("some-process-type"))
 				Expect(processIndexArg).To(Equal(uint(1)))
 				Expect(sshOptionsArg).To(Equal(v3action.SSHOptions{
-					Commands:            []string{"some", "commands"},
-					TTYOption:           sharedaction.RequestTTYNo,
+					Commands:  []string{"some", "commands"},
+					TTYOption: sharedaction.RequestTTYNo,
+					LocalPortForwardSpecs: []sharedaction.LocalPortForward{
+						{LocalAddress: "localhost:8080", RemoteAddress: "localhost:80"},
+					},
+					RemotePortForwardSpecs: []sharedaction.RemotePortForward{
+						{RemoteAddress: "localhost:9090", LocalAddress: "localhost:90"},
+					},
+					DynamicPortForwardSpecs: []sharedaction.DynamicPortForward{
+						{LocalAddress: "localhost:1080"},
+					},
 					SkipHostValidation:  true,
 					SkipRemoteExecution: true,
 				}))
@@ -191,4 +208,77 @@ var _ = Describe("v3-ssh Command", func() {
 			)
 		})
 	})
+
+	Context("when --all-instances is provided", func() {
+		BeforeEach(func() {
+			cmd.AllInstances = true
+			cmd.ProcessIndex = 0
+			cmd.ForwardSpecs = nil
+			cmd.RemoteForwardSpecs = nil
+			cmd.DynamicForwardSpecs = nil
+			cmd.SkipRemoteExecution = false
+
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+		})
+
+		Context("when no command is provided", func() {
+			BeforeEach(func() {
+				cmd.Commands = nil
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--all-instances", Arg2: "--command, -c"}))
+				Expect(fakeActor.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when flags that conflict with --all-instances are provided", func() {
+			BeforeEach(func() {
+				cmd.ProcessIndex = 1
+				cmd.ForwardSpecs = []flag.SSHPortForwarding{{ListenAddress: "localhost:8080", ConnectAddress: "localhost:80"}}
+				cmd.RequestPseudoTTY = true
+			})
+
+			It("returns an ArgumentCombinationError listing the conflicting flags", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{
+					"--all-instances", "--app-instance-index, -i", "-L", "--request-pseudo-tty, -t",
+				}}))
+				Expect(fakeActor.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when every instance succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeReturns([]v3action.SSHInstanceResult{{Index: 0}, {Index: 1}}, v3action.Warnings{"some-warnings"}, nil)
+			})
+
+			It("runs the command on every instance of the process", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("some-warnings"))
+				Expect(testUI.Out).To(Say(`#0\s+0`))
+				Expect(testUI.Out).To(Say(`#1\s+0`))
+
+				Expect(fakeActor.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeCallCount()).To(Equal(1))
+				appNameArg, spaceGUIDArg, processTypeArg, sshOptionsArg, _, _ := fakeActor.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeArgsForCall(0)
+				Expect(appNameArg).To(Equal(appName))
+				Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+				Expect(processTypeArg).To(Equal("some-process-type"))
+				Expect(sshOptionsArg).To(Equal(v3action.SSHOptions{
+					Commands:           []string{"some", "commands"},
+					SkipHostValidation: true,
+				}))
+				Expect(fakeActor.ExecuteSecureShellByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when an instance fails", func() {
+			BeforeEach(func() {
+				fakeActor.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeReturns([]v3action.SSHInstanceResult{{Index: 0}, {Index: 1, ExitStatus: 1}}, nil, nil)
+			})
+
+			It("returns an SSHAllInstancesFailedError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.SSHAllInstancesFailedError{FailedCount: 1, TotalCount: 2}))
+			})
+		})
+	})
 })
@@ -18,6 +18,20 @@ type FakeV3PushActor struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ConfigureApplicationProcessStub        func(appGUID string, config v3action.ProcessConfiguration) (v3action.Warnings, error)
+	configureApplicationProcessMutex       sync.RWMutex
+	configureApplicationProcessArgsForCall []struct {
+		appGUID string
+		config  v3action.ProcessConfiguration
+	}
+	configureApplicationProcessReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	configureApplicationProcessReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	CreateAndUploadBitsPackageByApplicationNameAndSpaceStub        func(appName string, spaceGUID string, bitsPath string) (v3action.Package, v3action.Warnings, error)
 	createAndUploadBitsPackageByApplicationNameAndSpaceMutex       sync.RWMutex
 	createAndUploadBitsPackageByApplicationNameAndSpaceArgsForCall []struct {
@@ -35,6 +49,23 @@ type FakeV3PushActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	CreateAndUploadDropletByApplicationNameAndSpaceStub        func(appName string, spaceGUID string, dropletPath string) (v3action.Droplet, v3action.Warnings, error)
+	createAndUploadDropletByApplicationNameAndSpaceMutex       sync.RWMutex
+	createAndUploadDropletByApplicationNameAndSpaceArgsForCall []struct {
+		appName     string
+		spaceGUID   string
+		dropletPath string
+	}
+	createAndUploadDropletByApplicationNameAndSpaceReturns struct {
+		result1 v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}
+	createAndUploadDropletByApplicationNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}
 	CreateDockerPackageByApplicationNameAndSpaceStub        func(appName string, spaceGUID string, dockerImageCredentials v3action.DockerImageCredentials) (v3action.Package, v3action.Warnings, error)
 	createDockerPackageByApplicationNameAndSpaceMutex       sync.RWMutex
 	createDockerPackageByApplicationNameAndSpaceArgsForCall []struct {
@@ -100,6 +131,20 @@ type FakeV3PushActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	GetManifestProcessConfigurationsStub        func(pathToManifest string, appName string) ([]v3action.ProcessConfiguration, error)
+	getManifestProcessConfigurationsMutex       sync.RWMutex
+	getManifestProcessConfigurationsArgsForCall []struct {
+		pathToManifest string
+		appName        string
+	}
+	getManifestProcessConfigurationsReturns struct {
+		result1 []v3action.ProcessConfiguration
+		result2 error
+	}
+	getManifestProcessConfigurationsReturnsOnCall map[int]struct {
+		result1 []v3action.ProcessConfiguration
+		result2 error
+	}
 	GetStreamingLogsForApplicationByNameAndSpaceStub        func(appName string, spaceGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error)
 	getStreamingLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
 	getStreamingLogsForApplicationByNameAndSpaceArgsForCall []struct {
@@ -249,6 +294,58 @@ func (fake *FakeV3PushActor) CloudControllerAPIVersionReturnsOnCall(i int, resul
 	}{result1}
 }
 
+func (fake *FakeV3PushActor) ConfigureApplicationProcess(appGUID string, config v3action.ProcessConfiguration) (v3action.Warnings, error) {
+	fake.configureApplicationProcessMutex.Lock()
+	ret, specificReturn := fake.configureApplicationProcessReturnsOnCall[len(fake.configureApplicationProcessArgsForCall)]
+	fake.configureApplicationProcessArgsForCall = append(fake.configureApplicationProcessArgsForCall, struct {
+		appGUID string
+		config  v3action.ProcessConfiguration
+	}{appGUID, config})
+	fake.recordInvocation("ConfigureApplicationProcess", []interface{}{appGUID, config})
+	fake.configureApplicationProcessMutex.Unlock()
+	if fake.ConfigureApplicationProcessStub != nil {
+		return fake.ConfigureApplicationProcessStub(appGUID, config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.configureApplicationProcessReturns.result1, fake.configureApplicationProcessReturns.result2
+}
+
+func (fake *FakeV3PushActor) ConfigureApplicationProcessCallCount() int {
+	fake.configureApplicationProcessMutex.RLock()
+	defer fake.configureApplicationProcessMutex.RUnlock()
+	return len(fake.configureApplicationProcessArgsForCall)
+}
+
+func (fake *FakeV3PushActor) ConfigureApplicationProcessArgsForCall(i int) (string, v3action.ProcessConfiguration) {
+	fake.configureApplicationProcessMutex.RLock()
+	defer fake.configureApplicationProcessMutex.RUnlock()
+	return fake.configureApplicationProcessArgsForCall[i].appGUID, fake.configureApplicationProcessArgsForCall[i].config
+}
+
+func (fake *FakeV3PushActor) ConfigureApplicationProcessReturns(result1 v3action.Warnings, result2 error) {
+	fake.ConfigureApplicationProcessStub = nil
+	fake.configureApplicationProcessReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3PushActor) ConfigureApplicationProcessReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.ConfigureApplicationProcessStub = nil
+	if fake.configureApplicationProcessReturnsOnCall == nil {
+		fake.configureApplicationProcessReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.configureApplicationProcessReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3PushActor) CreateAndUploadBitsPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (v3action.Package, v3action.Warnings, error) {
 	fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.createAndUploadBitsPackageByApplicationNameAndSpaceReturnsOnCall[len(fake.createAndUploadBitsPackageByApplicationNameAndSpaceArgsForCall)]
@@ -305,6 +402,62 @@ func (fake *FakeV3PushActor) CreateAndUploadBitsPackageByApplicationNameAndSpace
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3PushActor) CreateAndUploadDropletByApplicationNameAndSpace(appName string, spaceGUID string, dropletPath string) (v3action.Droplet, v3action.Warnings, error) {
+	fake.createAndUploadDropletByApplicationNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.createAndUploadDropletByApplicationNameAndSpaceReturnsOnCall[len(fake.createAndUploadDropletByApplicationNameAndSpaceArgsForCall)]
+	fake.createAndUploadDropletByApplicationNameAndSpaceArgsForCall = append(fake.createAndUploadDropletByApplicationNameAndSpaceArgsForCall, struct {
+		appName     string
+		spaceGUID   string
+		dropletPath string
+	}{appName, spaceGUID, dropletPath})
+	fake.recordInvocation("CreateAndUploadDropletByApplicationNameAndSpace", []interface{}{appName, spaceGUID, dropletPath})
+	fake.createAndUploadDropletByApplicationNameAndSpaceMutex.Unlock()
+	if fake.CreateAndUploadDropletByApplicationNameAndSpaceStub != nil {
+		return fake.CreateAndUploadDropletByApplicationNameAndSpaceStub(appName, spaceGUID, dropletPath)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createAndUploadDropletByApplicationNameAndSpaceReturns.result1, fake.createAndUploadDropletByApplicationNameAndSpaceReturns.result2, fake.createAndUploadDropletByApplicationNameAndSpaceReturns.result3
+}
+
+func (fake *FakeV3PushActor) CreateAndUploadDropletByApplicationNameAndSpaceCallCount() int {
+	fake.createAndUploadDropletByApplicationNameAndSpaceMutex.RLock()
+	defer fake.createAndUploadDropletByApplicationNameAndSpaceMutex.RUnlock()
+	return len(fake.createAndUploadDropletByApplicationNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV3PushActor) CreateAndUploadDropletByApplicationNameAndSpaceArgsForCall(i int) (string, string, string) {
+	fake.createAndUploadDropletByApplicationNameAndSpaceMutex.RLock()
+	defer fake.createAndUploadDropletByApplicationNameAndSpaceMutex.RUnlock()
+	return fake.createAndUploadDropletByApplicationNameAndSpaceArgsForCall[i].appName, fake.createAndUploadDropletByApplicationNameAndSpaceArgsForCall[i].spaceGUID, fake.createAndUploadDropletByApplicationNameAndSpaceArgsForCall[i].dropletPath
+}
+
+func (fake *FakeV3PushActor) CreateAndUploadDropletByApplicationNameAndSpaceReturns(result1 v3action.Droplet, result2 v3action.Warnings, result3 error) {
+	fake.CreateAndUploadDropletByApplicationNameAndSpaceStub = nil
+	fake.createAndUploadDropletByApplicationNameAndSpaceReturns = struct {
+		result1 v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3PushActor) CreateAndUploadDropletByApplicationNameAndSpaceReturnsOnCall(i int, result1 v3action.Droplet, result2 v3action.Warnings, result3 error) {
+	fake.CreateAndUploadDropletByApplicationNameAndSpaceStub = nil
+	if fake.createAndUploadDropletByApplicationNameAndSpaceReturnsOnCall == nil {
+		fake.createAndUploadDropletByApplicationNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Droplet
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.createAndUploadDropletByApplicationNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV3PushActor) CreateDockerPackageByApplicationNameAndSpace(appName string, spaceGUID string, dockerImageCredentials v3action.DockerImageCredentials) (v3action.Package, v3action.Warnings, error) {
 	fake.createDockerPackageByApplicationNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.createDockerPackageByApplicationNameAndSpaceReturnsOnCall[len(fake.createDockerPackageByApplicationNameAndSpaceArgsForCall)]
@@ -526,6 +679,58 @@ func (fake *FakeV3PushActor) GetApplicationSummaryByNameAndSpaceReturnsOnCall(i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3PushActor) GetManifestProcessConfigurations(pathToManifest string, appName string) ([]v3action.ProcessConfiguration, error) {
+	fake.getManifestProcessConfigurationsMutex.Lock()
+	ret, specificReturn := fake.getManifestProcessConfigurationsReturnsOnCall[len(fake.getManifestProcessConfigurationsArgsForCall)]
+	fake.getManifestProcessConfigurationsArgsForCall = append(fake.getManifestProcessConfigurationsArgsForCall, struct {
+		pathToManifest string
+		appName        string
+	}{pathToManifest, appName})
+	fake.recordInvocation("GetManifestProcessConfigurations", []interface{}{pathToManifest, appName})
+	fake.getManifestProcessConfigurationsMutex.Unlock()
+	if fake.GetManifestProcessConfigurationsStub != nil {
+		return fake.GetManifestProcessConfigurationsStub(pathToManifest, appName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getManifestProcessConfigurationsReturns.result1, fake.getManifestProcessConfigurationsReturns.result2
+}
+
+func (fake *FakeV3PushActor) GetManifestProcessConfigurationsCallCount() int {
+	fake.getManifestProcessConfigurationsMutex.RLock()
+	defer fake.getManifestProcessConfigurationsMutex.RUnlock()
+	return len(fake.getManifestProcessConfigurationsArgsForCall)
+}
+
+func (fake *FakeV3PushActor) GetManifestProcessConfigurationsArgsForCall(i int) (string, string) {
+	fake.getManifestProcessConfigurationsMutex.RLock()
+	defer fake.getManifestProcessConfigurationsMutex.RUnlock()
+	return fake.getManifestProcessConfigurationsArgsForCall[i].pathToManifest, fake.getManifestProcessConfigurationsArgsForCall[i].appName
+}
+
+func (fake *FakeV3PushActor) GetManifestProcessConfigurationsReturns(result1 []v3action.ProcessConfiguration, result2 error) {
+	fake.GetManifestProcessConfigurationsStub = nil
+	fake.getManifestProcessConfigurationsReturns = struct {
+		result1 []v3action.ProcessConfiguration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3PushActor) GetManifestProcessConfigurationsReturnsOnCall(i int, result1 []v3action.ProcessConfiguration, result2 error) {
+	fake.GetManifestProcessConfigurationsStub = nil
+	if fake.getManifestProcessConfigurationsReturnsOnCall == nil {
+		fake.getManifestProcessConfigurationsReturnsOnCall = make(map[int]struct {
+			result1 []v3action.ProcessConfiguration
+			result2 error
+		})
+	}
+	fake.getManifestProcessConfigurationsReturnsOnCall[i] = struct {
+		result1 []v3action.ProcessConfiguration
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3PushActor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error) {
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall)]
@@ -906,8 +1111,12 @@ func (fake *FakeV3PushActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.configureApplicationProcessMutex.RLock()
+	defer fake.configureApplicationProcessMutex.RUnlock()
 	fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.RLock()
 	defer fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.RUnlock()
+	fake.createAndUploadDropletByApplicationNameAndSpaceMutex.RLock()
+	defer fake.createAndUploadDropletByApplicationNameAndSpaceMutex.RUnlock()
 	fake.createDockerPackageByApplicationNameAndSpaceMutex.RLock()
 	defer fake.createDockerPackageByApplicationNameAndSpaceMutex.RUnlock()
 	fake.createApplicationInSpaceMutex.RLock()
@@ -916,6 +1125,8 @@ func (fake *FakeV3PushActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	fake.getManifestProcessConfigurationsMutex.RLock()
+	defer fake.getManifestProcessConfigurationsMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
 	fake.pollStartMutex.RLock()
@@ -35,6 +35,26 @@ type FakeV3SSHActor struct {
 		result1 v3action.Warnings
 		result2 error
 	}
+	ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeStub        func(appName string, spaceGUID string, processType string, sshOptions v3action.SSHOptions, maxInFlight int, outputs v3action.SSHOutputFunc) ([]v3action.SSHInstanceResult, v3action.Warnings, error)
+	executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeMutex       sync.RWMutex
+	executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeArgsForCall []struct {
+		appName     string
+		spaceGUID   string
+		processType string
+		sshOptions  v3action.SSHOptions
+		maxInFlight int
+		outputs     v3action.SSHOutputFunc
+	}
+	executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeReturns struct {
+		result1 []v3action.SSHInstanceResult
+		result2 v3action.Warnings
+		result3 error
+	}
+	executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeReturnsOnCall map[int]struct {
+		result1 []v3action.SSHInstanceResult
+		result2 v3action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -134,6 +154,65 @@ func (fake *FakeV3SSHActor) ExecuteSecureShellByApplicationNameSpaceProcessTypeA
 	}{result1, result2}
 }
 
+func (fake *FakeV3SSHActor) ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessType(appName string, spaceGUID string, processType string, sshOptions v3action.SSHOptions, maxInFlight int, outputs v3action.SSHOutputFunc) ([]v3action.SSHInstanceResult, v3action.Warnings, error) {
+	fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeMutex.Lock()
+	ret, specificReturn := fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeReturnsOnCall[len(fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeArgsForCall)]
+	fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeArgsForCall = append(fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeArgsForCall, struct {
+		appName     string
+		spaceGUID   string
+		processType string
+		sshOptions  v3action.SSHOptions
+		maxInFlight int
+		outputs     v3action.SSHOutputFunc
+	}{appName, spaceGUID, processType, sshOptions, maxInFlight, outputs})
+	fake.recordInvocation("ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessType", []interface{}{appName, spaceGUID, processType, sshOptions, maxInFlight, outputs})
+	fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeMutex.Unlock()
+	if fake.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeStub != nil {
+		return fake.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeStub(appName, spaceGUID, processType, sshOptions, maxInFlight, outputs)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeReturns.result1, fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeReturns.result2, fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeReturns.result3
+}
+
+func (fake *FakeV3SSHActor) ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeCallCount() int {
+	fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeMutex.RLock()
+	defer fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeMutex.RUnlock()
+	return len(fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeArgsForCall)
+}
+
+func (fake *FakeV3SSHActor) ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeArgsForCall(i int) (string, string, string, v3action.SSHOptions, int, v3action.SSHOutputFunc) {
+	fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeMutex.RLock()
+	defer fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeMutex.RUnlock()
+	return fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeArgsForCall[i].appName, fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeArgsForCall[i].spaceGUID, fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeArgsForCall[i].processType, fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeArgsForCall[i].sshOptions, fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeArgsForCall[i].maxInFlight, fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeArgsForCall[i].outputs
+}
+
+func (fake *FakeV3SSHActor) ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeReturns(result1 []v3action.SSHInstanceResult, result2 v3action.Warnings, result3 error) {
+	fake.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeStub = nil
+	fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeReturns = struct {
+		result1 []v3action.SSHInstanceResult
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3SSHActor) ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeReturnsOnCall(i int, result1 []v3action.SSHInstanceResult, result2 v3action.Warnings, result3 error) {
+	fake.ExecuteSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeStub = nil
+	if fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeReturnsOnCall == nil {
+		fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeReturnsOnCall = make(map[int]struct {
+			result1 []v3action.SSHInstanceResult
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeReturnsOnCall[i] = struct {
+		result1 []v3action.SSHInstanceResult
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV3SSHActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -141,6 +220,8 @@ func (fake *FakeV3SSHActor) Invocations() map[string][][]interface{} {
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.executeSecureShellByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
 	defer fake.executeSecureShellByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
+	fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeMutex.RLock()
+	defer fake.executeSecureShellOnAllInstancesByApplicationNameSpaceAndProcessTypeMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
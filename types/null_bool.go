@@ -0,0 +1,40 @@
+package types
+
+import "encoding/json"
+
+// NullBool is a wrapper around bool values that can be null or a bool. Use
+// IsSet to check if the value is provided, instead of checking against false.
+type NullBool struct {
+	IsSet bool
+	Value bool
+}
+
+// ParseBoolValue is used to parse a user provided *bool argument.
+func (n *NullBool) ParseBoolValue(val *bool) {
+	if val == nil {
+		n.IsSet = false
+		n.Value = false
+		return
+	}
+
+	n.Value = *val
+	n.IsSet = true
+}
+
+func (n *NullBool) UnmarshalJSON(rawJSON []byte) error {
+	var value *bool
+	err := json.Unmarshal(rawJSON, &value)
+	if err != nil {
+		return err
+	}
+
+	n.ParseBoolValue(value)
+	return nil
+}
+
+func (n NullBool) MarshalJSON() ([]byte, error) {
+	if n.IsSet {
+		return json.Marshal(n.Value)
+	}
+	return []byte("null"), nil
+}
@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	. "code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NullBool", func() {
+	var nullBool NullBool
+
+	BeforeEach(func() {
+		nullBool = NullBool{}
+	})
+
+	Describe("ParseBoolValue", func() {
+		Context("when nil is provided", func() {
+			It("sets IsSet to false", func() {
+				nullBool.ParseBoolValue(nil)
+				Expect(nullBool).To(Equal(NullBool{Value: false, IsSet: false}))
+			})
+		})
+
+		Context("when non-nil pointer is provided", func() {
+			It("sets IsSet to true and Value to provided value", func() {
+				b := true
+				nullBool.ParseBoolValue(&b)
+				Expect(nullBool).To(Equal(NullBool{Value: true, IsSet: true}))
+			})
+		})
+	})
+
+	DescribeTable("UnmarshalJSON",
+		func(input string, expected NullBool) {
+			err := nullBool.UnmarshalJSON([]byte(input))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nullBool).To(Equal(expected))
+		},
+		Entry("false", "false", NullBool{Value: false, IsSet: true}),
+		Entry("true", "true", NullBool{Value: true, IsSet: true}),
+		Entry("null", "null", NullBool{Value: false, IsSet: false}),
+	)
+
+	DescribeTable("MarshalJSON",
+		func(input NullBool, expected string) {
+			bytes, err := input.MarshalJSON()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(bytes)).To(Equal(expected))
+		},
+		Entry("false", NullBool{Value: false, IsSet: true}, "false"),
+		Entry("true", NullBool{Value: true, IsSet: true}, "true"),
+		Entry("null", NullBool{Value: false, IsSet: false}, "null"),
+	)
+})
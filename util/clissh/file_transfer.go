@@ -0,0 +1,206 @@
+package clissh
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/sftp"
+)
+
+type FileTransferDirection int
+
+const (
+	FileTransferDownload FileTransferDirection = iota
+	FileTransferUpload
+)
+
+// FileTransferSpec describes a copy between the local file system and an app
+// instance's file system.
+type FileTransferSpec struct {
+	Direction  FileTransferDirection
+	LocalPath  string
+	RemotePath string
+	Recursive  bool
+}
+
+//go:generate counterfeiter . ProgressBar
+
+type ProgressBar interface {
+	NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader
+}
+
+// TransferFiles copies files to or from the connected app instance over an
+// SFTP subsystem session. Directories are only copied when spec.Recursive is
+// set.
+func (c *SecureShell) TransferFiles(spec FileTransferSpec, progressBar ProgressBar) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	inPipe, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.RequestSubsystem("sftp")
+	if err != nil {
+		return fmt.Errorf("SFTP subsystem request failed: %s", err.Error())
+	}
+
+	client, err := sftp.NewClientPipe(outPipe, inPipe)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	if spec.Direction == FileTransferUpload {
+		return upload(client, spec.LocalPath, spec.RemotePath, spec.Recursive, progressBar)
+	}
+	return download(client, spec.RemotePath, spec.LocalPath, spec.Recursive, progressBar)
+}
+
+func download(client *sftp.Client, remotePath string, localPath string, recursive bool, progressBar ProgressBar) error {
+	remoteInfo, err := client.Stat(remotePath)
+	if err != nil {
+		return fmt.Errorf("%s: %s", remotePath, err.Error())
+	}
+
+	if localInfo, statErr := os.Stat(localPath); statErr == nil && localInfo.IsDir() {
+		localPath = filepath.Join(localPath, path.Base(remotePath))
+	}
+
+	if !remoteInfo.IsDir() {
+		return downloadFile(client, remotePath, localPath, remoteInfo, progressBar)
+	}
+
+	if !recursive {
+		return fmt.Errorf("%s: is a directory (use --recursive to copy directories)", remotePath)
+	}
+
+	walker := client.Walk(remotePath)
+	for walker.Step() {
+		if err = walker.Err(); err != nil {
+			return err
+		}
+
+		relativePath := strings.TrimPrefix(walker.Path(), remotePath)
+		target := filepath.Join(localPath, filepath.FromSlash(relativePath))
+
+		if walker.Stat().IsDir() {
+			err = os.MkdirAll(target, walker.Stat().Mode().Perm()|0700)
+		} else {
+			err = downloadFile(client, walker.Path(), target, walker.Stat(), progressBar)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func downloadFile(client *sftp.Client, remotePath string, localPath string, remoteInfo os.FileInfo, progressBar ProgressBar) error {
+	source, err := client.Open(remotePath)
+	if err != nil {
+		return fmt.Errorf("%s: %s", remotePath, err.Error())
+	}
+	defer source.Close()
+
+	destination, err := os.OpenFile(localPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, remoteInfo.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	defer destination.Close()
+
+	_, err = io.Copy(destination, progressBar.NewProgressBarWrapper(source, remoteInfo.Size()))
+	return err
+}
+
+func upload(client *sftp.Client, localPath string, remotePath string, recursive bool, progressBar ProgressBar) error {
+	localInfo, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+
+	if remoteInfo, statErr := client.Stat(remotePath); statErr == nil && remoteInfo.IsDir() {
+		remotePath = path.Join(remotePath, filepath.Base(localPath))
+	}
+
+	if !localInfo.IsDir() {
+		return uploadFile(client, localPath, remotePath, localInfo, progressBar)
+	}
+
+	if !recursive {
+		return fmt.Errorf("%s: is a directory (use --recursive to copy directories)", localPath)
+	}
+
+	return filepath.Walk(localPath, func(currentPath string, info os.FileInfo, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+
+		relativePath, err := filepath.Rel(localPath, currentPath)
+		if err != nil {
+			return err
+		}
+		target := path.Join(remotePath, filepath.ToSlash(relativePath))
+
+		if info.IsDir() {
+			return mkdirRemote(client, target, info.Mode().Perm())
+		}
+		return uploadFile(client, currentPath, target, info, progressBar)
+	})
+}
+
+func uploadFile(client *sftp.Client, localPath string, remotePath string, localInfo os.FileInfo, progressBar ProgressBar) error {
+	source, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	destination, err := client.OpenFile(remotePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		return fmt.Errorf("%s: %s", remotePath, err.Error())
+	}
+	defer destination.Close()
+
+	_, err = io.Copy(destination, progressBar.NewProgressBarWrapper(source, localInfo.Size()))
+	if err != nil {
+		return err
+	}
+
+	return client.Chmod(remotePath, localInfo.Mode().Perm())
+}
+
+func mkdirRemote(client *sftp.Client, remotePath string, mode os.FileMode) error {
+	if info, err := client.Stat(remotePath); err == nil {
+		if !info.IsDir() {
+			return fmt.Errorf("%s: not a directory", remotePath)
+		}
+		return nil
+	}
+
+	err := client.Mkdir(remotePath)
+	if err != nil {
+		return fmt.Errorf("%s: %s", remotePath, err.Error())
+	}
+	return client.Chmod(remotePath, mode|0700)
+}
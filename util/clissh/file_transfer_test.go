@@ -0,0 +1,207 @@
+// +build !windows,!386
+
+package clissh_test
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/util/clissh/clisshfakes"
+	"code.cloudfoundry.org/diego-ssh/test_helpers/fake_ssh"
+	"github.com/pkg/sftp"
+
+	. "code.cloudfoundry.org/cli/util/clissh"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type sftpServerConn struct {
+	io.Reader
+	io.WriteCloser
+}
+
+var _ = Describe("File Transfer", func() {
+	var (
+		fakeSecureDialer  *clisshfakes.FakeSecureDialer
+		fakeSecureClient  *clisshfakes.FakeSecureClient
+		fakeSecureSession *clisshfakes.FakeSecureSession
+		fakeProgressBar   *clisshfakes.FakeProgressBar
+
+		secureShell *SecureShell
+		remoteDir   string
+		localDir    string
+		spec        FileTransferSpec
+		transferErr error
+	)
+
+	BeforeEach(func() {
+		fakeSecureDialer = new(clisshfakes.FakeSecureDialer)
+		fakeSecureClient = new(clisshfakes.FakeSecureClient)
+		fakeSecureSession = new(clisshfakes.FakeSecureSession)
+		fakeProgressBar = new(clisshfakes.FakeProgressBar)
+
+		fakeSecureDialer.DialReturns(fakeSecureClient, nil)
+		fakeSecureClient.NewSessionReturns(fakeSecureSession, nil)
+		fakeSecureClient.ConnReturns(new(fake_ssh.FakeConn))
+		fakeProgressBar.NewProgressBarWrapperStub = func(reader io.Reader, _ int64) io.Reader {
+			return reader
+		}
+
+		clientReader, clientWriter := io.Pipe()
+		serverReader, serverWriter := io.Pipe()
+		fakeSecureSession.StdinPipeReturns(clientWriter, nil)
+		fakeSecureSession.StdoutPipeReturns(serverReader, nil)
+		fakeSecureSession.RequestSubsystemStub = func(string) error {
+			server, err := sftp.NewServer(sftpServerConn{Reader: clientReader, WriteCloser: serverWriter})
+			Expect(err).ToNot(HaveOccurred())
+			go func() {
+				_ = server.Serve()
+				_ = serverWriter.Close()
+			}()
+			return nil
+		}
+
+		var err error
+		remoteDir, err = ioutil.TempDir("", "clissh-remote")
+		Expect(err).ToNot(HaveOccurred())
+		localDir, err = ioutil.TempDir("", "clissh-local")
+		Expect(err).ToNot(HaveOccurred())
+
+		secureShell = NewSecureShell(
+			fakeSecureDialer,
+			new(clisshfakes.FakeTerminalHelper),
+			new(clisshfakes.FakeListenerFactory),
+			time.Minute,
+			nil,
+			nil,
+			nil,
+		)
+		Expect(secureShell.Connect("some-user", "some-passcode", "some-endpoint", "", true)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(remoteDir)).To(Succeed())
+		Expect(os.RemoveAll(localDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		transferErr = secureShell.TransferFiles(spec, fakeProgressBar)
+	})
+
+	Context("when downloading a file", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(filepath.Join(remoteDir, "heap.dump"), []byte("some-heap"), 0640)).To(Succeed())
+			spec = FileTransferSpec{
+				Direction:  FileTransferDownload,
+				RemotePath: filepath.Join(remoteDir, "heap.dump"),
+				LocalPath:  localDir,
+			}
+		})
+
+		It("copies the file into the local directory over the sftp subsystem", func() {
+			Expect(transferErr).ToNot(HaveOccurred())
+			Expect(fakeSecureSession.RequestSubsystemArgsForCall(0)).To(Equal("sftp"))
+
+			contents, err := ioutil.ReadFile(filepath.Join(localDir, "heap.dump"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-heap"))
+
+			Expect(fakeProgressBar.NewProgressBarWrapperCallCount()).To(Equal(1))
+			_, size := fakeProgressBar.NewProgressBarWrapperArgsForCall(0)
+			Expect(size).To(BeEquivalentTo(len("some-heap")))
+		})
+	})
+
+	Context("when downloading a directory", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Join(remoteDir, "logs", "nested"), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(remoteDir, "logs", "a.log"), []byte("a"), 0644)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(remoteDir, "logs", "nested", "b.log"), []byte("b"), 0644)).To(Succeed())
+			spec = FileTransferSpec{
+				Direction:  FileTransferDownload,
+				RemotePath: filepath.Join(remoteDir, "logs"),
+				LocalPath:  filepath.Join(localDir, "copied-logs"),
+			}
+		})
+
+		Context("when recursive is not set", func() {
+			It("returns an error", func() {
+				Expect(transferErr).To(MatchError(ContainSubstring("is a directory")))
+			})
+		})
+
+		Context("when recursive is set", func() {
+			BeforeEach(func() {
+				spec.Recursive = true
+			})
+
+			It("copies the directory tree", func() {
+				Expect(transferErr).ToNot(HaveOccurred())
+
+				contents, err := ioutil.ReadFile(filepath.Join(localDir, "copied-logs", "a.log"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("a"))
+
+				contents, err = ioutil.ReadFile(filepath.Join(localDir, "copied-logs", "nested", "b.log"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("b"))
+			})
+		})
+	})
+
+	Context("when uploading a directory", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Join(localDir, "config", "nested"), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(localDir, "config", "nested", "app.yml"), []byte("some-config"), 0600)).To(Succeed())
+			spec = FileTransferSpec{
+				Direction:  FileTransferUpload,
+				LocalPath:  filepath.Join(localDir, "config"),
+				RemotePath: remoteDir,
+				Recursive:  true,
+			}
+		})
+
+		It("copies the directory tree into the remote directory", func() {
+			Expect(transferErr).ToNot(HaveOccurred())
+
+			remoteFile := filepath.Join(remoteDir, "config", "nested", "app.yml")
+			contents, err := ioutil.ReadFile(remoteFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-config"))
+
+			info, err := os.Stat(remoteFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+	})
+
+	Context("when the remote file does not exist", func() {
+		BeforeEach(func() {
+			spec = FileTransferSpec{
+				Direction:  FileTransferDownload,
+				RemotePath: filepath.Join(remoteDir, "missing"),
+				LocalPath:  localDir,
+			}
+		})
+
+		It("returns an error", func() {
+			Expect(transferErr).To(MatchError(ContainSubstring("missing")))
+		})
+	})
+
+	Context("when requesting the sftp subsystem fails", func() {
+		BeforeEach(func() {
+			fakeSecureSession.RequestSubsystemStub = nil
+			fakeSecureSession.RequestSubsystemReturns(errors.New("subsystem-error"))
+		})
+
+		It("returns the error and closes the session", func() {
+			Expect(transferErr).To(MatchError("SFTP subsystem request failed: subsystem-error"))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,71 @@
+package knownhosts
+
+import (
+	"bytes"
+	"encoding/base64"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// HostKeyChangedError is returned when the SSH proxy presents a different key
+// than the one trusted for the API endpoint.
+type HostKeyChangedError struct {
+	APIEndpoint         string
+	SSHEndpoint         string
+	TrustedFingerprint  string
+	ReceivedFingerprint string
+}
+
+func (e HostKeyChangedError) Error() string {
+	return "@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@\n" +
+		"@    WARNING: REMOTE HOST IDENTIFICATION HAS CHANGED!     @\n" +
+		"@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@\n" +
+		"IT IS POSSIBLE THAT SOMEONE IS DOING SOMETHING NASTY!\n" +
+		"Someone could be eavesdropping on you right now (man-in-the-middle attack)!\n" +
+		"It is also possible that the SSH host key for " + e.APIEndpoint + " has just been changed.\n" +
+		"The fingerprint of the key sent by " + e.SSHEndpoint + " is " + e.ReceivedFingerprint + ".\n" +
+		"The trusted fingerprint is " + e.TrustedFingerprint + ".\n" +
+		"If the change is expected, remove the trusted key with 'remove-ssh-known-host' and try again."
+}
+
+// Checker verifies host keys for a single API endpoint, trusting the first
+// key it sees.
+type Checker struct {
+	Store       *Store
+	APIEndpoint string
+}
+
+// NewChecker returns a Checker for apiEndpoint backed by the known hosts file
+// at path.
+func NewChecker(path string, apiEndpoint string) Checker {
+	return Checker{
+		Store:       NewStore(path),
+		APIEndpoint: apiEndpoint,
+	}
+}
+
+// CheckHostKey compares key to the key trusted for the API endpoint. When no
+// key has been trusted yet, key is recorded and firstUse is true.
+func (checker Checker) CheckHostKey(sshEndpoint string, key ssh.PublicKey) (bool, error) {
+	host, exists, err := checker.Store.Get(checker.APIEndpoint)
+	if err != nil {
+		return false, err
+	}
+
+	if !exists {
+		_, err = checker.Store.Trust(checker.APIEndpoint, sshEndpoint, key)
+		return err == nil, err
+	}
+
+	trustedKey, err := base64.StdEncoding.DecodeString(host.PublicKey)
+	if err != nil || !bytes.Equal(trustedKey, key.Marshal()) {
+		return false, HostKeyChangedError{
+			APIEndpoint:         checker.APIEndpoint,
+			SSHEndpoint:         sshEndpoint,
+			TrustedFingerprint:  host.Fingerprint,
+			ReceivedFingerprint: Fingerprint(key),
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,89 @@
+package knownhosts_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/clissh/knownhosts"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+)
+
+var _ = Describe("Checker", func() {
+	var (
+		tempDir string
+		checker Checker
+		key     ssh.PublicKey
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "known-hosts")
+		Expect(err).ToNot(HaveOccurred())
+
+		checker = NewChecker(filepath.Join(tempDir, "ssh_known_hosts.json"), "https://api.example.com")
+		key = generatePublicKey()
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	Context("when no key is trusted for the API endpoint", func() {
+		It("trusts the key", func() {
+			firstUse, err := checker.CheckHostKey("ssh.example.com:2222", key)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(firstUse).To(BeTrue())
+
+			host, exists, err := checker.Store.Get("https://api.example.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			Expect(host.Fingerprint).To(Equal(Fingerprint(key)))
+		})
+	})
+
+	Context("when a key is trusted for the API endpoint", func() {
+		BeforeEach(func() {
+			_, err := checker.Store.Trust("https://api.example.com", "ssh.example.com:2222", key)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("accepts the same key", func() {
+			firstUse, err := checker.CheckHostKey("ssh.example.com:2222", key)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(firstUse).To(BeFalse())
+		})
+
+		It("rejects a different key without trusting it", func() {
+			otherKey := generatePublicKey()
+
+			_, err := checker.CheckHostKey("ssh.example.com:2222", otherKey)
+			Expect(err).To(MatchError(HostKeyChangedError{
+				APIEndpoint:         "https://api.example.com",
+				SSHEndpoint:         "ssh.example.com:2222",
+				TrustedFingerprint:  Fingerprint(key),
+				ReceivedFingerprint: Fingerprint(otherKey),
+			}))
+			Expect(err.Error()).To(ContainSubstring("REMOTE HOST IDENTIFICATION HAS CHANGED"))
+
+			host, _, err := checker.Store.Get("https://api.example.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(host.Fingerprint).To(Equal(Fingerprint(key)))
+		})
+	})
+
+	Context("when a key is trusted for a different API endpoint", func() {
+		BeforeEach(func() {
+			_, err := checker.Store.Trust("https://api.other.example.com", "ssh.example.com:2222", generatePublicKey())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("trusts the key for this API endpoint", func() {
+			firstUse, err := checker.CheckHostKey("ssh.example.com:2222", key)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(firstUse).To(BeTrue())
+		})
+	})
+})
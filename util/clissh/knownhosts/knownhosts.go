@@ -0,0 +1,166 @@
+// Package knownhosts records the SSH proxy host keys the CLI has connected to,
+// keyed by Cloud Controller API endpoint, so that a changed key is detected
+// even when the fingerprint advertised by the API changes along with it.
+package knownhosts
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// Host is an SSH proxy host key trusted for an API endpoint.
+type Host struct {
+	APIEndpoint string    `json:"-"`
+	SSHEndpoint string    `json:"SSHEndpoint"`
+	KeyType     string    `json:"KeyType"`
+	PublicKey   string    `json:"PublicKey"`
+	Fingerprint string    `json:"Fingerprint"`
+	TrustedAt   time.Time `json:"TrustedAt"`
+}
+
+// Store reads and writes the known hosts file.
+type Store struct {
+	path string
+}
+
+// NewStore returns a Store backed by the file at path. The file does not need
+// to exist.
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// Hosts returns every trusted host sorted by API endpoint.
+func (store *Store) Hosts() ([]Host, error) {
+	hosts, err := store.load()
+	if err != nil {
+		return nil, err
+	}
+
+	var sortedHosts []Host
+	for _, host := range hosts {
+		sortedHosts = append(sortedHosts, host)
+	}
+	sort.Slice(sortedHosts, func(i int, j int) bool {
+		return sortedHosts[i].APIEndpoint < sortedHosts[j].APIEndpoint
+	})
+
+	return sortedHosts, nil
+}
+
+// Get returns the host trusted for apiEndpoint and true if one exists.
+func (store *Store) Get(apiEndpoint string) (Host, bool, error) {
+	hosts, err := store.load()
+	if err != nil {
+		return Host{}, false, err
+	}
+
+	host, exists := hosts[apiEndpoint]
+	return host, exists, nil
+}
+
+// Trust records key as the host key for apiEndpoint, replacing any key
+// previously trusted for it.
+func (store *Store) Trust(apiEndpoint string, sshEndpoint string, key ssh.PublicKey) (Host, error) {
+	hosts, err := store.load()
+	if err != nil {
+		return Host{}, err
+	}
+
+	host := Host{
+		APIEndpoint: apiEndpoint,
+		SSHEndpoint: sshEndpoint,
+		KeyType:     key.Type(),
+		PublicKey:   base64.StdEncoding.EncodeToString(key.Marshal()),
+		Fingerprint: Fingerprint(key),
+		TrustedAt:   time.Now().UTC(),
+	}
+	hosts[apiEndpoint] = host
+
+	return host, store.write(hosts)
+}
+
+// Remove deletes the host trusted for apiEndpoint. It returns false if no
+// host was trusted for it.
+func (store *Store) Remove(apiEndpoint string) (bool, error) {
+	hosts, err := store.load()
+	if err != nil {
+		return false, err
+	}
+
+	if _, exists := hosts[apiEndpoint]; !exists {
+		return false, nil
+	}
+	delete(hosts, apiEndpoint)
+
+	return true, store.write(hosts)
+}
+
+// Fingerprint returns the SHA256 fingerprint of key in the format used by
+// OpenSSH.
+func Fingerprint(key ssh.PublicKey) string {
+	sum := sha256.Sum256(key.Marshal())
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+}
+
+func (store *Store) load() (map[string]Host, error) {
+	hosts := map[string]Host{}
+
+	rawHosts, err := ioutil.ReadFile(store.path)
+	if os.IsNotExist(err) {
+		return hosts, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rawHosts) == 0 {
+		return hosts, nil
+	}
+
+	err = json.Unmarshal(rawHosts, &hosts)
+	if err != nil {
+		return nil, err
+	}
+
+	for apiEndpoint, host := range hosts {
+		host.APIEndpoint = apiEndpoint
+		hosts[apiEndpoint] = host
+	}
+
+	return hosts, nil
+}
+
+func (store *Store) write(hosts map[string]Host) error {
+	rawHosts, err := json.MarshalIndent(hosts, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(store.path)
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(dir, "temp-known-hosts")
+	if err != nil {
+		return err
+	}
+	tempFile.Close()
+
+	err = ioutil.WriteFile(tempFile.Name(), rawHosts, 0600)
+	if err != nil {
+		_ = os.Remove(tempFile.Name())
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), store.path)
+}
@@ -0,0 +1,28 @@
+package knownhosts_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+
+	"testing"
+)
+
+func TestKnownHosts(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Known Hosts Suite")
+}
+
+func generatePublicKey() ssh.PublicKey {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+
+	publicKey, err := ssh.NewPublicKey(&privateKey.PublicKey)
+	Expect(err).ToNot(HaveOccurred())
+
+	return publicKey
+}
@@ -0,0 +1,121 @@
+package knownhosts_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/clissh/knownhosts"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+)
+
+var _ = Describe("Store", func() {
+	var (
+		tempDir string
+		path    string
+		store   *Store
+		key     ssh.PublicKey
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "known-hosts")
+		Expect(err).ToNot(HaveOccurred())
+
+		path = filepath.Join(tempDir, ".cf", "ssh_known_hosts.json")
+		store = NewStore(path)
+		key = generatePublicKey()
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	Context("when the file does not exist", func() {
+		It("has no hosts", func() {
+			hosts, err := store.Hosts()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hosts).To(BeEmpty())
+
+			_, exists, err := store.Get("https://api.example.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+	})
+
+	Context("when the file contains invalid JSON", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(path), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(path, []byte("not-json"), 0600)).To(Succeed())
+		})
+
+		It("returns an error", func() {
+			_, err := store.Hosts()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Trust", func() {
+		It("records the key for the API endpoint in a file only the user can read", func() {
+			host, err := store.Trust("https://api.example.com", "ssh.example.com:2222", key)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(host.APIEndpoint).To(Equal("https://api.example.com"))
+			Expect(host.SSHEndpoint).To(Equal("ssh.example.com:2222"))
+			Expect(host.KeyType).To(Equal("ecdsa-sha2-nistp256"))
+			Expect(host.Fingerprint).To(Equal(Fingerprint(key)))
+
+			storedHost, exists, err := NewStore(path).Get("https://api.example.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			Expect(storedHost.APIEndpoint).To(Equal("https://api.example.com"))
+			Expect(storedHost.PublicKey).To(Equal(host.PublicKey))
+			Expect(storedHost.Fingerprint).To(Equal(host.Fingerprint))
+
+			if os.PathSeparator == '/' {
+				info, err := os.Stat(path)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+			}
+		})
+
+		It("keeps the hosts of other API endpoints", func() {
+			_, err := store.Trust("https://api.b.example.com", "ssh.b.example.com:2222", key)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = store.Trust("https://api.a.example.com", "ssh.a.example.com:2222", generatePublicKey())
+			Expect(err).ToNot(HaveOccurred())
+
+			hosts, err := store.Hosts()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hosts).To(HaveLen(2))
+			Expect(hosts[0].APIEndpoint).To(Equal("https://api.a.example.com"))
+			Expect(hosts[1].APIEndpoint).To(Equal("https://api.b.example.com"))
+		})
+	})
+
+	Describe("Remove", func() {
+		BeforeEach(func() {
+			_, err := store.Trust("https://api.example.com", "ssh.example.com:2222", key)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("removes the host for the API endpoint", func() {
+			removed, err := store.Remove("https://api.example.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(BeTrue())
+
+			_, exists, err := store.Get("https://api.example.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		Context("when no host is trusted for the API endpoint", func() {
+			It("returns false", func() {
+				removed, err := store.Remove("https://api.other.example.com")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(removed).To(BeFalse())
+			})
+		})
+	})
+})
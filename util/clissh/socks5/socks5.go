@@ -0,0 +1,152 @@
+// Package socks5 implements the server side of the subset of the SOCKS5
+// protocol (RFC 1928) needed for dynamic port forwarding: unauthenticated
+// CONNECT requests.
+package socks5
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+)
+
+const (
+	version = 0x05
+
+	methodNoAuthentication = 0x00
+	methodNoAcceptable     = 0xff
+
+	commandConnect = 0x01
+
+	addressTypeIPv4   = 0x01
+	addressTypeDomain = 0x03
+	addressTypeIPv6   = 0x04
+
+	replySucceeded           = 0x00
+	replyGeneralFailure      = 0x01
+	replyCommandNotSupported = 0x07
+	replyAddressNotSupported = 0x08
+)
+
+var (
+	ErrUnsupportedVersion     = errors.New("unsupported SOCKS version")
+	ErrNoAcceptableMethod     = errors.New("no acceptable SOCKS authentication method")
+	ErrUnsupportedCommand     = errors.New("unsupported SOCKS command")
+	ErrUnsupportedAddressType = errors.New("unsupported SOCKS address type")
+
+	// the bound address is not meaningful for forwarded connections
+	unspecifiedBoundAddress = []byte{addressTypeIPv4, 0, 0, 0, 0, 0, 0}
+)
+
+// Handshake negotiates a SOCKS5 session on conn and returns the host:port
+// the client asked to connect to. When the request cannot be served an
+// error reply is written to conn before the error is returned.
+func Handshake(conn io.ReadWriter) (string, error) {
+	err := negotiateMethod(conn)
+	if err != nil {
+		return "", err
+	}
+
+	header := make([]byte, 4)
+	_, err = io.ReadFull(conn, header)
+	if err != nil {
+		return "", err
+	}
+
+	if header[0] != version {
+		return "", ErrUnsupportedVersion
+	}
+
+	if header[1] != commandConnect {
+		_ = writeReply(conn, replyCommandNotSupported)
+		return "", ErrUnsupportedCommand
+	}
+
+	host, err := readHost(conn, header[3])
+	if err != nil {
+		if err == ErrUnsupportedAddressType {
+			_ = writeReply(conn, replyAddressNotSupported)
+		}
+		return "", err
+	}
+
+	portBytes := make([]byte, 2)
+	_, err = io.ReadFull(conn, portBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(portBytes)))), nil
+}
+
+// Reply tells the client whether the connection to the requested address
+// was established.
+func Reply(conn io.Writer, connectErr error) error {
+	if connectErr != nil {
+		return writeReply(conn, replyGeneralFailure)
+	}
+	return writeReply(conn, replySucceeded)
+}
+
+func negotiateMethod(conn io.ReadWriter) error {
+	header := make([]byte, 2)
+	_, err := io.ReadFull(conn, header)
+	if err != nil {
+		return err
+	}
+
+	if header[0] != version {
+		return ErrUnsupportedVersion
+	}
+
+	methods := make([]byte, header[1])
+	_, err = io.ReadFull(conn, methods)
+	if err != nil {
+		return err
+	}
+
+	for _, method := range methods {
+		if method == methodNoAuthentication {
+			_, err = conn.Write([]byte{version, methodNoAuthentication})
+			return err
+		}
+	}
+
+	_, _ = conn.Write([]byte{version, methodNoAcceptable})
+	return ErrNoAcceptableMethod
+}
+
+func readHost(conn io.Reader, addressType byte) (string, error) {
+	switch addressType {
+	case addressTypeIPv4:
+		ip := make([]byte, net.IPv4len)
+		_, err := io.ReadFull(conn, ip)
+		return net.IP(ip).String(), err
+	case addressTypeIPv6:
+		ip := make([]byte, net.IPv6len)
+		_, err := io.ReadFull(conn, ip)
+		return net.IP(ip).String(), err
+	case addressTypeDomain:
+		length := make([]byte, 1)
+		_, err := io.ReadFull(conn, length)
+		if err != nil {
+			return "", err
+		}
+
+		domain := make([]byte, length[0])
+		_, err = io.ReadFull(conn, domain)
+		return string(domain), err
+	default:
+		return "", ErrUnsupportedAddressType
+	}
+}
+
+func writeReply(conn io.Writer, status byte) error {
+	_, err := conn.Write(append([]byte{version, status, 0x00}, unspecifiedBoundAddress...))
+	if err != nil {
+		return fmt.Errorf("unable to write SOCKS reply: %s", err.Error())
+	}
+	return nil
+}
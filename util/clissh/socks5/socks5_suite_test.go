@@ -0,0 +1,13 @@
+package socks5_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestSocks5(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "SOCKS5 Suite")
+}
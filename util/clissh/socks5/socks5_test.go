@@ -0,0 +1,100 @@
+package socks5_test
+
+import (
+	"bytes"
+	"errors"
+
+	. "code.cloudfoundry.org/cli/util/clissh/socks5"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+type fakeConn struct {
+	*bytes.Buffer
+	written *bytes.Buffer
+}
+
+func newFakeConn(input []byte) fakeConn {
+	return fakeConn{Buffer: bytes.NewBuffer(input), written: new(bytes.Buffer)}
+}
+
+func (conn fakeConn) Write(p []byte) (int, error) {
+	return conn.written.Write(p)
+}
+
+var _ = Describe("SOCKS5", func() {
+	Describe("Handshake", func() {
+		DescribeTable("returns the requested address",
+			func(request []byte, expectedAddress string) {
+				conn := newFakeConn(append([]byte{0x05, 0x02, 0x02, 0x00}, request...))
+
+				address, err := Handshake(conn)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(address).To(Equal(expectedAddress))
+				Expect(conn.written.Bytes()).To(Equal([]byte{0x05, 0x00}))
+			},
+			Entry("IPv4", []byte{0x05, 0x01, 0x00, 0x01, 10, 0, 0, 1, 0x1f, 0x90}, "10.0.0.1:8080"),
+			Entry("domain", []byte{0x05, 0x01, 0x00, 0x03, 0x07, 'f', 'o', 'o', '.', 'c', 'o', 'm', 0x00, 0x50}, "foo.com:80"),
+			Entry("IPv6", []byte{0x05, 0x01, 0x00, 0x04, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0x01, 0xbb}, "[::1]:443"),
+		)
+
+		Context("when the client uses a different SOCKS version", func() {
+			It("returns an ErrUnsupportedVersion", func() {
+				_, err := Handshake(newFakeConn([]byte{0x04, 0x01, 0x00}))
+				Expect(err).To(MatchError(ErrUnsupportedVersion))
+			})
+		})
+
+		Context("when the client requires authentication", func() {
+			It("rejects all methods and returns an ErrNoAcceptableMethod", func() {
+				conn := newFakeConn([]byte{0x05, 0x01, 0x02})
+
+				_, err := Handshake(conn)
+				Expect(err).To(MatchError(ErrNoAcceptableMethod))
+				Expect(conn.written.Bytes()).To(Equal([]byte{0x05, 0xff}))
+			})
+		})
+
+		Context("when the client sends a BIND request", func() {
+			It("replies with command not supported and returns an ErrUnsupportedCommand", func() {
+				conn := newFakeConn([]byte{0x05, 0x01, 0x00, 0x05, 0x02, 0x00, 0x01})
+
+				_, err := Handshake(conn)
+				Expect(err).To(MatchError(ErrUnsupportedCommand))
+				Expect(conn.written.Bytes()[2:4]).To(Equal([]byte{0x05, 0x07}))
+			})
+		})
+
+		Context("when the client sends an unknown address type", func() {
+			It("replies with address type not supported and returns an ErrUnsupportedAddressType", func() {
+				conn := newFakeConn([]byte{0x05, 0x01, 0x00, 0x05, 0x01, 0x00, 0x09})
+
+				_, err := Handshake(conn)
+				Expect(err).To(MatchError(ErrUnsupportedAddressType))
+				Expect(conn.written.Bytes()[2:4]).To(Equal([]byte{0x05, 0x08}))
+			})
+		})
+
+		Context("when the request is truncated", func() {
+			It("returns the read error", func() {
+				_, err := Handshake(newFakeConn([]byte{0x05, 0x01, 0x00, 0x05, 0x01}))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Reply", func() {
+		It("writes a success reply when there is no error", func() {
+			buffer := new(bytes.Buffer)
+			Expect(Reply(buffer, nil)).To(Succeed())
+			Expect(buffer.Bytes()).To(Equal([]byte{0x05, 0x00, 0x00, 0x01, 0, 0, 0, 0, 0, 0}))
+		})
+
+		It("writes a failure reply when the connection failed", func() {
+			buffer := new(bytes.Buffer)
+			Expect(Reply(buffer, errors.New("connection refused"))).To(Succeed())
+			Expect(buffer.Bytes()).To(Equal([]byte{0x05, 0x01, 0x00, 0x01, 0, 0, 0, 0, 0, 0}))
+		})
+	})
+})
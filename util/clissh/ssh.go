@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/util/clissh/sigwinch"
+	"code.cloudfoundry.org/cli/util/clissh/socks5"
 	"github.com/docker/docker/pkg/term"
 	"golang.org/x/crypto/ssh"
 )
@@ -44,6 +45,19 @@ type LocalPortForward struct {
 	RemoteAddress string
 }
 
+// RemotePortForward listens on RemoteAddress inside the container and
+// forwards connections to LocalAddress from the local machine.
+type RemotePortForward struct {
+	RemoteAddress string
+	LocalAddress  string
+}
+
+// DynamicPortForward runs a SOCKS5 proxy on LocalAddress that forwards
+// connections to the requested destination from inside the container.
+type DynamicPortForward struct {
+	LocalAddress string
+}
+
 //go:generate counterfeiter . SecureDialer
 
 type SecureDialer interface {
@@ -56,6 +70,7 @@ type SecureClient interface {
 	NewSession() (SecureSession, error)
 	Conn() ssh.Conn
 	Dial(network, address string) (net.Conn, error)
+	Listen(network, address string) (net.Listener, error)
 	Wait() error
 	Close() error
 }
@@ -75,10 +90,19 @@ type ListenerFactory interface {
 	Listen(network, address string) (net.Listener, error)
 }
 
+//go:generate counterfeiter . KnownHosts
+
+// KnownHosts verifies host keys against the keys trusted on previous
+// connections. firstUse is true when key was trusted for the first time.
+type KnownHosts interface {
+	CheckHostKey(sshEndpoint string, key ssh.PublicKey) (firstUse bool, err error)
+}
+
 //go:generate counterfeiter . SecureSession
 
 type SecureSession interface {
 	RequestPty(term string, height, width int, termModes ssh.TerminalModes) error
+	RequestSubsystem(subsystem string) error
 	SendRequest(name string, wantReply bool, payload []byte) (bool, error)
 	StdinPipe() (io.WriteCloser, error)
 	StdoutPipe() (io.Reader, error)
@@ -94,8 +118,10 @@ type SecureShell struct {
 	secureClient    SecureClient
 	terminalHelper  TerminalHelper
 	listenerFactory ListenerFactory
+	knownHosts      KnownHosts
 
 	localListeners    []net.Listener
+	remoteListeners   []net.Listener
 	keepAliveInterval time.Duration
 
 	stdIn  io.Reader
@@ -113,6 +139,7 @@ func NewDefaultSecureShell(stdIn io.Reader, stdOut io.Writer, stdErr io.Writer)
 		listenerFactory:   defaultListenerFactory,
 		keepAliveInterval: DefaultKeepAliveInterval,
 		localListeners:    []net.Listener{},
+		remoteListeners:   []net.Listener{},
 		stdIn:             stdIn,
 		stdOut:            stdOut,
 		stdErr:            stdErr,
@@ -134,6 +161,7 @@ func NewSecureShell(
 		listenerFactory:   listenerFactory,
 		keepAliveInterval: keepAliveInterval,
 		localListeners:    []net.Listener{},
+		remoteListeners:   []net.Listener{},
 		stdIn:             stdIn,
 		stdOut:            stdOut,
 		stdErr:            stdErr,
@@ -144,7 +172,7 @@ func (c *SecureShell) Connect(username string, passcode string, appSSHEndpoint s
 	clientConfig := &ssh.ClientConfig{
 		User:            username,
 		Auth:            []ssh.AuthMethod{ssh.Password(passcode)},
-		HostKeyCallback: fingerprintCallback(skipHostValidation, appSSHHostKeyFingerprint),
+		HostKeyCallback: fingerprintCallback(skipHostValidation, appSSHHostKeyFingerprint, c.knownHosts, c.stdErr),
 	}
 
 	secureClient, err := c.secureDialer.Dial("tcp", appSSHEndpoint, clientConfig)
@@ -156,10 +184,19 @@ func (c *SecureShell) Connect(username string, passcode string, appSSHEndpoint s
 	return nil
 }
 
+// UseKnownHosts makes Connect check the host key against knownHosts after
+// it matches the expected fingerprint.
+func (c *SecureShell) UseKnownHosts(knownHosts KnownHosts) {
+	c.knownHosts = knownHosts
+}
+
 func (c *SecureShell) Close() error {
 	for _, listener := range c.localListeners {
 		_ = listener.Close()
 	}
+	for _, listener := range c.remoteListeners {
+		_ = listener.Close()
+	}
 	return c.secureClient.Close()
 }
 
@@ -171,13 +208,90 @@ func (c *SecureShell) LocalPortForward(localPortForwardSpecs []LocalPortForward)
 		}
 		c.localListeners = append(c.localListeners, listener)
 
-		go c.localForwardAcceptLoop(listener, spec.RemoteAddress)
+		go c.forwardAcceptLoop(listener, c.dialRemote(spec.RemoteAddress))
+	}
+
+	return nil
+}
+
+// RemotePortForward asks the SSH server to listen on each spec's
+// RemoteAddress and forwards accepted connections to its LocalAddress.
+func (c *SecureShell) RemotePortForward(remotePortForwardSpecs []RemotePortForward) error {
+	for _, spec := range remotePortForwardSpecs {
+		listener, err := c.secureClient.Listen("tcp", spec.RemoteAddress)
+		if err != nil {
+			return fmt.Errorf("remote port forwarding failed for listen address %s: %s", spec.RemoteAddress, err.Error())
+		}
+		c.remoteListeners = append(c.remoteListeners, listener)
+
+		go c.forwardAcceptLoop(listener, dialLocal(spec.LocalAddress))
+	}
+
+	return nil
+}
+
+// DynamicPortForward starts a SOCKS5 proxy on each spec's LocalAddress.
+// Connections are made to the requested destination from inside the
+// container.
+func (c *SecureShell) DynamicPortForward(dynamicPortForwardSpecs []DynamicPortForward) error {
+	for _, spec := range dynamicPortForwardSpecs {
+		listener, err := c.listenerFactory.Listen("tcp", spec.LocalAddress)
+		if err != nil {
+			return err
+		}
+		c.localListeners = append(c.localListeners, listener)
+
+		go c.forwardAcceptLoop(listener, c.dialSOCKS)
 	}
 
 	return nil
 }
 
-func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
+// dialFunc establishes the far side of a forwarded connection. It is given
+// the accepted connection so that protocols like SOCKS can negotiate the
+// target before dialing.
+type dialFunc func(conn net.Conn) (net.Conn, error)
+
+func (c *SecureShell) dialRemote(targetAddr string) dialFunc {
+	return func(net.Conn) (net.Conn, error) {
+		target, err := c.secureClient.Dial("tcp", targetAddr)
+		if err != nil {
+			return nil, fmt.Errorf("connect to %s failed: %s", targetAddr, err.Error())
+		}
+		return target, nil
+	}
+}
+
+func dialLocal(targetAddr string) dialFunc {
+	return func(net.Conn) (net.Conn, error) {
+		target, err := net.Dial("tcp", targetAddr)
+		if err != nil {
+			return nil, fmt.Errorf("connect to %s failed: %s", targetAddr, err.Error())
+		}
+		return target, nil
+	}
+}
+
+func (c *SecureShell) dialSOCKS(conn net.Conn) (net.Conn, error) {
+	targetAddr, err := socks5.Handshake(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := c.secureClient.Dial("tcp", targetAddr)
+	replyErr := socks5.Reply(conn, err)
+	if err != nil {
+		return nil, fmt.Errorf("connect to %s failed: %s", targetAddr, err.Error())
+	}
+	if replyErr != nil {
+		_ = target.Close()
+		return nil, replyErr
+	}
+
+	return target, nil
+}
+
+func (c *SecureShell) forwardAcceptLoop(listener net.Listener, dial dialFunc) {
 	defer listener.Close()
 
 	for {
@@ -190,16 +304,16 @@ func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string)
 			return
 		}
 
-		go c.handleForwardConnection(conn, addr)
+		go c.handleForwardConnection(conn, dial)
 	}
 }
 
-func (c *SecureShell) handleForwardConnection(conn net.Conn, targetAddr string) {
+func (c *SecureShell) handleForwardConnection(conn net.Conn, dial dialFunc) {
 	defer conn.Close()
 
-	target, err := c.secureClient.Dial("tcp", targetAddr)
+	target, err := dial(conn)
 	if err != nil {
-		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		fmt.Println(err.Error())
 		return
 	}
 	defer target.Close()
@@ -346,7 +460,7 @@ func base64Sha256Fingerprint(key ssh.PublicKey) string {
 	return base64.RawStdEncoding.EncodeToString(sum[:])
 }
 
-func fingerprintCallback(skipHostValidation bool, expectedFingerprint string) ssh.HostKeyCallback {
+func fingerprintCallback(skipHostValidation bool, expectedFingerprint string, knownHosts KnownHosts, stdErr io.Writer) ssh.HostKeyCallback {
 	if skipHostValidation {
 		return nil
 	}
@@ -371,6 +485,18 @@ func fingerprintCallback(skipHostValidation bool, expectedFingerprint string) ss
 		if fingerprint != expectedFingerprint {
 			return fmt.Errorf("Host key verification failed.\n\nThe fingerprint of the received key was %q.", fingerprint)
 		}
+
+		if knownHosts == nil {
+			return nil
+		}
+
+		firstUse, err := knownHosts.CheckHostKey(hostname, key)
+		if err != nil {
+			return err
+		}
+		if firstUse {
+			fmt.Fprintf(stdErr, "Warning: Permanently added the host key for %s (%s) to the list of known hosts.\n", hostname, fingerprint)
+		}
 		return nil
 	}
 }
@@ -41,6 +41,7 @@ var _ = Describe("CLI SSH", func() {
 		fakeTerminalHelper  *clisshfakes.FakeTerminalHelper
 		fakeListenerFactory *clisshfakes.FakeListenerFactory
 		fakeSecureSession   *clisshfakes.FakeSecureSession
+		fakeKnownHosts      *clisshfakes.FakeKnownHosts
 
 		fakeConnection *fake_ssh.FakeConn
 		stdinPipe      *fake_io.FakeWriteCloser
@@ -63,6 +64,7 @@ var _ = Describe("CLI SSH", func() {
 		fakeTerminalHelper = new(clisshfakes.FakeTerminalHelper)
 		fakeListenerFactory = new(clisshfakes.FakeListenerFactory)
 		fakeSecureSession = new(clisshfakes.FakeSecureSession)
+		fakeKnownHosts = nil
 
 		fakeConnection = new(fake_ssh.FakeConn)
 		stdinPipe = new(fake_io.FakeWriteCloser)
@@ -111,6 +113,9 @@ var _ = Describe("CLI SSH", func() {
 			testUI.GetOut(),
 			testUI.GetErr(),
 		)
+		if fakeKnownHosts != nil {
+			secureShell.UseKnownHosts(fakeKnownHosts)
+		}
 	})
 
 	Describe("Connect", func() {
@@ -267,6 +272,55 @@ var _ = Describe("CLI SSH", func() {
 				})
 			})
 
+			Context("when known hosts are in use", func() {
+				BeforeEach(func() {
+					sshEndpointFingerprint = "sp/jrLuj66r+yrLDUKZdJU5tdzt4mq/UaSiNBjpgr+8"
+					fakeKnownHosts = new(clisshfakes.FakeKnownHosts)
+				})
+
+				It("checks the host key against the known hosts", func() {
+					Expect(callback("ssh.example.com:2222", addr, TestHostKey.PublicKey())).ToNot(HaveOccurred())
+
+					Expect(fakeKnownHosts.CheckHostKeyCallCount()).To(Equal(1))
+					hostnameArg, keyArg := fakeKnownHosts.CheckHostKeyArgsForCall(0)
+					Expect(hostnameArg).To(Equal("ssh.example.com:2222"))
+					Expect(keyArg.Marshal()).To(Equal(TestHostKey.PublicKey().Marshal()))
+					Expect(testUI.Err).ToNot(Say("Permanently added"))
+				})
+
+				Context("when the host key is trusted for the first time", func() {
+					BeforeEach(func() {
+						fakeKnownHosts.CheckHostKeyReturns(true, nil)
+					})
+
+					It("warns that the key was added", func() {
+						Expect(callback("ssh.example.com:2222", addr, TestHostKey.PublicKey())).ToNot(HaveOccurred())
+						Expect(testUI.Err).To(Say(`Warning: Permanently added the host key for ssh\.example\.com:2222 \(sp/jrLuj66r\+yrLDUKZdJU5tdzt4mq/UaSiNBjpgr\+8\) to the list of known hosts\.`))
+					})
+				})
+
+				Context("when the host key does not match the known hosts", func() {
+					BeforeEach(func() {
+						fakeKnownHosts.CheckHostKeyReturns(false, errors.New("some-host-key-changed-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(callback("ssh.example.com:2222", addr, TestHostKey.PublicKey())).To(MatchError("some-host-key-changed-error"))
+					})
+				})
+
+				Context("when the fingerprint does not match", func() {
+					BeforeEach(func() {
+						sshEndpointFingerprint = "0000000000000000000000000000000000000000000"
+					})
+
+					It("does not check the known hosts", func() {
+						Expect(callback("ssh.example.com:2222", addr, TestHostKey.PublicKey())).To(HaveOccurred())
+						Expect(fakeKnownHosts.CheckHostKeyCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			Context("when the fingerprint length doesn't make sense", func() {
 				BeforeEach(func() {
 					sshEndpointFingerprint = "garbage"
@@ -1108,6 +1162,239 @@ var _ = Describe("CLI SSH", func() {
 		})
 	})
 
+	Describe("RemotePortForward", func() {
+		var (
+			forwardErr error
+
+			echoListener   net.Listener
+			remoteListener net.Listener
+			forwardSpecs   []RemotePortForward
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+
+			go func() {
+				for {
+					conn, acceptErr := echoListener.Accept()
+					if acceptErr != nil {
+						return
+					}
+					go func() {
+						io.Copy(conn, conn)
+						conn.Close()
+					}()
+				}
+			}()
+
+			remoteListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeSecureClient.ListenReturns(remoteListener, nil)
+
+			forwardSpecs = []RemotePortForward{{
+				RemoteAddress: "localhost:9999",
+				LocalAddress:  echoListener.Addr().String(),
+			}}
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.RemotePortForward(forwardSpecs)
+		})
+
+		AfterEach(func() {
+			err := secureShell.Close()
+			Expect(err).NotTo(HaveOccurred())
+			echoListener.Close()
+		})
+
+		It("asks the server to listen on the remote address", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:9999"))
+		})
+
+		It("copies data between the remote connection and the local address", func() {
+			conn, err := net.Dial("tcp", remoteListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			msg := "Hello from the container\n"
+			_, err = conn.Write([]byte(msg))
+			Expect(err).NotTo(HaveOccurred())
+
+			response := make([]byte, len(msg))
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(response)).To(Equal(msg))
+		})
+
+		Context("when the secure shell is closed", func() {
+			var fakeRemoteListener *fake_net.FakeListener
+
+			BeforeEach(func() {
+				fakeRemoteListener = new(fake_net.FakeListener)
+				fakeRemoteListener.AcceptReturns(nil, errors.New("not accepting connections"))
+				fakeSecureClient.ListenReturns(fakeRemoteListener, nil)
+			})
+
+			It("closes the remote listener", func() {
+				Eventually(fakeRemoteListener.AcceptCallCount).Should(Equal(1))
+
+				originalCloseCount := fakeRemoteListener.CloseCallCount()
+				err := secureShell.Close()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeRemoteListener.CloseCallCount()).To(Equal(originalCloseCount + 1))
+			})
+		})
+
+		Context("when the server refuses to listen", func() {
+			BeforeEach(func() {
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied by peer"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("remote port forwarding failed for listen address localhost:9999: tcpip-forward request denied by peer"))
+			})
+		})
+	})
+
+	Describe("DynamicPortForward", func() {
+		var (
+			forwardErr error
+
+			echoListener  net.Listener
+			localListener net.Listener
+			forwardSpecs  []DynamicPortForward
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+
+			go func() {
+				for {
+					conn, acceptErr := echoListener.Accept()
+					if acceptErr != nil {
+						return
+					}
+					go func() {
+						io.Copy(conn, conn)
+						conn.Close()
+					}()
+				}
+			}()
+
+			localListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeListenerFactory.ListenReturns(localListener, nil)
+			fakeListenerFactory.ListenStub = nil
+
+			fakeSecureClient.DialStub = net.Dial
+
+			forwardSpecs = []DynamicPortForward{{LocalAddress: "localhost:1080"}}
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.DynamicPortForward(forwardSpecs)
+		})
+
+		AfterEach(func() {
+			err := secureShell.Close()
+			Expect(err).NotTo(HaveOccurred())
+			echoListener.Close()
+		})
+
+		socksConnect := func(targetAddr string) (net.Conn, []byte) {
+			conn, err := net.Dial("tcp", localListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = conn.Write([]byte{0x05, 0x01, 0x00})
+			Expect(err).NotTo(HaveOccurred())
+
+			methodReply := make([]byte, 2)
+			_, err = io.ReadFull(conn, methodReply)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(methodReply).To(Equal([]byte{0x05, 0x00}))
+
+			tcpAddr, err := net.ResolveTCPAddr("tcp", targetAddr)
+			Expect(err).NotTo(HaveOccurred())
+			request := append([]byte{0x05, 0x01, 0x00, 0x01}, tcpAddr.IP.To4()...)
+			request = append(request, byte(tcpAddr.Port>>8), byte(tcpAddr.Port))
+			_, err = conn.Write(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			reply := make([]byte, 10)
+			_, err = io.ReadFull(conn, reply)
+			Expect(err).NotTo(HaveOccurred())
+
+			return conn, reply
+		}
+
+		It("listens on the local address", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+
+			Expect(fakeListenerFactory.ListenCallCount()).To(Equal(1))
+			network, addr := fakeListenerFactory.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:1080"))
+		})
+
+		It("dials the requested address through the secure client and copies data", func() {
+			conn, reply := socksConnect(echoListener.Addr().String())
+			defer conn.Close()
+			Expect(reply[1]).To(Equal(byte(0x00)))
+
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal(echoListener.Addr().String()))
+
+			msg := "Hello through the proxy\n"
+			_, err := conn.Write([]byte(msg))
+			Expect(err).NotTo(HaveOccurred())
+
+			response := make([]byte, len(msg))
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(response)).To(Equal(msg))
+		})
+
+		Context("when dialing the requested address fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.DialStub = nil
+				fakeSecureClient.DialReturns(nil, errors.New("connection refused"))
+			})
+
+			It("replies with a failure", func() {
+				conn, reply := socksConnect(echoListener.Addr().String())
+				defer conn.Close()
+				Expect(reply[1]).To(Equal(byte(0x01)))
+			})
+		})
+
+		Context("when listen fails", func() {
+			BeforeEach(func() {
+				fakeListenerFactory.ListenReturns(nil, errors.New("failure is an option"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("failure is an option"))
+			})
+		})
+	})
+
 	Describe("Wait", func() {
 		var waitErr error
 
@@ -397,6 +397,24 @@ func (config *Config) RefreshToken() string {
 	return config.ConfigFile.RefreshToken
 }
 
+// ResourceCacheFilePath returns the location of the file that caches the
+// SHA1s of files gathered for a push
+func (config *Config) ResourceCacheFilePath() string {
+	return filepath.Join(configDirectory(), "resource_cache.json")
+}
+
+// RollbackHistoryFilePath returns the location of the file that records the
+// droplet each app ran before it was last rolled back
+func (config *Config) RollbackHistoryFilePath() string {
+	return filepath.Join(configDirectory(), "rollback_history.json")
+}
+
+// SSHKnownHostsFilePath returns the location of the file that records the SSH
+// proxy host keys trusted for each API endpoint
+func (config *Config) SSHKnownHostsFilePath() string {
+	return filepath.Join(configDirectory(), "ssh_known_hosts.json")
+}
+
 // SSHOAuthClient returns the OAuth client id used for SSHing into
 // application/process containers
 func (config *Config) SSHOAuthClient() string {
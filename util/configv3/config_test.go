@@ -326,6 +326,33 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("ResourceCacheFilePath", func() {
+			It("returns the resource cache file in the config directory", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(config.ResourceCacheFilePath()).To(Equal(filepath.Join(homeDir, ".cf", "resource_cache.json")))
+			})
+		})
+
+		Describe("RollbackHistoryFilePath", func() {
+			It("returns the rollback history file in the config directory", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(config.RollbackHistoryFilePath()).To(Equal(filepath.Join(homeDir, ".cf", "rollback_history.json")))
+			})
+		})
+
+		Describe("SSHKnownHostsFilePath", func() {
+			It("returns the known hosts file in the config directory", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(config.SSHKnownHostsFilePath()).To(Equal(filepath.Join(homeDir, ".cf", "ssh_known_hosts.json")))
+			})
+		})
+
 		Describe("OverallPollingTimeout", func() {
 			var config *Config
 
@@ -0,0 +1,37 @@
+package generic
+
+import "fmt"
+
+// StringifyKeys converts the nested maps in values, which YAML decodes with
+// interface{} keys, into maps with string keys so that values can be encoded
+// as JSON.
+func StringifyKeys(values map[string]interface{}) map[string]interface{} {
+	if values == nil {
+		return nil
+	}
+
+	converted := map[string]interface{}{}
+	for key, value := range values {
+		converted[key] = stringifyValueKeys(value)
+	}
+	return converted
+}
+
+func stringifyValueKeys(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case map[interface{}]interface{}:
+		converted := map[string]interface{}{}
+		for key, nestedValue := range typedValue {
+			converted[fmt.Sprint(key)] = stringifyValueKeys(nestedValue)
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(typedValue))
+		for i, nestedValue := range typedValue {
+			converted[i] = stringifyValueKeys(nestedValue)
+		}
+		return converted
+	default:
+		return value
+	}
+}
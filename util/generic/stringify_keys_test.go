@@ -0,0 +1,35 @@
+package generic_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/generic"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StringifyKeys", func() {
+	It("converts the keys of nested maps, including maps in lists, to strings", func() {
+		Expect(StringifyKeys(map[string]interface{}{
+			"name": "some-value",
+			"nested": map[interface{}]interface{}{
+				"key": map[interface{}]interface{}{1: true},
+				"list": []interface{}{
+					map[interface{}]interface{}{"item": "value"},
+					"plain",
+				},
+			},
+		})).To(Equal(map[string]interface{}{
+			"name": "some-value",
+			"nested": map[string]interface{}{
+				"key": map[string]interface{}{"1": true},
+				"list": []interface{}{
+					map[string]interface{}{"item": "value"},
+					"plain",
+				},
+			},
+		}))
+	})
+
+	It("returns nil for a nil map", func() {
+		Expect(StringifyKeys(nil)).To(BeNil())
+	})
+})
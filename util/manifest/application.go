@@ -29,9 +29,13 @@ type Application struct {
 	NoHostname         bool
 	NoRoute            bool
 	Path               string
+	Processes          []Process
 	Routes             []string
-	Services           []string
-	StackName          string
+	// Services lists the names of all service instances to bind, and
+	// ServiceDeclarations the entries among them declared in detail.
+	Services            []string
+	ServiceDeclarations []Service
+	StackName           string
 }
 
 func (app Application) String() string {
@@ -70,7 +74,7 @@ func (app Application) MarshalYAML() (interface{}, error) {
 		Name:                    app.Name,
 		NoRoute:                 app.NoRoute,
 		Path:                    app.Path,
-		Services:                app.Services,
+		Processes:               app.Processes,
 		StackName:               app.StackName,
 		Timeout:                 app.HealthCheckTimeout,
 	}
@@ -85,6 +89,18 @@ func (app Application) MarshalYAML() (interface{}, error) {
 		m.Routes = append(m.Routes, rawManifestRoute{Route: route})
 	}
 
+	declarations := map[string]Service{}
+	for _, service := range app.ServiceDeclarations {
+		declarations[service.Name] = service
+	}
+	for _, name := range app.Services {
+		service, ok := declarations[name]
+		if !ok {
+			service = Service{Name: name}
+		}
+		m.Services = append(m.Services, rawManifestService(service))
+	}
+
 	return m, nil
 }
 
@@ -103,7 +119,7 @@ func (app *Application) UnmarshalYAML(unmarshaller func(interface{}) error) erro
 	app.Name = m.Name
 	app.NoRoute = m.NoRoute
 	app.Path = m.Path
-	app.Services = m.Services
+	app.Processes = m.Processes
 	app.StackName = m.StackName
 	app.HealthCheckTimeout = m.Timeout
 	app.EnvironmentVariables = m.EnvironmentVariables
@@ -122,6 +138,13 @@ func (app *Application) UnmarshalYAML(unmarshaller func(interface{}) error) erro
 		app.Routes = append(app.Routes, route.Route)
 	}
 
+	for _, service := range m.Services {
+		app.Services = append(app.Services, service.Name)
+		if service.Offering != "" || service.Plan != "" || service.Parameters != nil || service.Tags != nil || service.BindingParameters != nil {
+			app.ServiceDeclarations = append(app.ServiceDeclarations, Service(service))
+		}
+	}
+
 	// "null" values are identical to non-existant values in YAML. In order to
 	// detect if an explicit null is given, a manual existance check is required.
 	exists := map[string]interface{}{}
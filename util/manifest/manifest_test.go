@@ -59,6 +59,20 @@ applications:
   services:
   - service_1
   - service_2
+  - name: service_3
+    offering: p-mysql
+    plan: small
+    parameters:
+      storage: 10
+      backup:
+        schedule: daily
+        regions: [eu, us]
+        retention:
+          days: 7
+    tags:
+    - db
+    binding_parameters:
+      role: read-only
 - name: "app-3"
   no-route: true
   env:
@@ -69,6 +83,17 @@ applications:
 - name: "app-4"
   buildpack: null
   command: null
+- name: "app-5"
+  processes:
+  - type: web
+    instances: 2
+    memory: 512M
+    health-check-type: http
+    health-check-http-endpoint: /health
+  - type: worker
+    command: bundle exec sidekiq
+    disk_quota: 2G
+    health-check-type: process
 `
 				tempFile, err := ioutil.TempFile("", "manifest-test-")
 				Expect(err).ToNot(HaveOccurred())
@@ -134,7 +159,26 @@ applications:
 							IsSet: true,
 						},
 						Routes:   []string{"foo.bar.com", "baz.qux.com", "blep.blah.com/boop"},
-						Services: []string{"service_1", "service_2"},
+						Services: []string{"service_1", "service_2", "service_3"},
+						ServiceDeclarations: []Service{
+							{
+								Name:     "service_3",
+								Offering: "p-mysql",
+								Plan:     "small",
+								Parameters: map[string]interface{}{
+									"storage": 10,
+									"backup": map[string]interface{}{
+										"schedule": "daily",
+										"regions":  []interface{}{"eu", "us"},
+										"retention": map[string]interface{}{
+											"days": 7,
+										},
+									},
+								},
+								Tags:              []string{"db"},
+								BindingParameters: map[string]interface{}{"role": "read-only"},
+							},
+						},
 					},
 					Application{
 						Name: "app-3",
@@ -157,6 +201,24 @@ applications:
 							Value: "",
 						},
 					},
+					Application{
+						Name: "app-5",
+						Processes: []Process{
+							{
+								Type:                    "web",
+								Instances:               types.NullInt{Value: 2, IsSet: true},
+								Memory:                  types.NullByteSizeInMb{Value: 512, IsSet: true},
+								HealthCheckType:         "http",
+								HealthCheckHTTPEndpoint: "/health",
+							},
+							{
+								Type:            "worker",
+								Command:         "bundle exec sidekiq",
+								DiskQuota:       types.NullByteSizeInMb{Value: 2048, IsSet: true},
+								HealthCheckType: "process",
+							},
+						},
+					},
 				))
 			})
 		})
@@ -313,6 +375,39 @@ applications:
 			})
 		})
 
+		Context("when a service is declared in detail", func() {
+			BeforeEach(func() {
+				application = Application{
+					Name:     "app-1",
+					Services: []string{"service_1", "service_2"},
+					ServiceDeclarations: []Service{
+						{
+							Name:              "service_2",
+							Offering:          "p-mysql",
+							Plan:              "small",
+							BindingParameters: map[string]interface{}{"role": "read-only"},
+						},
+					},
+				}
+			})
+
+			It("writes the declaration in place of the name", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				manifestBytes, err := ioutil.ReadFile(filePath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(manifestBytes)).To(Equal(`applications:
+- name: app-1
+  services:
+  - service_1
+  - name: service_2
+    offering: p-mysql
+    plan: small
+    binding_parameters:
+      role: read-only
+`))
+			})
+		})
+
 		Context("when some properties are not provided", func() {
 			BeforeEach(func() {
 				application = Application{
@@ -0,0 +1,54 @@
+package manifest
+
+import "code.cloudfoundry.org/cli/types"
+
+// Process is an entry in an application's processes section, describing one
+// process type of a multi-process app.
+type Process struct {
+	Type                    string
+	Command                 string
+	DiskQuota               types.NullByteSizeInMb
+	HealthCheckHTTPEndpoint string
+	HealthCheckType         string
+	Instances               types.NullInt
+	Memory                  types.NullByteSizeInMb
+}
+
+func (process Process) MarshalYAML() (interface{}, error) {
+	var m = rawManifestProcess{
+		Type:                    process.Type,
+		Command:                 process.Command,
+		HealthCheckHTTPEndpoint: process.HealthCheckHTTPEndpoint,
+		HealthCheckType:         process.HealthCheckType,
+	}
+	m.DiskQuota = process.DiskQuota.String()
+	m.Memory = process.Memory.String()
+
+	if process.Instances.IsSet {
+		m.Instances = &process.Instances.Value
+	}
+
+	return m, nil
+}
+
+func (process *Process) UnmarshalYAML(unmarshaller func(interface{}) error) error {
+	var m rawManifestProcess
+
+	err := unmarshaller(&m)
+	if err != nil {
+		return err
+	}
+
+	process.Type = m.Type
+	process.Command = m.Command
+	process.HealthCheckHTTPEndpoint = m.HealthCheckHTTPEndpoint
+	process.HealthCheckType = m.HealthCheckType
+
+	process.Instances.ParseIntValue(m.Instances)
+
+	if fmtErr := process.DiskQuota.ParseStringValue(m.DiskQuota); fmtErr != nil {
+		return fmtErr
+	}
+
+	return process.Memory.ParseStringValue(m.Memory)
+}
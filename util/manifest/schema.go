@@ -0,0 +1,102 @@
+package manifest
+
+import "encoding/json"
+
+// JSONSchema returns a JSON Schema describing the application manifest, for
+// editors that offer completion and validation while writing one.
+func JSONSchema() ([]byte, error) {
+	processProperties := schemaProperties(processFields)
+	processProperties["health-check-type"] = healthCheckTypeSchema()
+
+	serviceProperties := schemaProperties(serviceFields)
+	serviceProperties["tags"] = map[string]interface{}{
+		"type":  "array",
+		"items": map[string]interface{}{"type": "string"},
+	}
+
+	appProperties := schemaProperties(applicationFields)
+	appProperties["docker"] = objectSchema(schemaProperties(dockerFields), "image")
+	appProperties["env"] = map[string]interface{}{
+		"type": "object",
+		"additionalProperties": map[string]interface{}{
+			"type": []string{"string", "number", "boolean"},
+		},
+	}
+	appProperties["health-check-type"] = healthCheckTypeSchema()
+	appProperties["processes"] = map[string]interface{}{
+		"type":  "array",
+		"items": objectSchema(processProperties, "type"),
+	}
+	appProperties["routes"] = map[string]interface{}{
+		"type":  "array",
+		"items": objectSchema(schemaProperties(routeFields), "route"),
+	}
+	appProperties["services"] = map[string]interface{}{
+		"type": "array",
+		"items": map[string]interface{}{
+			"oneOf": []interface{}{
+				map[string]interface{}{"type": "string"},
+				objectSchema(serviceProperties, "name"),
+			},
+		},
+	}
+
+	schema := map[string]interface{}{
+		"$schema":              "http://json-schema.org/draft-07/schema#",
+		"title":                "Cloud Foundry application manifest",
+		"type":                 "object",
+		"additionalProperties": false,
+		"properties": map[string]interface{}{
+			"applications": map[string]interface{}{
+				"type":  "array",
+				"items": objectSchema(appProperties, "name"),
+			},
+		},
+	}
+
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+func schemaProperties(fields map[string]valueKind) map[string]interface{} {
+	properties := map[string]interface{}{}
+	for name, kind := range fields {
+		properties[name] = kindSchema(kind)
+	}
+	return properties
+}
+
+func kindSchema(kind valueKind) map[string]interface{} {
+	switch kind {
+	case integerValue:
+		return map[string]interface{}{"type": "integer"}
+	case booleanValue:
+		return map[string]interface{}{"type": "boolean"}
+	case byteSizeValue:
+		return map[string]interface{}{
+			"type":    "string",
+			"pattern": "^[0-9]+([KMGTkmgt][Bb]?|[Bb])$",
+		}
+	case mapValue:
+		return map[string]interface{}{"type": "object"}
+	case listValue:
+		return map[string]interface{}{"type": "array"}
+	default:
+		return map[string]interface{}{"type": "string"}
+	}
+}
+
+func objectSchema(properties map[string]interface{}, required ...string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":                 "object",
+		"additionalProperties": false,
+		"properties":           properties,
+		"required":             required,
+	}
+}
+
+func healthCheckTypeSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "string",
+		"enum": healthCheckTypes,
+	}
+}
@@ -0,0 +1,61 @@
+package manifest
+
+import "code.cloudfoundry.org/cli/util/generic"
+
+// Service is an entry in an application's services section that declares how
+// to create the service instance when it does not already exist, and how to
+// bind it. Entries that only name a service instance are listed in
+// Application.Services alone.
+type Service struct {
+	Name              string
+	Offering          string
+	Plan              string
+	Parameters        map[string]interface{}
+	Tags              []string
+	BindingParameters map[string]interface{}
+}
+
+// Creatable returns true if the entry declares enough to create the service
+// instance.
+func (service Service) Creatable() bool {
+	return service.Offering != "" && service.Plan != ""
+}
+
+// rawManifestService is a services entry, which is either the name of a
+// service instance or a map declaring it.
+type rawManifestService struct {
+	Name              string                 `yaml:"name"`
+	Offering          string                 `yaml:"offering,omitempty"`
+	Plan              string                 `yaml:"plan,omitempty"`
+	Parameters        map[string]interface{} `yaml:"parameters,omitempty"`
+	Tags              []string               `yaml:"tags,omitempty"`
+	BindingParameters map[string]interface{} `yaml:"binding_parameters,omitempty"`
+}
+
+func (raw rawManifestService) MarshalYAML() (interface{}, error) {
+	if raw.Offering == "" && raw.Plan == "" && raw.Parameters == nil && raw.Tags == nil && raw.BindingParameters == nil {
+		return raw.Name, nil
+	}
+
+	type plainService rawManifestService
+	return plainService(raw), nil
+}
+
+func (raw *rawManifestService) UnmarshalYAML(unmarshaller func(interface{}) error) error {
+	var name string
+	if err := unmarshaller(&name); err == nil {
+		raw.Name = name
+		return nil
+	}
+
+	type plainService rawManifestService
+	var service plainService
+	if err := unmarshaller(&service); err != nil {
+		return err
+	}
+
+	*raw = rawManifestService(service)
+	raw.Parameters = generic.StringifyKeys(raw.Parameters)
+	raw.BindingParameters = generic.StringifyKeys(raw.BindingParameters)
+	return nil
+}
@@ -0,0 +1,532 @@
+package manifest
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/types"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// ValidationError is a single problem found in a manifest. Line and Column
+// point at the key the problem was found under.
+type ValidationError struct {
+	Line    int
+	Column  int
+	Path    string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%d:%d: %s: %s", e.Line, e.Column, e.Path, e.Message)
+}
+
+type valueKind int
+
+const (
+	stringValue valueKind = iota
+	integerValue
+	booleanValue
+	byteSizeValue
+	mapValue
+	listValue
+)
+
+var applicationFields = map[string]valueKind{
+	"buildpack":                  stringValue,
+	"command":                    stringValue,
+	"disk_quota":                 byteSizeValue,
+	"docker":                     mapValue,
+	"env":                        mapValue,
+	"health-check-http-endpoint": stringValue,
+	"health-check-type":          stringValue,
+	"instances":                  integerValue,
+	"memory":                     byteSizeValue,
+	"name":                       stringValue,
+	"no-route":                   booleanValue,
+	"path":                       stringValue,
+	"processes":                  listValue,
+	"routes":                     listValue,
+	"services":                   listValue,
+	"stack":                      stringValue,
+	"timeout":                    integerValue,
+}
+
+var dockerFields = map[string]valueKind{
+	"image":    stringValue,
+	"username": stringValue,
+}
+
+var processFields = map[string]valueKind{
+	"command":                    stringValue,
+	"disk_quota":                 byteSizeValue,
+	"health-check-http-endpoint": stringValue,
+	"health-check-type":          stringValue,
+	"instances":                  integerValue,
+	"memory":                     byteSizeValue,
+	"type":                       stringValue,
+}
+
+var routeFields = map[string]valueKind{
+	"route": stringValue,
+}
+
+var serviceFields = map[string]valueKind{
+	"binding_parameters": mapValue,
+	"name":               stringValue,
+	"offering":           stringValue,
+	"parameters":         mapValue,
+	"plan":               stringValue,
+	"tags":               listValue,
+}
+
+// deprecatedGlobalFields are the top level keys older manifests used to share
+// settings between applications; they are rejected with
+// UnsupportedFieldsError when the manifest is read.
+var deprecatedGlobalFields = []string{
+	"buildpack", "command", "disk_quota", "docker", "domain", "domains", "env",
+	"health-check-http-endpoint", "timeout", "health-check-type", "host",
+	"hosts", "inherit", "instances", "memory", "name", "no-hostname",
+	"no-route", "path", "random-route", "routes", "services", "stack",
+}
+
+var healthCheckTypes = []string{"http", "none", "port", "process"}
+
+var syntaxErrorRegexp = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+// Validate checks the contents of a manifest without contacting a Cloud
+// Controller and returns every problem found, ordered by position.
+func Validate(rawManifest []byte) []ValidationError {
+	var document yaml.MapSlice
+	err := yaml.Unmarshal(rawManifest, &document)
+	if err != nil {
+		return []ValidationError{syntaxError(err)}
+	}
+
+	v := validator{positions: locateKeys(rawManifest)}
+	v.validateDocument(document)
+
+	sort.SliceStable(v.errors, func(i int, j int) bool {
+		if v.errors[i].Line != v.errors[j].Line {
+			return v.errors[i].Line < v.errors[j].Line
+		}
+		return v.errors[i].Column < v.errors[j].Column
+	})
+	return v.errors
+}
+
+func syntaxError(err error) ValidationError {
+	matches := syntaxErrorRegexp.FindStringSubmatch(err.Error())
+	if matches == nil {
+		return ValidationError{Line: 1, Column: 1, Message: strings.TrimPrefix(err.Error(), "yaml: ")}
+	}
+
+	line, _ := strconv.Atoi(matches[1])
+	return ValidationError{Line: line, Column: 1, Message: matches[2]}
+}
+
+type validator struct {
+	positions map[string]position
+	errors    []ValidationError
+}
+
+func (v *validator) add(path string, format string, args ...interface{}) {
+	pos := v.position(path)
+	v.errors = append(v.errors, ValidationError{
+		Line:    pos.line,
+		Column:  pos.column,
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// position returns the location of path, falling back to its closest located
+// ancestor.
+func (v *validator) position(path string) position {
+	for path != "" {
+		if pos, ok := v.positions[path]; ok {
+			return pos
+		}
+		path = path[:strings.LastIndexAny(path, ".[")+1]
+		path = strings.TrimRight(path, ".[")
+	}
+	return position{line: 1, column: 1}
+}
+
+func (v *validator) validateDocument(document yaml.MapSlice) {
+	for _, item := range document {
+		key := fmt.Sprint(item.Key)
+		switch {
+		case key == "applications":
+			apps, ok := item.Value.([]interface{})
+			if !ok {
+				if item.Value != nil {
+					v.add(key, "expected a list of applications")
+				}
+				continue
+			}
+			for i, app := range apps {
+				v.validateApplication(fmt.Sprintf("applications[%d]", i), app)
+			}
+		case contains(deprecatedGlobalFields, key):
+			v.add(key, "global key %q is not supported; set it on each application instead", key)
+		default:
+			v.add(key, "unknown key %q", key)
+		}
+	}
+}
+
+func (v *validator) validateApplication(path string, value interface{}) {
+	app, ok := asMap(value)
+	if !ok {
+		v.add(path, "expected an application map")
+		return
+	}
+
+	fields := v.validateFields(path, app, applicationFields)
+
+	if name, ok := fields["name"]; !ok || name == nil || fmt.Sprint(name) == "" {
+		v.add(path, "application is missing a name")
+	}
+
+	if docker, ok := fields["docker"]; ok {
+		v.validateDocker(path+".docker", docker)
+		if _, ok := fields["buildpack"]; ok {
+			v.add(path+".buildpack", "docker and buildpack cannot be used together")
+		}
+		if _, ok := fields["path"]; ok {
+			v.add(path+".path", "docker and path cannot be used together")
+		}
+	}
+
+	if noRoute, ok := fields["no-route"].(bool); ok && noRoute {
+		if _, ok := fields["routes"]; ok {
+			v.add(path+".routes", "routes and no-route cannot be used together")
+		}
+	}
+
+	v.validateHealthCheck(path, fields)
+
+	if env, ok := asMap(fields["env"]); ok {
+		for _, item := range env {
+			if !isScalar(item.Value) {
+				v.add(fmt.Sprintf("%s.env.%v", path, item.Key), "expected a string value")
+			}
+		}
+	}
+
+	if routes, ok := fields["routes"].([]interface{}); ok {
+		for i, route := range routes {
+			routePath := fmt.Sprintf("%s.routes[%d]", path, i)
+			routeMap, ok := asMap(route)
+			if !ok {
+				v.add(routePath, "expected a map with a route key")
+				continue
+			}
+			if _, ok := v.validateFields(routePath, routeMap, routeFields)["route"]; !ok {
+				v.add(routePath, "route entry is missing a route")
+			}
+		}
+	}
+
+	if processes, ok := fields["processes"].([]interface{}); ok {
+		for i, process := range processes {
+			v.validateProcess(fmt.Sprintf("%s.processes[%d]", path, i), process)
+		}
+	}
+
+	if services, ok := fields["services"].([]interface{}); ok {
+		for i, service := range services {
+			v.validateService(fmt.Sprintf("%s.services[%d]", path, i), service)
+		}
+	}
+}
+
+func (v *validator) validateDocker(path string, value interface{}) {
+	docker, ok := asMap(value)
+	if !ok {
+		return
+	}
+
+	if image, ok := v.validateFields(path, docker, dockerFields)["image"]; !ok || image == nil {
+		v.add(path, "docker is missing an image")
+	}
+}
+
+func (v *validator) validateHealthCheck(path string, fields map[string]interface{}) {
+	healthCheckType, ok := fields["health-check-type"].(string)
+	if !ok {
+		return
+	}
+
+	if !contains(healthCheckTypes, healthCheckType) {
+		v.add(path+".health-check-type", "health-check-type must be one of %s", strings.Join(healthCheckTypes, ", "))
+		return
+	}
+
+	if endpoint, ok := fields["health-check-http-endpoint"]; ok && healthCheckType != "http" {
+		if endpoint != nil && fmt.Sprint(endpoint) != "" && fmt.Sprint(endpoint) != "/" {
+			v.add(path+".health-check-http-endpoint", "health-check-http-endpoint requires health-check-type http")
+		}
+	}
+}
+
+func (v *validator) validateProcess(path string, value interface{}) {
+	process, ok := asMap(value)
+	if !ok {
+		v.add(path, "expected a process map")
+		return
+	}
+
+	fields := v.validateFields(path, process, processFields)
+	if processType, ok := fields["type"]; !ok || processType == nil || fmt.Sprint(processType) == "" {
+		v.add(path, "process is missing a type")
+	}
+	v.validateHealthCheck(path, fields)
+}
+
+func (v *validator) validateService(path string, value interface{}) {
+	if isScalar(value) {
+		return
+	}
+
+	service, ok := asMap(value)
+	if !ok {
+		v.add(path, "expected a service name or map")
+		return
+	}
+
+	fields := v.validateFields(path, service, serviceFields)
+	if name, ok := fields["name"]; !ok || name == nil || fmt.Sprint(name) == "" {
+		v.add(path, "service is missing a name")
+	}
+
+	_, hasOffering := fields["offering"]
+	_, hasPlan := fields["plan"]
+	if hasOffering != hasPlan {
+		v.add(path, "offering and plan must be given together")
+	}
+}
+
+// validateFields checks every key of m against known, reporting unknown keys
+// and values of the wrong type. It returns the known keys with their values.
+func (v *validator) validateFields(path string, m yaml.MapSlice, known map[string]valueKind) map[string]interface{} {
+	fields := map[string]interface{}{}
+	for _, item := range m {
+		key := fmt.Sprint(item.Key)
+		fieldPath := path + "." + key
+
+		kind, ok := known[key]
+		if !ok {
+			v.add(fieldPath, "unknown key %q", key)
+			continue
+		}
+
+		fields[key] = item.Value
+		if item.Value == nil {
+			continue
+		}
+
+		if !v.checkKind(fieldPath, kind, item.Value) {
+			delete(fields, key)
+		}
+	}
+	return fields
+}
+
+func (v *validator) checkKind(path string, kind valueKind, value interface{}) bool {
+	switch kind {
+	case stringValue:
+		if !isScalar(value) {
+			v.add(path, "expected a string")
+			return false
+		}
+	case integerValue:
+		switch value.(type) {
+		case int, int64, uint64:
+		default:
+			v.add(path, "expected an integer")
+			return false
+		}
+	case booleanValue:
+		if _, ok := value.(bool); !ok {
+			v.add(path, "expected a boolean")
+			return false
+		}
+	case byteSizeValue:
+		if !isScalar(value) {
+			v.add(path, "expected a size such as 256M or 1G")
+			return false
+		}
+		var size types.NullByteSizeInMb
+		if err := size.ParseStringValue(fmt.Sprint(value)); err != nil {
+			v.add(path, "invalid size %q; use a whole number with a unit such as M or G", fmt.Sprint(value))
+			return false
+		}
+	case mapValue:
+		if _, ok := asMap(value); !ok {
+			v.add(path, "expected a map")
+			return false
+		}
+	case listValue:
+		if _, ok := value.([]interface{}); !ok {
+			v.add(path, "expected a list")
+			return false
+		}
+	}
+	return true
+}
+
+func asMap(value interface{}) (yaml.MapSlice, bool) {
+	switch m := value.(type) {
+	case yaml.MapSlice:
+		return m, true
+	case map[interface{}]interface{}:
+		var slice yaml.MapSlice
+		for key, val := range m {
+			slice = append(slice, yaml.MapItem{Key: key, Value: val})
+		}
+		return slice, true
+	}
+	return nil, false
+}
+
+func isScalar(value interface{}) bool {
+	switch value.(type) {
+	case yaml.MapSlice, map[interface{}]interface{}, []interface{}:
+		return false
+	}
+	return true
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+type position struct {
+	line   int
+	column int
+}
+
+// locateKeys maps the path of every key and list item in a block style YAML
+// document (e.g. applications[0].memory) to where it appears. yaml.v2 does
+// not expose node positions, so this follows indentation line by line.
+func locateKeys(rawManifest []byte) map[string]position {
+	type frame struct {
+		indent int
+		path   string
+		item   bool
+	}
+
+	var stack []frame
+	positions := map[string]position{}
+	itemCounts := map[string]int{}
+	blockIndent := -1
+
+	parent := func() string {
+		if len(stack) == 0 {
+			return ""
+		}
+		return stack[len(stack)-1].path
+	}
+	record := func(path string, line int, indent int) {
+		if _, ok := positions[path]; !ok {
+			positions[path] = position{line: line, column: indent + 1}
+		}
+	}
+
+	for i, line := range strings.Split(string(rawManifest), "\n") {
+		line = strings.TrimRight(line, "\r")
+		content := strings.TrimLeft(line, " ")
+		indent := len(line) - len(content)
+
+		if blockIndent >= 0 {
+			if content == "" || indent > blockIndent {
+				continue
+			}
+			blockIndent = -1
+		}
+		if content == "" || strings.HasPrefix(content, "#") || strings.HasPrefix(content, "---") {
+			continue
+		}
+
+		for content == "-" || strings.HasPrefix(content, "- ") {
+			for len(stack) > 0 {
+				top := stack[len(stack)-1]
+				if top.indent < indent || (top.indent == indent && !top.item) {
+					break
+				}
+				stack = stack[:len(stack)-1]
+			}
+
+			p := parent()
+			path := fmt.Sprintf("%s[%d]", p, itemCounts[p])
+			itemCounts[p]++
+			record(path, i+1, indent)
+			stack = append(stack, frame{indent: indent, path: path, item: true})
+
+			rest := content[1:]
+			content = strings.TrimLeft(rest, " ")
+			indent += 1 + len(rest) - len(content)
+		}
+
+		key, value, ok := splitKey(content)
+		if !ok {
+			if strings.HasPrefix(content, "|") || strings.HasPrefix(content, ">") {
+				blockIndent = indent - 2
+			}
+			continue
+		}
+
+		for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+
+		path := key
+		if p := parent(); p != "" {
+			path = p + "." + key
+		}
+		record(path, i+1, indent)
+		stack = append(stack, frame{indent: indent, path: path})
+
+		if strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">") {
+			blockIndent = indent
+		}
+	}
+
+	return positions
+}
+
+// splitKey splits a "key: value" line, returning false for lines that do not
+// start with a mapping key.
+func splitKey(content string) (string, string, bool) {
+	if content == "" || strings.ContainsAny(content[:1], "{[&*!|>") {
+		return "", "", false
+	}
+
+	if quote := content[0]; quote == '"' || quote == '\'' {
+		end := strings.IndexByte(content[1:], quote)
+		if end == -1 || !strings.HasPrefix(content[end+2:], ":") {
+			return "", "", false
+		}
+		return content[1 : end+1], strings.TrimSpace(content[end+3:]), true
+	}
+
+	for i := 0; i < len(content); i++ {
+		if content[i] == '#' && i > 0 && content[i-1] == ' ' {
+			return "", "", false
+		}
+		if content[i] == ':' && (i == len(content)-1 || content[i+1] == ' ') {
+			return content[:i], strings.TrimSpace(content[i+1:]), true
+		}
+	}
+	return "", "", false
+}
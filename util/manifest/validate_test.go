@@ -0,0 +1,166 @@
+package manifest_test
+
+import (
+	"encoding/json"
+
+	. "code.cloudfoundry.org/cli/util/manifest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validate", func() {
+	var (
+		rawManifest string
+		errs        []ValidationError
+	)
+
+	JustBeforeEach(func() {
+		errs = Validate([]byte(rawManifest))
+	})
+
+	Context("when the manifest is valid", func() {
+		BeforeEach(func() {
+			rawManifest = `---
+applications:
+- name: app-1
+  buildpack: some-buildpack
+  memory: 1G
+  disk_quota: 512M
+  instances: 2
+  env:
+    SOME_KEY: some-value
+    PORT: 8080
+  routes:
+  - route: app-1.example.com
+  services:
+  - service_1
+  - name: service_2
+    offering: some-offering
+    plan: some-plan
+  processes:
+  - type: worker
+    command: |
+      bundle exec sidekiq
+    health-check-type: process
+- name: app-2
+  docker:
+    image: some-image
+  no-route: true
+`
+		})
+
+		It("returns no errors", func() {
+			Expect(errs).To(BeEmpty())
+		})
+	})
+
+	Context("when the manifest has several problems", func() {
+		BeforeEach(func() {
+			rawManifest = `---
+applications:
+- name: app-1
+  memroy: 1G
+  memory: 1X
+  instances: two
+  docker:
+    image: some-image
+  buildpack: some-buildpack
+- name: app-2
+  routes:
+  - route: app-2.example.com
+  no-route: true
+  health-check-type: ping
+  services:
+  - name: service_1
+    offering: some-offering
+`
+		})
+
+		It("returns all of them with their positions", func() {
+			Expect(errs).To(Equal([]ValidationError{
+				{Line: 4, Column: 3, Path: "applications[0].memroy", Message: `unknown key "memroy"`},
+				{Line: 5, Column: 3, Path: "applications[0].memory", Message: `invalid size "1X"; use a whole number with a unit such as M or G`},
+				{Line: 6, Column: 3, Path: "applications[0].instances", Message: "expected an integer"},
+				{Line: 9, Column: 3, Path: "applications[0].buildpack", Message: "docker and buildpack cannot be used together"},
+				{Line: 11, Column: 3, Path: "applications[1].routes", Message: "routes and no-route cannot be used together"},
+				{Line: 14, Column: 3, Path: "applications[1].health-check-type", Message: "health-check-type must be one of http, none, port, process"},
+				{Line: 16, Column: 3, Path: "applications[1].services[0]", Message: "offering and plan must be given together"},
+			}))
+		})
+	})
+
+	Context("when the manifest uses global fields", func() {
+		BeforeEach(func() {
+			rawManifest = `---
+memory: 1G
+applications:
+- memory: 256M
+`
+		})
+
+		It("reports the global fields and missing names", func() {
+			Expect(errs).To(Equal([]ValidationError{
+				{Line: 2, Column: 1, Path: "memory", Message: `global key "memory" is not supported; set it on each application instead`},
+				{Line: 4, Column: 1, Path: "applications[0]", Message: "application is missing a name"},
+			}))
+		})
+	})
+
+	Context("when a process is missing its type", func() {
+		BeforeEach(func() {
+			rawManifest = `---
+applications:
+- name: app-1
+  processes:
+  - command: some-command
+    health-check-type: port
+    health-check-http-endpoint: /health
+`
+		})
+
+		It("reports the process errors", func() {
+			Expect(errs).To(Equal([]ValidationError{
+				{Line: 5, Column: 3, Path: "applications[0].processes[0]", Message: "process is missing a type"},
+				{Line: 7, Column: 5, Path: "applications[0].processes[0].health-check-http-endpoint", Message: "health-check-http-endpoint requires health-check-type http"},
+			}))
+		})
+	})
+
+	Context("when the manifest is not valid YAML", func() {
+		BeforeEach(func() {
+			rawManifest = `---
+applications:
+- name: app-1
+  memory: 1G
+ path: foo
+`
+		})
+
+		It("returns the syntax error where the parser found it", func() {
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Line).To(Equal(4))
+			Expect(errs[0].Message).To(Equal("did not find expected key"))
+		})
+	})
+})
+
+var _ = Describe("JSONSchema", func() {
+	It("returns a schema describing application fields", func() {
+		rawSchema, err := JSONSchema()
+		Expect(err).ToNot(HaveOccurred())
+
+		var schema map[string]interface{}
+		Expect(json.Unmarshal(rawSchema, &schema)).To(Succeed())
+		Expect(schema).To(HaveKeyWithValue("$schema", "http://json-schema.org/draft-07/schema#"))
+
+		apps := schema["properties"].(map[string]interface{})["applications"].(map[string]interface{})
+		app := apps["items"].(map[string]interface{})
+		Expect(app["required"]).To(ConsistOf("name"))
+
+		properties := app["properties"].(map[string]interface{})
+		Expect(properties).To(HaveKey("memory"))
+		Expect(properties).To(HaveKey("processes"))
+		Expect(properties["instances"]).To(Equal(map[string]interface{}{"type": "integer"}))
+	})
+})
@@ -0,0 +1,116 @@
+// Package orgconfig reads and writes files that declare the desired state of
+// an organization and its spaces.
+package orgconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Org is the desired state of an organization. Users get only the
+// organization user role; users listed in any other role also get it.
+type Org struct {
+	Name              string       `yaml:"name" json:"name"`
+	Quota             string       `yaml:"quota,omitempty" json:"quota,omitempty"`
+	IsolationSegments []string     `yaml:"isolation_segments,omitempty" json:"isolation_segments,omitempty"`
+	PrivateDomains    []string     `yaml:"private_domains,omitempty" json:"private_domains,omitempty"`
+	Users             []string     `yaml:"users,omitempty" json:"users,omitempty"`
+	Managers          []string     `yaml:"managers,omitempty" json:"managers,omitempty"`
+	BillingManagers   []string     `yaml:"billing_managers,omitempty" json:"billing_managers,omitempty"`
+	Auditors          []string     `yaml:"auditors,omitempty" json:"auditors,omitempty"`
+	SpaceQuotas       []SpaceQuota `yaml:"space_quotas,omitempty" json:"space_quotas,omitempty"`
+	Spaces            []Space      `yaml:"spaces,omitempty" json:"spaces,omitempty"`
+}
+
+// Space is the desired state of a space in an organization.
+type Space struct {
+	Name             string   `yaml:"name" json:"name"`
+	Quota            string   `yaml:"quota,omitempty" json:"quota,omitempty"`
+	IsolationSegment string   `yaml:"isolation_segment,omitempty" json:"isolation_segment,omitempty"`
+	SecurityGroups   []string `yaml:"security_groups,omitempty" json:"security_groups,omitempty"`
+	Managers         []string `yaml:"managers,omitempty" json:"managers,omitempty"`
+	Developers       []string `yaml:"developers,omitempty" json:"developers,omitempty"`
+	Auditors         []string `yaml:"auditors,omitempty" json:"auditors,omitempty"`
+}
+
+// InvalidOrgConfigError is returned when an org config file is well formed
+// YAML but does not describe a valid organization.
+type InvalidOrgConfigError struct {
+	Reason string
+}
+
+func (e InvalidOrgConfigError) Error() string {
+	return fmt.Sprintf("invalid org config: %s", e.Reason)
+}
+
+// ReadOrgConfig reads and validates the org config at the provided path.
+func ReadOrgConfig(pathToConfig string) (Org, error) {
+	raw, err := ioutil.ReadFile(pathToConfig)
+	if err != nil {
+		return Org{}, err
+	}
+
+	var org Org
+	err = yaml.Unmarshal(raw, &org)
+	if err != nil {
+		return Org{}, err
+	}
+
+	return org, org.validate()
+}
+
+// WriteOrgConfig writes the org config to the provided path. The config is
+// written as JSON when the path has a .json extension and as YAML otherwise.
+func WriteOrgConfig(org Org, pathToConfig string) error {
+	var (
+		raw []byte
+		err error
+	)
+	if strings.EqualFold(filepath.Ext(pathToConfig), ".json") {
+		raw, err = json.MarshalIndent(org, "", "  ")
+		raw = append(raw, '\n')
+	} else {
+		raw, err = yaml.Marshal(org)
+		raw = append([]byte("---\n"), raw...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(pathToConfig, raw, 0666)
+}
+
+func (org Org) validate() error {
+	if org.Name == "" {
+		return InvalidOrgConfigError{Reason: "name is required"}
+	}
+
+	quotaNames := map[string]bool{}
+	for _, spaceQuota := range org.SpaceQuotas {
+		if spaceQuota.Name == "" {
+			return InvalidOrgConfigError{Reason: "every space quota needs a name"}
+		}
+		if quotaNames[spaceQuota.Name] {
+			return InvalidOrgConfigError{Reason: fmt.Sprintf("space quota '%s' is declared more than once", spaceQuota.Name)}
+		}
+		quotaNames[spaceQuota.Name] = true
+	}
+
+	spaceNames := map[string]bool{}
+	for _, space := range org.Spaces {
+		if space.Name == "" {
+			return InvalidOrgConfigError{Reason: "every space needs a name"}
+		}
+		if spaceNames[space.Name] {
+			return InvalidOrgConfigError{Reason: fmt.Sprintf("space '%s' is declared more than once", space.Name)}
+		}
+		spaceNames[space.Name] = true
+	}
+
+	return nil
+}
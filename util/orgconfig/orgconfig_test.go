@@ -0,0 +1,204 @@
+package orgconfig_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/orgconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Org Config", func() {
+	var (
+		tmpDir       string
+		pathToConfig string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "orgconfig")
+		Expect(err).ToNot(HaveOccurred())
+		pathToConfig = filepath.Join(tmpDir, "org.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	Describe("ReadOrgConfig", func() {
+		var (
+			rawConfig  string
+			org        Org
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			Expect(ioutil.WriteFile(pathToConfig, []byte(rawConfig), 0666)).To(Succeed())
+			org, executeErr = ReadOrgConfig(pathToConfig)
+		})
+
+		Context("when the config is valid", func() {
+			BeforeEach(func() {
+				rawConfig = `---
+name: some-org
+quota: some-org-quota
+isolation_segments: [some-iso-seg]
+private_domains: [some-domain.com]
+users: [some-user]
+managers: [some-manager]
+billing_managers: [some-billing-manager]
+auditors: [some-auditor]
+space_quotas:
+- name: small
+  memory: 2G
+  instance_memory: 512M
+  routes: 10
+  services: unlimited
+  paid_service_plans: true
+spaces:
+- name: dev
+  quota: small
+  isolation_segment: some-iso-seg
+  security_groups: [public_networks]
+  managers: [some-manager]
+  developers: [some-developer]
+  auditors: [some-auditor]
+- name: prod
+`
+			})
+
+			It("returns the org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(org).To(Equal(Org{
+					Name:              "some-org",
+					Quota:             "some-org-quota",
+					IsolationSegments: []string{"some-iso-seg"},
+					PrivateDomains:    []string{"some-domain.com"},
+					Users:             []string{"some-user"},
+					Managers:          []string{"some-manager"},
+					BillingManagers:   []string{"some-billing-manager"},
+					Auditors:          []string{"some-auditor"},
+					SpaceQuotas: []SpaceQuota{{
+						Name:                    "small",
+						MemoryLimitInMB:         2048,
+						InstanceMemoryLimitInMB: 512,
+						TotalRoutes:             10,
+						TotalServices:           Unlimited,
+						AppInstanceLimit:        Unlimited,
+						PaidServicePlans:        true,
+					}},
+					Spaces: []Space{
+						{
+							Name:             "dev",
+							Quota:            "small",
+							IsolationSegment: "some-iso-seg",
+							SecurityGroups:   []string{"public_networks"},
+							Managers:         []string{"some-manager"},
+							Developers:       []string{"some-developer"},
+							Auditors:         []string{"some-auditor"},
+						},
+						{Name: "prod"},
+					},
+				}))
+			})
+		})
+
+		Context("when the name is missing", func() {
+			BeforeEach(func() {
+				rawConfig = "quota: some-org-quota\n"
+			})
+
+			It("returns an InvalidOrgConfigError", func() {
+				Expect(executeErr).To(MatchError(InvalidOrgConfigError{Reason: "name is required"}))
+			})
+		})
+
+		Context("when a space is declared twice", func() {
+			BeforeEach(func() {
+				rawConfig = "name: some-org\nspaces:\n- name: dev\n- name: dev\n"
+			})
+
+			It("returns an InvalidOrgConfigError", func() {
+				Expect(executeErr).To(MatchError(InvalidOrgConfigError{Reason: "space 'dev' is declared more than once"}))
+			})
+		})
+
+		Context("when a space quota limit is invalid", func() {
+			BeforeEach(func() {
+				rawConfig = "name: some-org\nspace_quotas:\n- name: small\n  memory: lots\n"
+			})
+
+			It("returns an InvalidOrgConfigError", func() {
+				Expect(executeErr).To(MatchError(InvalidOrgConfigError{Reason: "space quota 'small' has an invalid memory 'lots'"}))
+			})
+		})
+
+		Context("when the file does not exist", func() {
+			It("returns the error", func() {
+				_, err := ReadOrgConfig(filepath.Join(tmpDir, "missing.yml"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("WriteOrgConfig", func() {
+		var org Org
+
+		BeforeEach(func() {
+			org = Org{
+				Name:              "some-org",
+				Quota:             "some-org-quota",
+				IsolationSegments: []string{"some-iso-seg"},
+				Managers:          []string{"some-manager"},
+				SpaceQuotas: []SpaceQuota{{
+					Name:                    "small",
+					MemoryLimitInMB:         2048,
+					InstanceMemoryLimitInMB: Unlimited,
+					TotalRoutes:             10,
+					TotalServices:           Unlimited,
+					AppInstanceLimit:        Unlimited,
+				}},
+				Spaces: []Space{
+					{Name: "dev", Quota: "small", Developers: []string{"some-developer"}},
+				},
+			}
+		})
+
+		Context("when the path has a .json extension", func() {
+			BeforeEach(func() {
+				pathToConfig = filepath.Join(tmpDir, "org.json")
+			})
+
+			It("writes the config as JSON", func() {
+				Expect(WriteOrgConfig(org, pathToConfig)).To(Succeed())
+
+				raw, err := ioutil.ReadFile(pathToConfig)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(raw)).To(HavePrefix("{"))
+				Expect(string(raw)).To(ContainSubstring(`"memory": "2048M"`))
+				Expect(string(raw)).To(ContainSubstring(`"instance_memory": "unlimited"`))
+
+				readOrg, err := ReadOrgConfig(pathToConfig)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(readOrg).To(Equal(org))
+			})
+		})
+
+		Context("when the path has any other extension", func() {
+			It("writes the config as YAML", func() {
+				Expect(WriteOrgConfig(org, pathToConfig)).To(Succeed())
+
+				raw, err := ioutil.ReadFile(pathToConfig)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(raw)).To(HavePrefix("---\nname: some-org\n"))
+				Expect(string(raw)).To(ContainSubstring("  memory: 2048M\n"))
+
+				readOrg, err := ReadOrgConfig(pathToConfig)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(readOrg).To(Equal(org))
+			})
+		})
+	})
+})
@@ -0,0 +1,134 @@
+package orgconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloudfoundry/bytefmt"
+)
+
+// Unlimited is the value of a space quota limit without a maximum.
+const Unlimited = -1
+
+// SpaceQuota is the desired state of a space quota defined in an
+// organization. Limits that are left out of the file are Unlimited.
+type SpaceQuota struct {
+	Name                    string
+	MemoryLimitInMB         int
+	InstanceMemoryLimitInMB int
+	TotalRoutes             int
+	TotalServices           int
+	AppInstanceLimit        int
+	PaidServicePlans        bool
+}
+
+type rawSpaceQuota struct {
+	Name             string `yaml:"name" json:"name"`
+	Memory           string `yaml:"memory,omitempty" json:"memory,omitempty"`
+	InstanceMemory   string `yaml:"instance_memory,omitempty" json:"instance_memory,omitempty"`
+	Routes           string `yaml:"routes,omitempty" json:"routes,omitempty"`
+	Services         string `yaml:"services,omitempty" json:"services,omitempty"`
+	AppInstances     string `yaml:"app_instances,omitempty" json:"app_instances,omitempty"`
+	PaidServicePlans bool   `yaml:"paid_service_plans,omitempty" json:"paid_service_plans,omitempty"`
+}
+
+func (spaceQuota SpaceQuota) MarshalJSON() ([]byte, error) {
+	return json.Marshal(spaceQuota.raw())
+}
+
+func (spaceQuota SpaceQuota) MarshalYAML() (interface{}, error) {
+	return spaceQuota.raw(), nil
+}
+
+func (spaceQuota SpaceQuota) raw() rawSpaceQuota {
+	return rawSpaceQuota{
+		Name:             spaceQuota.Name,
+		Memory:           formatMemoryLimit(spaceQuota.MemoryLimitInMB),
+		InstanceMemory:   formatMemoryLimit(spaceQuota.InstanceMemoryLimitInMB),
+		Routes:           formatCountLimit(spaceQuota.TotalRoutes),
+		Services:         formatCountLimit(spaceQuota.TotalServices),
+		AppInstances:     formatCountLimit(spaceQuota.AppInstanceLimit),
+		PaidServicePlans: spaceQuota.PaidServicePlans,
+	}
+}
+
+func (spaceQuota *SpaceQuota) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var raw rawSpaceQuota
+	err := unmarshal(&raw)
+	if err != nil {
+		return err
+	}
+
+	spaceQuota.Name = raw.Name
+	spaceQuota.PaidServicePlans = raw.PaidServicePlans
+
+	limits := []struct {
+		field string
+		value string
+		parse func(string) (int, error)
+		dest  *int
+	}{
+		{"memory", raw.Memory, parseMemoryLimit, &spaceQuota.MemoryLimitInMB},
+		{"instance_memory", raw.InstanceMemory, parseMemoryLimit, &spaceQuota.InstanceMemoryLimitInMB},
+		{"routes", raw.Routes, parseCountLimit, &spaceQuota.TotalRoutes},
+		{"services", raw.Services, parseCountLimit, &spaceQuota.TotalServices},
+		{"app_instances", raw.AppInstances, parseCountLimit, &spaceQuota.AppInstanceLimit},
+	}
+	for _, limit := range limits {
+		*limit.dest, err = limit.parse(limit.value)
+		if err != nil {
+			return InvalidOrgConfigError{
+				Reason: fmt.Sprintf("space quota '%s' has an invalid %s '%s'", raw.Name, limit.field, limit.value),
+			}
+		}
+	}
+
+	return nil
+}
+
+func isUnlimited(value string) bool {
+	return value == "" || value == "-1" || strings.EqualFold(value, "unlimited")
+}
+
+func parseMemoryLimit(value string) (int, error) {
+	if isUnlimited(value) {
+		return Unlimited, nil
+	}
+
+	megabytes, err := bytefmt.ToMegabytes(value)
+	if err != nil {
+		return 0, err
+	}
+	return int(megabytes), nil
+}
+
+func parseCountLimit(value string) (int, error) {
+	if isUnlimited(value) {
+		return Unlimited, nil
+	}
+
+	count, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+	if count < 0 {
+		return 0, fmt.Errorf("negative limit %d", count)
+	}
+	return count, nil
+}
+
+func formatMemoryLimit(megabytes int) string {
+	if megabytes < 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%dM", megabytes)
+}
+
+func formatCountLimit(count int) string {
+	if count < 0 {
+		return "unlimited"
+	}
+	return strconv.Itoa(count)
+}
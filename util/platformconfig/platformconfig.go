@@ -0,0 +1,68 @@
+// Package platformconfig reads files that declare the desired feature flags
+// and environment variable groups of a Cloud Foundry platform.
+package platformconfig
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/util/generic"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Platform is the desired state of the platform's feature flags and
+// environment variable groups. A section left out of the file is left as it
+// is on the platform.
+type Platform struct {
+	FeatureFlags                map[string]bool        `yaml:"feature_flags"`
+	RunningEnvironmentVariables map[string]interface{} `yaml:"running_environment_variables"`
+	StagingEnvironmentVariables map[string]interface{} `yaml:"staging_environment_variables"`
+}
+
+// InvalidPlatformConfigError is returned when a platform config file is well
+// formed YAML but does not describe a valid platform configuration.
+type InvalidPlatformConfigError struct {
+	Reason string
+}
+
+func (e InvalidPlatformConfigError) Error() string {
+	return fmt.Sprintf("invalid platform config: %s", e.Reason)
+}
+
+// ReadPlatformConfig reads and validates the platform config at the provided
+// path.
+func ReadPlatformConfig(pathToConfig string) (Platform, error) {
+	raw, err := ioutil.ReadFile(pathToConfig)
+	if err != nil {
+		return Platform{}, err
+	}
+
+	var platform Platform
+	err = yaml.Unmarshal(raw, &platform)
+	if err != nil {
+		return Platform{}, err
+	}
+
+	platform.RunningEnvironmentVariables = generic.StringifyKeys(platform.RunningEnvironmentVariables)
+	platform.StagingEnvironmentVariables = generic.StringifyKeys(platform.StagingEnvironmentVariables)
+
+	return platform, platform.validate()
+}
+
+func (platform Platform) validate() error {
+	if platform.FeatureFlags == nil && platform.RunningEnvironmentVariables == nil && platform.StagingEnvironmentVariables == nil {
+		return InvalidPlatformConfigError{Reason: "the file has no feature_flags, running_environment_variables or staging_environment_variables"}
+	}
+
+	if _, ok := platform.FeatureFlags[""]; ok {
+		return InvalidPlatformConfigError{Reason: "a feature flag has no name"}
+	}
+	if _, ok := platform.RunningEnvironmentVariables[""]; ok {
+		return InvalidPlatformConfigError{Reason: "a running environment variable has no name"}
+	}
+	if _, ok := platform.StagingEnvironmentVariables[""]; ok {
+		return InvalidPlatformConfigError{Reason: "a staging environment variable has no name"}
+	}
+
+	return nil
+}
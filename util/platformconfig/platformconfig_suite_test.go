@@ -0,0 +1,13 @@
+package platformconfig_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestPlatformConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Platform Config Suite")
+}
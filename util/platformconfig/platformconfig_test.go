@@ -0,0 +1,115 @@
+package platformconfig_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/platformconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Platform Config", func() {
+	var (
+		tmpDir       string
+		pathToConfig string
+		rawConfig    string
+		platform     Platform
+		executeErr   error
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "platformconfig")
+		Expect(err).ToNot(HaveOccurred())
+		pathToConfig = filepath.Join(tmpDir, "platform.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		Expect(ioutil.WriteFile(pathToConfig, []byte(rawConfig), 0666)).To(Succeed())
+		platform, executeErr = ReadPlatformConfig(pathToConfig)
+	})
+
+	Context("when the config is valid", func() {
+		BeforeEach(func() {
+			rawConfig = `---
+feature_flags:
+  user_org_creation: false
+  diego_docker: true
+running_environment_variables:
+  LANG: en_US.UTF-8
+  MAX_THREADS: 16
+  LIMITS:
+    cpu: 2
+    regions: [eu, us]
+staging_environment_variables: {}
+`
+		})
+
+		It("returns the platform, keeping the type of each variable", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(platform).To(Equal(Platform{
+				FeatureFlags: map[string]bool{
+					"user_org_creation": false,
+					"diego_docker":      true,
+				},
+				RunningEnvironmentVariables: map[string]interface{}{
+					"LANG":        "en_US.UTF-8",
+					"MAX_THREADS": 16,
+					"LIMITS": map[string]interface{}{
+						"cpu":     2,
+						"regions": []interface{}{"eu", "us"},
+					},
+				},
+				StagingEnvironmentVariables: map[string]interface{}{},
+			}))
+		})
+	})
+
+	Context("when a section is left out", func() {
+		BeforeEach(func() {
+			rawConfig = "feature_flags:\n  diego_docker: true\n"
+		})
+
+		It("leaves the section nil", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(platform.RunningEnvironmentVariables).To(BeNil())
+			Expect(platform.StagingEnvironmentVariables).To(BeNil())
+		})
+	})
+
+	Context("when the file declares nothing", func() {
+		BeforeEach(func() {
+			rawConfig = "---\n"
+		})
+
+		It("returns an InvalidPlatformConfigError", func() {
+			Expect(executeErr).To(MatchError(InvalidPlatformConfigError{Reason: "the file has no feature_flags, running_environment_variables or staging_environment_variables"}))
+		})
+	})
+
+	Context("when an environment variable has no name", func() {
+		BeforeEach(func() {
+			rawConfig = "staging_environment_variables:\n  \"\": value\n"
+		})
+
+		It("returns an InvalidPlatformConfigError", func() {
+			Expect(executeErr).To(MatchError(InvalidPlatformConfigError{Reason: "a staging environment variable has no name"}))
+		})
+	})
+
+	Context("when the file is not valid YAML", func() {
+		BeforeEach(func() {
+			rawConfig = "feature_flags: [\n"
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(HaveOccurred())
+		})
+	})
+})
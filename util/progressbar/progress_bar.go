@@ -1,6 +1,7 @@
 package progressbar
 
 import (
+	"fmt"
 	"io"
 	"time"
 
@@ -8,8 +9,9 @@ import (
 )
 
 type ProgressBar struct {
-	ready chan bool
-	bar   *pb.ProgressBar
+	ready   chan bool
+	bar     *pb.ProgressBar
+	retries int
 }
 
 func NewProgressBar() *ProgressBar {
@@ -26,6 +28,9 @@ func (p *ProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64)
 
 	p.bar = pb.New(int(sizeOfFile)).SetUnits(pb.U_BYTES)
 	p.bar.ShowTimeLeft = false
+	if p.retries > 0 {
+		p.bar.Prefix(fmt.Sprintf("retry %d ", p.retries))
+	}
 	p.bar.Start()
 	return p.bar.NewProxyReader(reader)
 }
@@ -34,7 +39,44 @@ func (p *ProgressBar) Ready() {
 	p.ready <- true
 }
 
+// Retry stops the bar of a failed upload; the bar of the next upload is
+// labelled with the number of the retry.
+func (p *ProgressBar) Retry() {
+	if p.bar != nil {
+		p.bar.Finish()
+		p.bar = nil
+	}
+	p.retries++
+}
+
 func (p *ProgressBar) Complete() {
 	// Adding sleep to ensure UI has finished drawing
 	time.Sleep(time.Second)
 }
+
+// TransferProgressBar displays a new progress bar for every reader it wraps,
+// finishing the previous one. Unlike ProgressBar it does not wait to be
+// signalled as ready.
+type TransferProgressBar struct {
+	bar *pb.ProgressBar
+}
+
+func NewTransferProgressBar() *TransferProgressBar {
+	return &TransferProgressBar{}
+}
+
+func (p *TransferProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader {
+	p.Complete()
+
+	p.bar = pb.New64(sizeOfFile).SetUnits(pb.U_BYTES)
+	p.bar.ShowTimeLeft = false
+	p.bar.Start()
+	return p.bar.NewProxyReader(reader)
+}
+
+func (p *TransferProgressBar) Complete() {
+	if p.bar != nil {
+		p.bar.Finish()
+		p.bar = nil
+	}
+}
@@ -0,0 +1,176 @@
+// Package resourcecache records the SHA1 of files gathered for a push, keyed
+// by path, size and modification time, so that unchanged files do not need to
+// be hashed again. It also records the resources last uploaded for each app so
+// that an upload can be skipped when nothing has changed.
+package resourcecache
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// File is the cached SHA1 of a file.
+type File struct {
+	Size    int64  `json:"size"`
+	ModTime int64  `json:"mtime"`
+	SHA1    string `json:"sha1"`
+}
+
+// Package describes the resources last uploaded for an app.
+type Package struct {
+	UpdatedAt   time.Time `json:"updated_at"`
+	Fingerprint string    `json:"fingerprint"`
+}
+
+// Resource is a file or directory that makes up an app's package.
+type Resource struct {
+	Filename string
+	Mode     os.FileMode
+	SHA1     string
+}
+
+// Cache is the contents of the resource cache file.
+type Cache struct {
+	Files    map[string]File    `json:"files"`
+	Packages map[string]Package `json:"packages"`
+
+	path string
+	seen map[string]bool
+}
+
+// Load reads the cache file at path. A missing file results in an empty
+// cache.
+func Load(path string) (*Cache, error) {
+	cache := &Cache{
+		Files:    map[string]File{},
+		Packages: map[string]Package{},
+		path:     path,
+		seen:     map[string]bool{},
+	}
+
+	rawCache, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cache, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rawCache) == 0 {
+		return cache, nil
+	}
+
+	err = json.Unmarshal(rawCache, cache)
+	if err != nil {
+		return nil, err
+	}
+
+	if cache.Files == nil {
+		cache.Files = map[string]File{}
+	}
+	if cache.Packages == nil {
+		cache.Packages = map[string]Package{}
+	}
+
+	return cache, nil
+}
+
+// Get returns the SHA1 recorded for the file at path, provided its size and
+// modification time have not changed since it was recorded.
+func (cache *Cache) Get(path string, info os.FileInfo) (string, bool) {
+	cache.seen[path] = true
+
+	file, exists := cache.Files[path]
+	if !exists || file.Size != info.Size() || file.ModTime != info.ModTime().UnixNano() {
+		return "", false
+	}
+
+	return file.SHA1, true
+}
+
+// Set records the SHA1 of the file at path.
+func (cache *Cache) Set(path string, info os.FileInfo, sha1 string) {
+	cache.seen[path] = true
+	cache.Files[path] = File{
+		Size:    info.Size(),
+		ModTime: info.ModTime().UnixNano(),
+		SHA1:    sha1,
+	}
+}
+
+// Prune removes the files under dir that have not been looked up or set since
+// the cache was loaded, such as files that have been deleted or ignored.
+func (cache *Cache) Prune(dir string) {
+	prefix := strings.TrimSuffix(dir, string(filepath.Separator)) + string(filepath.Separator)
+	for path := range cache.Files {
+		if strings.HasPrefix(path, prefix) && !cache.seen[path] {
+			delete(cache.Files, path)
+		}
+	}
+}
+
+// PackageUnchanged returns true if resources are the resources last recorded
+// for appGUID and the app's package has not been updated since.
+func (cache *Cache) PackageUnchanged(appGUID string, updatedAt time.Time, resources []Resource) bool {
+	pkg, exists := cache.Packages[appGUID]
+	if !exists {
+		return false
+	}
+
+	return pkg.UpdatedAt.Equal(updatedAt) && pkg.Fingerprint == Fingerprint(resources)
+}
+
+// SetPackage records resources as the package uploaded for appGUID.
+func (cache *Cache) SetPackage(appGUID string, updatedAt time.Time, resources []Resource) {
+	cache.Packages[appGUID] = Package{
+		UpdatedAt:   updatedAt,
+		Fingerprint: Fingerprint(resources),
+	}
+}
+
+// Save writes the cache back to the file it was loaded from.
+func (cache *Cache) Save() error {
+	rawCache, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(cache.path)
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(dir, "temp-resource-cache")
+	if err != nil {
+		return err
+	}
+	tempFile.Close()
+
+	err = ioutil.WriteFile(tempFile.Name(), rawCache, 0600)
+	if err != nil {
+		_ = os.Remove(tempFile.Name())
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), cache.path)
+}
+
+// Fingerprint returns a digest of resources that does not depend on their
+// order.
+func Fingerprint(resources []Resource) string {
+	lines := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		lines = append(lines, fmt.Sprintf("%s\x00%o\x00%s", resource.Filename, resource.Mode, resource.SHA1))
+	}
+	sort.Strings(lines)
+
+	return fmt.Sprintf("%x", sha1.Sum([]byte(strings.Join(lines, "\n"))))
+}
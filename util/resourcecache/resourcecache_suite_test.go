@@ -0,0 +1,13 @@
+package resourcecache_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestResourceCache(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Resource Cache Suite")
+}
@@ -0,0 +1,213 @@
+package resourcecache_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/resourcecache"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cache", func() {
+	var (
+		tempDir  string
+		path     string
+		appDir   string
+		filePath string
+		fileInfo os.FileInfo
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "resource-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		path = filepath.Join(tempDir, ".cf", "resource_cache.json")
+
+		appDir = filepath.Join(tempDir, "app")
+		Expect(os.MkdirAll(appDir, 0700)).To(Succeed())
+		filePath = filepath.Join(appDir, "some-file")
+		Expect(ioutil.WriteFile(filePath, []byte("some-contents"), 0600)).To(Succeed())
+
+		fileInfo, err = os.Stat(filePath)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	Describe("Load", func() {
+		Context("when the file does not exist", func() {
+			It("returns an empty cache", func() {
+				cache, err := Load(path)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cache.Files).To(BeEmpty())
+				Expect(cache.Packages).To(BeEmpty())
+			})
+		})
+
+		Context("when the file contains invalid JSON", func() {
+			BeforeEach(func() {
+				Expect(os.MkdirAll(filepath.Dir(path), 0700)).To(Succeed())
+				Expect(ioutil.WriteFile(path, []byte("not-json"), 0600)).To(Succeed())
+			})
+
+			It("returns an error", func() {
+				_, err := Load(path)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Get and Set", func() {
+		var cache *Cache
+
+		BeforeEach(func() {
+			var err error
+			cache, err = Load(path)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the SHA1 set for an unchanged file", func() {
+			_, found := cache.Get(filePath, fileInfo)
+			Expect(found).To(BeFalse())
+
+			cache.Set(filePath, fileInfo, "some-sha1")
+
+			sha1, found := cache.Get(filePath, fileInfo)
+			Expect(found).To(BeTrue())
+			Expect(sha1).To(Equal("some-sha1"))
+		})
+
+		It("persists the SHA1 between loads", func() {
+			cache.Set(filePath, fileInfo, "some-sha1")
+			Expect(cache.Save()).To(Succeed())
+
+			reloadedCache, err := Load(path)
+			Expect(err).ToNot(HaveOccurred())
+
+			sha1, found := reloadedCache.Get(filePath, fileInfo)
+			Expect(found).To(BeTrue())
+			Expect(sha1).To(Equal("some-sha1"))
+		})
+
+		It("writes the file readable only by the user", func() {
+			Expect(cache.Save()).To(Succeed())
+
+			info, err := os.Stat(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+
+		Context("when the file has been modified", func() {
+			BeforeEach(func() {
+				cache.Set(filePath, fileInfo, "some-sha1")
+
+				modTime := fileInfo.ModTime().Add(time.Minute)
+				Expect(os.Chtimes(filePath, modTime, modTime)).To(Succeed())
+
+				var err error
+				fileInfo, err = os.Stat(filePath)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not return the stale SHA1", func() {
+				_, found := cache.Get(filePath, fileInfo)
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the file has changed size", func() {
+			BeforeEach(func() {
+				cache.Set(filePath, fileInfo, "some-sha1")
+
+				modTime := fileInfo.ModTime()
+				Expect(ioutil.WriteFile(filePath, []byte("some-longer-contents"), 0600)).To(Succeed())
+				Expect(os.Chtimes(filePath, modTime, modTime)).To(Succeed())
+
+				var err error
+				fileInfo, err = os.Stat(filePath)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not return the stale SHA1", func() {
+				_, found := cache.Get(filePath, fileInfo)
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Prune", func() {
+		It("removes the files under the directory that were not seen", func() {
+			cache, err := Load(path)
+			Expect(err).ToNot(HaveOccurred())
+
+			cache.Set(filePath, fileInfo, "some-sha1")
+			cache.Set(filepath.Join(appDir, "deleted-file"), fileInfo, "some-other-sha1")
+			cache.Set(filepath.Join(tempDir, "other-app", "some-file"), fileInfo, "some-other-app-sha1")
+			Expect(cache.Save()).To(Succeed())
+
+			cache, err = Load(path)
+			Expect(err).ToNot(HaveOccurred())
+			_, found := cache.Get(filePath, fileInfo)
+			Expect(found).To(BeTrue())
+
+			cache.Prune(appDir)
+			Expect(cache.Files).To(HaveLen(2))
+			Expect(cache.Files).To(HaveKey(filePath))
+			Expect(cache.Files).To(HaveKey(filepath.Join(tempDir, "other-app", "some-file")))
+		})
+	})
+
+	Describe("PackageUnchanged and SetPackage", func() {
+		var (
+			cache     *Cache
+			updatedAt time.Time
+			resources []Resource
+		)
+
+		BeforeEach(func() {
+			var err error
+			cache, err = Load(path)
+			Expect(err).ToNot(HaveOccurred())
+
+			updatedAt = time.Unix(1500000000, 0).UTC()
+			resources = []Resource{
+				{Filename: "a", Mode: 0644, SHA1: "some-sha1"},
+				{Filename: "b", Mode: 0755},
+			}
+		})
+
+		It("returns false when no package has been recorded", func() {
+			Expect(cache.PackageUnchanged("some-app-guid", updatedAt, resources)).To(BeFalse())
+		})
+
+		Context("when a package has been recorded", func() {
+			BeforeEach(func() {
+				cache.SetPackage("some-app-guid", updatedAt, resources)
+				Expect(cache.Save()).To(Succeed())
+
+				var err error
+				cache, err = Load(path)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns true for the same resources in any order", func() {
+				Expect(cache.PackageUnchanged("some-app-guid", updatedAt, []Resource{resources[1], resources[0]})).To(BeTrue())
+			})
+
+			It("returns false when a resource has changed", func() {
+				resources[0].SHA1 = "some-other-sha1"
+				Expect(cache.PackageUnchanged("some-app-guid", updatedAt, resources)).To(BeFalse())
+			})
+
+			It("returns false when the package has since been updated", func() {
+				Expect(cache.PackageUnchanged("some-app-guid", updatedAt.Add(time.Second), resources)).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,115 @@
+// Package rollbackhistory records the droplet an application was running
+// before it was last rolled back, keyed by application GUID, so that the
+// rollback can be undone.
+package rollbackhistory
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Entry is the most recent rollback of an application.
+type Entry struct {
+	AppGUID         string    `json:"-"`
+	AppName         string    `json:"AppName"`
+	FromDropletGUID string    `json:"FromDropletGUID"`
+	ToDropletGUID   string    `json:"ToDropletGUID"`
+	RolledBackAt    time.Time `json:"RolledBackAt"`
+}
+
+// Store reads and writes the rollback history file.
+type Store struct {
+	path string
+}
+
+// NewStore returns a Store backed by the file at path. The file does not need
+// to exist.
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// Get returns the most recent rollback of the application and true if one
+// was recorded.
+func (store *Store) Get(appGUID string) (Entry, bool, error) {
+	entries, err := store.load()
+	if err != nil {
+		return Entry{}, false, err
+	}
+
+	entry, exists := entries[appGUID]
+	return entry, exists, nil
+}
+
+// Record saves entry as the most recent rollback of its application,
+// replacing any rollback previously recorded for it.
+func (store *Store) Record(entry Entry) error {
+	entries, err := store.load()
+	if err != nil {
+		return err
+	}
+
+	if entry.RolledBackAt.IsZero() {
+		entry.RolledBackAt = time.Now().UTC()
+	}
+	entries[entry.AppGUID] = entry
+
+	return store.write(entries)
+}
+
+func (store *Store) load() (map[string]Entry, error) {
+	entries := map[string]Entry{}
+
+	rawEntries, err := ioutil.ReadFile(store.path)
+	if os.IsNotExist(err) {
+		return entries, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rawEntries) == 0 {
+		return entries, nil
+	}
+
+	err = json.Unmarshal(rawEntries, &entries)
+	if err != nil {
+		return nil, err
+	}
+
+	for appGUID, entry := range entries {
+		entry.AppGUID = appGUID
+		entries[appGUID] = entry
+	}
+
+	return entries, nil
+}
+
+func (store *Store) write(entries map[string]Entry) error {
+	rawEntries, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(store.path)
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(dir, "temp-rollback-history")
+	if err != nil {
+		return err
+	}
+	tempFile.Close()
+
+	err = ioutil.WriteFile(tempFile.Name(), rawEntries, 0600)
+	if err != nil {
+		_ = os.Remove(tempFile.Name())
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), store.path)
+}
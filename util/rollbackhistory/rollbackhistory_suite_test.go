@@ -0,0 +1,13 @@
+package rollbackhistory_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestRollbackHistory(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Rollback History Suite")
+}
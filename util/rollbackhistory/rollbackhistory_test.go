@@ -0,0 +1,96 @@
+package rollbackhistory_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/rollbackhistory"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Store", func() {
+	var (
+		tmpDir string
+		path   string
+		store  *Store
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "rollback-history")
+		Expect(err).ToNot(HaveOccurred())
+
+		path = filepath.Join(tmpDir, "nested", "rollback_history.json")
+		store = NewStore(path)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	Context("when the file does not exist", func() {
+		It("has no entries", func() {
+			_, exists, err := store.Get("some-app-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+	})
+
+	Describe("Record", func() {
+		It("persists the entry keyed by app GUID", func() {
+			rolledBackAt := time.Date(2017, 1, 2, 3, 4, 5, 0, time.UTC)
+			Expect(store.Record(Entry{
+				AppGUID:         "some-app-guid",
+				AppName:         "some-app",
+				FromDropletGUID: "new-droplet-guid",
+				ToDropletGUID:   "old-droplet-guid",
+				RolledBackAt:    rolledBackAt,
+			})).To(Succeed())
+
+			entry, exists, err := NewStore(path).Get("some-app-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			Expect(entry).To(Equal(Entry{
+				AppGUID:         "some-app-guid",
+				AppName:         "some-app",
+				FromDropletGUID: "new-droplet-guid",
+				ToDropletGUID:   "old-droplet-guid",
+				RolledBackAt:    rolledBackAt,
+			}))
+
+			info, err := os.Stat(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+
+		It("replaces the previous entry of the same app and keeps other apps", func() {
+			Expect(store.Record(Entry{AppGUID: "app-1", ToDropletGUID: "droplet-1"})).To(Succeed())
+			Expect(store.Record(Entry{AppGUID: "app-2", ToDropletGUID: "droplet-2"})).To(Succeed())
+			Expect(store.Record(Entry{AppGUID: "app-1", ToDropletGUID: "droplet-3"})).To(Succeed())
+
+			entry, _, err := store.Get("app-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entry.ToDropletGUID).To(Equal("droplet-3"))
+			Expect(entry.RolledBackAt).ToNot(BeZero())
+
+			entry, _, err = store.Get("app-2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entry.ToDropletGUID).To(Equal("droplet-2"))
+		})
+	})
+
+	Context("when the file is not valid JSON", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(path), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(path, []byte("{"), 0600)).To(Succeed())
+		})
+
+		It("returns an error", func() {
+			_, _, err := store.Get("some-app-guid")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,175 @@
+// Package roster reads files that list which users hold which org and space
+// roles.
+package roster
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Role is an org or space role, named the way set-org-role and set-space-role
+// name them.
+type Role string
+
+const (
+	OrgUser        Role = "OrgUser"
+	OrgManager     Role = "OrgManager"
+	BillingManager Role = "BillingManager"
+	OrgAuditor     Role = "OrgAuditor"
+	SpaceManager   Role = "SpaceManager"
+	SpaceDeveloper Role = "SpaceDeveloper"
+	SpaceAuditor   Role = "SpaceAuditor"
+)
+
+// IsSpaceRole returns true when the role is held in a space rather than in an
+// organization.
+func (role Role) IsSpaceRole() bool {
+	return role == SpaceManager || role == SpaceDeveloper || role == SpaceAuditor
+}
+
+func (role Role) valid() bool {
+	switch role {
+	case OrgUser, OrgManager, BillingManager, OrgAuditor, SpaceManager, SpaceDeveloper, SpaceAuditor:
+		return true
+	}
+	return false
+}
+
+// Entry gives a user a role in an organization, or in a space when Space is
+// set. Origin and Password are only used to create users that do not exist
+// yet.
+type Entry struct {
+	Username string `yaml:"username"`
+	Origin   string `yaml:"origin,omitempty"`
+	Password string `yaml:"password,omitempty"`
+	Org      string `yaml:"org"`
+	Space    string `yaml:"space,omitempty"`
+	Role     Role   `yaml:"role"`
+}
+
+// InvalidRosterError is returned when a roster file can be parsed but one of
+// its entries is not valid.
+type InvalidRosterError struct {
+	Entry  int
+	Reason string
+}
+
+func (e InvalidRosterError) Error() string {
+	if e.Entry == 0 {
+		return fmt.Sprintf("invalid roster: %s", e.Reason)
+	}
+	return fmt.Sprintf("invalid roster entry %d: %s", e.Entry, e.Reason)
+}
+
+// ReadRoster reads and validates the roster at the provided path. Files with
+// a .csv extension are read as CSV with a header row naming the columns;
+// anything else is read as a YAML list of entries.
+func ReadRoster(pathToRoster string) ([]Entry, error) {
+	var (
+		entries []Entry
+		err     error
+	)
+	if strings.EqualFold(filepath.Ext(pathToRoster), ".csv") {
+		entries, err = readCSV(pathToRoster)
+	} else {
+		entries, err = readYAML(pathToRoster)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i, entry := range entries {
+		err = entry.validate()
+		if err != nil {
+			return nil, InvalidRosterError{Entry: i + 1, Reason: err.Error()}
+		}
+	}
+
+	return entries, nil
+}
+
+func readYAML(pathToRoster string) ([]Entry, error) {
+	raw, err := ioutil.ReadFile(pathToRoster)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	err = yaml.Unmarshal(raw, &entries)
+	return entries, err
+}
+
+func readCSV(pathToRoster string) ([]Entry, error) {
+	file, err := os.Open(pathToRoster)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, column := range header {
+		switch column {
+		case "username", "origin", "password", "org", "space", "role":
+		default:
+			return nil, InvalidRosterError{Reason: fmt.Sprintf("unknown column '%s'", column)}
+		}
+	}
+
+	var entries []Entry
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		fields := map[string]string{}
+		for i, column := range header {
+			fields[column] = strings.TrimSpace(record[i])
+		}
+		entries = append(entries, Entry{
+			Username: fields["username"],
+			Origin:   fields["origin"],
+			Password: fields["password"],
+			Org:      fields["org"],
+			Space:    fields["space"],
+			Role:     Role(fields["role"]),
+		})
+	}
+
+	return entries, nil
+}
+
+func (entry Entry) validate() error {
+	switch {
+	case entry.Username == "":
+		return fmt.Errorf("username is required")
+	case entry.Org == "":
+		return fmt.Errorf("org is required")
+	case !entry.Role.valid():
+		return fmt.Errorf("unknown role '%s'", entry.Role)
+	case entry.Role.IsSpaceRole() && entry.Space == "":
+		return fmt.Errorf("role %s needs a space", entry.Role)
+	case !entry.Role.IsSpaceRole() && entry.Space != "":
+		return fmt.Errorf("role %s cannot be given in a space", entry.Role)
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package roster_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestRoster(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Roster Suite")
+}
@@ -0,0 +1,123 @@
+package roster_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/roster"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Roster", func() {
+	var tmpDir string
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "roster")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	Describe("ReadRoster", func() {
+		var (
+			fileName   string
+			rawRoster  string
+			entries    []Entry
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			pathToRoster := filepath.Join(tmpDir, fileName)
+			Expect(ioutil.WriteFile(pathToRoster, []byte(rawRoster), 0666)).To(Succeed())
+			entries, executeErr = ReadRoster(pathToRoster)
+		})
+
+		Context("when the roster is YAML", func() {
+			BeforeEach(func() {
+				fileName = "roster.yml"
+				rawRoster = `---
+- username: alice
+  org: some-org
+  role: OrgManager
+- username: bob
+  origin: ldap
+  org: some-org
+  space: dev
+  role: SpaceDeveloper
+`
+			})
+
+			It("returns the entries", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(entries).To(Equal([]Entry{
+					{Username: "alice", Org: "some-org", Role: OrgManager},
+					{Username: "bob", Origin: "ldap", Org: "some-org", Space: "dev", Role: SpaceDeveloper},
+				}))
+			})
+		})
+
+		Context("when the roster is CSV", func() {
+			BeforeEach(func() {
+				fileName = "roster.csv"
+				rawRoster = "username,org,space,role,password\nalice,some-org,,BillingManager,\nbob, some-org, dev, SpaceAuditor, secret\n"
+			})
+
+			It("returns the entries", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(entries).To(Equal([]Entry{
+					{Username: "alice", Org: "some-org", Role: BillingManager},
+					{Username: "bob", Password: "secret", Org: "some-org", Space: "dev", Role: SpaceAuditor},
+				}))
+			})
+		})
+
+		Context("when a CSV column is unknown", func() {
+			BeforeEach(func() {
+				fileName = "roster.csv"
+				rawRoster = "username,org,team\nalice,some-org,blue\n"
+			})
+
+			It("returns an InvalidRosterError", func() {
+				Expect(executeErr).To(MatchError(InvalidRosterError{Reason: "unknown column 'team'"}))
+			})
+		})
+
+		Context("when a role is unknown", func() {
+			BeforeEach(func() {
+				fileName = "roster.yml"
+				rawRoster = "- username: alice\n  org: some-org\n  role: Admin\n"
+			})
+
+			It("returns an InvalidRosterError naming the entry", func() {
+				Expect(executeErr).To(MatchError(InvalidRosterError{Entry: 1, Reason: "unknown role 'Admin'"}))
+			})
+		})
+
+		Context("when a space role has no space", func() {
+			BeforeEach(func() {
+				fileName = "roster.yml"
+				rawRoster = "- username: alice\n  org: some-org\n  role: OrgAuditor\n- username: bob\n  org: some-org\n  role: SpaceManager\n"
+			})
+
+			It("returns an InvalidRosterError naming the entry", func() {
+				Expect(executeErr).To(MatchError(InvalidRosterError{Entry: 2, Reason: "role SpaceManager needs a space"}))
+			})
+		})
+
+		Context("when an org role has a space", func() {
+			BeforeEach(func() {
+				fileName = "roster.yml"
+				rawRoster = "- username: alice\n  org: some-org\n  space: dev\n  role: OrgManager\n"
+			})
+
+			It("returns an InvalidRosterError naming the entry", func() {
+				Expect(executeErr).To(MatchError(InvalidRosterError{Entry: 1, Reason: "role OrgManager cannot be given in a space"}))
+			})
+		})
+	})
+})
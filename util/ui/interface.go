@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"io"
+	"time"
+)
+
+// Interface is the set of methods commands use to interact with the user. It
+// is declared here rather than in the command package so that WithLinePrefix
+// can return it; command.UI is an alias of it.
+type Interface interface {
+	ClearScreen()
+	DisplayBoolPrompt(defaultResponse bool, template string, templateValues ...map[string]interface{}) (bool, error)
+	DisplayPasswordPrompt(template string, templateValues ...map[string]interface{}) (string, error)
+	DisplayChangesForPush(changeSet []Change) error
+	DisplayError(err error)
+	DisplayHeader(text string)
+	DisplayInstancesTableForApp(table [][]string)
+	DisplayInstancesTableForAppWithLimits(table [][]string, nearLimit map[int][]int)
+	DisplayKeyValueTable(prefix string, table [][]string, padding int)
+	DisplayKeyValueTableForApp(table [][]string)
+	DisplayKeyValueTableForV3App(table [][]string, crashedProcesses []string)
+	DisplayLogMessage(message LogMessage, displayHeader bool)
+	DisplayNewline()
+	DisplayNonWrappingTable(prefix string, table [][]string, padding int)
+	DisplayOK()
+	DisplayTableWithHeader(prefix string, table [][]string, padding int)
+	DisplayText(template string, data ...map[string]interface{})
+	DisplayTextWithFlavor(text string, keys ...map[string]interface{})
+	DisplayTextWithBold(text string, keys ...map[string]interface{})
+	DisplayWarning(formattedString string, keys ...map[string]interface{})
+	DisplayWarnings(warnings []string)
+	Flush() error
+	GetIn() io.Reader
+	GetOut() io.Writer
+	GetErr() io.Writer
+	RequestLoggerFileWriter(filePaths []string) *RequestLoggerFileWriter
+	RequestLoggerTerminalDisplay() *RequestLoggerTerminalDisplay
+	TranslateText(template string, data ...map[string]interface{}) string
+	UserFriendlyDate(input time.Time) string
+	WithLinePrefix(prefix string) Interface
+	Writer() io.Writer
+}
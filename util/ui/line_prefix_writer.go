@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// LinePrefixWriter writes each complete line it receives to the underlying
+// writer with a prefix. Writers that share a lock never interleave their
+// lines, which allows the output of concurrent processes to be multiplexed
+// onto a single terminal.
+type LinePrefixWriter struct {
+	writer io.Writer
+	lock   *sync.Mutex
+	prefix []byte
+	buffer []byte
+}
+
+// NewLinePrefixWriter returns a LinePrefixWriter that prefixes every line
+// written to writer with prefix.
+func NewLinePrefixWriter(writer io.Writer, lock *sync.Mutex, prefix string) *LinePrefixWriter {
+	return &LinePrefixWriter{
+		writer: writer,
+		lock:   lock,
+		prefix: []byte(prefix),
+	}
+}
+
+// Write buffers p and writes out every line it completes. Partial lines are
+// held until the rest of the line is written or Flush is called.
+func (w *LinePrefixWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.buffer = append(w.buffer, p...)
+	for {
+		newline := bytes.IndexByte(w.buffer, '\n')
+		if newline == -1 {
+			break
+		}
+
+		err := w.writeLine(w.buffer[:newline+1])
+		w.buffer = w.buffer[newline+1:]
+		if err != nil {
+			return len(p), err
+		}
+	}
+
+	return len(p), nil
+}
+
+// Flush writes out any buffered partial line, terminated with a newline.
+func (w *LinePrefixWriter) Flush() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if len(w.buffer) == 0 {
+		return nil
+	}
+
+	err := w.writeLine(append(w.buffer, '\n'))
+	w.buffer = nil
+	return err
+}
+
+func (w *LinePrefixWriter) writeLine(line []byte) error {
+	_, err := w.writer.Write(append(append([]byte{}, w.prefix...), line...))
+	return err
+}
@@ -0,0 +1,82 @@
+package ui_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+
+	. "code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LinePrefixWriter", func() {
+	var (
+		output *bytes.Buffer
+		lock   *sync.Mutex
+		writer *LinePrefixWriter
+	)
+
+	BeforeEach(func() {
+		output = new(bytes.Buffer)
+		lock = &sync.Mutex{}
+		writer = NewLinePrefixWriter(output, lock, "[0] ")
+	})
+
+	It("prefixes every complete line", func() {
+		n, err := writer.Write([]byte("hello\nworld\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(12))
+		Expect(output.String()).To(Equal("[0] hello\n[0] world\n"))
+	})
+
+	It("holds partial lines until they are completed", func() {
+		_, err := writer.Write([]byte("hel"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.String()).To(BeEmpty())
+
+		_, err = writer.Write([]byte("lo\nwor"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.String()).To(Equal("[0] hello\n"))
+	})
+
+	Describe("Flush", func() {
+		It("writes out the partial line with a trailing newline", func() {
+			_, err := writer.Write([]byte("no newline"))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(writer.Flush()).To(Succeed())
+			Expect(output.String()).To(Equal("[0] no newline\n"))
+		})
+
+		It("does nothing when there is nothing buffered", func() {
+			Expect(writer.Flush()).To(Succeed())
+			Expect(output.String()).To(BeEmpty())
+		})
+	})
+
+	Context("when multiple writers share a lock", func() {
+		It("does not interleave lines", func() {
+			wg := sync.WaitGroup{}
+			for i := 0; i < 5; i++ {
+				wg.Add(1)
+				go func(index int) {
+					defer wg.Done()
+					w := NewLinePrefixWriter(output, lock, fmt.Sprintf("[%d] ", index))
+					for j := 0; j < 50; j++ {
+						_, _ = w.Write([]byte("some "))
+						_, _ = w.Write([]byte("line\n"))
+					}
+				}(i)
+			}
+			wg.Wait()
+
+			lines := strings.Split(strings.TrimSuffix(output.String(), "\n"), "\n")
+			Expect(lines).To(HaveLen(250))
+			for _, line := range lines {
+				Expect(line).To(MatchRegexp(`^\[\d\] some line$`))
+			}
+		})
+	})
+})
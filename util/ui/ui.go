@@ -67,6 +67,7 @@ type UI struct {
 
 	terminalLock *sync.Mutex
 	fileLock     *sync.Mutex
+	outputLock   *sync.Mutex
 
 	IsTTY         bool
 	TerminalWidth int
@@ -92,6 +93,7 @@ func NewUI(config Config) (*UI, error) {
 		translate:        translateFunc,
 		terminalLock:     &sync.Mutex{},
 		fileLock:         &sync.Mutex{},
+		outputLock:       &sync.Mutex{},
 		IsTTY:            config.IsTTY(),
 		TerminalWidth:    config.TerminalWidth(),
 		TimezoneLocation: location,
@@ -114,6 +116,7 @@ func NewTestUI(in io.Reader, out io.Writer, err io.Writer) *UI {
 		translate:        translationFunc,
 		terminalLock:     &sync.Mutex{},
 		fileLock:         &sync.Mutex{},
+		outputLock:       &sync.Mutex{},
 		TimezoneLocation: time.UTC,
 	}
 }
@@ -130,6 +133,42 @@ func (ui *UI) GetErr() io.Writer {
 	return ui.Err
 }
 
+// WithLinePrefix returns a UI that writes to the same outputs as ui, with
+// every line prefixed with prefix. UIs derived from the same UI share their
+// locks, so lines displayed concurrently through them never interleave.
+func (ui *UI) WithLinePrefix(prefix string) Interface {
+	prefixedUI := *ui
+	prefixedUI.Out = NewLinePrefixWriter(ui.Out, ui.outputLock, prefix)
+	prefixedUI.Err = NewLinePrefixWriter(ui.Err, ui.outputLock, prefix)
+	return &prefixedUI
+}
+
+// Flush writes out the partial last lines buffered by a UI returned from
+// WithLinePrefix. It does nothing for any other UI.
+func (ui *UI) Flush() error {
+	for _, writer := range []io.Writer{ui.Out, ui.Err} {
+		if prefixWriter, ok := writer.(*LinePrefixWriter); ok {
+			if err := prefixWriter.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ClearScreen moves the cursor to the top left and clears the terminal. It
+// does nothing when the UI is not a TTY.
+func (ui *UI) ClearScreen() {
+	if !ui.IsTTY {
+		return
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	fmt.Fprint(ui.Out, "\033[H\033[2J")
+}
+
 // DisplayBoolPrompt outputs the prompt and waits for user input. It only
 // allows for a boolean response. A default boolean response can be set with
 // defaultResponse.
@@ -18,6 +18,21 @@ func (ui *UI) DisplayInstancesTableForApp(table [][]string) {
 	ui.DisplayTableWithHeader("", table, DefaultTableSpacePadding)
 }
 
+// DisplayInstancesTableForAppWithLimits displays the table like
+// DisplayInstancesTableForApp, also highlighting the cells whose usage is
+// close to its limit. nearLimit maps the index of a row in the table to the
+// indexes of the cells to highlight in that row.
+func (ui *UI) DisplayInstancesTableForAppWithLimits(table [][]string, nearLimit map[int][]int) {
+	yellowColor := color.New(color.FgYellow, color.Bold)
+
+	for row, columns := range nearLimit {
+		for _, column := range columns {
+			table[row][column] = ui.modifyColor(table[row][column], yellowColor)
+		}
+	}
+	ui.DisplayInstancesTableForApp(table)
+}
+
 func (ui *UI) DisplayKeyValueTableForApp(table [][]string) {
 	runningInstances := strings.Split(table[2][1], "/")[0]
 	state := table[1][1]
@@ -124,6 +124,19 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("DisplayInstancesTableForAppWithLimits", func() {
+		It("displays a table with yellow coloring for the cells near their limit", func() {
+			ui.DisplayInstancesTableForAppWithLimits([][]string{
+				{"", "header1", "header2", "header3"},
+				{"#0", "running", "val1", "val2"},
+				{"#1", "down", "val1", "val2"},
+			}, map[int][]int{1: {3}})
+
+			Expect(ui.Out).To(Say("#0\\s+running\\s+val1\\s+\x1b\\[33;1mval2\x1b\\[0m"))
+			Expect(ui.Out).To(Say("#1\\s+\x1b\\[31;1mdown\x1b\\[0m\\s+val1\\s+val2"))
+		})
+	})
+
 	Describe("DisplayKeyValueTableForApp", func() {
 		Context("when the app is running properly", func() {
 			BeforeEach(func() {
@@ -322,6 +322,26 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("ClearScreen", func() {
+		Context("in a TTY", func() {
+			BeforeEach(func() {
+				ui.IsTTY = true
+			})
+
+			It("clears the screen", func() {
+				ui.ClearScreen()
+				Expect(ui.Out).To(Say("\x1b\\[H\x1b\\[2J"))
+			})
+		})
+
+		Context("not in a TTY", func() {
+			It("displays nothing", func() {
+				ui.ClearScreen()
+				Expect(out.Contents()).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("DisplayNewline", func() {
 		It("displays a new line", func() {
 			ui.DisplayNewline()
@@ -638,4 +658,52 @@ var _ = Describe("UI", func() {
 			Expect(ui.UserFriendlyDate(time.Unix(0, 0))).To(MatchRegexp("\\w{3} [0-3]\\d \\w{3} [0-2]\\d:[0-5]\\d:[0-5]\\d \\w+ \\d{4}"))
 		})
 	})
+
+	Describe("WithLinePrefix", func() {
+		var (
+			errBuffer  *Buffer
+			prefixedUI Interface
+		)
+
+		BeforeEach(func() {
+			fakeConfig.ColorEnabledReturns(configv3.ColorDisabled)
+
+			var err error
+			ui, err = NewUI(fakeConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			out = NewBuffer()
+			errBuffer = NewBuffer()
+			ui.Out = out
+			ui.Err = errBuffer
+
+			prefixedUI = ui.WithLinePrefix("[some-prefix] ")
+		})
+
+		It("prefixes every line written to out", func() {
+			prefixedUI.DisplayText("some text")
+			prefixedUI.DisplayOK()
+			Expect(out).To(Say(`\[some-prefix\] some text\n\[some-prefix\] OK\n`))
+		})
+
+		It("prefixes every line written to err", func() {
+			prefixedUI.DisplayWarning("some warning")
+			Expect(errBuffer).To(Say(`\[some-prefix\] some warning\n`))
+		})
+
+		It("writes out the partial last line when flushed", func() {
+			_, err := prefixedUI.GetOut().Write([]byte("partial"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).ToNot(Say("partial"))
+
+			Expect(prefixedUI.Flush()).To(Succeed())
+			Expect(out).To(Say(`\[some-prefix\] partial\n`))
+		})
+
+		It("does not change the original UI", func() {
+			ui.DisplayText("some text")
+			Expect(out).To(Say("some text"))
+			Expect(out).ToNot(Say("some-prefix"))
+		})
+	})
 })